@@ -1,9 +1,16 @@
 package config
 
+import "tecdsa/pkg/sharestore"
+
 type Config struct {
+	// DBDriver 는 mysql (기본값), postgres, sqlite 중 하나입니다.
+	DBDriver   string
 	DBHost     string
+	DBPort     string
 	DBUser     string
 	DBPassword string
 	DBName     string
 	ServerPort string
+	// ShareStore 는 쉐어 바이트를 저장할 저장소 (sql (기본값), file, http) 와 그 설정입니다.
+	ShareStore sharestore.Config
 }
@@ -0,0 +1,163 @@
+package handlers
+
+import (
+	"encoding/base64"
+	"io"
+	"log"
+	"tecdsa/pkg/database/repository"
+	deserializer "tecdsa/pkg/deserializers"
+	"tecdsa/pkg/eddsa/sign"
+	"tecdsa/pkg/network"
+	pb "tecdsa/proto/eddsa"
+
+	"github.com/coinbase/kryptology/pkg/core/curves"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/metadata"
+)
+
+type eddsaSignContext struct {
+	alice     *sign.Alice
+	txOrigin  []byte
+	requestID string
+	address   string
+}
+
+type EddsaSignHandler struct {
+	curve *curves.Curve
+	repo  repository.ParitalSecretShareRepository
+}
+
+func NewEddsaSignHandler(repo repository.ParitalSecretShareRepository) *EddsaSignHandler {
+	return &EddsaSignHandler{
+		curve: curves.ED25519(),
+		repo:  repo,
+	}
+}
+
+func (h *EddsaSignHandler) HandleSign(stream pb.EddsaSignService_SignServer) error {
+	md, ok := metadata.FromIncomingContext(stream.Context())
+	if !ok {
+		return errors.New("no metadata received")
+	}
+
+	requestIDs := md.Get("request_id")
+	if len(requestIDs) == 0 {
+		return errors.New("request_id not found in metadata")
+	}
+	requestID := requestIDs[0]
+
+	addresses := md.Get("address")
+	if len(addresses) == 0 {
+		return errors.New("address not found in metadata")
+	}
+	address := addresses[0]
+
+	txOrigins := md.Get("tx_origin")
+	if len(txOrigins) == 0 {
+		return errors.New("tx_origin not found in metadata")
+	}
+	txOrigin, err := base64.StdEncoding.DecodeString(txOrigins[0])
+	if err != nil {
+		return errors.Wrap(err, "failed to decode tx_origin")
+	}
+
+	ctx := &eddsaSignContext{
+		requestID: requestID,
+		address:   address,
+		txOrigin:  txOrigin,
+	}
+
+	log.Printf("Starting ed25519 signing process for request ID: %s, address: %s", requestID, address)
+
+	for {
+		in, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return errors.Wrap(err, "error receiving message")
+		}
+
+		switch msg := in.Msg.(type) {
+		case *pb.EddsaSignMessage_EddsaSignGatewayTo1Output:
+			err = h.handleRound1(stream, ctx, msg.EddsaSignGatewayTo1Output)
+		case *pb.EddsaSignMessage_EddsaSignRound2To3Output:
+			err = h.handleRound3(stream, ctx, msg.EddsaSignRound2To3Output)
+		default:
+			err = errors.New("unexpected message type")
+		}
+
+		if err != nil {
+			log.Printf("Error in ed25519 signing process: %v", err)
+			return err
+		}
+	}
+}
+
+func (h *EddsaSignHandler) handleRound1(stream pb.EddsaSignService_SignServer, ctx *eddsaSignContext, msg *pb.EddsaSignGatewayTo1Output) error {
+	log.Printf("Ed25519 서명 라운드1")
+
+	output, err := h.repo.FindByAddress(ctx.address)
+	if err != nil {
+		return errors.Wrap(err, "failed to get secret share")
+	}
+	if output.Curve != int32(network.Ed25519) {
+		return errors.New("secret share is not an ed25519 key")
+	}
+
+	aliceOutput, err := deserializer.DecodeEddsaDkgResult(output.Share)
+	if err != nil {
+		return errors.New("retrieved secret share is not an ed25519 share")
+	}
+
+	ctx.alice = sign.NewAlice(h.curve, aliceOutput, ctx.txOrigin)
+
+	round1Result, err := ctx.alice.Round1Commit()
+	if err != nil {
+		return errors.Wrap(err, "failed to commit nonce in Round 1")
+	}
+
+	round1Payload, err := deserializer.EncodeEddsaSignRound1Payload(round1Result)
+	if err != nil {
+		return errors.Wrap(err, "failed to encode result in Round 1")
+	}
+
+	return stream.Send(&pb.EddsaSignMessage{
+		Msg: &pb.EddsaSignMessage_EddsaSignRound1To2Output{
+			EddsaSignRound1To2Output: &pb.EddsaSignRound1To2Output{
+				Payload: round1Payload,
+			},
+		},
+	})
+}
+
+func (h *EddsaSignHandler) handleRound3(stream pb.EddsaSignService_SignServer, ctx *eddsaSignContext, msg *pb.EddsaSignRound2To3Output) error {
+	log.Printf("Ed25519 서명 라운드3")
+
+	if ctx.alice == nil {
+		return errors.New("round 1 has not been completed")
+	}
+
+	round2Payload, err := deserializer.DecodeEddsaSignRound2Payload(msg.Payload)
+	if err != nil {
+		return errors.Wrap(err, "failed to decode in Round 3 input")
+	}
+
+	round3Result, err := ctx.alice.Round3Sign(round2Payload)
+	if err != nil {
+		return errors.Wrap(err, "failed to sign in Round 3")
+	}
+
+	round3Payload, err := deserializer.EncodeEddsaSignRound3Payload(round3Result)
+	if err != nil {
+		return errors.Wrap(err, "failed to encode in Round 3 payload")
+	}
+
+	return stream.Send(&pb.EddsaSignMessage{
+		Msg: &pb.EddsaSignMessage_EddsaSignRound3To4Output{
+			EddsaSignRound3To4Output: &pb.EddsaSignRound3To4Output{
+				Payload: round3Payload,
+			},
+		},
+	})
+}
@@ -0,0 +1,239 @@
+package handlers
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"hash"
+	"io"
+	"log"
+	"strconv"
+	"tecdsa/pkg/database/models"
+	"tecdsa/pkg/database/repository"
+	deserializer "tecdsa/pkg/deserializers"
+	"tecdsa/pkg/network"
+	"tecdsa/pkg/presign"
+	"tecdsa/pkg/service"
+	pb "tecdsa/proto/presign"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/sha3"
+	"google.golang.org/grpc/metadata"
+)
+
+type presignContext struct {
+	alice       *presign.Alice
+	presignID   string
+	keyID       uint32
+	shareDigest string
+}
+
+type PresignHandler struct {
+	repo           repository.ParitalSecretShareRepository
+	presignRepo    repository.PresignatureRepository
+	keyAddressRepo repository.KeyAddressRepository
+	networkService *service.NetworkService
+}
+
+func NewPresignHandler(repo repository.ParitalSecretShareRepository, presignRepo repository.PresignatureRepository, keyAddressRepo repository.KeyAddressRepository, networkService *service.NetworkService) *PresignHandler {
+	return &PresignHandler{
+		repo:           repo,
+		presignRepo:    presignRepo,
+		keyAddressRepo: keyAddressRepo,
+		networkService: networkService,
+	}
+}
+
+func (h *PresignHandler) HandlePresign(stream pb.PresignService_PresignServer) error {
+	md, ok := metadata.FromIncomingContext(stream.Context())
+	if !ok {
+		return errors.New("no metadata received")
+	}
+
+	presignIDs := md.Get("presign_id")
+	if len(presignIDs) == 0 {
+		return errors.New("presign_id not found in metadata")
+	}
+
+	keyIDStr := md.Get("key_id")
+	if len(keyIDStr) == 0 {
+		return errors.New("key_id not found in metadata")
+	}
+	keyID, err := strconv.ParseUint(keyIDStr[0], 10, 32)
+	if err != nil {
+		return errors.Wrap(err, "invalid key_id value in metadata")
+	}
+
+	ctx := &presignContext{
+		presignID: presignIDs[0],
+		keyID:     uint32(keyID),
+	}
+
+	for {
+		in, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return errors.Wrap(err, "error receiving message")
+		}
+
+		switch msg := in.Msg.(type) {
+		case *pb.PresignMessage_PresignGatewayTo1Output:
+			err = h.handleRound1(stream, ctx)
+		case *pb.PresignMessage_PresignRound2To3Output:
+			err = h.handleRound3(stream, ctx, msg.PresignRound2To3Output)
+		default:
+			err = errors.New("unexpected message type")
+		}
+
+		if err != nil {
+			log.Printf("Error in presign process: %v", err)
+			return err
+		}
+	}
+}
+
+func (h *PresignHandler) handleRound1(stream pb.PresignService_PresignServer, ctx *presignContext) error {
+	log.Printf("presign 라운드1")
+
+	share, err := h.repo.FindByKeyID(ctx.keyID)
+	if err != nil {
+		return errors.Wrap(err, "failed to get secret share")
+	}
+	curve := network.Curve(share.Curve)
+	if !curve.IsEcdsaCurve() {
+		return errors.Errorf("presign is not supported for %s keys", curve)
+	}
+
+	aliceOutput, err := deserializer.DecodeAliceDkgResult(share.Share)
+	if err != nil {
+		return errors.New("retrieved secret share is not an AliceOutput")
+	}
+
+	ctx.alice = presign.NewAlice(curve.KryptologyCurve(), aliceOutput)
+	ctx.shareDigest = shareDigest(share)
+
+	seed, err := ctx.alice.Round1GenerateRandomSeed()
+	if err != nil {
+		return err
+	}
+
+	payload, err := deserializer.EncodePresignRound1Payload(seed)
+	if err != nil {
+		return errors.Wrap(err, "failed to encode in Round 1")
+	}
+
+	return stream.Send(&pb.PresignMessage{
+		Msg: &pb.PresignMessage_PresignRound1To2Output{
+			PresignRound1To2Output: &pb.PresignRound1To2Output{
+				Payload: payload,
+			},
+		},
+	})
+}
+
+func (h *PresignHandler) handleRound3(stream pb.PresignService_PresignServer, ctx *presignContext, msg *pb.PresignRound2To3Output) error {
+	log.Printf("presign 라운드3")
+
+	if ctx.alice == nil {
+		return errors.New("round 1 has not been completed")
+	}
+
+	round2Payload, err := deserializer.DecodePresignRound2Payload(msg.Payload)
+	if err != nil {
+		return errors.Wrap(err, "failed to decode in Round 3")
+	}
+
+	round3Result, presignature, err := ctx.alice.Round3Presign(round2Payload)
+	if err != nil {
+		return errors.Wrap(err, "failed in Round3Presign")
+	}
+
+	data, err := deserializer.EncodeAlicePresignature(presignature)
+	if err != nil {
+		return errors.Wrap(err, "failed to encode presignature")
+	}
+	if err := h.presignRepo.Create(ctx.presignID, ctx.keyID, ctx.shareDigest, data); err != nil {
+		return err
+	}
+
+	round3Payload, err := deserializer.EncodePresignRound3Payload(round3Result)
+	if err != nil {
+		return errors.Wrap(err, "failed to encode in Round 3")
+	}
+
+	return stream.Send(&pb.PresignMessage{
+		Msg: &pb.PresignMessage_PresignRound3To4Output{
+			PresignRound3To4Output: &pb.PresignRound3To4Output{
+				Payload: round3Payload,
+			},
+		},
+	})
+}
+
+// HandleSignWithPresign 은 presignature 를 소비하고 메시지에 대한 Alice 의 값을 Bob 에게 전달할 payload 로 돌려줍니다.
+func (h *PresignHandler) HandleSignWithPresign(_ context.Context, req *pb.SignWithPresignRequest) (*pb.SignWithPresignResponse, error) {
+	share, err := h.repo.FindByAddress(req.Address)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get secret share")
+	}
+
+	record, err := h.presignRepo.Consume(req.PresignId)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkPresignature(h.presignRepo, record, share); err != nil {
+		return nil, err
+	}
+
+	presignature, err := deserializer.DecodeAlicePresignature(record.Data)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode presignature")
+	}
+
+	curve := network.Curve(share.Curve).KryptologyCurve()
+	output, err := presignature.Sign(curve, h.newMessageHash(req.Address, share), req.TxOrigin)
+	if err != nil {
+		return nil, err
+	}
+
+	payload, err := deserializer.EncodePresignOnlinePayload(output)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to encode online payload")
+	}
+	return &pb.SignWithPresignResponse{Payload: payload}, nil
+}
+
+// newMessageHash 는 서명 주소의 네트워크에 맞는 해시를 서명 세션마다 새로 만듭니다.
+func (h *PresignHandler) newMessageHash(address string, share *models.ParitalSecretShare) hash.Hash {
+	networkID := share.Network
+	if keyAddress, err := h.keyAddressRepo.FindByAddress(address); err == nil {
+		networkID = keyAddress.Network
+	}
+	networkObj, err := h.networkService.GetNetworkByID(networkID)
+	if err != nil {
+		return sha3.NewLegacyKeccak256()
+	}
+	return h.networkService.NewMessageHash(networkObj)
+}
+
+// checkPresignature 는 presignature 가 서명 주소의 키로, 현재 쉐어로 만들어졌는지 확인합니다.
+// 쉐어가 바뀌었으면 (키 리프레시) 키의 남은 presignature 를 모두 무효로 만듭니다.
+func checkPresignature(presignRepo repository.PresignatureRepository, record *models.Presignature, share *models.ParitalSecretShare) error {
+	if record.KeyID != share.KeyID {
+		return errors.New("presignature does not belong to the signing key")
+	}
+	if record.ShareDigest != shareDigest(share) {
+		if err := presignRepo.InvalidateByKeyID(share.KeyID); err != nil {
+			log.Printf("failed to invalidate presignatures for key %d: %v", share.KeyID, err)
+		}
+		return errors.New("presignature was created with a stale secret share")
+	}
+	return nil
+}
+
+func shareDigest(share *models.ParitalSecretShare) string {
+	digest := sha256.Sum256(share.Share)
+	return hex.EncodeToString(digest[:])
+}
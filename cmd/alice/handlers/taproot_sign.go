@@ -0,0 +1,178 @@
+package handlers
+
+import (
+	"encoding/base64"
+	"io"
+	"log"
+	"tecdsa/pkg/database/models"
+	"tecdsa/pkg/database/repository"
+	deserializer "tecdsa/pkg/deserializers"
+	"tecdsa/pkg/network"
+	"tecdsa/pkg/schnorr"
+	pb "tecdsa/proto/taproot"
+
+	"github.com/coinbase/kryptology/pkg/core/curves"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/metadata"
+)
+
+type taprootSignContext struct {
+	alice     *schnorr.Alice
+	txOrigin  []byte
+	requestID string
+	address   string
+}
+
+type TaprootSignHandler struct {
+	curve          *curves.Curve
+	repo           repository.ParitalSecretShareRepository
+	keyAddressRepo repository.KeyAddressRepository
+}
+
+func NewTaprootSignHandler(repo repository.ParitalSecretShareRepository, keyAddressRepo repository.KeyAddressRepository) *TaprootSignHandler {
+	return &TaprootSignHandler{
+		curve:          curves.K256(),
+		repo:           repo,
+		keyAddressRepo: keyAddressRepo,
+	}
+}
+
+// isTaprootAddress 는 서명 주소가 P2TR 로 등록된 주소인지 확인합니다.
+// Schnorr 서명은 Taproot 출력에만 쓰이므로 다른 주소 유형에는 서명하지 않습니다.
+func (h *TaprootSignHandler) isTaprootAddress(address string, share *models.ParitalSecretShare) bool {
+	if keyAddress, err := h.keyAddressRepo.FindByAddress(address); err == nil {
+		return keyAddress.AddressType == network.P2TR
+	}
+	return share.AddressType == network.P2TR
+}
+
+func (h *TaprootSignHandler) HandleSign(stream pb.TaprootSignService_SignServer) error {
+	md, ok := metadata.FromIncomingContext(stream.Context())
+	if !ok {
+		return errors.New("no metadata received")
+	}
+
+	requestIDs := md.Get("request_id")
+	if len(requestIDs) == 0 {
+		return errors.New("request_id not found in metadata")
+	}
+	requestID := requestIDs[0]
+
+	addresses := md.Get("address")
+	if len(addresses) == 0 {
+		return errors.New("address not found in metadata")
+	}
+	address := addresses[0]
+
+	txOrigins := md.Get("tx_origin")
+	if len(txOrigins) == 0 {
+		return errors.New("tx_origin not found in metadata")
+	}
+	txOrigin, err := base64.StdEncoding.DecodeString(txOrigins[0])
+	if err != nil {
+		return errors.Wrap(err, "failed to decode tx_origin")
+	}
+
+	ctx := &taprootSignContext{
+		requestID: requestID,
+		address:   address,
+		txOrigin:  txOrigin,
+	}
+
+	log.Printf("Starting taproot signing process for request ID: %s, address: %s", requestID, address)
+
+	for {
+		in, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return errors.Wrap(err, "error receiving message")
+		}
+
+		switch msg := in.Msg.(type) {
+		case *pb.TaprootSignMessage_TaprootSignGatewayTo1Output:
+			err = h.handleRound1(stream, ctx, msg.TaprootSignGatewayTo1Output)
+		case *pb.TaprootSignMessage_TaprootSignRound2To3Output:
+			err = h.handleRound3(stream, ctx, msg.TaprootSignRound2To3Output)
+		default:
+			err = errors.New("unexpected message type")
+		}
+
+		if err != nil {
+			log.Printf("Error in taproot signing process: %v", err)
+			return err
+		}
+	}
+}
+
+func (h *TaprootSignHandler) handleRound1(stream pb.TaprootSignService_SignServer, ctx *taprootSignContext, msg *pb.TaprootSignGatewayTo1Output) error {
+	log.Printf("Taproot 라운드1")
+
+	output, err := h.repo.FindByAddress(ctx.address)
+	if err != nil {
+		return errors.Wrap(err, "failed to get secret share")
+	}
+	if !h.isTaprootAddress(ctx.address, output) {
+		return errors.New("address is not a taproot address")
+	}
+
+	aliceOutput, err := deserializer.DecodeAliceDkgResult(output.Share)
+	if err != nil {
+		return errors.New("retrieved secret share is not an AliceOutput")
+	}
+
+	ctx.alice, err = schnorr.NewAlice(h.curve, aliceOutput, ctx.txOrigin)
+	if err != nil {
+		return errors.Wrap(err, "failed to create schnorr signer")
+	}
+
+	round1Result, err := ctx.alice.Round1Commit()
+	if err != nil {
+		return errors.Wrap(err, "failed to commit nonce in Round 1")
+	}
+
+	round1Payload, err := deserializer.EncodeTaprootSignRound1Payload(round1Result)
+	if err != nil {
+		return errors.Wrap(err, "failed to encode result in Round 1")
+	}
+
+	return stream.Send(&pb.TaprootSignMessage{
+		Msg: &pb.TaprootSignMessage_TaprootSignRound1To2Output{
+			TaprootSignRound1To2Output: &pb.TaprootSignRound1To2Output{
+				Payload: round1Payload,
+			},
+		},
+	})
+}
+
+func (h *TaprootSignHandler) handleRound3(stream pb.TaprootSignService_SignServer, ctx *taprootSignContext, msg *pb.TaprootSignRound2To3Output) error {
+	log.Printf("Taproot 라운드3")
+
+	if ctx.alice == nil {
+		return errors.New("round 1 has not been completed")
+	}
+
+	round2Payload, err := deserializer.DecodeTaprootSignRound2Payload(msg.Payload)
+	if err != nil {
+		return errors.Wrap(err, "failed to decode in Round 3 input")
+	}
+
+	round3Result, err := ctx.alice.Round3Sign(round2Payload)
+	if err != nil {
+		return errors.Wrap(err, "failed to sign in Round 3")
+	}
+
+	round3Payload, err := deserializer.EncodeTaprootSignRound3Payload(round3Result)
+	if err != nil {
+		return errors.Wrap(err, "failed to encode in Round 3 payload")
+	}
+
+	return stream.Send(&pb.TaprootSignMessage{
+		Msg: &pb.TaprootSignMessage_TaprootSignRound3To4Output{
+			TaprootSignRound3To4Output: &pb.TaprootSignRound3To4Output{
+				Payload: round3Payload,
+			},
+		},
+	})
+}
@@ -0,0 +1,42 @@
+package server
+
+import (
+	"log"
+	handlers "tecdsa/cmd/alice/handlers"
+	"tecdsa/pkg/database/repository"
+	"tecdsa/pkg/service"
+
+	pbEddsa "tecdsa/proto/eddsa"
+)
+
+// EddsaServer 는 Ed25519 키 생성/서명 서비스를 제공합니다.
+// rpc 이름(KeyGen, Sign)이 ECDSA 서비스와 같아 Server 와 별도 타입으로 등록합니다.
+type EddsaServer struct {
+	pbEddsa.UnimplementedEddsaKeygenServiceServer
+	pbEddsa.UnimplementedEddsaSignServiceServer
+	keygenHandler *handlers.EddsaKeygenHandler
+	signHandler   *handlers.EddsaSignHandler
+}
+
+func NewEddsaServer(repo repository.ParitalSecretShareRepository, networkService *service.NetworkService) *EddsaServer {
+	return &EddsaServer{
+		keygenHandler: handlers.NewEddsaKeygenHandler(repo, networkService),
+		signHandler:   handlers.NewEddsaSignHandler(repo),
+	}
+}
+
+func (s *EddsaServer) KeyGen(stream pbEddsa.EddsaKeygenService_KeyGenServer) error {
+	err := s.keygenHandler.HandleKeyGen(stream)
+	if err != nil {
+		log.Printf("Error in EddsaKeyGen: %v", err)
+	}
+	return err
+}
+
+func (s *EddsaServer) Sign(stream pbEddsa.EddsaSignService_SignServer) error {
+	err := s.signHandler.HandleSign(stream)
+	if err != nil {
+		log.Printf("Error in EddsaSign: %v", err)
+	}
+	return err
+}
@@ -0,0 +1,39 @@
+package server
+
+import (
+	"context"
+	"log"
+	handlers "tecdsa/cmd/alice/handlers"
+	"tecdsa/pkg/database/repository"
+	"tecdsa/pkg/service"
+
+	pbPresign "tecdsa/proto/presign"
+)
+
+// PresignServer 는 presignature 생성과 presignature 를 이용한 한 번 왕복 서명을 제공합니다.
+type PresignServer struct {
+	pbPresign.UnimplementedPresignServiceServer
+	presignHandler *handlers.PresignHandler
+}
+
+func NewPresignServer(repo repository.ParitalSecretShareRepository, presignRepo repository.PresignatureRepository, keyAddressRepo repository.KeyAddressRepository, networkService *service.NetworkService) *PresignServer {
+	return &PresignServer{
+		presignHandler: handlers.NewPresignHandler(repo, presignRepo, keyAddressRepo, networkService),
+	}
+}
+
+func (s *PresignServer) Presign(stream pbPresign.PresignService_PresignServer) error {
+	err := s.presignHandler.HandlePresign(stream)
+	if err != nil {
+		log.Printf("Error in Presign: %v", err)
+	}
+	return err
+}
+
+func (s *PresignServer) SignWithPresign(ctx context.Context, req *pbPresign.SignWithPresignRequest) (*pbPresign.SignWithPresignResponse, error) {
+	res, err := s.presignHandler.HandleSignWithPresign(ctx, req)
+	if err != nil {
+		log.Printf("Error in SignWithPresign: %v", err)
+	}
+	return res, err
+}
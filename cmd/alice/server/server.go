@@ -2,11 +2,13 @@
 package server
 
 import (
+	"context"
 	"log"
 	handlers "tecdsa/cmd/alice/handlers"
 	"tecdsa/pkg/database/repository"
 	"tecdsa/pkg/service"
 
+	pbKey "tecdsa/proto/key"
 	pbKeygen "tecdsa/proto/keygen"
 	pbSign "tecdsa/proto/sign"
 )
@@ -14,15 +16,18 @@ import (
 type Server struct {
 	pbKeygen.UnimplementedKeygenServiceServer
 	pbSign.UnimplementedSignServiceServer
+	pbKey.UnimplementedKeyServiceServer
 	keygenHandler  *handlers.KeygenHandler
 	signHandler    *handlers.SignHandler
+	keyHandler     *handlers.KeyHandler
 	networkService *service.NetworkService
 }
 
-func NewServer(repo repository.ParitalSecretShareRepository, networkService *service.NetworkService) *Server {
+func NewServer(repo repository.ParitalSecretShareRepository, keyAddressRepo repository.KeyAddressRepository, networkService *service.NetworkService) *Server {
 	return &Server{
 		keygenHandler:  handlers.NewKeygenHandler(repo, networkService),
-		signHandler:    handlers.NewSignHandler(repo),
+		signHandler:    handlers.NewSignHandler(repo, keyAddressRepo, networkService),
+		keyHandler:     handlers.NewKeyHandler(repo, keyAddressRepo, networkService),
 		networkService: networkService,
 	}
 }
@@ -42,3 +47,35 @@ func (s *Server) Sign(stream pbSign.SignService_SignServer) error {
 	}
 	return err
 }
+
+func (s *Server) AddAddress(ctx context.Context, req *pbKey.AddAddressRequest) (*pbKey.AddAddressResponse, error) {
+	res, err := s.keyHandler.HandleAddAddress(ctx, req)
+	if err != nil {
+		log.Printf("Error in AddAddress: %v", err)
+	}
+	return res, err
+}
+
+func (s *Server) AssignKey(ctx context.Context, req *pbKey.AssignKeyRequest) (*pbKey.AssignKeyResponse, error) {
+	res, err := s.keyHandler.HandleAssignKey(ctx, req)
+	if err != nil {
+		log.Printf("Error in AssignKey: %v", err)
+	}
+	return res, err
+}
+
+func (s *Server) ReleaseKey(ctx context.Context, req *pbKey.AssignKeyRequest) (*pbKey.AssignKeyResponse, error) {
+	res, err := s.keyHandler.HandleReleaseKey(ctx, req)
+	if err != nil {
+		log.Printf("Error in ReleaseKey: %v", err)
+	}
+	return res, err
+}
+
+func (s *Server) DeleteKey(ctx context.Context, req *pbKey.DeleteKeyRequest) (*pbKey.DeleteKeyResponse, error) {
+	res, err := s.keyHandler.HandleDeleteKey(ctx, req)
+	if err != nil {
+		log.Printf("Error in DeleteKey: %v", err)
+	}
+	return res, err
+}
@@ -0,0 +1,30 @@
+package server
+
+import (
+	"log"
+	handlers "tecdsa/cmd/alice/handlers"
+	"tecdsa/pkg/database/repository"
+
+	pbTaproot "tecdsa/proto/taproot"
+)
+
+// TaprootServer 는 TaprootSignService 를 제공합니다.
+// SignService 와 rpc 이름(Sign)이 같아 Server 와 별도 타입으로 등록합니다.
+type TaprootServer struct {
+	pbTaproot.UnimplementedTaprootSignServiceServer
+	taprootSignHandler *handlers.TaprootSignHandler
+}
+
+func NewTaprootServer(repo repository.ParitalSecretShareRepository, keyAddressRepo repository.KeyAddressRepository) *TaprootServer {
+	return &TaprootServer{
+		taprootSignHandler: handlers.NewTaprootSignHandler(repo, keyAddressRepo),
+	}
+}
+
+func (s *TaprootServer) Sign(stream pbTaproot.TaprootSignService_SignServer) error {
+	err := s.taprootSignHandler.HandleSign(stream)
+	if err != nil {
+		log.Printf("Error in TaprootSign: %v", err)
+	}
+	return err
+}
@@ -0,0 +1,187 @@
+package handlers
+
+import (
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log"
+	"strconv"
+	"tecdsa/pkg/database/repository"
+	deserializer "tecdsa/pkg/deserializers"
+	"tecdsa/pkg/eddsa/dkg"
+	"tecdsa/pkg/network"
+	"tecdsa/pkg/service"
+	pb "tecdsa/proto/eddsa"
+
+	"github.com/coinbase/kryptology/pkg/core/curves"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/metadata"
+)
+
+type eddsaKeygenContext struct {
+	keyID            uint32
+	network          network.Network
+	bob              *dkg.Bob
+	clientSecurityID uint32
+	requestID        string
+}
+
+type EddsaKeygenHandler struct {
+	curve          *curves.Curve
+	repo           repository.ParitalSecretShareRepository
+	networkService *service.NetworkService
+}
+
+func NewEddsaKeygenHandler(repo repository.ParitalSecretShareRepository, networkService *service.NetworkService) *EddsaKeygenHandler {
+	return &EddsaKeygenHandler{
+		curve:          curves.ED25519(),
+		repo:           repo,
+		networkService: networkService,
+	}
+}
+
+func (h *EddsaKeygenHandler) HandleKeyGen(stream pb.EddsaKeygenService_KeyGenServer) error {
+	md, ok := metadata.FromIncomingContext(stream.Context())
+	if !ok {
+		return errors.New("no metadata received")
+	}
+
+	requestIDs := md.Get("request_id")
+	if len(requestIDs) == 0 {
+		return errors.New("request_id not found in metadata")
+	}
+	requestID := requestIDs[0]
+
+	networkStr := md.Get("network")
+	if len(networkStr) == 0 {
+		return errors.New("network not found in metadata")
+	}
+	networkID, err := strconv.Atoi(networkStr[0])
+	if err != nil {
+		return errors.Wrap(err, "invalid network value in metadata")
+	}
+	networkObj, err := h.networkService.GetNetworkByID(int32(networkID))
+	if err != nil {
+		return errors.Wrap(err, "failed to get network by ID")
+	}
+	if networkObj.Curve() != network.Ed25519 {
+		return errors.Errorf("network %s does not use ed25519 keys", networkObj)
+	}
+
+	var keyID uint64
+	if keyIDStr := md.Get("key_id"); len(keyIDStr) > 0 {
+		keyID, err = strconv.ParseUint(keyIDStr[0], 10, 32)
+		if err != nil {
+			return errors.Wrap(err, "invalid key_id value in metadata")
+		}
+	}
+
+	clientSecurityIDStr := md.Get("client_security_id")
+	if len(clientSecurityIDStr) == 0 {
+		return errors.New("client_security_id not found in metadata")
+	}
+	clientSecurityID, err := strconv.ParseUint(clientSecurityIDStr[0], 10, 32)
+	if err != nil {
+		return errors.Wrap(err, "invalid client_security_id value in metadata")
+	}
+
+	ctx := &eddsaKeygenContext{
+		bob:              dkg.NewBob(h.curve),
+		requestID:        requestID,
+		keyID:            uint32(keyID),
+		network:          networkObj,
+		clientSecurityID: uint32(clientSecurityID),
+	}
+
+	for {
+		in, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		switch msg := in.Msg.(type) {
+		case *pb.EddsaKeygenMessage_EddsaKeyGenRound1To2Output:
+			err = h.handleRound2(stream, ctx, msg.EddsaKeyGenRound1To2Output)
+		case *pb.EddsaKeygenMessage_EddsaKeyGenRound3To4Output:
+			err = h.handleRound4(stream, ctx, msg.EddsaKeyGenRound3To4Output)
+		default:
+			err = fmt.Errorf("unexpected message type")
+		}
+
+		if err != nil {
+			log.Printf("Error in ed25519 key generation: %v", err)
+			return err
+		}
+	}
+}
+
+func (h *EddsaKeygenHandler) handleRound2(stream pb.EddsaKeygenService_KeyGenServer, ctx *eddsaKeygenContext, msg *pb.EddsaKeyGenRound1To2Output) error {
+	log.Printf("Ed25519 키 생성 라운드2")
+
+	round1Payload, err := deserializer.DecodeEddsaDkgRound1Payload(msg.Payload)
+	if err != nil {
+		return errors.Wrap(err, "failed to decode in Round 2")
+	}
+
+	round2Result, err := ctx.bob.Round2Prove(round1Payload)
+	if err != nil {
+		return errors.Wrap(err, "failed to prove in Round 2")
+	}
+
+	round2Payload, err := deserializer.EncodeEddsaDkgRound2Payload(round2Result)
+	if err != nil {
+		return errors.Wrap(err, "failed to encode in Round 2")
+	}
+
+	return stream.Send(&pb.EddsaKeygenMessage{
+		Msg: &pb.EddsaKeygenMessage_EddsaKeyGenRound2To3Output{
+			EddsaKeyGenRound2To3Output: &pb.EddsaKeyGenRound2To3Output{
+				Payload: round2Payload,
+			},
+		},
+	})
+}
+
+func (h *EddsaKeygenHandler) handleRound4(stream pb.EddsaKeygenService_KeyGenServer, ctx *eddsaKeygenContext, msg *pb.EddsaKeyGenRound3To4Output) error {
+	log.Printf("Ed25519 키 생성 라운드4")
+
+	round3Payload, err := deserializer.DecodeEddsaDkgRound3Payload(msg.Payload)
+	if err != nil {
+		return errors.Wrap(err, "failed to decode in Round 4")
+	}
+
+	if err := ctx.bob.Round4Verify(round3Payload); err != nil {
+		return errors.Wrap(err, "failed to verify in Round 4")
+	}
+
+	bobOutput := ctx.bob.Output()
+	address, err := h.networkService.DeriveAddress(bobOutput.PublicKey, ctx.network, 0)
+	if err != nil {
+		return err
+	}
+
+	share, err := deserializer.EncodeEddsaDkgOutput(bobOutput)
+	if err != nil {
+		return errors.Wrap(err, "failed to encode bob output")
+	}
+
+	if err := h.repo.Create(ctx.keyID, address, share, ctx.network.ID(), 0, int32(network.Ed25519), uint(ctx.clientSecurityID)); err != nil {
+		return errors.Wrap(err, "failed to store secret bob share")
+	}
+
+	publicKeyHex := hex.EncodeToString(bobOutput.PublicKey.ToAffineCompressed())
+	log.Printf("bob ed25519 public key hex: %s", publicKeyHex)
+
+	return stream.Send(&pb.EddsaKeygenMessage{
+		Msg: &pb.EddsaKeygenMessage_EddsaKeyGenRound4ToGatewayOutput{
+			EddsaKeyGenRound4ToGatewayOutput: &pb.EddsaKeyGenRound4ToGatewayOutput{
+				Address:   address,
+				RequestId: ctx.requestID,
+				PublicKey: publicKeyHex,
+			},
+		},
+	})
+}
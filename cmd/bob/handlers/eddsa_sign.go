@@ -0,0 +1,164 @@
+package handlers
+
+import (
+	"encoding/base64"
+	"io"
+	"log"
+	"tecdsa/pkg/database/repository"
+	deserializer "tecdsa/pkg/deserializers"
+	"tecdsa/pkg/eddsa/sign"
+	"tecdsa/pkg/network"
+	pb "tecdsa/proto/eddsa"
+
+	"github.com/coinbase/kryptology/pkg/core/curves"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/metadata"
+)
+
+type eddsaSignContext struct {
+	bob       *sign.Bob
+	txOrigin  []byte
+	requestID string
+	address   string
+}
+
+type EddsaSignHandler struct {
+	curve *curves.Curve
+	repo  repository.ParitalSecretShareRepository
+}
+
+func NewEddsaSignHandler(repo repository.ParitalSecretShareRepository) *EddsaSignHandler {
+	return &EddsaSignHandler{
+		curve: curves.ED25519(),
+		repo:  repo,
+	}
+}
+
+func (h *EddsaSignHandler) HandleSign(stream pb.EddsaSignService_SignServer) error {
+	md, ok := metadata.FromIncomingContext(stream.Context())
+	if !ok {
+		return errors.New("no metadata received")
+	}
+
+	requestIDs := md.Get("request_id")
+	if len(requestIDs) == 0 {
+		return errors.New("request_id not found in metadata")
+	}
+	requestID := requestIDs[0]
+
+	addresses := md.Get("address")
+	if len(addresses) == 0 {
+		return errors.New("address not found in metadata")
+	}
+	address := addresses[0]
+
+	txOrigins := md.Get("tx_origin")
+	if len(txOrigins) == 0 {
+		return errors.New("tx_origin not found in metadata")
+	}
+	txOrigin, err := base64.StdEncoding.DecodeString(txOrigins[0])
+	if err != nil {
+		return errors.Wrap(err, "failed to decode tx_origin")
+	}
+
+	ctx := &eddsaSignContext{
+		requestID: requestID,
+		address:   address,
+		txOrigin:  txOrigin,
+	}
+
+	log.Printf("Starting ed25519 signing process for request ID: %s, address: %s", requestID, address)
+
+	for {
+		in, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return errors.Wrap(err, "error receiving message")
+		}
+
+		switch msg := in.Msg.(type) {
+		case *pb.EddsaSignMessage_EddsaSignRound1To2Output:
+			err = h.handleRound2(stream, ctx, msg.EddsaSignRound1To2Output)
+		case *pb.EddsaSignMessage_EddsaSignRound3To4Output:
+			err = h.handleRound4(stream, ctx, msg.EddsaSignRound3To4Output)
+		default:
+			err = errors.New("unexpected message type")
+		}
+
+		if err != nil {
+			log.Printf("Error in ed25519 signing process: %v", err)
+			return err
+		}
+	}
+}
+
+func (h *EddsaSignHandler) handleRound2(stream pb.EddsaSignService_SignServer, ctx *eddsaSignContext, msg *pb.EddsaSignRound1To2Output) error {
+	log.Printf("Ed25519 서명 라운드2")
+
+	output, err := h.repo.FindByAddress(ctx.address)
+	if err != nil {
+		return errors.Wrap(err, "failed to get secret share")
+	}
+	if output.Curve != int32(network.Ed25519) {
+		return errors.New("secret share is not an ed25519 key")
+	}
+
+	bobOutput, err := deserializer.DecodeEddsaDkgResult(output.Share)
+	if err != nil {
+		return errors.New("retrieved secret share is not an ed25519 share")
+	}
+
+	ctx.bob = sign.NewBob(h.curve, bobOutput, ctx.txOrigin)
+
+	round1Payload, err := deserializer.DecodeEddsaSignRound1Payload(msg.Payload)
+	if err != nil {
+		return errors.Wrap(err, "failed to decode round 1 payload")
+	}
+
+	round2Result, err := ctx.bob.Round2Respond(round1Payload)
+	if err != nil {
+		return errors.Wrap(err, "failed in Round2Respond")
+	}
+
+	round2Payload, err := deserializer.EncodeEddsaSignRound2Payload(round2Result)
+	if err != nil {
+		return errors.Wrap(err, "failed to encode in Round 2")
+	}
+
+	return stream.Send(&pb.EddsaSignMessage{
+		Msg: &pb.EddsaSignMessage_EddsaSignRound2To3Output{
+			EddsaSignRound2To3Output: &pb.EddsaSignRound2To3Output{
+				Payload: round2Payload,
+			},
+		},
+	})
+}
+
+func (h *EddsaSignHandler) handleRound4(stream pb.EddsaSignService_SignServer, ctx *eddsaSignContext, msg *pb.EddsaSignRound3To4Output) error {
+	log.Printf("Ed25519 서명 라운드4")
+
+	if ctx.bob == nil {
+		return errors.New("round 2 has not been completed")
+	}
+
+	round3Payload, err := deserializer.DecodeEddsaSignRound3Payload(msg.Payload)
+	if err != nil {
+		return errors.Wrap(err, "failed to decode in Round 4")
+	}
+
+	signature, err := ctx.bob.Round4Sign(round3Payload)
+	if err != nil {
+		return errors.Wrap(err, "failed in Round4Sign")
+	}
+
+	return stream.Send(&pb.EddsaSignMessage{
+		Msg: &pb.EddsaSignMessage_EddsaSignRound4ToGatewayOutput{
+			EddsaSignRound4ToGatewayOutput: &pb.EddsaSignRound4ToGatewayOutput{
+				RequestId: ctx.requestID,
+				Signature: signature,
+			},
+		},
+	})
+}
@@ -0,0 +1,110 @@
+package handlers
+
+import (
+	"context"
+	"tecdsa/pkg/database/models"
+	"tecdsa/pkg/database/repository"
+	deserializer "tecdsa/pkg/deserializers"
+	"tecdsa/pkg/network"
+	"tecdsa/pkg/service"
+	pb "tecdsa/proto/key"
+
+	"github.com/coinbase/kryptology/pkg/core/curves"
+	"github.com/pkg/errors"
+)
+
+type KeyHandler struct {
+	repo           repository.ParitalSecretShareRepository
+	keyAddressRepo repository.KeyAddressRepository
+	networkService *service.NetworkService
+}
+
+func NewKeyHandler(repo repository.ParitalSecretShareRepository, keyAddressRepo repository.KeyAddressRepository, networkService *service.NetworkService) *KeyHandler {
+	return &KeyHandler{
+		repo:           repo,
+		keyAddressRepo: keyAddressRepo,
+		networkService: networkService,
+	}
+}
+
+// HandleAddAddress 는 쉐어의 공개키로 요청한 네트워크의 주소를 파생해 키에 등록합니다.
+// 이미 등록된 (네트워크, 주소 유형) 이면 기존 주소를 돌려줍니다.
+func (h *KeyHandler) HandleAddAddress(ctx context.Context, req *pb.AddAddressRequest) (*pb.AddAddressResponse, error) {
+	networkObj, err := h.networkService.GetNetworkByID(req.Network)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get network by ID")
+	}
+	if !network.IsValidAddressType(networkObj, int(req.AddressType)) {
+		return nil, errors.Errorf("unsupported address type %d for network %s", req.AddressType, networkObj)
+	}
+
+	if existing, err := h.keyAddressRepo.FindByKeyAndNetwork(req.KeyId, req.Network, req.AddressType); err == nil {
+		return &pb.AddAddressResponse{Address: existing.Address}, nil
+	}
+
+	share, err := h.repo.FindByKeyID(req.KeyId)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get secret share")
+	}
+
+	publicKey, err := sharePublicKey(share)
+	if err != nil {
+		return nil, err
+	}
+
+	// 키와 곡선이 다른 네트워크(예: Ed25519 키의 이더리움 주소)는 DeriveAddress 가 거부합니다.
+	address, err := h.networkService.DeriveAddress(publicKey, networkObj, int(req.AddressType))
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := h.keyAddressRepo.Create(req.KeyId, req.Network, req.AddressType, address); err != nil {
+		return nil, errors.Wrap(err, "failed to store key address")
+	}
+
+	return &pb.AddAddressResponse{Address: address}, nil
+}
+
+// HandleAssignKey 는 키 풀에서 미리 생성한 쉐어를 요청한 클라이언트에 할당합니다.
+func (h *KeyHandler) HandleAssignKey(ctx context.Context, req *pb.AssignKeyRequest) (*pb.AssignKeyResponse, error) {
+	if req.ClientSecurityId == 0 {
+		return nil, errors.New("client_security_id is required")
+	}
+	if err := h.repo.AssignClientSecurity(req.KeyId, uint(req.ClientSecurityId)); err != nil {
+		return nil, err
+	}
+	return &pb.AssignKeyResponse{}, nil
+}
+
+// HandleReleaseKey 는 다른 파티의 할당이 실패했을 때 할당을 되돌립니다.
+func (h *KeyHandler) HandleReleaseKey(ctx context.Context, req *pb.AssignKeyRequest) (*pb.AssignKeyResponse, error) {
+	if err := h.repo.ReleaseClientSecurity(req.KeyId, uint(req.ClientSecurityId)); err != nil {
+		return nil, err
+	}
+	return &pb.AssignKeyResponse{}, nil
+}
+
+// HandleDeleteKey 는 키 풀의 DKG 가 중간에 실패했을 때 남은 (할당되지 않은) 쉐어를 지웁니다.
+func (h *KeyHandler) HandleDeleteKey(ctx context.Context, req *pb.DeleteKeyRequest) (*pb.DeleteKeyResponse, error) {
+	if err := h.repo.DeleteUnassigned(req.KeyId); err != nil {
+		return nil, err
+	}
+	return &pb.DeleteKeyResponse{}, nil
+}
+
+// sharePublicKey 는 쉐어를 곡선에 맞게 디코딩해 공개키를 돌려줍니다.
+func sharePublicKey(share *models.ParitalSecretShare) (curves.Point, error) {
+	if share.Curve == int32(network.Ed25519) {
+		output, err := deserializer.DecodeEddsaDkgResult(share.Share)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to decode secret share")
+		}
+		return output.PublicKey, nil
+	}
+
+	bobOutput, err := deserializer.DecodeBobDkgResult(share.Share)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode secret share")
+	}
+	return bobOutput.PublicKey, nil
+}
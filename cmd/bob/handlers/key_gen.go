@@ -7,31 +7,32 @@ import (
 	"strconv"
 	"tecdsa/pkg/database/repository"
 	deserializer "tecdsa/pkg/deserializers"
+	"tecdsa/pkg/network"
 	"tecdsa/pkg/service"
 	pb "tecdsa/proto/keygen"
 
-	"github.com/coinbase/kryptology/pkg/core/curves"
 	"github.com/coinbase/kryptology/pkg/tecdsa/dkls/v1/dkg"
 	"github.com/pkg/errors"
 	"google.golang.org/grpc/metadata"
 )
 
 type keygenContext struct {
+	keyID            uint32
 	network          int32
+	addressType      int32
+	curve            network.Curve
 	bob              *dkg.Bob
 	clientSecurityID uint32
 	requestID        string
 }
 
 type KeygenHandler struct {
-	curve          *curves.Curve
 	repo           repository.ParitalSecretShareRepository
 	networkService *service.NetworkService
 }
 
 func NewKeygenHandler(repo repository.ParitalSecretShareRepository, networkService *service.NetworkService) *KeygenHandler {
 	return &KeygenHandler{
-		curve:          curves.K256(),
 		repo:           repo,
 		networkService: networkService,
 	}
@@ -53,11 +54,42 @@ func (h *KeygenHandler) HandleKeyGen(stream pb.KeygenService_KeyGenServer) error
 	if len(networkStr) == 0 {
 		return errors.New("network not found in metadata")
 	}
-	network, err := strconv.Atoi(networkStr[0])
+	networkID, err := strconv.Atoi(networkStr[0])
 	if err != nil {
 		return errors.Wrap(err, "invalid network value in metadata")
 	}
 
+	// curve 가 없으면 secp256k1 로 처리합니다.
+	curve := network.Secp256k1
+	if curveStr := md.Get("curve"); len(curveStr) > 0 {
+		curveID, err := strconv.Atoi(curveStr[0])
+		if err != nil {
+			return errors.Wrap(err, "invalid curve value in metadata")
+		}
+		curve = network.Curve(curveID)
+	}
+	if !curve.IsEcdsaCurve() {
+		return errors.Errorf("unsupported curve for ecdsa key generation: %s", curve)
+	}
+
+	// address_type 이 없으면 기본 유형(0)으로 처리합니다.
+	addressType := 0
+	if addressTypeStr := md.Get("address_type"); len(addressTypeStr) > 0 {
+		addressType, err = strconv.Atoi(addressTypeStr[0])
+		if err != nil {
+			return errors.Wrap(err, "invalid address_type value in metadata")
+		}
+	}
+
+	// key_id 는 게이트웨이가 발급한 키 ID 로, 없으면 주소로만 쉐어를 저장합니다.
+	var keyID uint64
+	if keyIDStr := md.Get("key_id"); len(keyIDStr) > 0 {
+		keyID, err = strconv.ParseUint(keyIDStr[0], 10, 32)
+		if err != nil {
+			return errors.Wrap(err, "invalid key_id value in metadata")
+		}
+	}
+
 	clientSecurityIDStr := md.Get("client_security_id")
 	if len(clientSecurityIDStr) == 0 {
 		return errors.New("client_security_id not found in metadata")
@@ -68,9 +100,12 @@ func (h *KeygenHandler) HandleKeyGen(stream pb.KeygenService_KeyGenServer) error
 	}
 
 	ctx := &keygenContext{
-		bob:              dkg.NewBob(h.curve),
+		bob:              dkg.NewBob(curve.KryptologyCurve()),
 		requestID:        requestID,
-		network:          int32(network),
+		keyID:            uint32(keyID),
+		network:          int32(networkID),
+		addressType:      int32(addressType),
+		curve:            curve,
 		clientSecurityID: uint32(clientSecurityID),
 	}
 
@@ -244,7 +279,7 @@ func (h *KeygenHandler) handleRound11(stream pb.KeygenService_KeyGenServer, ctx
 		return errors.Wrap(err, "failed to get network by ID")
 	}
 
-	address, err := h.networkService.DeriveAddress(bobOutput.PublicKey, networkObj)
+	address, err := h.networkService.DeriveAddress(bobOutput.PublicKey, networkObj, int(ctx.addressType))
 	if err != nil {
 		return err
 	}
@@ -254,7 +289,7 @@ func (h *KeygenHandler) handleRound11(stream pb.KeygenService_KeyGenServer, ctx
 		return errors.Wrap(err, "failed to encode bob output")
 	}
 
-	if err := h.repo.Create(address, share, uint(ctx.clientSecurityID)); err != nil {
+	if err := h.repo.Create(ctx.keyID, address, share, ctx.network, ctx.addressType, int32(ctx.curve), uint(ctx.clientSecurityID)); err != nil {
 		return errors.Wrap(err, "failed to store secret bob share")
 	}
 
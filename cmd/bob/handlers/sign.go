@@ -7,11 +7,13 @@ import (
 	"hash"
 	"io"
 	"log"
+	"tecdsa/pkg/database/models"
 	"tecdsa/pkg/database/repository"
 	deserializer "tecdsa/pkg/deserializers"
+	"tecdsa/pkg/network"
+	"tecdsa/pkg/service"
 	pb "tecdsa/proto/sign"
 
-	"github.com/coinbase/kryptology/pkg/core/curves"
 	"github.com/coinbase/kryptology/pkg/tecdsa/dkls/v1/sign"
 	"github.com/pkg/errors"
 	"golang.org/x/crypto/sha3"
@@ -26,19 +28,33 @@ type signContext struct {
 }
 
 type SignHandler struct {
-	curve *curves.Curve
-	hash  hash.Hash
-	repo  repository.ParitalSecretShareRepository
+	repo           repository.ParitalSecretShareRepository
+	keyAddressRepo repository.KeyAddressRepository
+	networkService *service.NetworkService
 }
 
-func NewSignHandler(repo repository.ParitalSecretShareRepository) *SignHandler {
+func NewSignHandler(repo repository.ParitalSecretShareRepository, keyAddressRepo repository.KeyAddressRepository, networkService *service.NetworkService) *SignHandler {
 	return &SignHandler{
-		curve: curves.K256(),
-		hash:  sha3.NewLegacyKeccak256(),
-		repo:  repo,
+		repo:           repo,
+		keyAddressRepo: keyAddressRepo,
+		networkService: networkService,
 	}
 }
 
+// newMessageHash 는 서명 주소의 네트워크에 맞는 해시를 서명 세션마다 새로 만듭니다.
+// 해시 인스턴스를 세션 간에 공유하면 이전 메시지가 누적되므로 재사용하지 않습니다.
+func (h *SignHandler) newMessageHash(address string, share *models.ParitalSecretShare) hash.Hash {
+	networkID := share.Network
+	if keyAddress, err := h.keyAddressRepo.FindByAddress(address); err == nil {
+		networkID = keyAddress.Network
+	}
+	networkObj, err := h.networkService.GetNetworkByID(networkID)
+	if err != nil {
+		return sha3.NewLegacyKeccak256()
+	}
+	return h.networkService.NewMessageHash(networkObj)
+}
+
 func (h *SignHandler) HandleSign(stream pb.SignService_SignServer) error {
 	md, ok := metadata.FromIncomingContext(stream.Context())
 	if !ok {
@@ -116,7 +132,7 @@ func (h *SignHandler) handleRound2(stream pb.SignService_SignServer, ctx *signCo
 	publicKeyBytes := bobOutput.PublicKey.ToAffineCompressed()
 	publicKeyHex := hex.EncodeToString(publicKeyBytes)
 	fmt.Println("Public Key (hex):", publicKeyHex)
-	ctx.bob = sign.NewBob(h.curve, h.hash, bobOutput)
+	ctx.bob = sign.NewBob(network.Curve(output.Curve).KryptologyCurve(), h.newMessageHash(ctx.address, output), bobOutput)
 
 	round1Payload, err := deserializer.DecodeSignRound1Payload(msg.Payload)
 	if err != nil {
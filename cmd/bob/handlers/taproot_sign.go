@@ -0,0 +1,179 @@
+package handlers
+
+import (
+	"encoding/base64"
+	"io"
+	"log"
+	"tecdsa/pkg/database/models"
+	"tecdsa/pkg/database/repository"
+	deserializer "tecdsa/pkg/deserializers"
+	"tecdsa/pkg/network"
+	"tecdsa/pkg/schnorr"
+	pb "tecdsa/proto/taproot"
+
+	"github.com/coinbase/kryptology/pkg/core/curves"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/metadata"
+)
+
+type taprootSignContext struct {
+	bob       *schnorr.Bob
+	txOrigin  []byte
+	requestID string
+	address   string
+}
+
+type TaprootSignHandler struct {
+	curve          *curves.Curve
+	repo           repository.ParitalSecretShareRepository
+	keyAddressRepo repository.KeyAddressRepository
+}
+
+func NewTaprootSignHandler(repo repository.ParitalSecretShareRepository, keyAddressRepo repository.KeyAddressRepository) *TaprootSignHandler {
+	return &TaprootSignHandler{
+		curve:          curves.K256(),
+		repo:           repo,
+		keyAddressRepo: keyAddressRepo,
+	}
+}
+
+// isTaprootAddress 는 서명 주소가 P2TR 로 등록된 주소인지 확인합니다.
+// Schnorr 서명은 Taproot 출력에만 쓰이므로 다른 주소 유형에는 서명하지 않습니다.
+func (h *TaprootSignHandler) isTaprootAddress(address string, share *models.ParitalSecretShare) bool {
+	if keyAddress, err := h.keyAddressRepo.FindByAddress(address); err == nil {
+		return keyAddress.AddressType == network.P2TR
+	}
+	return share.AddressType == network.P2TR
+}
+
+func (h *TaprootSignHandler) HandleSign(stream pb.TaprootSignService_SignServer) error {
+	md, ok := metadata.FromIncomingContext(stream.Context())
+	if !ok {
+		return errors.New("no metadata received")
+	}
+
+	requestIDs := md.Get("request_id")
+	if len(requestIDs) == 0 {
+		return errors.New("request_id not found in metadata")
+	}
+	requestID := requestIDs[0]
+
+	addresses := md.Get("address")
+	if len(addresses) == 0 {
+		return errors.New("address not found in metadata")
+	}
+	address := addresses[0]
+
+	txOrigins := md.Get("tx_origin")
+	if len(txOrigins) == 0 {
+		return errors.New("tx_origin not found in metadata")
+	}
+	txOrigin, err := base64.StdEncoding.DecodeString(txOrigins[0])
+	if err != nil {
+		return errors.Wrap(err, "failed to decode tx_origin")
+	}
+
+	ctx := &taprootSignContext{
+		requestID: requestID,
+		address:   address,
+		txOrigin:  txOrigin,
+	}
+
+	log.Printf("Starting taproot signing process for request ID: %s, address: %s", requestID, address)
+
+	for {
+		in, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return errors.Wrap(err, "error receiving message")
+		}
+
+		switch msg := in.Msg.(type) {
+		case *pb.TaprootSignMessage_TaprootSignRound1To2Output:
+			err = h.handleRound2(stream, ctx, msg.TaprootSignRound1To2Output)
+		case *pb.TaprootSignMessage_TaprootSignRound3To4Output:
+			err = h.handleRound4(stream, ctx, msg.TaprootSignRound3To4Output)
+		default:
+			err = errors.New("unexpected message type")
+		}
+
+		if err != nil {
+			log.Printf("Error in taproot signing process: %v", err)
+			return err
+		}
+	}
+}
+
+func (h *TaprootSignHandler) handleRound2(stream pb.TaprootSignService_SignServer, ctx *taprootSignContext, msg *pb.TaprootSignRound1To2Output) error {
+	log.Printf("Taproot 라운드2")
+
+	output, err := h.repo.FindByAddress(ctx.address)
+	if err != nil {
+		return errors.Wrap(err, "failed to get secret share")
+	}
+	if !h.isTaprootAddress(ctx.address, output) {
+		return errors.New("address is not a taproot address")
+	}
+
+	bobOutput, err := deserializer.DecodeBobDkgResult(output.Share)
+	if err != nil {
+		return errors.New("retrieved secret share is not a BobOutput")
+	}
+
+	ctx.bob, err = schnorr.NewBob(h.curve, bobOutput, ctx.txOrigin)
+	if err != nil {
+		return errors.Wrap(err, "failed to create schnorr signer")
+	}
+
+	round1Payload, err := deserializer.DecodeTaprootSignRound1Payload(msg.Payload)
+	if err != nil {
+		return errors.Wrap(err, "failed to decode round 1 payload")
+	}
+
+	round2Result, err := ctx.bob.Round2Respond(round1Payload)
+	if err != nil {
+		return errors.Wrap(err, "failed in Round2Respond")
+	}
+
+	round2Payload, err := deserializer.EncodeTaprootSignRound2Payload(round2Result)
+	if err != nil {
+		return errors.Wrap(err, "failed to encode in Round 2")
+	}
+
+	return stream.Send(&pb.TaprootSignMessage{
+		Msg: &pb.TaprootSignMessage_TaprootSignRound2To3Output{
+			TaprootSignRound2To3Output: &pb.TaprootSignRound2To3Output{
+				Payload: round2Payload,
+			},
+		},
+	})
+}
+
+func (h *TaprootSignHandler) handleRound4(stream pb.TaprootSignService_SignServer, ctx *taprootSignContext, msg *pb.TaprootSignRound3To4Output) error {
+	log.Printf("Taproot 라운드4")
+
+	if ctx.bob == nil {
+		return errors.New("round 2 has not been completed")
+	}
+
+	round3Payload, err := deserializer.DecodeTaprootSignRound3Payload(msg.Payload)
+	if err != nil {
+		return errors.Wrap(err, "failed to decode in Round 4")
+	}
+
+	signature, err := ctx.bob.Round4Sign(round3Payload)
+	if err != nil {
+		return errors.Wrap(err, "failed in Round4Sign")
+	}
+
+	return stream.Send(&pb.TaprootSignMessage{
+		Msg: &pb.TaprootSignMessage_TaprootSignRound4ToGatewayOutput{
+			TaprootSignRound4ToGatewayOutput: &pb.TaprootSignRound4ToGatewayOutput{
+				RequestId: ctx.requestID,
+				Signature: signature,
+			},
+		},
+	})
+}
@@ -1,7 +1,6 @@
 package main
 
 import (
-	"fmt"
 	"log"
 	"net"
 	"os"
@@ -10,9 +9,9 @@ import (
 	"tecdsa/cmd/bob/server"
 	"tecdsa/pkg/database"
 	"tecdsa/pkg/database/repository"
+	"tecdsa/pkg/network"
 	"tecdsa/pkg/service"
-	pbKeygen "tecdsa/proto/keygen"
-	pbSign "tecdsa/proto/sign"
+	"tecdsa/pkg/sharestore"
 
 	"google.golang.org/grpc"
 	"gorm.io/gorm"
@@ -22,34 +21,63 @@ func main() {
 	// 설정 로드
 	cfg := loadConfig()
 
+	// 네트워크 설정 로드 (NETWORKS_CONFIG 가 없으면 기본 네트워크만 사용)
+	if path := os.Getenv("NETWORKS_CONFIG"); path != "" {
+		if err := network.LoadNetworks(path); err != nil {
+			log.Fatalf("Failed to load network config: %v", err)
+		}
+	}
+
 	// 데이터베이스 연결
 	db := connectDatabase(cfg)
 
+	// 쉐어 저장소 생성
+	shareStore, err := sharestore.New(cfg.ShareStore, db)
+	if err != nil {
+		log.Fatalf("Failed to create share store: %v", err)
+	}
+
 	// 리포지토리 생성
-	paritalSecretShareRepository := repository.NewPartialSecretShareRepository(db)
+	paritalSecretShareRepository := repository.NewPartialSecretShareRepositoryWithStore(db, shareStore)
+	keyAddressRepository := repository.NewKeyAddressRepository(db)
+	presignatureRepository := repository.NewPresignatureRepository(db)
 
 	// 네트워크 서비스 생성
 	networkService := service.NewNetworkService()
 
 	// gRPC 서버 시작
-	startGRPCServer(cfg, paritalSecretShareRepository, networkService)
+	startGRPCServer(cfg, paritalSecretShareRepository, keyAddressRepository, presignatureRepository, networkService)
 }
 
 func loadConfig() *config.Config {
 	cfg := &config.Config{
+		DBDriver:   os.Getenv("DB_DRIVER"),
 		DBHost:     os.Getenv("DB_HOST"),
+		DBPort:     os.Getenv("DB_PORT"),
 		DBUser:     os.Getenv("DB_USER"),
 		DBPassword: os.Getenv("DB_PASSWORD"),
 		DBName:     os.Getenv("DB_NAME"),
 		ServerPort: os.Getenv("SERVER_PORT"),
+		ShareStore: sharestore.Config{
+			Type:  os.Getenv("SHARE_STORE"),
+			Path:  os.Getenv("SHARE_STORE_PATH"),
+			Key:   os.Getenv("SHARE_STORE_KEY"),
+			URL:   os.Getenv("SHARE_STORE_URL"),
+			Token: os.Getenv("SHARE_STORE_TOKEN"),
+		},
 	}
 	return cfg
 }
 
 func connectDatabase(cfg *config.Config) *gorm.DB {
-	dsn := fmt.Sprintf("%s:%s@tcp(%s:3306)/%s?charset=utf8mb4&parseTime=True&loc=Local",
-		cfg.DBUser, cfg.DBPassword, cfg.DBHost, cfg.DBName)
-	db, err := database.NewDatabase(dsn)
+	db, err := database.Connect(database.Config{
+		Driver:   cfg.DBDriver,
+		Host:     cfg.DBHost,
+		Port:     cfg.DBPort,
+		User:     cfg.DBUser,
+		Password: cfg.DBPassword,
+		Name:     cfg.DBName,
+	})
 	if err != nil {
 		log.Fatalf("Failed to connect to database: %v", err)
 	}
@@ -58,17 +86,14 @@ func connectDatabase(cfg *config.Config) *gorm.DB {
 	return db
 }
 
-func startGRPCServer(cfg *config.Config, repo repository.ParitalSecretShareRepository, networkService *service.NetworkService) {
+func startGRPCServer(cfg *config.Config, repo repository.ParitalSecretShareRepository, keyAddressRepo repository.KeyAddressRepository, presignRepo repository.PresignatureRepository, networkService *service.NetworkService) {
 	lis, err := net.Listen("tcp", ":"+cfg.ServerPort)
 	if err != nil {
 		log.Fatalf("failed to listen: %v", err)
 	}
 
 	s := grpc.NewServer()
-	srv := server.NewServer(repo, networkService)
-
-	pbKeygen.RegisterKeygenServiceServer(s, srv)
-	pbSign.RegisterSignServiceServer(s, srv)
+	server.Register(s, repo, keyAddressRepo, presignRepo, networkService)
 
 	log.Printf("Alice server listening at :%s", cfg.ServerPort)
 	if err := s.Serve(lis); err != nil {
@@ -0,0 +1,31 @@
+package server
+
+import (
+	"tecdsa/pkg/database/repository"
+	"tecdsa/pkg/service"
+
+	pbEddsa "tecdsa/proto/eddsa"
+	pbKey "tecdsa/proto/key"
+	pbKeygen "tecdsa/proto/keygen"
+	pbPresign "tecdsa/proto/presign"
+	pbSign "tecdsa/proto/sign"
+	pbTaproot "tecdsa/proto/taproot"
+
+	"google.golang.org/grpc"
+)
+
+// Register 는 파티의 모든 gRPC 서비스를 등록합니다. main 과 테스트 하네스가 같은 구성을 사용합니다.
+func Register(s *grpc.Server, repo repository.ParitalSecretShareRepository, keyAddressRepo repository.KeyAddressRepository, presignRepo repository.PresignatureRepository, networkService *service.NetworkService) {
+	srv := NewServer(repo, keyAddressRepo, networkService)
+
+	pbKeygen.RegisterKeygenServiceServer(s, srv)
+	pbSign.RegisterSignServiceServer(s, srv)
+	pbKey.RegisterKeyServiceServer(s, srv)
+	pbTaproot.RegisterTaprootSignServiceServer(s, NewTaprootServer(repo, keyAddressRepo))
+
+	eddsaSrv := NewEddsaServer(repo, networkService)
+	pbEddsa.RegisterEddsaKeygenServiceServer(s, eddsaSrv)
+	pbEddsa.RegisterEddsaSignServiceServer(s, eddsaSrv)
+
+	pbPresign.RegisterPresignServiceServer(s, NewPresignServer(repo, presignRepo, keyAddressRepo, networkService))
+}
@@ -1,6 +1,16 @@
 package config
 
-import "sync"
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"tecdsa/pkg/network"
+
+	"google.golang.org/grpc"
+)
 
 var (
 	once     sync.Once
@@ -8,13 +18,40 @@ var (
 )
 
 type Config struct {
+	// DBDriver 는 mysql (기본값), postgres, sqlite 중 하나입니다.
+	DBDriver         string
 	DBHost           string
+	DBPort           string
 	DBUser           string
 	DBPassword       string
 	DBName           string
 	ServerPort       string
 	BobGRPCAddress   string
 	AliceGRPCAddress string
+	// PresignPoolSize 는 키마다 미리 만들어 둘 presignature 수입니다. 0 이면 풀을 사용하지 않습니다.
+	PresignPoolSize int
+	// KeyPoolTargets 는 (네트워크, 주소 유형) 별로 미리 생성해 둘 할당되지 않은 키 수입니다.
+	KeyPoolTargets []KeyPoolTarget
+	// PresignRefillInterval, KeyPoolRefillInterval 은 풀을 다시 채우는 주기입니다. 0 이면 기본값을 사용합니다.
+	PresignRefillInterval time.Duration
+	KeyPoolRefillInterval time.Duration
+	// RequireSignedRequests 면 /register, /networks, /docs 외의 요청은 클라이언트 키 서명 (pkg/auth) 이 있어야 합니다.
+	// 기본값은 false 로 서명이 없는 요청을 IP 로만 식별하며, REQUIRE_SIGNED_REQUESTS=true 로 켭니다.
+	// false 여도 서명 헤더가 있는 요청은 서명과 재전송 여부를 검증합니다.
+	RequireSignedRequests bool
+	// DialOptions 는 Alice, Bob 에 연결할 때 추가로 사용할 gRPC 옵션입니다 (테스트 하네스의 bufconn 다이얼러 등).
+	DialOptions []grpc.DialOption
+	// ChainNonce 는 논스 관리자가 EVM 계정의 pending 논스를 조회하는 함수입니다. nil 이면 네트워크의 RPC 를 사용합니다.
+	ChainNonce func(network.Network, string) (uint64, error)
+	// NonceReservationTTL 은 할당한 논스를 서명하지 않았을 때 다른 트랜잭션에 다시 할당하기까지의 시간입니다. 0 이면 기본값 (10분) 을 사용합니다.
+	NonceReservationTTL time.Duration
+}
+
+// KeyPoolTarget 은 키 풀이 유지할 (네트워크, 주소 유형) 과 키 수입니다.
+type KeyPoolTarget struct {
+	Network     int32
+	AddressType int32
+	Size        int
 }
 
 func GetConfig() *Config {
@@ -29,3 +66,44 @@ func SetAddresses(bobAddress, aliceAddress string) {
 	config.BobGRPCAddress = bobAddress
 	config.AliceGRPCAddress = aliceAddress
 }
+
+// ParseKeyPoolTargets 는 "network[:address_type]=size" 를 쉼표로 나열한 값을 해석합니다.
+// 예: "4=10,1:2=5" 는 이더리움 키 10개, 비트코인 P2WPKH 키 5개를 유지합니다.
+func ParseKeyPoolTargets(value string) ([]KeyPoolTarget, error) {
+	var targets []KeyPoolTarget
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		spec, sizeStr, ok := strings.Cut(entry, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid key pool entry %q", entry)
+		}
+		size, err := strconv.Atoi(strings.TrimSpace(sizeStr))
+		if err != nil || size < 0 {
+			return nil, fmt.Errorf("invalid key pool size in %q", entry)
+		}
+
+		networkStr, addressTypeStr, hasAddressType := strings.Cut(spec, ":")
+		networkID, err := strconv.ParseInt(strings.TrimSpace(networkStr), 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid key pool network in %q", entry)
+		}
+		var addressType int64
+		if hasAddressType {
+			addressType, err = strconv.ParseInt(strings.TrimSpace(addressTypeStr), 10, 32)
+			if err != nil {
+				return nil, fmt.Errorf("invalid key pool address type in %q", entry)
+			}
+		}
+
+		targets = append(targets, KeyPoolTarget{
+			Network:     int32(networkID),
+			AddressType: int32(addressType),
+			Size:        size,
+		})
+	}
+	return targets, nil
+}
@@ -0,0 +1,160 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strings"
+
+	"tecdsa/pkg/database/models"
+	"tecdsa/pkg/database/repository"
+	"tecdsa/pkg/network"
+	"tecdsa/pkg/response"
+	"tecdsa/pkg/service"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+type RegisterContractABIRequest struct {
+	Name string          `json:"name"`
+	ABI  json.RawMessage `json:"abi"`
+	// Network, Address 를 주면 ABI 를 해당 컨트랙트에만 사용합니다.
+	Network int32  `json:"network,omitempty"`
+	Address string `json:"address,omitempty"`
+}
+
+type ContractABIResponse struct {
+	Name    string          `json:"name"`
+	Network int32           `json:"network,omitempty"`
+	Address string          `json:"address,omitempty"`
+	Methods []string        `json:"methods"`
+	ABI     json.RawMessage `json:"abi,omitempty"`
+}
+
+type ContractABIHandler struct {
+	contractABIRepo repository.ContractABIRepository
+	networkService  *service.NetworkService
+}
+
+func NewContractABIHandler(contractABIRepo repository.ContractABIRepository, networkService *service.NetworkService) *ContractABIHandler {
+	return &ContractABIHandler{
+		contractABIRepo: contractABIRepo,
+		networkService:  networkService,
+	}
+}
+
+// Serve 는 GET /abis (목록), GET /abis/{name} (조회), POST /abis (등록) 요청을 처리합니다.
+func (h *ContractABIHandler) Serve(w http.ResponseWriter, r *http.Request) {
+	name := strings.Trim(strings.TrimPrefix(r.URL.Path, "/abis"), "/")
+	switch {
+	case r.Method == http.MethodGet && name == "":
+		h.listABIs(w)
+	case r.Method == http.MethodGet:
+		h.getABI(w, name)
+	case r.Method == http.MethodPost && name == "":
+		h.registerABI(w, r)
+	default:
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+func (h *ContractABIHandler) listABIs(w http.ResponseWriter) {
+	records, err := h.contractABIRepo.FindAll()
+	if err != nil {
+		response.SendResponse(w, response.NewErrorResponse(response.ErrCodeInternalServerError, response.ErrMsgFailedRetrieveContractABIs))
+		return
+	}
+
+	abis := make([]ContractABIResponse, 0, len(records))
+	for _, record := range records {
+		abis = append(abis, newContractABIResponse(record, false))
+	}
+	response.SendResponse(w, response.NewSuccessResponse(http.StatusOK, abis))
+}
+
+func (h *ContractABIHandler) getABI(w http.ResponseWriter, name string) {
+	record, err := h.contractABIRepo.FindByName(name)
+	if err != nil {
+		response.SendResponse(w, response.NewErrorResponse(response.ErrCodeNotFound, response.ErrMsgContractABINotFound))
+		return
+	}
+	response.SendResponse(w, response.NewSuccessResponse(http.StatusOK, newContractABIResponse(record, true)))
+}
+
+func (h *ContractABIHandler) registerABI(w http.ResponseWriter, r *http.Request) {
+	var req RegisterContractABIRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.SendResponse(w, response.NewErrorResponse(response.ErrCodeBadRequest, response.ErrMsgInvalidRequestBody))
+		return
+	}
+	if req.Name == "" {
+		response.SendResponse(w, response.NewErrorResponse(response.ErrCodeBadRequest, "'name' is required"))
+		return
+	}
+	if strings.Contains(req.Name, "/") {
+		response.SendResponse(w, response.NewErrorResponse(response.ErrCodeBadRequest, "'name' must not contain '/'"))
+		return
+	}
+	if _, err := network.ParseContractABI(req.ABI); err != nil {
+		response.SendResponse(w, response.NewErrorResponse(response.ErrCodeBadRequest, response.ErrMsgInvalidContractABI))
+		return
+	}
+
+	address := ""
+	if req.Address != "" || req.Network != 0 {
+		net, err := h.networkService.GetNetworkByID(req.Network)
+		if err != nil || !network.IsEthereumNetwork(net) {
+			response.SendResponse(w, response.NewErrorResponse(response.ErrCodeBadRequest, response.ErrMsgUnsupportedNetwork))
+			return
+		}
+		if !network.IsValidEthereumAddress(req.Address) {
+			response.SendResponse(w, response.NewErrorResponse(response.ErrCodeBadRequest, "'address' is required"))
+			return
+		}
+		address = common.HexToAddress(req.Address).Hex()
+	}
+
+	if _, err := h.contractABIRepo.FindByName(req.Name); err == nil {
+		response.SendResponse(w, response.NewErrorResponse(response.ErrCodeBadRequest, response.ErrMsgDuplicateContractABI))
+		return
+	}
+
+	// JSON 문자열로 감싼 ABI 도 JSON 배열로 저장합니다.
+	definition := []byte(req.ABI)
+	var wrapped string
+	if err := json.Unmarshal(req.ABI, &wrapped); err == nil {
+		definition = []byte(wrapped)
+	}
+	var compact bytes.Buffer
+	if err := json.Compact(&compact, definition); err != nil {
+		response.SendResponse(w, response.NewErrorResponse(response.ErrCodeBadRequest, response.ErrMsgInvalidContractABI))
+		return
+	}
+
+	record, err := h.contractABIRepo.Create(req.Name, req.Network, address, compact.String())
+	if err != nil {
+		response.SendResponse(w, response.NewErrorResponse(response.ErrCodeInternalServerError, response.ErrMsgFailedStoreContractABI))
+		return
+	}
+	response.SendResponse(w, response.NewSuccessResponse(http.StatusOK, newContractABIResponse(record, true)))
+}
+
+func newContractABIResponse(record *models.ContractABI, withABI bool) ContractABIResponse {
+	res := ContractABIResponse{
+		Name:    record.Name,
+		Network: record.Network,
+		Address: record.Address,
+		Methods: []string{},
+	}
+	if contractABI, err := network.ParseContractABI([]byte(record.ABI)); err == nil {
+		for _, method := range contractABI.Methods {
+			res.Methods = append(res.Methods, method.Sig)
+		}
+		sort.Strings(res.Methods)
+	}
+	if withABI {
+		res.ABI = json.RawMessage(record.ABI)
+	}
+	return res
+}
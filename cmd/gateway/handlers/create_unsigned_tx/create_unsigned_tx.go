@@ -3,18 +3,29 @@ package handlers
 import (
 	"encoding/json"
 	"fmt"
+	"log"
 	"net/http"
 	"strconv"
 	"strings"
 
+	"tecdsa/pkg/database/models"
+	"tecdsa/pkg/database/repository"
 	"tecdsa/pkg/network"
 	"tecdsa/pkg/response"
 	"tecdsa/pkg/service"
+	"tecdsa/pkg/utils"
+
+	"github.com/ethereum/go-ethereum/common"
 )
 
-func NewCreateUnsignedTxHandler(networkService *service.NetworkService) *CreateUnsignedTxHandler {
+func NewCreateUnsignedTxHandler(networkService *service.NetworkService, clientSecurityRepo repository.ClientSecurityRepository, keyRepo repository.KeyRepository, keyAddressRepo repository.KeyAddressRepository, contractABIRepo repository.ContractABIRepository, nonceManager *service.NonceManager) *CreateUnsignedTxHandler {
 	return &CreateUnsignedTxHandler{
-		networkService: networkService,
+		networkService:     networkService,
+		clientSecurityRepo: clientSecurityRepo,
+		keyRepo:            keyRepo,
+		keyAddressRepo:     keyAddressRepo,
+		contractABIRepo:    contractABIRepo,
+		nonceManager:       nonceManager,
 	}
 }
 
@@ -33,8 +44,11 @@ func (h *CreateUnsignedTxHandler) Serve(w http.ResponseWriter, r *http.Request)
 
 	fmt.Println("networkType", networkType)
 	var txRequest interface{}
-	switch networkType {
-	case network.Bitcoin, network.BitcoinTestNet:
+	// reservedNonce 는 논스 관리자가 할당한 논스입니다. 트랜잭션을 만들지 못하면 해제합니다.
+	var reservedNonce *uint64
+	var reservedFrom string
+	switch networkType.Family() {
+	case network.FamilyBitcoin:
 		var btcReq network.BitcoinTxRequest
 		if err := json.NewDecoder(r.Body).Decode(&btcReq); err != nil {
 			response.SendResponse(w, response.NewErrorResponse(response.ErrCodeBadRequest, "Invalid request body"))
@@ -56,8 +70,17 @@ func (h *CreateUnsignedTxHandler) Serve(w http.ResponseWriter, r *http.Request)
 		}
 		fmt.Print(btcReq)
 
+		// 보내는 주소가 게이트웨이 키의 주소면 공개키를 채워 P2SH-P2WPKH 서명 해시와 PSBT 키 출처를 계산합니다.
+		if btcReq.PublicKey == "" {
+			if keyAddress, err := h.keyAddressRepo.FindByAddress(btcReq.From); err == nil {
+				if key, err := h.keyRepo.FindByID(uint(keyAddress.KeyID)); err == nil {
+					btcReq.PublicKey = key.PublicKey
+				}
+			}
+		}
+
 		txRequest = btcReq
-	case network.Ethereum, network.Ethereum_Sepolia:
+	case network.FamilyEVM:
 		var ethReq network.EthereumTxRequest
 		if err := json.NewDecoder(r.Body).Decode(&ethReq); err != nil {
 			response.SendResponse(w, response.NewErrorResponse(response.ErrCodeBadRequest, "Invalid request body"))
@@ -73,12 +96,62 @@ func (h *CreateUnsignedTxHandler) Serve(w http.ResponseWriter, r *http.Request)
 			response.SendResponse(w, response.NewErrorResponse(response.ErrCodeBadRequest, "'to' is required"))
 			return
 		}
-		if ethReq.Amount == "" {
+		// ERC-721 전송은 amount 대신 tokenId 로 보낼 토큰을 정하고, 컨트랙트 호출은 amount 를 생략할 수 있습니다.
+		if ethReq.Method != "" {
+			if !h.resolveContractABI(w, networkType, &ethReq) {
+				return
+			}
+		} else if ethReq.Collection != "" {
+			if ethReq.TokenID == "" {
+				response.SendResponse(w, response.NewErrorResponse(response.ErrCodeBadRequest, "'tokenId' is required"))
+				return
+			}
+		} else if ethReq.Amount == "" {
 			response.SendResponse(w, response.NewErrorResponse(response.ErrCodeBadRequest, "'amount' is required"))
 			return
 		}
 
+		// 논스를 주지 않으면 같은 주소의 트랜잭션끼리 겹치지 않게 논스 관리자가 할당합니다.
+		if ethReq.Nonce == nil {
+			if !network.IsValidEthereumAddress(ethReq.From) {
+				response.SendResponse(w, response.NewErrorResponse(response.ErrCodeBadRequest, fmt.Sprintf("invalid 'from' address: %s", ethReq.From)))
+				return
+			}
+			if !h.checkAddressOwner(w, r, networkType, ethReq.From) {
+				return
+			}
+			nonce, err := h.nonceManager.Reserve(networkType, ethReq.From)
+			if err != nil {
+				response.SendResponse(w, response.NewErrorResponse(response.ErrCodeInternalServerError, response.ErrMsgFailedReserveNonce))
+				return
+			}
+			ethReq.Nonce = &nonce
+			reservedNonce, reservedFrom = &nonce, ethReq.From
+		}
+
 		txRequest = ethReq
+	case network.FamilySolana:
+		var solReq network.SolanaTxRequest
+		if err := json.NewDecoder(r.Body).Decode(&solReq); err != nil {
+			response.SendResponse(w, response.NewErrorResponse(response.ErrCodeBadRequest, "Invalid request body"))
+			return
+		}
+
+		// 필수 필드 유효성 검사
+		if solReq.From == "" {
+			response.SendResponse(w, response.NewErrorResponse(response.ErrCodeBadRequest, "'from' is required"))
+			return
+		}
+		if solReq.To == "" {
+			response.SendResponse(w, response.NewErrorResponse(response.ErrCodeBadRequest, "'to' is required"))
+			return
+		}
+		if solReq.Amount == "" {
+			response.SendResponse(w, response.NewErrorResponse(response.ErrCodeBadRequest, "'amount' is required"))
+			return
+		}
+
+		txRequest = solReq
 	default:
 		response.SendResponse(w, response.NewErrorResponse(response.ErrCodeBadRequest, "Unsupported network type"))
 		return
@@ -88,9 +161,72 @@ func (h *CreateUnsignedTxHandler) Serve(w http.ResponseWriter, r *http.Request)
 	unsignedTx, err := h.networkService.CreateUnsignedTransaction(networkType, txRequest)
 	if err != nil {
 		fmt.Printf("Error creating unsigned transaction: %v\n", err)
+		if reservedNonce != nil {
+			if _, err := h.nonceManager.Release(networkType, reservedFrom, *reservedNonce); err != nil {
+				log.Printf("failed to release nonce %d for %s: %v", *reservedNonce, reservedFrom, err)
+			}
+		}
 		response.SendResponse(w, response.NewErrorResponse(response.ErrCodeInternalServerError, fmt.Sprintf("Failed to create unsigned transaction: %v", err)))
 		return
 	}
 
 	response.SendResponse(w, response.NewSuccessResponse(http.StatusOK, unsignedTx))
 }
+
+// resolveContractABI 는 컨트랙트 호출에 사용할 ABI 를 정합니다. abiName 이면 등록된 ABI 를,
+// abi, abiName 이 모두 없으면 to 컨트랙트에 등록된 ABI 를 사용합니다. 실패하면 오류 응답을 보내고 false 를 돌려줍니다.
+func (h *CreateUnsignedTxHandler) resolveContractABI(w http.ResponseWriter, networkType network.Network, ethReq *network.EthereumTxRequest) bool {
+	if len(ethReq.ABI) > 0 {
+		if ethReq.ABIName != "" {
+			response.SendResponse(w, response.NewErrorResponse(response.ErrCodeBadRequest, "'abi' and 'abiName' cannot be used together"))
+			return false
+		}
+		return true
+	}
+
+	to := common.HexToAddress(ethReq.To).Hex()
+	var record *models.ContractABI
+	var err error
+	if ethReq.ABIName != "" {
+		record, err = h.contractABIRepo.FindByName(ethReq.ABIName)
+	} else {
+		record, err = h.contractABIRepo.FindByContract(networkType.ID(), to)
+	}
+	if err != nil {
+		response.SendResponse(w, response.NewErrorResponse(response.ErrCodeNotFound, response.ErrMsgContractABINotFound))
+		return false
+	}
+	// 컨트랙트에 등록한 ABI 는 그 컨트랙트에만 사용합니다.
+	if record.Address != "" && (record.Network != networkType.ID() || record.Address != to) {
+		response.SendResponse(w, response.NewErrorResponse(response.ErrCodeBadRequest, response.ErrMsgContractABIMismatch))
+		return false
+	}
+	ethReq.ABI = json.RawMessage(record.ABI)
+	ethReq.ABIName = record.Name
+	return true
+}
+
+// checkAddressOwner 는 from 주소가 요청한 클라이언트의 키 주소인지 확인합니다.
+// 다른 클라이언트 주소의 논스를 할당하지 않도록 논스 관리자를 쓰기 전에 확인하며, 실패하면 오류 응답을 보내고 false 를 돌려줍니다.
+func (h *CreateUnsignedTxHandler) checkAddressOwner(w http.ResponseWriter, r *http.Request, networkType network.Network, address string) bool {
+	keyAddress, err := h.keyAddressRepo.FindByNetworkAndAddress(networkType.ID(), common.HexToAddress(address).Hex())
+	if err != nil {
+		response.SendResponse(w, response.NewErrorResponse(response.ErrCodeNotFound, response.ErrMsgKeyNotFound))
+		return false
+	}
+	key, err := h.keyRepo.FindByID(uint(keyAddress.KeyID))
+	if err != nil {
+		response.SendResponse(w, response.NewErrorResponse(response.ErrCodeNotFound, response.ErrMsgKeyNotFound))
+		return false
+	}
+	clientSecurity, err := h.clientSecurityRepo.FindByIP(utils.GetClientIP(r))
+	if err != nil {
+		response.SendResponse(w, response.NewErrorResponse(response.ErrCodeInternalServerError, response.ErrMsgFailedRetrieveClientSecurity))
+		return false
+	}
+	if key.ClientSecurityID != uint(clientSecurity.ID) {
+		response.SendResponse(w, response.NewErrorResponse(response.ErrCodeForbidden, response.ErrMsgKeyNotOwned))
+		return false
+	}
+	return true
+}
@@ -1,7 +1,15 @@
 package handlers
 
-import "tecdsa/pkg/service"
+import (
+	"tecdsa/pkg/database/repository"
+	"tecdsa/pkg/service"
+)
 
 type CreateUnsignedTxHandler struct {
-	networkService *service.NetworkService
+	networkService     *service.NetworkService
+	clientSecurityRepo repository.ClientSecurityRepository
+	keyRepo            repository.KeyRepository
+	keyAddressRepo     repository.KeyAddressRepository
+	contractABIRepo    repository.ContractABIRepository
+	nonceManager       *service.NonceManager
 }
\ No newline at end of file
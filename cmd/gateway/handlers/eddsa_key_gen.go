@@ -0,0 +1,264 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"tecdsa/cmd/gateway/config"
+	"tecdsa/pkg/database/repository"
+	"tecdsa/pkg/network"
+	"tecdsa/pkg/response"
+	"tecdsa/pkg/service"
+	"tecdsa/pkg/utils"
+	pb "tecdsa/proto/eddsa"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+type EddsaKeyGenRequest struct {
+	RequestID string `json:"request_id,omitempty"`
+	Network   int32  `json:"network"`
+}
+
+type EddsaKeyGenHandler struct {
+	clientSecurityRepo repository.ClientSecurityRepository
+	keyRepo            repository.KeyRepository
+	config             *config.Config
+	networkService     *service.NetworkService
+	requestContexts    map[string]*requestContext
+	mutex              sync.Mutex
+}
+
+func NewEddsaKeyGenHandler(cfg *config.Config, repo repository.ClientSecurityRepository, keyRepo repository.KeyRepository, networkService *service.NetworkService) *EddsaKeyGenHandler {
+	return &EddsaKeyGenHandler{
+		clientSecurityRepo: repo,
+		keyRepo:            keyRepo,
+		config:             cfg,
+		networkService:     networkService,
+		requestContexts:    make(map[string]*requestContext),
+	}
+}
+
+func (h *EddsaKeyGenHandler) Serve(w http.ResponseWriter, r *http.Request) {
+	req, requestID, err := h.parseAndValidateRequest(r)
+	if err != nil {
+		response.SendResponse(w, response.NewErrorResponse(response.ErrCodeBadRequest, err.Error()))
+		return
+	}
+
+	clientIP := utils.GetClientIP(r)
+
+	clientSecurity, err := h.clientSecurityRepo.FindByIP(clientIP)
+	if err != nil {
+		response.SendResponse(w, response.NewErrorResponse(response.ErrCodeInternalServerError, response.ErrMsgFailedRetrieveClientSecurity))
+		return
+	}
+
+	// Ed25519 키는 xpub 을 내보내지 않으므로 체인코드가 없습니다.
+	key, err := h.keyRepo.Create(req.Network, 0, int32(network.Ed25519), nil, uint(clientSecurity.ID))
+	if err != nil {
+		response.SendResponse(w, response.NewErrorResponse(response.ErrCodeInternalServerError, response.ErrMsgFailedStoreKey))
+		return
+	}
+	completed := false
+	defer func() {
+		if !completed {
+			h.keyRepo.Delete(key.ID)
+		}
+	}()
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Minute)
+	defer cancel()
+
+	ctx = metadata.NewOutgoingContext(ctx, metadata.New(map[string]string{
+		"request_id":         requestID,
+		"key_id":             fmt.Sprintf("%d", key.ID),
+		"network":            fmt.Sprintf("%d", req.Network),
+		"client_security_id": fmt.Sprintf("%d", clientSecurity.ID),
+	}))
+
+	if err := h.storeRequestContext(requestID, req, key.ID, uint32(clientSecurity.ID)); err != nil {
+		response.SendResponse(w, response.NewErrorResponse(response.ErrCodeBadRequest, err.Error()))
+		return
+	}
+	defer h.removeRequestContext(requestID)
+
+	bobStream, aliceStream, closeConns, err := h.setupStreams(ctx)
+	if err != nil {
+		response.SendResponse(w, response.NewErrorResponse(response.ErrCodeInternalServerError, response.ErrMsgFailedSetupStreams))
+		return
+	}
+	defer closeConns()
+	defer bobStream.CloseSend()
+	defer aliceStream.CloseSend()
+
+	if err := h.performKeyGeneration(w, bobStream, aliceStream, requestID); err != nil {
+		response.SendResponse(w, response.NewErrorResponse(response.ErrCodeKeyGeneration, err.Error()))
+		return
+	}
+	completed = true
+}
+
+func (h *EddsaKeyGenHandler) parseAndValidateRequest(r *http.Request) (EddsaKeyGenRequest, string, error) {
+	var req EddsaKeyGenRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return req, "", fmt.Errorf(response.ErrMsgInvalidRequestBody)
+	}
+
+	requestID := strings.TrimSpace(req.RequestID)
+	if requestID == "" {
+		requestID = uuid.New().String()
+	}
+
+	net, err := h.networkService.GetNetworkByID(req.Network)
+	if err != nil {
+		return req, "", fmt.Errorf(response.ErrMsgUnsupportedNetwork)
+	}
+
+	if net.Curve() != network.Ed25519 {
+		return req, "", fmt.Errorf(response.ErrMsgNotEd25519Network)
+	}
+
+	return req, requestID, nil
+}
+
+func (h *EddsaKeyGenHandler) storeRequestContext(requestID string, req EddsaKeyGenRequest, keyID uint32, clientSecurityID uint32) error {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	if _, exists := h.requestContexts[requestID]; exists {
+		return fmt.Errorf(response.ErrMsgDuplicateRequestID)
+	}
+
+	h.requestContexts[requestID] = &requestContext{
+		startTime:        time.Now(),
+		keyID:            keyID,
+		network:          req.Network,
+		curve:            network.Ed25519,
+		clientSecurityID: clientSecurityID,
+	}
+
+	return nil
+}
+
+func (h *EddsaKeyGenHandler) removeRequestContext(requestID string) {
+	h.mutex.Lock()
+	delete(h.requestContexts, requestID)
+	h.mutex.Unlock()
+}
+
+func (h *EddsaKeyGenHandler) performKeyGeneration(w http.ResponseWriter, bobStream, aliceStream pb.EddsaKeygenService_KeyGenClient, requestID string) error {
+	bobChan := make(chan *pb.EddsaKeygenMessage)
+	aliceChan := make(chan *pb.EddsaKeygenMessage)
+	// 두 수신 고루틴이 모두 오류를 보낼 수 있으므로, 먼저 끝난 쪽만 읽어도 나머지가 막히지 않도록 버퍼를 둡니다.
+	errorChan := make(chan error, 2)
+
+	go h.receiveMessages(bobStream, bobChan, errorChan)
+	go h.receiveMessages(aliceStream, aliceChan, errorChan)
+
+	// Ed25519 DKG 는 Alice 의 커밋으로 시작합니다.
+	err := aliceStream.Send(&pb.EddsaKeygenMessage{
+		Msg: &pb.EddsaKeygenMessage_EddsaKeyGenGatewayTo1Output{
+			EddsaKeyGenGatewayTo1Output: &pb.EddsaKeyGenGatewayTo1Output{},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf(response.ErrMsgFailedStartKeyGeneration)
+	}
+
+	for {
+		select {
+		case bobResp := <-bobChan:
+			if res, ok := bobResp.Msg.(*pb.EddsaKeygenMessage_EddsaKeyGenRound4ToGatewayOutput); ok {
+				return h.handleFinalResponse(w, res, requestID)
+			}
+			if err := aliceStream.Send(bobResp); err != nil {
+				return fmt.Errorf(response.ErrMsgFailedDuringKeyGeneration)
+			}
+		case aliceResp := <-aliceChan:
+			if err := bobStream.Send(aliceResp); err != nil {
+				return fmt.Errorf(response.ErrMsgFailedDuringKeyGeneration)
+			}
+		case <-errorChan:
+			return fmt.Errorf(response.ErrMsgFailedDuringKeyGeneration)
+		}
+	}
+}
+
+func (h *EddsaKeyGenHandler) handleFinalResponse(w http.ResponseWriter, res *pb.EddsaKeygenMessage_EddsaKeyGenRound4ToGatewayOutput, requestID string) error {
+	h.mutex.Lock()
+	reqCtx, exists := h.requestContexts[requestID]
+	h.mutex.Unlock()
+
+	if !exists {
+		return fmt.Errorf(response.ErrMsgInvalidRequestID)
+	}
+
+	output := res.EddsaKeyGenRound4ToGatewayOutput
+	if err := h.keyRepo.Complete(reqCtx.keyID, output.PublicKey, output.Address); err != nil {
+		return fmt.Errorf(response.ErrMsgFailedStoreKey)
+	}
+
+	duration := time.Since(reqCtx.startTime)
+
+	response.SendResponse(w, response.NewSuccessResponse(http.StatusOK, KeyGenResponse{
+		RequestID: requestID,
+		KeyID:     reqCtx.keyID,
+		Address:   output.Address,
+		Curve:     network.Ed25519.String(),
+		Publickey: output.PublicKey,
+		Duration:  int32(duration.Milliseconds()),
+	}))
+	return nil
+}
+
+// setupStreams 는 두 파티와 키 생성 스트림을 엽니다. 키 생성이 끝나면 호출자가 closeConns 로 연결을 닫아야 합니다.
+func (h *EddsaKeyGenHandler) setupStreams(ctx context.Context) (pb.EddsaKeygenService_KeyGenClient, pb.EddsaKeygenService_KeyGenClient, func(), error) {
+	bobStream, bobConn, err := h.setupStream(ctx, h.config.BobGRPCAddress)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf(response.ErrMsgFailedSetupStreams)
+	}
+
+	aliceStream, aliceConn, err := h.setupStream(ctx, h.config.AliceGRPCAddress)
+	if err != nil {
+		bobConn.Close()
+		return nil, nil, nil, fmt.Errorf(response.ErrMsgFailedSetupStreams)
+	}
+
+	closeConns := func() {
+		bobConn.Close()
+		aliceConn.Close()
+	}
+	return bobStream, aliceStream, closeConns, nil
+}
+
+func (h *EddsaKeyGenHandler) setupStream(ctx context.Context, address string) (pb.EddsaKeygenService_KeyGenClient, *grpc.ClientConn, error) {
+	conn, err := dialParty(ctx, h.config, address)
+	if err != nil {
+		return nil, nil, fmt.Errorf(response.ErrMsgFailedConnectGRPC)
+	}
+	stream, err := pb.NewEddsaKeygenServiceClient(conn).KeyGen(ctx)
+	if err != nil {
+		conn.Close()
+		return nil, nil, err
+	}
+	return stream, conn, nil
+}
+
+func (h *EddsaKeyGenHandler) receiveMessages(stream pb.EddsaKeygenService_KeyGenClient, msgChan chan<- *pb.EddsaKeygenMessage, errChan chan<- error) {
+	for {
+		resp, err := stream.Recv()
+		if err != nil {
+			errChan <- err
+			return
+		}
+		msgChan <- resp
+	}
+}
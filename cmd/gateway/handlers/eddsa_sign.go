@@ -0,0 +1,326 @@
+package handlers
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"tecdsa/cmd/gateway/config"
+	"tecdsa/pkg/database/repository"
+	"tecdsa/pkg/network"
+	"tecdsa/pkg/response"
+	"tecdsa/pkg/service"
+	"tecdsa/pkg/transaction"
+	"tecdsa/pkg/utils"
+	pb "tecdsa/proto/eddsa"
+
+	"github.com/coinbase/kryptology/pkg/core/curves"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+type EddsaSignRequest struct {
+	Address string `json:"address"`
+	// TxOrigin 은 서명할 메시지(솔라나는 직렬화된 트랜잭션 메시지)의 base64 입니다.
+	TxOrigin   string                           `json:"tx_origin"`
+	RequestID  string                           `json:"request_id,omitempty"`
+	UnsignedTx *transaction.UnsignedTransaction `json:"unsigned_tx,omitempty"`
+}
+
+type EddsaSignResponse struct {
+	// Signature 는 64바이트 Ed25519 서명(R || S)의 base64 입니다.
+	Signature string `json:"signature"`
+	SignedTx  string `json:"signed_tx,omitempty"`
+	Duration  int32  `json:"duration"`
+	RequestID string `json:"request_id"`
+}
+
+type eddsaSignRequestContext struct {
+	startTime  time.Time
+	unsignedTx *transaction.UnsignedTransaction
+	network    network.Network
+	publicKey  curves.Point
+}
+
+type EddsaSignHandler struct {
+	clientSecurityRepo repository.ClientSecurityRepository
+	keyRepo            repository.KeyRepository
+	keyAddressRepo     repository.KeyAddressRepository
+	config             *config.Config
+	networkService     *service.NetworkService
+	requestContexts    map[string]*eddsaSignRequestContext
+	mutex              sync.Mutex
+}
+
+func NewEddsaSignHandler(cfg *config.Config, repo repository.ClientSecurityRepository, keyRepo repository.KeyRepository, keyAddressRepo repository.KeyAddressRepository, networkService *service.NetworkService) *EddsaSignHandler {
+	return &EddsaSignHandler{
+		clientSecurityRepo: repo,
+		keyRepo:            keyRepo,
+		keyAddressRepo:     keyAddressRepo,
+		config:             cfg,
+		networkService:     networkService,
+		requestContexts:    make(map[string]*eddsaSignRequestContext),
+	}
+}
+
+func (h *EddsaSignHandler) Serve(w http.ResponseWriter, r *http.Request) {
+	req, requestID, err := h.parseAndValidateRequest(r)
+	if err != nil {
+		response.SendResponse(w, response.NewErrorResponse(response.ErrCodeBadRequest, err.Error()))
+		return
+	}
+
+	clientIP := utils.GetClientIP(r)
+	clientSecurity, err := h.clientSecurityRepo.FindByIP(clientIP)
+	if err != nil {
+		response.SendResponse(w, response.NewErrorResponse(response.ErrCodeInternalServerError, response.ErrMsgFailedRetrieveClientSecurity))
+		return
+	}
+
+	reqCtx := &eddsaSignRequestContext{startTime: time.Now()}
+	if err := h.prepareRequest(&req, reqCtx); err != nil {
+		response.SendResponse(w, response.NewErrorResponse(response.ErrCodeBadRequest, err.Error()))
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Minute)
+	defer cancel()
+
+	ctx = metadata.NewOutgoingContext(ctx, metadata.New(map[string]string{
+		"request_id":         requestID,
+		"address":            req.Address,
+		"tx_origin":          req.TxOrigin,
+		"client_security_id": fmt.Sprintf("%d", clientSecurity.ID),
+	}))
+
+	if err := h.storeRequestContext(requestID, reqCtx); err != nil {
+		response.SendResponse(w, response.NewErrorResponse(response.ErrCodeBadRequest, err.Error()))
+		return
+	}
+	defer h.removeRequestContext(requestID)
+
+	bobStream, aliceStream, closeConns, err := h.setupStreams(ctx)
+	if err != nil {
+		response.SendResponse(w, response.NewErrorResponse(response.ErrCodeInternalServerError, response.ErrMsgFailedSetupStreams))
+		return
+	}
+	defer closeConns()
+	defer bobStream.CloseSend()
+	defer aliceStream.CloseSend()
+
+	if err := h.performSigning(w, bobStream, aliceStream, requestID); err != nil {
+		response.SendResponse(w, response.NewErrorResponse(response.ErrCodeSigning, err.Error()))
+	}
+}
+
+func (h *EddsaSignHandler) parseAndValidateRequest(r *http.Request) (EddsaSignRequest, string, error) {
+	var req EddsaSignRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return req, "", fmt.Errorf(response.ErrMsgInvalidRequestBody)
+	}
+
+	requestID := strings.TrimSpace(req.RequestID)
+	if requestID == "" {
+		requestID = uuid.New().String()
+	}
+
+	if req.Address == "" || (req.TxOrigin == "" && req.UnsignedTx == nil) {
+		return req, "", fmt.Errorf(response.ErrMsgInvalidSignRequest)
+	}
+
+	return req, requestID, nil
+}
+
+// prepareRequest 는 키가 Ed25519 인지 확인하고, 미서명 트랜잭션이 있으면 서명할 메시지를 tx_origin 으로 사용합니다.
+func (h *EddsaSignHandler) prepareRequest(req *EddsaSignRequest, reqCtx *eddsaSignRequestContext) error {
+	keyAddress, err := h.keyAddressRepo.FindByAddress(req.Address)
+	if err != nil {
+		return fmt.Errorf(response.ErrMsgKeyNotFound)
+	}
+
+	key, err := h.keyRepo.FindByID(uint(keyAddress.KeyID))
+	if err != nil {
+		return fmt.Errorf(response.ErrMsgKeyNotFound)
+	}
+	if key.Curve != int32(network.Ed25519) {
+		return fmt.Errorf(response.ErrMsgNotEd25519Key)
+	}
+
+	if req.UnsignedTx == nil {
+		if _, err := base64.StdEncoding.DecodeString(req.TxOrigin); err != nil {
+			return fmt.Errorf(response.ErrMsgInvalidSignRequest)
+		}
+		return nil
+	}
+
+	if req.UnsignedTx.NetworkID != keyAddress.Network {
+		return fmt.Errorf(response.ErrMsgUnsupportedNetwork)
+	}
+
+	net, err := h.networkService.GetNetworkByID(keyAddress.Network)
+	if err != nil {
+		return fmt.Errorf(response.ErrMsgUnsupportedNetwork)
+	}
+
+	publicKeyBytes, err := hex.DecodeString(key.PublicKey)
+	if err != nil {
+		return fmt.Errorf(response.ErrMsgFailedCreateSigningPayload)
+	}
+	point, err := curves.ED25519().Point.FromAffineCompressed(publicKeyBytes)
+	if err != nil {
+		return fmt.Errorf(response.ErrMsgFailedCreateSigningPayload)
+	}
+
+	payload, err := h.networkService.CreateSigningPayload(net, req.UnsignedTx, point)
+	if err != nil {
+		return fmt.Errorf("%s: %v", response.ErrMsgFailedCreateSigningPayload, err)
+	}
+
+	req.TxOrigin = base64.StdEncoding.EncodeToString(payload)
+	reqCtx.unsignedTx = req.UnsignedTx
+	reqCtx.network = net
+	reqCtx.publicKey = point
+	return nil
+}
+
+func (h *EddsaSignHandler) storeRequestContext(requestID string, reqCtx *eddsaSignRequestContext) error {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	if _, exists := h.requestContexts[requestID]; exists {
+		return fmt.Errorf(response.ErrMsgDuplicateRequestID)
+	}
+
+	h.requestContexts[requestID] = reqCtx
+
+	return nil
+}
+
+func (h *EddsaSignHandler) removeRequestContext(requestID string) {
+	h.mutex.Lock()
+	delete(h.requestContexts, requestID)
+	h.mutex.Unlock()
+}
+
+func (h *EddsaSignHandler) performSigning(w http.ResponseWriter, bobStream, aliceStream pb.EddsaSignService_SignClient, requestID string) error {
+	bobChan := make(chan *pb.EddsaSignMessage)
+	aliceChan := make(chan *pb.EddsaSignMessage)
+	// 두 수신 고루틴이 모두 오류를 보낼 수 있으므로, 먼저 끝난 쪽만 읽어도 나머지가 막히지 않도록 버퍼를 둡니다.
+	errorChan := make(chan error, 2)
+
+	go h.receiveMessages(bobStream, bobChan, errorChan)
+	go h.receiveMessages(aliceStream, aliceChan, errorChan)
+
+	err := aliceStream.Send(&pb.EddsaSignMessage{
+		Msg: &pb.EddsaSignMessage_EddsaSignGatewayTo1Output{
+			EddsaSignGatewayTo1Output: &pb.EddsaSignGatewayTo1Output{},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf(response.ErrMsgFailedStartSigning)
+	}
+
+	for {
+		select {
+		case bobResp := <-bobChan:
+			if signResp, ok := bobResp.Msg.(*pb.EddsaSignMessage_EddsaSignRound4ToGatewayOutput); ok {
+				return h.handleFinalResponse(w, signResp, requestID)
+			}
+			if err := aliceStream.Send(bobResp); err != nil {
+				return fmt.Errorf(response.ErrMsgFailedDuringSigning)
+			}
+		case aliceResp := <-aliceChan:
+			if err := bobStream.Send(aliceResp); err != nil {
+				return fmt.Errorf(response.ErrMsgFailedDuringSigning)
+			}
+		case <-errorChan:
+			return fmt.Errorf(response.ErrMsgFailedDuringSigning)
+		}
+	}
+}
+
+func (h *EddsaSignHandler) handleFinalResponse(w http.ResponseWriter, signResp *pb.EddsaSignMessage_EddsaSignRound4ToGatewayOutput, requestID string) error {
+	h.mutex.Lock()
+	reqCtx, exists := h.requestContexts[requestID]
+	h.mutex.Unlock()
+
+	if !exists {
+		return fmt.Errorf(response.ErrMsgInvalidRequestID)
+	}
+
+	sig := signResp.EddsaSignRound4ToGatewayOutput.Signature
+	if len(sig) != 64 {
+		return fmt.Errorf(response.ErrMsgFailedDuringSigning)
+	}
+
+	var signedTx string
+	if reqCtx.unsignedTx != nil {
+		signature := &transaction.Signature{R: sig[:32], S: sig[32:]}
+		var err error
+		signedTx, err = h.networkService.AssembleSignedTransaction(reqCtx.network, reqCtx.unsignedTx, reqCtx.publicKey, signature)
+		if err != nil {
+			return fmt.Errorf(response.ErrMsgFailedAssembleTransaction)
+		}
+	}
+
+	duration := time.Since(reqCtx.startTime)
+
+	response.SendResponse(w, response.NewSuccessResponse(http.StatusOK, EddsaSignResponse{
+		Signature: base64.StdEncoding.EncodeToString(sig),
+		SignedTx:  signedTx,
+		Duration:  int32(duration.Milliseconds()),
+		RequestID: requestID,
+	}))
+	return nil
+}
+
+// setupStreams 는 두 파티와 서명 스트림을 엽니다. 서명이 끝나면 호출자가 closeConns 로 연결을 닫아야 합니다.
+func (h *EddsaSignHandler) setupStreams(ctx context.Context) (pb.EddsaSignService_SignClient, pb.EddsaSignService_SignClient, func(), error) {
+	bobStream, bobConn, err := h.setupStream(ctx, h.config.BobGRPCAddress)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf(response.ErrMsgFailedSetupStreams)
+	}
+
+	aliceStream, aliceConn, err := h.setupStream(ctx, h.config.AliceGRPCAddress)
+	if err != nil {
+		bobConn.Close()
+		return nil, nil, nil, fmt.Errorf(response.ErrMsgFailedSetupStreams)
+	}
+
+	closeConns := func() {
+		bobConn.Close()
+		aliceConn.Close()
+	}
+	return bobStream, aliceStream, closeConns, nil
+}
+
+func (h *EddsaSignHandler) setupStream(ctx context.Context, address string) (pb.EddsaSignService_SignClient, *grpc.ClientConn, error) {
+	conn, err := dialParty(ctx, h.config, address)
+	if err != nil {
+		return nil, nil, fmt.Errorf(response.ErrMsgFailedConnectGRPC)
+	}
+	stream, err := pb.NewEddsaSignServiceClient(conn).Sign(ctx)
+	if err != nil {
+		conn.Close()
+		return nil, nil, err
+	}
+	return stream, conn, nil
+}
+
+func (h *EddsaSignHandler) receiveMessages(stream pb.EddsaSignService_SignClient, msgChan chan<- *pb.EddsaSignMessage, errChan chan<- error) {
+	for {
+		resp, err := stream.Recv()
+		if err != nil {
+			errChan <- err
+			return
+		}
+		msgChan <- resp
+	}
+}
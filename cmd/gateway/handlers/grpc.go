@@ -0,0 +1,15 @@
+package handlers
+
+import (
+	"context"
+
+	"tecdsa/cmd/gateway/config"
+
+	"google.golang.org/grpc"
+)
+
+// dialParty 는 Alice 또는 Bob 의 gRPC 서버에 연결합니다.
+func dialParty(ctx context.Context, cfg *config.Config, address string) (*grpc.ClientConn, error) {
+	opts := append([]grpc.DialOption{grpc.WithInsecure()}, cfg.DialOptions...)
+	return grpc.DialContext(ctx, address, opts...)
+}
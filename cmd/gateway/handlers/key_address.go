@@ -0,0 +1,198 @@
+package handlers
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"tecdsa/cmd/gateway/config"
+	"tecdsa/pkg/database/models"
+	"tecdsa/pkg/database/repository"
+	"tecdsa/pkg/network"
+	"tecdsa/pkg/response"
+	"tecdsa/pkg/service"
+	"tecdsa/pkg/utils"
+	pb "tecdsa/proto/key"
+)
+
+type AddKeyAddressRequest struct {
+	Network     int32 `json:"network"`
+	AddressType int32 `json:"address_type,omitempty"`
+}
+
+type KeyAddressResponse struct {
+	KeyID       uint32 `json:"key_id"`
+	Network     int32  `json:"network"`
+	AddressType int32  `json:"address_type"`
+	Address     string `json:"address"`
+}
+
+type KeyAddressHandler struct {
+	config             *config.Config
+	clientSecurityRepo repository.ClientSecurityRepository
+	keyRepo            repository.KeyRepository
+	keyAddressRepo     repository.KeyAddressRepository
+	networkService     *service.NetworkService
+}
+
+func NewKeyAddressHandler(cfg *config.Config, repo repository.ClientSecurityRepository, keyRepo repository.KeyRepository, keyAddressRepo repository.KeyAddressRepository, networkService *service.NetworkService) *KeyAddressHandler {
+	return &KeyAddressHandler{
+		config:             cfg,
+		clientSecurityRepo: repo,
+		keyRepo:            keyRepo,
+		keyAddressRepo:     keyAddressRepo,
+		networkService:     networkService,
+	}
+}
+
+// Serve 는 GET /keys/{id}/addresses (주소 목록), POST /keys/{id}/addresses (주소 추가) 요청을 처리합니다.
+// 키를 생성한 (할당받은) 클라이언트만 주소를 조회하거나 추가할 수 있습니다.
+func (h *KeyAddressHandler) Serve(w http.ResponseWriter, r *http.Request) {
+	parts := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, "/keys/"), "/"), "/")
+	if len(parts) != 2 || parts[1] != "addresses" {
+		response.SendResponse(w, response.NewErrorResponse(response.ErrCodeNotFound))
+		return
+	}
+
+	keyID, err := strconv.ParseUint(parts[0], 10, 32)
+	if err != nil {
+		response.SendResponse(w, response.NewErrorResponse(response.ErrCodeBadRequest, response.ErrMsgInvalidKeyID))
+		return
+	}
+
+	key, err := h.keyRepo.FindByID(uint(keyID))
+	if err != nil || key.PublicKey == "" {
+		response.SendResponse(w, response.NewErrorResponse(response.ErrCodeNotFound, response.ErrMsgKeyNotFound))
+		return
+	}
+
+	clientIP := utils.GetClientIP(r)
+	clientSecurity, err := h.clientSecurityRepo.FindByIP(clientIP)
+	if err != nil {
+		response.SendResponse(w, response.NewErrorResponse(response.ErrCodeInternalServerError, response.ErrMsgFailedRetrieveClientSecurity))
+		return
+	}
+	if key.ClientSecurityID != uint(clientSecurity.ID) {
+		response.SendResponse(w, response.NewErrorResponse(response.ErrCodeForbidden, response.ErrMsgKeyNotOwned))
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		h.listAddresses(w, key)
+	case http.MethodPost:
+		h.addAddress(w, r, key)
+	default:
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+func (h *KeyAddressHandler) listAddresses(w http.ResponseWriter, key *models.Key) {
+	records, err := h.keyAddressRepo.FindByKeyID(key.ID)
+	if err != nil {
+		response.SendResponse(w, response.NewErrorResponse(response.ErrCodeInternalServerError, response.ErrMsgFailedRetrieveKeyAddresses))
+		return
+	}
+
+	addresses := make([]KeyAddressResponse, 0, len(records))
+	for _, record := range records {
+		addresses = append(addresses, newKeyAddressResponse(record))
+	}
+	response.SendResponse(w, response.NewSuccessResponse(http.StatusOK, addresses))
+}
+
+func (h *KeyAddressHandler) addAddress(w http.ResponseWriter, r *http.Request, key *models.Key) {
+	var req AddKeyAddressRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.SendResponse(w, response.NewErrorResponse(response.ErrCodeBadRequest, response.ErrMsgInvalidRequestBody))
+		return
+	}
+
+	net, err := h.networkService.GetNetworkByID(req.Network)
+	if err != nil {
+		response.SendResponse(w, response.NewErrorResponse(response.ErrCodeBadRequest, response.ErrMsgUnsupportedNetwork))
+		return
+	}
+	if !network.IsValidAddressType(net, int(req.AddressType)) {
+		response.SendResponse(w, response.NewErrorResponse(response.ErrCodeBadRequest, response.ErrMsgInvalidAddressType))
+		return
+	}
+
+	if existing, err := h.keyAddressRepo.FindByKeyAndNetwork(key.ID, req.Network, req.AddressType); err == nil {
+		response.SendResponse(w, response.NewSuccessResponse(http.StatusOK, newKeyAddressResponse(existing)))
+		return
+	}
+
+	address, err := h.deriveAddress(key, net, req.AddressType)
+	if err != nil {
+		response.SendResponse(w, response.NewErrorResponse(response.ErrCodeInternalServerError, response.ErrMsgFailedAddKeyAddress))
+		return
+	}
+
+	// Alice, Bob 도 각자의 쉐어에서 같은 주소를 파생해 등록해야 /sign 에서 찾을 수 있습니다.
+	ctx, cancel := context.WithTimeout(r.Context(), 30*time.Second)
+	defer cancel()
+	for _, partyAddress := range []string{h.config.AliceGRPCAddress, h.config.BobGRPCAddress} {
+		partyResult, err := h.addPartyAddress(ctx, partyAddress, key.ID, req)
+		if err != nil {
+			response.SendResponse(w, response.NewErrorResponse(response.ErrCodeInternalServerError, response.ErrMsgFailedAddKeyAddress))
+			return
+		}
+		if partyResult != address {
+			response.SendResponse(w, response.NewErrorResponse(response.ErrCodeInternalServerError, response.ErrMsgKeyAddressMismatch))
+			return
+		}
+	}
+
+	record, err := h.keyAddressRepo.Create(key.ID, req.Network, req.AddressType, address)
+	if err != nil {
+		response.SendResponse(w, response.NewErrorResponse(response.ErrCodeInternalServerError, response.ErrMsgFailedAddKeyAddress))
+		return
+	}
+
+	response.SendResponse(w, response.NewSuccessResponse(http.StatusOK, newKeyAddressResponse(record)))
+}
+
+func (h *KeyAddressHandler) deriveAddress(key *models.Key, net network.Network, addressType int32) (string, error) {
+	publicKeyBytes, err := hex.DecodeString(key.PublicKey)
+	if err != nil {
+		return "", err
+	}
+	point, err := network.Curve(key.Curve).KryptologyCurve().Point.FromAffineCompressed(publicKeyBytes)
+	if err != nil {
+		return "", err
+	}
+	return h.networkService.DeriveAddress(point, net, int(addressType))
+}
+
+func (h *KeyAddressHandler) addPartyAddress(ctx context.Context, address string, keyID uint32, req AddKeyAddressRequest) (string, error) {
+	conn, err := dialParty(ctx, h.config, address)
+	if err != nil {
+		return "", fmt.Errorf(response.ErrMsgFailedConnectGRPC)
+	}
+	defer conn.Close()
+
+	res, err := pb.NewKeyServiceClient(conn).AddAddress(ctx, &pb.AddAddressRequest{
+		KeyId:       keyID,
+		Network:     req.Network,
+		AddressType: req.AddressType,
+	})
+	if err != nil {
+		return "", err
+	}
+	return res.Address, nil
+}
+
+func newKeyAddressResponse(record *models.KeyAddress) KeyAddressResponse {
+	return KeyAddressResponse{
+		KeyID:       record.KeyID,
+		Network:     record.Network,
+		AddressType: record.AddressType,
+		Address:     record.Address,
+	}
+}
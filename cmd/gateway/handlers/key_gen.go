@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"context"
+	"crypto/rand"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -10,46 +11,61 @@ import (
 	"time"
 
 	"tecdsa/cmd/gateway/config"
+	"tecdsa/pkg/database/models"
 	"tecdsa/pkg/database/repository"
+	"tecdsa/pkg/network"
 	"tecdsa/pkg/response"
 	"tecdsa/pkg/service"
 	"tecdsa/pkg/utils"
 	pb "tecdsa/proto/keygen"
 
 	"github.com/google/uuid"
-	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
 )
 
 type KeyGenRequest struct {
 	RequestID string `json:"request_id,omitempty"`
 	Network   int32  `json:"network"`
+	// AddressType 은 비트코인 주소 유형입니다 (0: P2PKH, 1: P2SH-P2WPKH, 2: P2WPKH, 3: P2TR). 생략하면 P2PKH 입니다.
+	AddressType int32 `json:"address_type,omitempty"`
+	// Curve 는 키의 곡선입니다 (0: secp256k1, 2: P-256). 생략하면 네트워크의 곡선을 사용합니다.
+	Curve *int32 `json:"curve,omitempty"`
 }
 
 type KeyGenResponse struct {
-	RequestID string `json:"request_id"`
-	Address   string `json:"address"`
-	Publickey string `json:"public_key"`
-	Duration  int32  `json:"duration"`
+	RequestID   string `json:"request_id"`
+	KeyID       uint32 `json:"key_id"`
+	Address     string `json:"address"`
+	AddressType int32  `json:"address_type"`
+	Curve       string `json:"curve"`
+	Publickey   string `json:"public_key"`
+	Duration    int32  `json:"duration"`
 }
 
 type requestContext struct {
 	startTime        time.Time
+	keyID            uint32
 	network          int32
+	addressType      int32
+	curve            network.Curve
 	clientSecurityID uint32
 }
 
 type KeyGenHandler struct {
 	clientSecurityRepo repository.ClientSecurityRepository
+	keyRepo            repository.KeyRepository
+	keyPool            *KeyPool
 	config             *config.Config
 	networkService     *service.NetworkService
 	requestContexts    map[string]*requestContext
 	mutex              sync.Mutex
 }
 
-func NewKeyGenHandler(cfg *config.Config, repo repository.ClientSecurityRepository, networkService *service.NetworkService) *KeyGenHandler {
+func NewKeyGenHandler(cfg *config.Config, repo repository.ClientSecurityRepository, keyRepo repository.KeyRepository, keyPool *KeyPool, networkService *service.NetworkService) *KeyGenHandler {
 	return &KeyGenHandler{
 		clientSecurityRepo: repo,
+		keyRepo:            keyRepo,
+		keyPool:            keyPool,
 		config:             cfg,
 		networkService:     networkService,
 		requestContexts:    make(map[string]*requestContext),
@@ -71,12 +87,40 @@ func (h *KeyGenHandler) Serve(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// 키 풀에 미리 생성한 키가 있으면 DKG 없이 바로 할당합니다.
+	startTime := time.Now()
+	if key, address, ok := h.keyPool.Assign(r.Context(), req.Network, req.AddressType, *req.Curve, uint32(clientSecurity.ID)); ok {
+		response.SendResponse(w, response.NewSuccessResponse(http.StatusOK, KeyGenResponse{
+			RequestID:   requestID,
+			KeyID:       key.ID,
+			Address:     address,
+			AddressType: key.AddressType,
+			Curve:       network.Curve(key.Curve).String(),
+			Publickey:   key.PublicKey,
+			Duration:    int32(time.Since(startTime).Milliseconds()),
+		}))
+		return
+	}
+
+	// 키 ID 를 먼저 발급받아 Alice, Bob 이 같은 ID 로 쉐어를 저장하도록 합니다.
+	key, err := h.createPendingKey(req, uint32(clientSecurity.ID))
+	if err != nil {
+		response.SendResponse(w, response.NewErrorResponse(response.ErrCodeInternalServerError, err.Error()))
+		return
+	}
+	completed := false
+	defer func() {
+		if !completed {
+			h.keyRepo.Delete(key.ID)
+		}
+	}()
+
 	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Minute)
 	defer cancel()
 
-	ctx = h.addMetadataToContext(ctx, requestID, req, uint32(clientSecurity.ID))
+	ctx = h.addMetadataToContext(ctx, requestID, req, key.ID, uint32(clientSecurity.ID))
 
-	if err := h.storeRequestContext(requestID, req, uint32(clientSecurity.ID)); err != nil {
+	if err := h.storeRequestContext(requestID, req, key.ID, uint32(clientSecurity.ID)); err != nil {
 		response.SendResponse(w, response.NewErrorResponse(response.ErrCodeBadRequest, err.Error()))
 		return
 	}
@@ -92,7 +136,28 @@ func (h *KeyGenHandler) Serve(w http.ResponseWriter, r *http.Request) {
 
 	if err := h.performKeyGeneration(w, bobStream, aliceStream, requestID); err != nil {
 		response.SendResponse(w, response.NewErrorResponse(response.ErrCodeKeyGeneration, err.Error()))
+		return
+	}
+	completed = true
+}
+
+func (h *KeyGenHandler) createPendingKey(req KeyGenRequest, clientSecurityID uint32) (*models.Key, error) {
+	return newPendingKey(h.keyRepo, req.Network, req.AddressType, *req.Curve, clientSecurityID)
+}
+
+// newPendingKey 는 체인코드와 함께 공개키가 없는 키를 등록합니다. 키 풀은 clientSecurityID 0 으로 등록합니다.
+func newPendingKey(keyRepo repository.KeyRepository, networkID int32, addressType int32, curve int32, clientSecurityID uint32) (*models.Key, error) {
+	// xpub 내보내기에 사용할 체인코드 (HD 파생이 없으므로 키 생성 시 무작위로 정합니다)
+	chainCode := make([]byte, 32)
+	if _, err := rand.Read(chainCode); err != nil {
+		return nil, fmt.Errorf(response.ErrMsgFailedStoreKey)
+	}
+
+	key, err := keyRepo.Create(networkID, addressType, curve, chainCode, uint(clientSecurityID))
+	if err != nil {
+		return nil, fmt.Errorf(response.ErrMsgFailedStoreKey)
 	}
+	return key, nil
 }
 
 func (h *KeyGenHandler) parseAndValidateRequest(r *http.Request) (KeyGenRequest, string, error) {
@@ -106,23 +171,41 @@ func (h *KeyGenHandler) parseAndValidateRequest(r *http.Request) (KeyGenRequest,
 		requestID = uuid.New().String()
 	}
 
-	if _, err := h.networkService.GetNetworkByID(req.Network); err != nil {
+	net, err := h.networkService.GetNetworkByID(req.Network)
+	if err != nil {
 		return req, "", fmt.Errorf(response.ErrMsgUnsupportedNetwork)
 	}
 
+	if !net.Curve().IsEcdsaCurve() {
+		return req, "", fmt.Errorf(response.ErrMsgUseEd25519KeyGen)
+	}
+
+	curve := int32(net.Curve())
+	if req.Curve != nil && *req.Curve != curve {
+		return req, "", fmt.Errorf(response.ErrMsgUnsupportedCurve)
+	}
+	req.Curve = &curve
+
+	if !network.IsValidAddressType(net, int(req.AddressType)) {
+		return req, "", fmt.Errorf(response.ErrMsgInvalidAddressType)
+	}
+
 	return req, requestID, nil
 }
 
-func (h *KeyGenHandler) addMetadataToContext(ctx context.Context, requestID string, req KeyGenRequest, clientSecurityID uint32) context.Context {
+func (h *KeyGenHandler) addMetadataToContext(ctx context.Context, requestID string, req KeyGenRequest, keyID uint32, clientSecurityID uint32) context.Context {
 	md := metadata.New(map[string]string{
 		"request_id":         requestID,
+		"key_id":             fmt.Sprintf("%d", keyID),
 		"network":            fmt.Sprintf("%d", req.Network),
+		"address_type":       fmt.Sprintf("%d", req.AddressType),
+		"curve":              fmt.Sprintf("%d", *req.Curve),
 		"client_security_id": fmt.Sprintf("%d", clientSecurityID),
 	})
 	return metadata.NewOutgoingContext(ctx, md)
 }
 
-func (h *KeyGenHandler) storeRequestContext(requestID string, req KeyGenRequest, clientSecurityID uint32) error {
+func (h *KeyGenHandler) storeRequestContext(requestID string, req KeyGenRequest, keyID uint32, clientSecurityID uint32) error {
 	h.mutex.Lock()
 	defer h.mutex.Unlock()
 
@@ -132,7 +215,10 @@ func (h *KeyGenHandler) storeRequestContext(requestID string, req KeyGenRequest,
 
 	h.requestContexts[requestID] = &requestContext{
 		startTime:        time.Now(),
+		keyID:            keyID,
 		network:          req.Network,
+		addressType:      req.AddressType,
+		curve:            network.Curve(*req.Curve),
 		clientSecurityID: clientSecurityID,
 	}
 
@@ -196,13 +282,24 @@ func (h *KeyGenHandler) handleFinalResponse(w http.ResponseWriter, res *pb.Keyge
 		return fmt.Errorf(response.ErrMsgInvalidRequestID)
 	}
 
+	if err := h.keyRepo.Complete(
+		reqCtx.keyID,
+		res.KeyGenRound11ToGatewayOutput.PublicKey,
+		res.KeyGenRound11ToGatewayOutput.Address,
+	); err != nil {
+		return fmt.Errorf(response.ErrMsgFailedStoreKey)
+	}
+
 	duration := time.Since(reqCtx.startTime)
 
 	keyGenResponse := KeyGenResponse{
-		RequestID: requestID,
-		Address:   res.KeyGenRound11ToGatewayOutput.Address,
-		Publickey: res.KeyGenRound11ToGatewayOutput.PublicKey,
-		Duration:  int32(duration.Milliseconds()),
+		RequestID:   requestID,
+		KeyID:       reqCtx.keyID,
+		Address:     res.KeyGenRound11ToGatewayOutput.Address,
+		AddressType: reqCtx.addressType,
+		Curve:       reqCtx.curve.String(),
+		Publickey:   res.KeyGenRound11ToGatewayOutput.PublicKey,
+		Duration:    int32(duration.Milliseconds()),
 	}
 
 	response.SendResponse(w, response.NewSuccessResponse(http.StatusOK, keyGenResponse))
@@ -224,7 +321,7 @@ func (h *KeyGenHandler) setupKeygenStreams(ctx context.Context) (pb.KeygenServic
 }
 
 func (h *KeyGenHandler) setupKeygenStream(ctx context.Context, address string) (pb.KeygenService_KeyGenClient, error) {
-	conn, err := grpc.DialContext(ctx, address, grpc.WithInsecure())
+	conn, err := dialParty(ctx, h.config, address)
 	if err != nil {
 		return nil, fmt.Errorf(response.ErrMsgFailedConnectGRPC)
 	}
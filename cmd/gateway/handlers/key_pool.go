@@ -0,0 +1,411 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"tecdsa/cmd/gateway/config"
+	"tecdsa/pkg/database/models"
+	"tecdsa/pkg/database/repository"
+	"tecdsa/pkg/network"
+	"tecdsa/pkg/response"
+	"tecdsa/pkg/service"
+	pbKey "tecdsa/proto/key"
+	pb "tecdsa/proto/keygen"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// defaultKeyPoolRefillInterval 은 풀이 찼을 때 다시 확인하는 기본 주기입니다.
+	defaultKeyPoolRefillInterval = 10 * time.Second
+	// keyPoolRateWindow 는 리필 속도를 계산하는 구간입니다.
+	keyPoolRateWindow = 10 * time.Minute
+)
+
+type keyPoolTargetID struct {
+	network     int32
+	addressType int32
+}
+
+type keyPoolStats struct {
+	generated int64
+	failed    int64
+	assigned  int64
+	// refills 는 keyPoolRateWindow 안에서 키 생성이 끝난 시각입니다.
+	refills []time.Time
+}
+
+// KeyPoolMetrics 는 (네트워크, 주소 유형) 별 키 풀 상태입니다.
+type KeyPoolMetrics struct {
+	Network     int32 `json:"network"`
+	AddressType int32 `json:"address_type"`
+	Target      int   `json:"target"`
+	// Depth 는 할당을 기다리는 키 수입니다.
+	Depth          int64 `json:"depth"`
+	GeneratedTotal int64 `json:"generated_total"`
+	FailedTotal    int64 `json:"failed_total"`
+	AssignedTotal  int64 `json:"assigned_total"`
+	// RefillRatePerMinute 는 최근 10분 동안 분당 생성한 키 수입니다.
+	RefillRatePerMinute float64 `json:"refill_rate_per_minute"`
+}
+
+// KeyPool 은 config.KeyPoolTargets 의 (네트워크, 주소 유형) 마다 클라이언트가 없는 키를 백그라운드에서 미리 생성해 둡니다.
+// /key_gen 은 풀의 키를 Alice, Bob, 게이트웨이에서 요청한 클라이언트에 할당하고 바로 응답합니다.
+type KeyPool struct {
+	config         *config.Config
+	keyRepo        repository.KeyRepository
+	keyAddressRepo repository.KeyAddressRepository
+	networkService *service.NetworkService
+	mutex          sync.Mutex
+	stats          map[keyPoolTargetID]*keyPoolStats
+	stop           chan struct{}
+}
+
+func NewKeyPool(cfg *config.Config, keyRepo repository.KeyRepository, keyAddressRepo repository.KeyAddressRepository, networkService *service.NetworkService) *KeyPool {
+	stats := make(map[keyPoolTargetID]*keyPoolStats)
+	for _, target := range cfg.KeyPoolTargets {
+		stats[keyPoolTargetID{target.Network, target.AddressType}] = &keyPoolStats{}
+	}
+	return &KeyPool{
+		config:         cfg,
+		keyRepo:        keyRepo,
+		keyAddressRepo: keyAddressRepo,
+		networkService: networkService,
+		stats:          stats,
+		stop:           make(chan struct{}),
+	}
+}
+
+// Start 는 풀 설정을 확인하고, 채울 키가 있으면 풀을 채우는 고루틴을 시작합니다.
+func (p *KeyPool) Start() error {
+	enabled := false
+	for _, target := range p.config.KeyPoolTargets {
+		net, err := p.networkService.GetNetworkByID(target.Network)
+		if err != nil {
+			return fmt.Errorf("%s: %d", response.ErrMsgUnsupportedNetwork, target.Network)
+		}
+		if !net.Curve().IsEcdsaCurve() {
+			return fmt.Errorf("key pool does not support %s keys (network %d)", net.Curve(), target.Network)
+		}
+		if !network.IsValidAddressType(net, int(target.AddressType)) {
+			return fmt.Errorf("%s: network %d, address type %d", response.ErrMsgInvalidAddressType, target.Network, target.AddressType)
+		}
+		if target.Size > 0 {
+			enabled = true
+		}
+	}
+	if !enabled {
+		return nil
+	}
+
+	interval := p.config.KeyPoolRefillInterval
+	if interval <= 0 {
+		interval = defaultKeyPoolRefillInterval
+	}
+	go func() {
+		for {
+			p.refill()
+			select {
+			case <-time.After(interval):
+			case <-p.stop:
+				return
+			}
+		}
+	}()
+	return nil
+}
+
+// Stop 은 풀을 채우는 고루틴을 멈춥니다.
+func (p *KeyPool) Stop() {
+	close(p.stop)
+}
+
+func (p *KeyPool) refill() {
+	for _, target := range p.config.KeyPoolTargets {
+		net, err := p.networkService.GetNetworkByID(target.Network)
+		if err != nil {
+			continue
+		}
+		curve := int32(net.Curve())
+
+		count, err := p.keyRepo.CountUnassigned(target.Network, target.AddressType, curve)
+		if err != nil {
+			log.Printf("key pool: failed to count keys for network %d: %v", target.Network, err)
+			continue
+		}
+		for i := count; i < int64(target.Size); i++ {
+			err := p.generate(target.Network, target.AddressType, curve)
+			p.recordGeneration(keyPoolTargetID{target.Network, target.AddressType}, err)
+			if err != nil {
+				log.Printf("key pool: failed to generate key for network %d: %v", target.Network, err)
+				break
+			}
+		}
+	}
+}
+
+// generate 는 클라이언트 없이 (client_security_id 0) DKG 를 한 번 중계해 풀에 키를 추가합니다.
+func (p *KeyPool) generate(networkID int32, addressType int32, curve int32) error {
+	key, err := newPendingKey(p.keyRepo, networkID, addressType, curve, 0)
+	if err != nil {
+		return err
+	}
+	completed := false
+	defer func() {
+		if !completed {
+			// 한쪽 파티만 쉐어를 저장했거나 게이트웨이에 키를 기록하지 못했으면 파티에 남은 쉐어도 지웁니다.
+			p.deleteOnParties(key.ID)
+			p.keyRepo.Delete(key.ID)
+		}
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	ctx = metadata.NewOutgoingContext(ctx, metadata.New(map[string]string{
+		"request_id":         uuid.New().String(),
+		"key_id":             fmt.Sprintf("%d", key.ID),
+		"network":            fmt.Sprintf("%d", networkID),
+		"address_type":       fmt.Sprintf("%d", addressType),
+		"curve":              fmt.Sprintf("%d", curve),
+		"client_security_id": "0",
+	}))
+
+	// 백그라운드에서 계속 실행되므로 연결은 매번 닫습니다.
+	bobConn, bobStream, err := p.setupKeygenStream(ctx, p.config.BobGRPCAddress)
+	if err != nil {
+		return err
+	}
+	defer bobConn.Close()
+
+	aliceConn, aliceStream, err := p.setupKeygenStream(ctx, p.config.AliceGRPCAddress)
+	if err != nil {
+		return err
+	}
+	defer aliceConn.Close()
+
+	bobChan := make(chan *pb.KeygenMessage)
+	aliceChan := make(chan *pb.KeygenMessage)
+	errorChan := make(chan error, 2)
+
+	go p.receiveKeygenMessages(bobStream, bobChan, errorChan)
+	go p.receiveKeygenMessages(aliceStream, aliceChan, errorChan)
+
+	if err := bobStream.Send(&pb.KeygenMessage{Msg: &pb.KeygenMessage_KeyGenGatewayTo1Output{
+		KeyGenGatewayTo1Output: &pb.KeyGenGatewayTo1Output{},
+	}}); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case bobResp := <-bobChan:
+			if res, ok := bobResp.Msg.(*pb.KeygenMessage_KeyGenRound11ToGatewayOutput); ok {
+				if err := p.keyRepo.Complete(key.ID, res.KeyGenRound11ToGatewayOutput.PublicKey, res.KeyGenRound11ToGatewayOutput.Address); err != nil {
+					return err
+				}
+				completed = true
+				return nil
+			}
+			if err := aliceStream.Send(bobResp); err != nil {
+				return err
+			}
+		case aliceResp := <-aliceChan:
+			if err := bobStream.Send(aliceResp); err != nil {
+				return err
+			}
+		case err := <-errorChan:
+			return err
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// Assign 은 풀의 키 하나를 게이트웨이, Alice, Bob 순서로 클라이언트에 할당합니다.
+// 풀이 비었거나 할당에 실패하면 false 를 돌려주며, 호출자는 DKG 를 직접 실행합니다.
+func (p *KeyPool) Assign(ctx context.Context, networkID int32, addressType int32, curve int32, clientSecurityID uint32) (*models.Key, string, bool) {
+	targetID := keyPoolTargetID{networkID, addressType}
+	if _, ok := p.stats[targetID]; !ok {
+		return nil, "", false
+	}
+
+	key, err := p.keyRepo.ClaimUnassigned(networkID, addressType, curve, uint(clientSecurityID))
+	if err != nil {
+		return nil, "", false
+	}
+
+	if err := p.assignOnParties(ctx, key.ID, clientSecurityID); err != nil {
+		log.Printf("key pool: failed to assign key %d: %v", key.ID, err)
+		if err := p.keyRepo.Release(key.ID, uint(clientSecurityID)); err != nil {
+			log.Printf("key pool: failed to release key %d: %v", key.ID, err)
+		}
+		return nil, "", false
+	}
+
+	keyAddress, err := p.keyAddressRepo.FindByKeyAndNetwork(key.ID, networkID, addressType)
+	if err != nil {
+		log.Printf("key pool: failed to find address of key %d: %v", key.ID, err)
+		p.releaseOnParties(ctx, key.ID, clientSecurityID)
+		if err := p.keyRepo.Release(key.ID, uint(clientSecurityID)); err != nil {
+			log.Printf("key pool: failed to release key %d: %v", key.ID, err)
+		}
+		return nil, "", false
+	}
+
+	p.mutex.Lock()
+	p.stats[targetID].assigned++
+	p.mutex.Unlock()
+
+	return key, keyAddress.Address, true
+}
+
+// assignOnParties 는 Alice, Bob 의 쉐어를 할당하고, Bob 이 실패하면 Alice 의 할당을 되돌립니다.
+func (p *KeyPool) assignOnParties(ctx context.Context, keyID uint32, clientSecurityID uint32) error {
+	req := &pbKey.AssignKeyRequest{KeyId: keyID, ClientSecurityId: clientSecurityID}
+
+	if err := p.callKeyService(ctx, p.config.AliceGRPCAddress, func(client pbKey.KeyServiceClient) error {
+		_, err := client.AssignKey(ctx, req)
+		return err
+	}); err != nil {
+		return err
+	}
+
+	if err := p.callKeyService(ctx, p.config.BobGRPCAddress, func(client pbKey.KeyServiceClient) error {
+		_, err := client.AssignKey(ctx, req)
+		return err
+	}); err != nil {
+		if releaseErr := p.callKeyService(ctx, p.config.AliceGRPCAddress, func(client pbKey.KeyServiceClient) error {
+			_, err := client.ReleaseKey(ctx, req)
+			return err
+		}); releaseErr != nil {
+			log.Printf("key pool: failed to release key %d on alice: %v", keyID, releaseErr)
+		}
+		return err
+	}
+	return nil
+}
+
+// releaseOnParties 는 Alice, Bob 에 할당한 쉐어를 다시 풀로 되돌립니다.
+func (p *KeyPool) releaseOnParties(ctx context.Context, keyID uint32, clientSecurityID uint32) {
+	req := &pbKey.AssignKeyRequest{KeyId: keyID, ClientSecurityId: clientSecurityID}
+	for _, address := range []string{p.config.AliceGRPCAddress, p.config.BobGRPCAddress} {
+		if err := p.callKeyService(ctx, address, func(client pbKey.KeyServiceClient) error {
+			_, err := client.ReleaseKey(ctx, req)
+			return err
+		}); err != nil {
+			log.Printf("key pool: failed to release key %d on %s: %v", keyID, address, err)
+		}
+	}
+}
+
+// deleteOnParties 는 생성에 실패한 풀 키의 쉐어를 Alice, Bob 에서 지웁니다.
+// DKG 의 컨텍스트가 만료되었을 수 있으므로 새 컨텍스트를 사용합니다.
+func (p *KeyPool) deleteOnParties(keyID uint32) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	req := &pbKey.DeleteKeyRequest{KeyId: keyID}
+	for _, address := range []string{p.config.AliceGRPCAddress, p.config.BobGRPCAddress} {
+		if err := p.callKeyService(ctx, address, func(client pbKey.KeyServiceClient) error {
+			_, err := client.DeleteKey(ctx, req)
+			return err
+		}); err != nil {
+			log.Printf("key pool: failed to delete key %d on %s: %v", keyID, address, err)
+		}
+	}
+}
+
+func (p *KeyPool) callKeyService(ctx context.Context, address string, call func(client pbKey.KeyServiceClient) error) error {
+	conn, err := dialParty(ctx, p.config, address)
+	if err != nil {
+		return fmt.Errorf(response.ErrMsgFailedConnectGRPC)
+	}
+	defer conn.Close()
+	return call(pbKey.NewKeyServiceClient(conn))
+}
+
+// Metrics 는 설정된 (네트워크, 주소 유형) 별 풀 깊이와 리필 속도를 돌려줍니다.
+func (p *KeyPool) Metrics() []KeyPoolMetrics {
+	metrics := make([]KeyPoolMetrics, 0, len(p.config.KeyPoolTargets))
+	for _, target := range p.config.KeyPoolTargets {
+		m := KeyPoolMetrics{
+			Network:     target.Network,
+			AddressType: target.AddressType,
+			Target:      target.Size,
+		}
+		if net, err := p.networkService.GetNetworkByID(target.Network); err == nil {
+			if depth, err := p.keyRepo.CountUnassigned(target.Network, target.AddressType, int32(net.Curve())); err == nil {
+				m.Depth = depth
+			}
+		}
+
+		p.mutex.Lock()
+		stats := p.stats[keyPoolTargetID{target.Network, target.AddressType}]
+		stats.refills = pruneRefills(stats.refills, time.Now())
+		m.GeneratedTotal = stats.generated
+		m.FailedTotal = stats.failed
+		m.AssignedTotal = stats.assigned
+		m.RefillRatePerMinute = float64(len(stats.refills)) / keyPoolRateWindow.Minutes()
+		p.mutex.Unlock()
+
+		metrics = append(metrics, m)
+	}
+	return metrics
+}
+
+func (p *KeyPool) recordGeneration(targetID keyPoolTargetID, err error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	stats := p.stats[targetID]
+	if err != nil {
+		stats.failed++
+		return
+	}
+	now := time.Now()
+	stats.generated++
+	stats.refills = append(pruneRefills(stats.refills, now), now)
+}
+
+func pruneRefills(refills []time.Time, now time.Time) []time.Time {
+	i := 0
+	for i < len(refills) && now.Sub(refills[i]) > keyPoolRateWindow {
+		i++
+	}
+	return refills[i:]
+}
+
+func (p *KeyPool) setupKeygenStream(ctx context.Context, address string) (*grpc.ClientConn, pb.KeygenService_KeyGenClient, error) {
+	conn, err := dialParty(ctx, p.config, address)
+	if err != nil {
+		return nil, nil, fmt.Errorf(response.ErrMsgFailedConnectGRPC)
+	}
+	stream, err := pb.NewKeygenServiceClient(conn).KeyGen(ctx)
+	if err != nil {
+		conn.Close()
+		return nil, nil, err
+	}
+	return conn, stream, nil
+}
+
+func (p *KeyPool) receiveKeygenMessages(stream pb.KeygenService_KeyGenClient, msgChan chan<- *pb.KeygenMessage, errChan chan<- error) {
+	for {
+		resp, err := stream.Recv()
+		if err != nil {
+			errChan <- err
+			return
+		}
+		select {
+		case msgChan <- resp:
+		case <-stream.Context().Done():
+			return
+		}
+	}
+}
@@ -0,0 +1,23 @@
+package handlers
+
+import (
+	"net/http"
+	"tecdsa/pkg/response"
+)
+
+type KeyPoolMetricsHandler struct {
+	keyPool *KeyPool
+}
+
+func NewKeyPoolMetricsHandler(keyPool *KeyPool) *KeyPoolMetricsHandler {
+	return &KeyPoolMetricsHandler{
+		keyPool: keyPool,
+	}
+}
+
+// Serve 는 GET /key_pool 요청에 (네트워크, 주소 유형) 별 풀 깊이와 리필 속도를 돌려줍니다.
+func (h *KeyPoolMetricsHandler) Serve(w http.ResponseWriter, r *http.Request) {
+	response.SendResponse(w, response.NewSuccessResponse(http.StatusOK, map[string]interface{}{
+		"pools": h.keyPool.Metrics(),
+	}))
+}
@@ -0,0 +1,108 @@
+package handlers
+
+import (
+	"encoding/hex"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"tecdsa/pkg/database/repository"
+	"tecdsa/pkg/network"
+	"tecdsa/pkg/response"
+	"tecdsa/pkg/service"
+	"tecdsa/pkg/utils"
+
+	"github.com/coinbase/kryptology/pkg/core/curves"
+)
+
+type KeyXpubResponse struct {
+	KeyID       uint32                      `json:"key_id"`
+	Network     int32                       `json:"network"`
+	PublicKey   string                      `json:"public_key"`
+	Xpub        string                      `json:"xpub"`
+	Descriptors []network.BitcoinDescriptor `json:"descriptors"`
+}
+
+type KeyXpubHandler struct {
+	clientSecurityRepo repository.ClientSecurityRepository
+	keyRepo            repository.KeyRepository
+	networkService     *service.NetworkService
+}
+
+func NewKeyXpubHandler(repo repository.ClientSecurityRepository, keyRepo repository.KeyRepository, networkService *service.NetworkService) *KeyXpubHandler {
+	return &KeyXpubHandler{
+		clientSecurityRepo: repo,
+		keyRepo:            keyRepo,
+		networkService:     networkService,
+	}
+}
+
+// Serve 는 GET /keys/{id}/xpub 요청을 처리합니다. 키를 생성한 (할당받은) 클라이언트만 조회할 수 있습니다.
+func (h *KeyXpubHandler) Serve(w http.ResponseWriter, r *http.Request) {
+	parts := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, "/keys/"), "/"), "/")
+	if len(parts) != 2 || parts[1] != "xpub" {
+		response.SendResponse(w, response.NewErrorResponse(response.ErrCodeNotFound))
+		return
+	}
+
+	keyID, err := strconv.ParseUint(parts[0], 10, 32)
+	if err != nil {
+		response.SendResponse(w, response.NewErrorResponse(response.ErrCodeBadRequest, response.ErrMsgInvalidKeyID))
+		return
+	}
+
+	key, err := h.keyRepo.FindByID(uint(keyID))
+	if err != nil {
+		response.SendResponse(w, response.NewErrorResponse(response.ErrCodeNotFound, response.ErrMsgKeyNotFound))
+		return
+	}
+
+	clientIP := utils.GetClientIP(r)
+	clientSecurity, err := h.clientSecurityRepo.FindByIP(clientIP)
+	if err != nil {
+		response.SendResponse(w, response.NewErrorResponse(response.ErrCodeInternalServerError, response.ErrMsgFailedRetrieveClientSecurity))
+		return
+	}
+	if key.ClientSecurityID != uint(clientSecurity.ID) {
+		response.SendResponse(w, response.NewErrorResponse(response.ErrCodeForbidden, response.ErrMsgKeyNotOwned))
+		return
+	}
+
+	net, err := h.networkService.GetNetworkByID(key.Network)
+	if err != nil || !network.IsBitcoinNetwork(net) {
+		response.SendResponse(w, response.NewErrorResponse(response.ErrCodeBadRequest, response.ErrMsgNotBitcoinKey))
+		return
+	}
+
+	publicKeyBytes, err := hex.DecodeString(key.PublicKey)
+	if err != nil {
+		response.SendResponse(w, response.NewErrorResponse(response.ErrCodeInternalServerError, response.ErrMsgFailedExportXpub))
+		return
+	}
+	point, err := curves.K256().Point.FromAffineCompressed(publicKeyBytes)
+	if err != nil {
+		response.SendResponse(w, response.NewErrorResponse(response.ErrCodeInternalServerError, response.ErrMsgFailedExportXpub))
+		return
+	}
+
+	// 체인코드는 키를 만들 때 정하고, 체인코드 없이 만든 이전 키는 데이터베이스를 열 때 채우므로 여기서는 읽기만 합니다.
+	xpub, err := network.DeriveBitcoinXpub(point, key.ChainCode, net)
+	if err != nil {
+		response.SendResponse(w, response.NewErrorResponse(response.ErrCodeInternalServerError, response.ErrMsgFailedExportXpub))
+		return
+	}
+
+	descriptors, err := network.DeriveBitcoinDescriptors(point, xpub, net)
+	if err != nil {
+		response.SendResponse(w, response.NewErrorResponse(response.ErrCodeInternalServerError, response.ErrMsgFailedExportXpub))
+		return
+	}
+
+	response.SendResponse(w, response.NewSuccessResponse(http.StatusOK, KeyXpubResponse{
+		KeyID:       key.ID,
+		Network:     key.Network,
+		PublicKey:   key.PublicKey,
+		Xpub:        xpub,
+		Descriptors: descriptors,
+	}))
+}
@@ -2,13 +2,18 @@ package handlers
 
 import (
 	"net/http"
+	"tecdsa/pkg/network"
 	"tecdsa/pkg/response"
 	"tecdsa/pkg/service"
 )
 
 type NetworkInfo struct {
-	ID   int    `json:"id"`
-	Name string `json:"name"`
+	ID          int      `json:"id"`
+	Name        string   `json:"name"`
+	Family      string   `json:"family"`
+	ChainID     *int64   `json:"chain_id,omitempty"`
+	ExplorerURL string   `json:"explorer_url,omitempty"`
+	Features    []string `json:"features,omitempty"`
 }
 
 type GetAllNetworksHandler struct {
@@ -29,8 +34,12 @@ func (h *GetAllNetworksHandler) Serve(w http.ResponseWriter, r *http.Request) {
 	var networkInfos []NetworkInfo
 	for _, net := range networks {
 		networkInfos = append(networkInfos, NetworkInfo{
-			ID:   int(net.ID()),
-			Name: net.String(),
+			ID:          int(net.ID()),
+			Name:        net.String(),
+			Family:      string(net.Family()),
+			ChainID:     net.ChainID(),
+			ExplorerURL: net.ExplorerURL(),
+			Features:    network.NetworkMetadata[net].Features,
 		})
 	}
 
@@ -0,0 +1,147 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"tecdsa/pkg/database/repository"
+	"tecdsa/pkg/network"
+	"tecdsa/pkg/response"
+	"tecdsa/pkg/service"
+	"tecdsa/pkg/utils"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+type ReleaseNonceRequest struct {
+	Nonce *uint64 `json:"nonce"`
+}
+
+type ReleaseNonceResponse struct {
+	Nonce    uint64 `json:"nonce"`
+	Released bool   `json:"released"`
+}
+
+type NonceHandler struct {
+	nonceManager       *service.NonceManager
+	clientSecurityRepo repository.ClientSecurityRepository
+	keyRepo            repository.KeyRepository
+	keyAddressRepo     repository.KeyAddressRepository
+	networkService     *service.NetworkService
+}
+
+func NewNonceHandler(nonceManager *service.NonceManager, clientSecurityRepo repository.ClientSecurityRepository, keyRepo repository.KeyRepository, keyAddressRepo repository.KeyAddressRepository, networkService *service.NetworkService) *NonceHandler {
+	return &NonceHandler{
+		nonceManager:       nonceManager,
+		clientSecurityRepo: clientSecurityRepo,
+		keyRepo:            keyRepo,
+		keyAddressRepo:     keyAddressRepo,
+		networkService:     networkService,
+	}
+}
+
+// Serve 는 GET /nonces/{network}/{address} (조회), POST /nonces/{network}/{address}/release (해제),
+// POST /nonces/{network}/{address}/resync (체인과 다시 맞춤) 요청을 처리합니다.
+func (h *NonceHandler) Serve(w http.ResponseWriter, r *http.Request) {
+	parts := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, "/nonces"), "/"), "/")
+	if len(parts) < 2 || len(parts) > 3 {
+		response.SendResponse(w, response.NewErrorResponse(response.ErrCodeNotFound, response.ErrorCodeToMessage[response.ErrCodeNotFound]))
+		return
+	}
+	action := ""
+	if len(parts) == 3 {
+		action = parts[2]
+	}
+
+	networkID, err := strconv.ParseInt(parts[0], 10, 32)
+	if err != nil {
+		response.SendResponse(w, response.NewErrorResponse(response.ErrCodeBadRequest, response.ErrMsgUnsupportedNetwork))
+		return
+	}
+	net, err := h.networkService.GetNetworkByID(int32(networkID))
+	if err != nil || !network.IsEthereumNetwork(net) {
+		response.SendResponse(w, response.NewErrorResponse(response.ErrCodeBadRequest, response.ErrMsgUnsupportedNetwork))
+		return
+	}
+	address := parts[1]
+	if !network.IsValidEthereumAddress(address) {
+		response.SendResponse(w, response.NewErrorResponse(response.ErrCodeBadRequest, response.ErrMsgInvalidAddress))
+		return
+	}
+
+	// 다른 클라이언트의 주소 논스를 해제하면 진행 중인 논스가 다시 할당되므로 요청한 클라이언트의 키 주소만 허용합니다.
+	keyAddress, err := h.keyAddressRepo.FindByNetworkAndAddress(net.ID(), common.HexToAddress(address).Hex())
+	if err != nil {
+		response.SendResponse(w, response.NewErrorResponse(response.ErrCodeNotFound, response.ErrMsgKeyNotFound))
+		return
+	}
+	key, err := h.keyRepo.FindByID(uint(keyAddress.KeyID))
+	if err != nil {
+		response.SendResponse(w, response.NewErrorResponse(response.ErrCodeNotFound, response.ErrMsgKeyNotFound))
+		return
+	}
+	clientSecurity, err := h.clientSecurityRepo.FindByIP(utils.GetClientIP(r))
+	if err != nil {
+		response.SendResponse(w, response.NewErrorResponse(response.ErrCodeInternalServerError, response.ErrMsgFailedRetrieveClientSecurity))
+		return
+	}
+	if key.ClientSecurityID != uint(clientSecurity.ID) {
+		response.SendResponse(w, response.NewErrorResponse(response.ErrCodeForbidden, response.ErrMsgKeyNotOwned))
+		return
+	}
+
+	switch {
+	case r.Method == http.MethodGet && action == "":
+		h.getState(w, net, address)
+	case r.Method == http.MethodPost && action == "release":
+		h.release(w, r, net, address)
+	case r.Method == http.MethodPost && action == "resync":
+		h.resync(w, net, address)
+	default:
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+func (h *NonceHandler) getState(w http.ResponseWriter, net network.Network, address string) {
+	state, err := h.nonceManager.State(net, address)
+	if err != nil {
+		response.SendResponse(w, response.NewErrorResponse(response.ErrCodeInternalServerError, response.ErrMsgFailedRetrieveNonces))
+		return
+	}
+	response.SendResponse(w, response.NewSuccessResponse(http.StatusOK, state))
+}
+
+// release 는 브로드캐스트에 실패한 트랜잭션의 논스를 해제합니다.
+func (h *NonceHandler) release(w http.ResponseWriter, r *http.Request, net network.Network, address string) {
+	var req ReleaseNonceRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.SendResponse(w, response.NewErrorResponse(response.ErrCodeBadRequest, response.ErrMsgInvalidRequestBody))
+		return
+	}
+	if req.Nonce == nil {
+		response.SendResponse(w, response.NewErrorResponse(response.ErrCodeBadRequest, "'nonce' is required"))
+		return
+	}
+
+	released, err := h.nonceManager.Release(net, address, *req.Nonce)
+	if err != nil {
+		response.SendResponse(w, response.NewErrorResponse(response.ErrCodeInternalServerError, response.ErrMsgFailedReleaseNonce))
+		return
+	}
+	if !released {
+		response.SendResponse(w, response.NewErrorResponse(response.ErrCodeNotFound, response.ErrMsgNonceNotReserved))
+		return
+	}
+	response.SendResponse(w, response.NewSuccessResponse(http.StatusOK, ReleaseNonceResponse{Nonce: *req.Nonce, Released: true}))
+}
+
+func (h *NonceHandler) resync(w http.ResponseWriter, net network.Network, address string) {
+	state, err := h.nonceManager.Resync(net, address)
+	if err != nil {
+		response.SendResponse(w, response.NewErrorResponse(response.ErrCodeInternalServerError, response.ErrMsgFailedRetrieveNonces))
+		return
+	}
+	response.SendResponse(w, response.NewSuccessResponse(http.StatusOK, state))
+}
@@ -0,0 +1,178 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"tecdsa/cmd/gateway/config"
+	"tecdsa/pkg/database/repository"
+	"tecdsa/pkg/network"
+	"tecdsa/pkg/response"
+	pb "tecdsa/proto/presign"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// defaultPresignRefillInterval 은 풀을 다시 채우는 기본 주기입니다.
+const defaultPresignRefillInterval = 30 * time.Second
+
+// PresignPool 은 ECDSA 키마다 config.PresignPoolSize 개의 presignature 를 백그라운드에서 미리 만들어 둡니다.
+// 게이트웨이는 presignature 의 상태만 저장하고, 서명 재료는 Alice 와 Bob 이 각자 저장합니다.
+type PresignPool struct {
+	config      *config.Config
+	keyRepo     repository.KeyRepository
+	presignRepo repository.PresignatureRepository
+	stop        chan struct{}
+}
+
+func NewPresignPool(cfg *config.Config, keyRepo repository.KeyRepository, presignRepo repository.PresignatureRepository) *PresignPool {
+	return &PresignPool{
+		config:      cfg,
+		keyRepo:     keyRepo,
+		presignRepo: presignRepo,
+		stop:        make(chan struct{}),
+	}
+}
+
+// Start 는 풀 크기가 0 보다 클 때만 풀을 채우는 고루틴을 시작합니다.
+func (p *PresignPool) Start() {
+	if p.config.PresignPoolSize <= 0 {
+		return
+	}
+	interval := p.config.PresignRefillInterval
+	if interval <= 0 {
+		interval = defaultPresignRefillInterval
+	}
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			p.refill()
+			select {
+			case <-ticker.C:
+			case <-p.stop:
+				return
+			}
+		}
+	}()
+}
+
+// Stop 은 풀을 채우는 고루틴을 멈춥니다.
+func (p *PresignPool) Stop() {
+	close(p.stop)
+}
+
+func (p *PresignPool) refill() {
+	keys, err := p.keyRepo.FindCompletedByCurves(int32(network.Secp256k1), int32(network.P256))
+	if err != nil {
+		log.Printf("presign pool: failed to list keys: %v", err)
+		return
+	}
+
+	for _, key := range keys {
+		count, err := p.presignRepo.CountAvailable(key.ID)
+		if err != nil {
+			log.Printf("presign pool: failed to count presignatures for key %d: %v", key.ID, err)
+			continue
+		}
+		for i := count; i < int64(p.config.PresignPoolSize); i++ {
+			if err := p.generate(key.ID); err != nil {
+				log.Printf("presign pool: failed to generate presignature for key %d: %v", key.ID, err)
+				break
+			}
+		}
+	}
+}
+
+// generate 는 Alice 와 Bob 사이에서 presign 프로토콜을 한 번 중계하고, 완료되면 풀에 추가합니다.
+func (p *PresignPool) generate(keyID uint32) error {
+	presignID := uuid.New().String()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	ctx = metadata.NewOutgoingContext(ctx, metadata.New(map[string]string{
+		"presign_id": presignID,
+		"key_id":     fmt.Sprintf("%d", keyID),
+	}))
+
+	// 백그라운드에서 계속 실행되므로 연결은 매번 닫습니다.
+	bobConn, bobStream, err := p.setupPresignStream(ctx, p.config.BobGRPCAddress)
+	if err != nil {
+		return err
+	}
+	defer bobConn.Close()
+
+	aliceConn, aliceStream, err := p.setupPresignStream(ctx, p.config.AliceGRPCAddress)
+	if err != nil {
+		return err
+	}
+	defer aliceConn.Close()
+
+	bobChan := make(chan *pb.PresignMessage)
+	aliceChan := make(chan *pb.PresignMessage)
+	errorChan := make(chan error, 2)
+
+	go p.receivePresignMessages(bobStream, bobChan, errorChan)
+	go p.receivePresignMessages(aliceStream, aliceChan, errorChan)
+
+	if err := aliceStream.Send(&pb.PresignMessage{
+		Msg: &pb.PresignMessage_PresignGatewayTo1Output{
+			PresignGatewayTo1Output: &pb.PresignGatewayTo1Output{},
+		},
+	}); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case bobResp := <-bobChan:
+			if _, ok := bobResp.Msg.(*pb.PresignMessage_PresignRound4ToGatewayOutput); ok {
+				return p.presignRepo.Create(presignID, keyID, "", nil)
+			}
+			if err := aliceStream.Send(bobResp); err != nil {
+				return err
+			}
+		case aliceResp := <-aliceChan:
+			if err := bobStream.Send(aliceResp); err != nil {
+				return err
+			}
+		case err := <-errorChan:
+			return err
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (p *PresignPool) setupPresignStream(ctx context.Context, address string) (*grpc.ClientConn, pb.PresignService_PresignClient, error) {
+	conn, err := dialParty(ctx, p.config, address)
+	if err != nil {
+		return nil, nil, fmt.Errorf(response.ErrMsgFailedConnectGRPC)
+	}
+	stream, err := pb.NewPresignServiceClient(conn).Presign(ctx)
+	if err != nil {
+		conn.Close()
+		return nil, nil, err
+	}
+	return conn, stream, nil
+}
+
+func (p *PresignPool) receivePresignMessages(stream pb.PresignService_PresignClient, msgChan chan<- *pb.PresignMessage, errChan chan<- error) {
+	for {
+		resp, err := stream.Recv()
+		if err != nil {
+			errChan <- err
+			return
+		}
+		select {
+		case msgChan <- resp:
+		case <-stream.Context().Done():
+			return
+		}
+	}
+}
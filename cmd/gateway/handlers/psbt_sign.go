@@ -0,0 +1,261 @@
+package handlers
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"tecdsa/pkg/database/repository"
+	"tecdsa/pkg/network"
+	"tecdsa/pkg/response"
+	"tecdsa/pkg/service"
+	"tecdsa/pkg/transaction"
+	"tecdsa/pkg/utils"
+
+	"github.com/coinbase/kryptology/pkg/core/curves"
+	"github.com/google/uuid"
+)
+
+type PSBTSignRequest struct {
+	Network int32 `json:"network"`
+	// PSBT 는 BIP174 PSBT 의 base64 입니다.
+	PSBT string `json:"psbt"`
+	// Finalize 가 true 면 서명한 뒤 완성할 수 있는 입력의 scriptSig / witness 를 채우고, 모든 입력이 완성되면 signed_tx 를 돌려줍니다.
+	Finalize  bool   `json:"finalize,omitempty"`
+	RequestID string `json:"request_id,omitempty"`
+}
+
+type PSBTSignResponse struct {
+	PSBT string `json:"psbt"`
+	// SignedInputs 는 이번 요청에서 서명한 입력의 인덱스입니다.
+	SignedInputs []int `json:"signed_inputs"`
+	// Complete 는 모든 입력이 완성되었는지 나타냅니다. finalize 를 주지 않으면 항상 false 입니다.
+	Complete  bool   `json:"complete"`
+	SignedTx  string `json:"signed_tx,omitempty"`
+	Duration  int32  `json:"duration"`
+	RequestID string `json:"request_id"`
+}
+
+// psbtSigningInput 은 게이트웨이 키가 서명할 PSBT 입력입니다.
+type psbtSigningInput struct {
+	index       int
+	address     string
+	addressType int32
+	keyID       uint32
+	point       curves.Point
+	txOrigin    string
+}
+
+// PSBTSignHandler 는 PSBT 의 입력 중 게이트웨이 키가 소유한 입력을 찾아 서명합니다.
+// 입력마다 ECDSA 는 /sign, P2TR 은 /sign_taproot 핸들러로 서명 세션을 차례로 실행하며,
+// 요청 ID 도 두 핸들러에 등록하므로 /sign, /sign_taproot 의 진행 중인 요청과 같은 ID 는 거부합니다.
+type PSBTSignHandler struct {
+	clientSecurityRepo repository.ClientSecurityRepository
+	keyRepo            repository.KeyRepository
+	keyAddressRepo     repository.KeyAddressRepository
+	networkService     *service.NetworkService
+	signer             *SignHandler
+	taprootSigner      *TaprootSignHandler
+}
+
+func NewPSBTSignHandler(repo repository.ClientSecurityRepository, keyRepo repository.KeyRepository, keyAddressRepo repository.KeyAddressRepository, networkService *service.NetworkService, signer *SignHandler, taprootSigner *TaprootSignHandler) *PSBTSignHandler {
+	return &PSBTSignHandler{
+		clientSecurityRepo: repo,
+		keyRepo:            keyRepo,
+		keyAddressRepo:     keyAddressRepo,
+		networkService:     networkService,
+		signer:             signer,
+		taprootSigner:      taprootSigner,
+	}
+}
+
+// Serve 는 POST /sign/psbt 요청을 처리합니다.
+func (h *PSBTSignHandler) Serve(w http.ResponseWriter, r *http.Request) {
+	req, requestID, err := h.parseAndValidateRequest(r)
+	if err != nil {
+		response.SendResponse(w, response.NewErrorResponse(response.ErrCodeBadRequest, err.Error()))
+		return
+	}
+
+	clientIP := utils.GetClientIP(r)
+	clientSecurity, err := h.clientSecurityRepo.FindByIP(clientIP)
+	if err != nil {
+		response.SendResponse(w, response.NewErrorResponse(response.ErrCodeInternalServerError, response.ErrMsgFailedRetrieveClientSecurity))
+		return
+	}
+
+	net, err := h.networkService.GetNetworkByID(req.Network)
+	if err != nil || !network.IsBitcoinNetwork(net) {
+		response.SendResponse(w, response.NewErrorResponse(response.ErrCodeBadRequest, response.ErrMsgUnsupportedNetwork))
+		return
+	}
+
+	psbt, err := network.DecodeBitcoinPSBT(req.PSBT)
+	if err != nil {
+		response.SendResponse(w, response.NewErrorResponse(response.ErrCodeBadRequest, fmt.Sprintf("%s: %v", response.ErrMsgInvalidPSBT, err)))
+		return
+	}
+
+	inputs, err := h.findSigningInputs(psbt, net, clientSecurity.ID)
+	if err != nil {
+		response.SendResponse(w, response.NewErrorResponse(response.ErrCodeBadRequest, err.Error()))
+		return
+	}
+	if len(inputs) == 0 {
+		response.SendResponse(w, response.NewErrorResponse(response.ErrCodeBadRequest, response.ErrMsgNoOwnedPSBTInputs))
+		return
+	}
+
+	startTime := time.Now()
+	if err := h.storeRequestContext(requestID, startTime); err != nil {
+		response.SendResponse(w, response.NewErrorResponse(response.ErrCodeBadRequest, err.Error()))
+		return
+	}
+	defer h.removeRequestContext(requestID)
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Minute)
+	defer cancel()
+
+	signedInputs := make([]int, 0, len(inputs))
+	for _, input := range inputs {
+		signature, errCode, err := h.signInput(ctx, input, fmt.Sprintf("%s-%d", requestID, input.index), clientSecurity.ID)
+		if err != nil {
+			response.SendResponse(w, response.NewErrorResponse(errCode, err.Error()))
+			return
+		}
+		if err := psbt.AddSignature(input.index, net, input.point, signature); err != nil {
+			response.SendResponse(w, response.NewErrorResponse(response.ErrCodeSigning, err.Error()))
+			return
+		}
+		signedInputs = append(signedInputs, input.index)
+	}
+
+	psbtResponse := PSBTSignResponse{
+		SignedInputs: signedInputs,
+		RequestID:    requestID,
+	}
+	if req.Finalize {
+		if psbtResponse.Complete, err = psbt.Finalize(); err != nil {
+			response.SendResponse(w, response.NewErrorResponse(response.ErrCodeSigning, fmt.Sprintf("%s: %v", response.ErrMsgFailedFinalizePSBT, err)))
+			return
+		}
+		if psbtResponse.Complete {
+			if psbtResponse.SignedTx, err = psbt.Extract(); err != nil {
+				response.SendResponse(w, response.NewErrorResponse(response.ErrCodeSigning, fmt.Sprintf("%s: %v", response.ErrMsgFailedFinalizePSBT, err)))
+				return
+			}
+		}
+	}
+	if psbtResponse.PSBT, err = psbt.Encode(); err != nil {
+		response.SendResponse(w, response.NewErrorResponse(response.ErrCodeSigning, err.Error()))
+		return
+	}
+	psbtResponse.Duration = int32(time.Since(startTime).Milliseconds())
+
+	response.SendResponse(w, response.NewSuccessResponse(http.StatusOK, psbtResponse))
+}
+
+func (h *PSBTSignHandler) parseAndValidateRequest(r *http.Request) (PSBTSignRequest, string, error) {
+	var req PSBTSignRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return req, "", fmt.Errorf(response.ErrMsgInvalidRequestBody)
+	}
+
+	requestID := strings.TrimSpace(req.RequestID)
+	if requestID == "" {
+		requestID = uuid.New().String()
+	}
+
+	if req.PSBT == "" {
+		return req, "", fmt.Errorf(response.ErrMsgInvalidSignRequest)
+	}
+
+	return req, requestID, nil
+}
+
+// findSigningInputs 는 이전 출력의 주소가 이 네트워크에서 요청한 클라이언트의 secp256k1 키 주소인 입력을 찾아 서명 해시를 만듭니다.
+// UTXO 정보가 없거나 다른 지갑 (다른 클라이언트의 키 포함) 의 입력, 이미 서명했거나 완성된 입력은 건너뜁니다.
+func (h *PSBTSignHandler) findSigningInputs(psbt *network.BitcoinPSBT, net network.Network, clientSecurityID uint32) ([]psbtSigningInput, error) {
+	var inputs []psbtSigningInput
+	for i := range psbt.UnsignedTx.TxIn {
+		address, err := psbt.InputAddress(i, net)
+		if err != nil {
+			continue
+		}
+		keyAddress, err := h.keyAddressRepo.FindByNetworkAndAddress(net.ID(), address)
+		if err != nil {
+			continue
+		}
+
+		key, err := h.keyRepo.FindByID(uint(keyAddress.KeyID))
+		if err != nil {
+			return nil, fmt.Errorf(response.ErrMsgKeyNotFound)
+		}
+		if key.ClientSecurityID != uint(clientSecurityID) || network.Curve(key.Curve) != network.Secp256k1 {
+			continue
+		}
+		publicKeyBytes, err := hex.DecodeString(key.PublicKey)
+		if err != nil {
+			return nil, fmt.Errorf(response.ErrMsgFailedCreateSigningPayload)
+		}
+		point, err := network.Curve(key.Curve).KryptologyCurve().Point.FromAffineCompressed(publicKeyBytes)
+		if err != nil {
+			return nil, fmt.Errorf(response.ErrMsgFailedCreateSigningPayload)
+		}
+		if psbt.IsInputSigned(i, point) {
+			continue
+		}
+
+		payload, err := psbt.SigningPayload(i, net, point)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", response.ErrMsgFailedCreateSigningPayload, err)
+		}
+		inputs = append(inputs, psbtSigningInput{
+			index:       i,
+			address:     address,
+			addressType: keyAddress.AddressType,
+			keyID:       key.ID,
+			point:       point,
+			txOrigin:    base64.StdEncoding.EncodeToString(payload),
+		})
+	}
+	return inputs, nil
+}
+
+// signInput 은 입력 하나의 서명 세션을 실행합니다. 실패하면 응답에 사용할 오류 코드를 함께 돌려줍니다.
+// 세션의 연결과 스트림은 입력마다 닫히므로, 다음 입력을 서명하기 전에 이전 세션이 남지 않습니다.
+func (h *PSBTSignHandler) signInput(ctx context.Context, input psbtSigningInput, sessionID string, clientSecurityID uint32) (*transaction.Signature, string, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	if input.addressType == network.P2TR {
+		sig, errCode, err := h.taprootSigner.signTxOrigin(ctx, input.address, input.txOrigin, sessionID, clientSecurityID)
+		if err != nil {
+			return nil, errCode, err
+		}
+		return &transaction.Signature{R: sig[:32], S: sig[32:]}, "", nil
+	}
+	return h.signer.signTxOrigin(ctx, input.keyID, SignRequest{Address: input.address, TxOrigin: input.txOrigin}, sessionID, clientSecurityID)
+}
+
+// storeRequestContext 는 요청 ID 를 /sign, /sign_taproot 핸들러에 함께 등록합니다.
+func (h *PSBTSignHandler) storeRequestContext(requestID string, startTime time.Time) error {
+	if err := h.signer.storeSignRequestContext(requestID, &signRequestContext{startTime: startTime}); err != nil {
+		return err
+	}
+	if err := h.taprootSigner.storeRequestContext(requestID, &taprootSignRequestContext{startTime: startTime}); err != nil {
+		h.signer.removeSignRequestContext(requestID)
+		return err
+	}
+	return nil
+}
+
+func (h *PSBTSignHandler) removeRequestContext(requestID string) {
+	h.signer.removeSignRequestContext(requestID)
+	h.taprootSigner.removeRequestContext(requestID)
+}
@@ -1,11 +1,13 @@
 package handlers
 
 import (
-	"database/sql"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"tecdsa/pkg/database/repository"
 	"tecdsa/pkg/utils"
+
+	"gorm.io/gorm"
 )
 
 type RegisterClientSecurityRequest struct {
@@ -46,11 +48,11 @@ func (h *RegisterClientSecurityHandler) Serve(w http.ResponseWriter, r *http.Req
 	existingRecord, err := h.clientSecurityRepo.FindByIP(ip)
 	if err != nil {
 		// 데이터베이스 조회 중 오류 발생
-		if err != sql.ErrNoRows { // 레코드가 없는 경우가 아닌 다른 오류
+		if !errors.Is(err, gorm.ErrRecordNotFound) { // 레코드가 없는 경우가 아닌 다른 오류
 			http.Error(w, "Failed to check existing record", http.StatusInternalServerError)
 			return
 		}
-		// sql.ErrNoRows 오류는 무시하고 계속 진행 (레코드가 없음을 의미)
+		// gorm.ErrRecordNotFound 오류는 무시하고 계속 진행 (레코드가 없음을 의미)
 	}
 
 	if existingRecord != nil {
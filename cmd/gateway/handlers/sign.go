@@ -2,9 +2,13 @@ package handlers
 
 import (
 	"context"
+	"encoding/asn1"
 	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"log"
+	"math/big"
 	"net/http"
 	"strings"
 	"sync"
@@ -12,11 +16,15 @@ import (
 
 	"tecdsa/cmd/gateway/config"
 	"tecdsa/pkg/database/repository"
+	"tecdsa/pkg/network"
 	"tecdsa/pkg/response"
 	"tecdsa/pkg/service"
+	"tecdsa/pkg/transaction"
 	"tecdsa/pkg/utils"
+	pbPresign "tecdsa/proto/presign"
 	pb "tecdsa/proto/sign"
 
+	"github.com/coinbase/kryptology/pkg/core/curves"
 	"github.com/google/uuid"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
@@ -26,36 +34,69 @@ type SignRequest struct {
 	Address   string `json:"address"`
 	TxOrigin  string `json:"tx_origin"`
 	RequestID string `json:"request_id,omitempty"`
+	// UnsignedTx 가 있으면 tx_origin 대신 서명 해시를 계산하고, 서명된 트랜잭션을 돌려줍니다.
+	UnsignedTx *transaction.UnsignedTransaction `json:"unsigned_tx,omitempty"`
 }
 
 type SignResponse struct {
-	V         uint64 `json:"v"`
-	R         string `json:"r"`
-	S         string `json:"s"`
-	Duration  int32  `json:"duration"`
-	RequestID string `json:"request_id"`
+	// V 는 secp256k1 서명의 복구 ID 입니다. P-256 서명에는 없습니다.
+	V *uint64 `json:"v,omitempty"`
+	R string  `json:"r"`
+	S string  `json:"s"`
+	// Signature 는 P-256 서명의 r || s (각 32바이트, JWS ES256 형식) 입니다.
+	Signature string `json:"signature,omitempty"`
+	// SignatureDER 는 P-256 서명의 ASN.1 DER 인코딩 (X.509, WebAuthn) 입니다.
+	SignatureDER string `json:"signature_der,omitempty"`
+	// Signatures 는 입력이 여러 개인 비트코인 트랜잭션의 입력별 서명입니다. V, R, S 는 첫 번째 입력의 서명입니다.
+	Signatures []InputSignature `json:"signatures,omitempty"`
+	SignedTx   string           `json:"signed_tx,omitempty"`
+	Duration   int32            `json:"duration"`
+	RequestID  string           `json:"request_id"`
+}
+
+// InputSignature 는 트랜잭션 입력 하나의 서명입니다.
+type InputSignature struct {
+	Input int     `json:"input"`
+	V     *uint64 `json:"v,omitempty"`
+	R     string  `json:"r"`
+	S     string  `json:"s"`
 }
 
 type signRequestContext struct {
-	startTime        time.Time
-	address          string
-	txOrigin         string
+	startTime time.Time
+	address   string
+	// txOrigins 는 서명 세션마다 파티에게 보낼 tx_origin 입니다. 입력이 여러 개인 비트코인 트랜잭션은 입력마다 하나씩입니다.
+	txOrigins        []string
 	clientSecurityID uint32
+	unsignedTx       *transaction.UnsignedTransaction
+	network          network.Network
+	publicKey        curves.Point
+	curve            network.Curve
+	// signed 는 서명 응답을 보냈는지 나타냅니다. 미서명 트랜잭션의 논스를 정리할 때 사용합니다.
+	signed bool
 }
 
 type SignHandler struct {
 	clientSecurityRepo repository.ClientSecurityRepository
+	keyRepo            repository.KeyRepository
+	keyAddressRepo     repository.KeyAddressRepository
+	presignRepo        repository.PresignatureRepository
 	config             *config.Config
 	networkService     *service.NetworkService
+	nonceManager       *service.NonceManager
 	requestContexts    map[string]*signRequestContext
 	mutex              sync.Mutex
 }
 
-func NewSignHandler(cfg *config.Config, repo repository.ClientSecurityRepository, networkService *service.NetworkService) *SignHandler {
+func NewSignHandler(cfg *config.Config, repo repository.ClientSecurityRepository, keyRepo repository.KeyRepository, keyAddressRepo repository.KeyAddressRepository, presignRepo repository.PresignatureRepository, networkService *service.NetworkService, nonceManager *service.NonceManager) *SignHandler {
 	return &SignHandler{
 		clientSecurityRepo: repo,
+		keyRepo:            keyRepo,
+		keyAddressRepo:     keyAddressRepo,
+		presignRepo:        presignRepo,
 		config:             cfg,
 		networkService:     networkService,
+		nonceManager:       nonceManager,
 		requestContexts:    make(map[string]*signRequestContext),
 	}
 }
@@ -74,28 +115,94 @@ func (h *SignHandler) Serve(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// 키의 곡선에 따라 응답 서명 형식이 달라지며, Ed25519 키는 별도의 EdDSA 서명 프로토콜을 사용합니다.
+	curve := network.Secp256k1
+	var keyID uint32
+	if key, err := h.keyRepo.FindByAddress(req.Address); err == nil {
+		curve = network.Curve(key.Curve)
+		keyID = key.ID
+	}
+	if curve == network.Ed25519 {
+		response.SendResponse(w, response.NewErrorResponse(response.ErrCodeBadRequest, response.ErrMsgUseEd25519Sign))
+		return
+	}
+
+	// Taproot 주소는 ECDSA 가 아닌 Schnorr 서명이 필요합니다.
+	if keyAddress, err := h.keyAddressRepo.FindByAddress(req.Address); err == nil && keyAddress.AddressType == network.P2TR {
+		response.SendResponse(w, response.NewErrorResponse(response.ErrCodeBadRequest, response.ErrMsgUseTaprootSign))
+		return
+	}
+
+	reqCtx := &signRequestContext{
+		startTime:        time.Now(),
+		address:          req.Address,
+		clientSecurityID: clientSecurity.ID,
+		curve:            curve,
+	}
+	if req.UnsignedTx != nil {
+		if err := h.prepareUnsignedTx(&req, reqCtx); err != nil {
+			response.SendResponse(w, response.NewErrorResponse(response.ErrCodeBadRequest, err.Error()))
+			return
+		}
+		defer h.settleNonce(reqCtx)
+	} else {
+		reqCtx.txOrigins = []string{req.TxOrigin}
+	}
+
 	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Minute)
 	defer cancel()
 
-	ctx = h.addSignMetadataToContext(ctx, requestID, req, clientSecurity.ID)
-
-	if err := h.storeSignRequestContext(requestID, req, clientSecurity.ID); err != nil {
+	if err := h.storeSignRequestContext(requestID, reqCtx); err != nil {
 		response.SendResponse(w, response.NewErrorResponse(response.ErrCodeBadRequest, err.Error()))
 		return
 	}
 	defer h.removeSignRequestContext(requestID)
 
-	bobStream, aliceStream, err := h.setupSignStreams(ctx)
+	// 입력이 여러 개면 입력마다 서명 세션을 차례로 실행하고, 모든 서명을 모아 트랜잭션을 만듭니다.
+	signatures := make([]*transaction.Signature, 0, len(reqCtx.txOrigins))
+	for i, txOrigin := range reqCtx.txOrigins {
+		sessionID := requestID
+		if len(reqCtx.txOrigins) > 1 {
+			sessionID = fmt.Sprintf("%s-%d", requestID, i)
+		}
+		req.TxOrigin = txOrigin
+		signature, errCode, err := h.signTxOrigin(ctx, keyID, req, sessionID, clientSecurity.ID)
+		if err != nil {
+			response.SendResponse(w, response.NewErrorResponse(errCode, err.Error()))
+			return
+		}
+		signatures = append(signatures, signature)
+	}
+
+	if err := h.sendSignResponse(w, reqCtx, requestID, signatures); err != nil {
+		response.SendResponse(w, response.NewErrorResponse(response.ErrCodeSigning, err.Error()))
+	}
+}
+
+// signTxOrigin 은 req.TxOrigin 하나를 서명합니다. 풀에 presignature 가 있으면 한 번 왕복으로 서명하고,
+// 없거나 실패하면 전체 서명 프로토콜로 진행합니다. 실패하면 응답에 사용할 오류 코드를 함께 돌려줍니다.
+func (h *SignHandler) signTxOrigin(ctx context.Context, keyID uint32, req SignRequest, sessionID string, clientSecurityID uint32) (*transaction.Signature, string, error) {
+	ctx = h.addSignMetadataToContext(ctx, sessionID, req, clientSecurityID)
+
+	if keyID != 0 && h.config.PresignPoolSize > 0 {
+		if result, ok := h.signWithPresign(ctx, keyID, req); ok {
+			return &transaction.Signature{V: result.V, R: result.R, S: result.S}, "", nil
+		}
+	}
+
+	bobStream, aliceStream, closeConns, err := h.setupSignStreams(ctx)
 	if err != nil {
-		response.SendResponse(w, response.NewErrorResponse(response.ErrCodeInternalServerError, response.ErrMsgFailedSetupStreams))
-		return
+		return nil, response.ErrCodeInternalServerError, fmt.Errorf(response.ErrMsgFailedSetupStreams)
 	}
+	defer closeConns()
 	defer bobStream.CloseSend()
 	defer aliceStream.CloseSend()
 
-	if err := h.performSigning(w, bobStream, aliceStream, requestID); err != nil {
-		response.SendResponse(w, response.NewErrorResponse(response.ErrCodeSigning, err.Error()))
+	signature, err := h.performSigning(bobStream, aliceStream)
+	if err != nil {
+		return nil, response.ErrCodeSigning, err
 	}
+	return signature, "", nil
 }
 
 func (h *SignHandler) parseAndValidateSignRequest(r *http.Request) (SignRequest, string, error) {
@@ -109,13 +216,58 @@ func (h *SignHandler) parseAndValidateSignRequest(r *http.Request) (SignRequest,
 		requestID = uuid.New().String()
 	}
 
-	if req.Address == "" || req.TxOrigin == "" {
+	if req.Address == "" || (req.TxOrigin == "" && req.UnsignedTx == nil) {
 		return req, "", fmt.Errorf(response.ErrMsgInvalidSignRequest)
 	}
 
 	return req, requestID, nil
 }
 
+// prepareUnsignedTx 는 주소의 공개키로 서명 해시 프리이미지를 만들어 tx_origin 으로 사용합니다.
+// 입력이 여러 개인 비트코인 트랜잭션은 입력마다 프리이미지를 만듭니다.
+func (h *SignHandler) prepareUnsignedTx(req *SignRequest, reqCtx *signRequestContext) error {
+	// 같은 키라도 서명 주소의 네트워크 기준으로 트랜잭션을 만듭니다.
+	keyAddress, err := h.keyAddressRepo.FindByAddress(req.Address)
+	if err != nil {
+		return fmt.Errorf(response.ErrMsgKeyNotFound)
+	}
+	if req.UnsignedTx.NetworkID != keyAddress.Network {
+		return fmt.Errorf(response.ErrMsgUnsupportedNetwork)
+	}
+
+	key, err := h.keyRepo.FindByID(uint(keyAddress.KeyID))
+	if err != nil {
+		return fmt.Errorf(response.ErrMsgKeyNotFound)
+	}
+
+	net, err := h.networkService.GetNetworkByID(keyAddress.Network)
+	if err != nil {
+		return fmt.Errorf(response.ErrMsgUnsupportedNetwork)
+	}
+
+	publicKeyBytes, err := hex.DecodeString(key.PublicKey)
+	if err != nil {
+		return fmt.Errorf(response.ErrMsgFailedCreateSigningPayload)
+	}
+	point, err := network.Curve(key.Curve).KryptologyCurve().Point.FromAffineCompressed(publicKeyBytes)
+	if err != nil {
+		return fmt.Errorf(response.ErrMsgFailedCreateSigningPayload)
+	}
+
+	payloads, err := h.networkService.CreateSigningPayloads(net, req.UnsignedTx, point)
+	if err != nil {
+		return fmt.Errorf("%s: %v", response.ErrMsgFailedCreateSigningPayload, err)
+	}
+
+	for _, payload := range payloads {
+		reqCtx.txOrigins = append(reqCtx.txOrigins, base64.StdEncoding.EncodeToString(payload))
+	}
+	reqCtx.unsignedTx = req.UnsignedTx
+	reqCtx.network = net
+	reqCtx.publicKey = point
+	return nil
+}
+
 func (h *SignHandler) addSignMetadataToContext(ctx context.Context, requestID string, req SignRequest, clientSecurityID uint32) context.Context {
 	md := metadata.New(map[string]string{
 		"request_id":         requestID,
@@ -126,7 +278,7 @@ func (h *SignHandler) addSignMetadataToContext(ctx context.Context, requestID st
 	return metadata.NewOutgoingContext(ctx, md)
 }
 
-func (h *SignHandler) storeSignRequestContext(requestID string, req SignRequest, clientSecurityID uint32) error {
+func (h *SignHandler) storeSignRequestContext(requestID string, reqCtx *signRequestContext) error {
 	h.mutex.Lock()
 	defer h.mutex.Unlock()
 
@@ -134,12 +286,7 @@ func (h *SignHandler) storeSignRequestContext(requestID string, req SignRequest,
 		return fmt.Errorf(response.ErrMsgDuplicateRequestID)
 	}
 
-	h.requestContexts[requestID] = &signRequestContext{
-		startTime:        time.Now(),
-		address:          req.Address,
-		txOrigin:         req.TxOrigin,
-		clientSecurityID: clientSecurityID,
-	}
+	h.requestContexts[requestID] = reqCtx
 
 	return nil
 }
@@ -150,22 +297,23 @@ func (h *SignHandler) removeSignRequestContext(requestID string) {
 	h.mutex.Unlock()
 }
 
-func (h *SignHandler) performSigning(w http.ResponseWriter, bobStream, aliceStream pb.SignService_SignClient, requestID string) error {
+func (h *SignHandler) performSigning(bobStream, aliceStream pb.SignService_SignClient) (*transaction.Signature, error) {
 	bobChan := make(chan *pb.SignMessage)
 	aliceChan := make(chan *pb.SignMessage)
-	errorChan := make(chan error)
+	// 두 수신 고루틴이 모두 오류를 보낼 수 있으므로, 먼저 끝난 쪽만 읽어도 나머지가 막히지 않도록 버퍼를 둡니다.
+	errorChan := make(chan error, 2)
 
 	go h.receiveSignMessages(bobStream, bobChan, errorChan)
 	go h.receiveSignMessages(aliceStream, aliceChan, errorChan)
 
-	if err := h.startSignProtocol(aliceStream, requestID); err != nil {
-		return fmt.Errorf(response.ErrMsgFailedStartSigning)
+	if err := h.startSignProtocol(aliceStream); err != nil {
+		return nil, fmt.Errorf(response.ErrMsgFailedStartSigning)
 	}
 
-	return h.handleSignMessages(w, bobStream, aliceStream, bobChan, aliceChan, errorChan, requestID)
+	return h.handleSignMessages(bobStream, aliceStream, bobChan, aliceChan, errorChan)
 }
 
-func (h *SignHandler) startSignProtocol(aliceStream pb.SignService_SignClient, requestID string) error {
+func (h *SignHandler) startSignProtocol(aliceStream pb.SignService_SignClient) error {
 	return aliceStream.Send(&pb.SignMessage{
 		Msg: &pb.SignMessage_SignGatewayTo1Output{
 			SignGatewayTo1Output: &pb.SignGatewayTo1Output{},
@@ -173,71 +321,170 @@ func (h *SignHandler) startSignProtocol(aliceStream pb.SignService_SignClient, r
 	})
 }
 
-func (h *SignHandler) handleSignMessages(w http.ResponseWriter, bobStream, aliceStream pb.SignService_SignClient, bobChan, aliceChan <-chan *pb.SignMessage, errorChan <-chan error, requestID string) error {
+func (h *SignHandler) handleSignMessages(bobStream, aliceStream pb.SignService_SignClient, bobChan, aliceChan <-chan *pb.SignMessage, errorChan <-chan error) (*transaction.Signature, error) {
 	for {
 		select {
 		case bobResp := <-bobChan:
 			if signResp, ok := bobResp.Msg.(*pb.SignMessage_SignRound4ToGatewayOutput); ok {
-				return h.handleFinalSignResponse(w, signResp, requestID)
+				output := signResp.SignRound4ToGatewayOutput
+				return &transaction.Signature{V: output.V, R: output.R, S: output.S}, nil
 			}
 			if err := aliceStream.Send(bobResp); err != nil {
-				return fmt.Errorf(response.ErrMsgFailedDuringSigning)
+				return nil, fmt.Errorf(response.ErrMsgFailedDuringSigning)
 			}
 		case aliceResp := <-aliceChan:
 			if err := bobStream.Send(aliceResp); err != nil {
-				return fmt.Errorf(response.ErrMsgFailedDuringSigning)
+				return nil, fmt.Errorf(response.ErrMsgFailedDuringSigning)
 			}
 		case <-errorChan:
-			return fmt.Errorf(response.ErrMsgFailedDuringSigning)
+			return nil, fmt.Errorf(response.ErrMsgFailedDuringSigning)
 		}
 	}
 }
 
-func (h *SignHandler) handleFinalSignResponse(w http.ResponseWriter, signResp *pb.SignMessage_SignRound4ToGatewayOutput, requestID string) error {
-	h.mutex.Lock()
-	reqCtx, exists := h.requestContexts[requestID]
-	h.mutex.Unlock()
-
-	if !exists {
-		response.SendResponse(w, response.NewErrorResponse(response.ErrCodeBadRequest, response.ErrMsgInvalidRequestID))
-		return fmt.Errorf(response.ErrMsgInvalidRequestID)
+// sendSignResponse 는 서명 결과를 응답으로 보냅니다. 미서명 트랜잭션이면 모든 서명을 결합한 트랜잭션을 함께 보냅니다.
+func (h *SignHandler) sendSignResponse(w http.ResponseWriter, reqCtx *signRequestContext, requestID string, signatures []*transaction.Signature) error {
+	var signedTx string
+	if reqCtx.unsignedTx != nil {
+		var err error
+		signedTx, err = h.networkService.AssembleSignedTransactionInputs(reqCtx.network, reqCtx.unsignedTx, reqCtx.publicKey, signatures)
+		if err != nil {
+			return fmt.Errorf(response.ErrMsgFailedAssembleTransaction)
+		}
 	}
 
 	duration := time.Since(reqCtx.startTime)
 
+	first := signatures[0]
 	signResponse := SignResponse{
-		V:         signResp.SignRound4ToGatewayOutput.V,
-		R:         base64.StdEncoding.EncodeToString(signResp.SignRound4ToGatewayOutput.R),
-		S:         base64.StdEncoding.EncodeToString(signResp.SignRound4ToGatewayOutput.S),
+		R:         base64.StdEncoding.EncodeToString(first.R),
+		S:         base64.StdEncoding.EncodeToString(first.S),
+		SignedTx:  signedTx,
 		Duration:  int32(duration.Milliseconds()),
 		RequestID: requestID,
 	}
+	if reqCtx.curve == network.P256 {
+		raw, der, err := encodeP256Signature(first.R, first.S)
+		if err != nil {
+			return fmt.Errorf(response.ErrMsgFailedDuringSigning)
+		}
+		signResponse.Signature = base64.StdEncoding.EncodeToString(raw)
+		signResponse.SignatureDER = base64.StdEncoding.EncodeToString(der)
+	} else {
+		signResponse.V = &first.V
+	}
+	if len(signatures) > 1 {
+		for i, signature := range signatures {
+			v := signature.V
+			signResponse.Signatures = append(signResponse.Signatures, InputSignature{
+				Input: i,
+				V:     &v,
+				R:     base64.StdEncoding.EncodeToString(signature.R),
+				S:     base64.StdEncoding.EncodeToString(signature.S),
+			})
+		}
+	}
 
 	response.SendResponse(w, response.NewSuccessResponse(http.StatusOK, signResponse))
+	reqCtx.signed = true
 	return nil
 }
 
-func (h *SignHandler) setupSignStreams(ctx context.Context) (pb.SignService_SignClient, pb.SignService_SignClient, error) {
-	bobStream, err := h.setupSignStream(ctx, h.config.BobGRPCAddress)
+// settleNonce 는 EVM 미서명 트랜잭션의 논스를 서명에 성공하면 서명됨으로 기록하고, 실패하면 해제해 다시 사용하게 합니다.
+func (h *SignHandler) settleNonce(reqCtx *signRequestContext) {
+	if !network.IsEthereumNetwork(reqCtx.network) {
+		return
+	}
+	from, nonce, err := network.EthereumTxNonce(reqCtx.unsignedTx, reqCtx.network)
+	if err != nil {
+		return
+	}
+	if reqCtx.signed {
+		err = h.nonceManager.MarkSigned(reqCtx.network, from, nonce)
+	} else {
+		_, err = h.nonceManager.Release(reqCtx.network, from, nonce)
+	}
+	if err != nil {
+		log.Printf("Failed to update nonce %d of %s: %v", nonce, from, err)
+	}
+}
+
+// signWithPresign 은 풀에서 presignature 하나를 꺼내 Alice, Bob 순서로 한 번씩 요청해 서명합니다.
+// 사용 가능한 presignature 가 없거나 실패하면 false 를 돌려주며, 호출자는 전체 서명 프로토콜로 진행합니다.
+func (h *SignHandler) signWithPresign(ctx context.Context, keyID uint32, req SignRequest) (*pbPresign.SignWithPresignResponse, bool) {
+	record, err := h.presignRepo.ClaimAvailable(keyID)
 	if err != nil {
-		return nil, nil, fmt.Errorf(response.ErrMsgFailedSetupStreams)
+		return nil, false
 	}
 
-	aliceStream, err := h.setupSignStream(ctx, h.config.AliceGRPCAddress)
+	txOrigin, err := base64.StdEncoding.DecodeString(req.TxOrigin)
 	if err != nil {
-		return nil, nil, fmt.Errorf(response.ErrMsgFailedSetupStreams)
+		return nil, false
 	}
 
-	return bobStream, aliceStream, nil
+	signReq := &pbPresign.SignWithPresignRequest{
+		PresignId: record.PresignID,
+		Address:   req.Address,
+		TxOrigin:  txOrigin,
+	}
+
+	aliceResult, err := h.requestSignWithPresign(ctx, h.config.AliceGRPCAddress, signReq)
+	if err == nil {
+		signReq.Payload = aliceResult.Payload
+		var bobResult *pbPresign.SignWithPresignResponse
+		if bobResult, err = h.requestSignWithPresign(ctx, h.config.BobGRPCAddress, signReq); err == nil {
+			return bobResult, true
+		}
+	}
+
+	// 쉐어가 바뀌었거나 파티의 presignature 가 어긋났을 수 있으므로 키의 남은 풀을 비웁니다.
+	log.Printf("presign sign failed for key %d, falling back to full signing: %v", keyID, err)
+	if err := h.presignRepo.InvalidateByKeyID(keyID); err != nil {
+		log.Printf("failed to invalidate presignatures for key %d: %v", keyID, err)
+	}
+	return nil, false
 }
 
-func (h *SignHandler) setupSignStream(ctx context.Context, address string) (pb.SignService_SignClient, error) {
-	conn, err := grpc.DialContext(ctx, address, grpc.WithInsecure())
+func (h *SignHandler) requestSignWithPresign(ctx context.Context, address string, req *pbPresign.SignWithPresignRequest) (*pbPresign.SignWithPresignResponse, error) {
+	conn, err := dialParty(ctx, h.config, address)
 	if err != nil {
 		return nil, fmt.Errorf(response.ErrMsgFailedConnectGRPC)
 	}
-	client := pb.NewSignServiceClient(conn)
-	return client.Sign(ctx)
+	defer conn.Close()
+	return pbPresign.NewPresignServiceClient(conn).SignWithPresign(ctx, req)
+}
+
+// setupSignStreams 는 두 파티와 서명 스트림을 엽니다. 서명이 끝나면 호출자가 closeConns 로 연결을 닫아야 합니다.
+func (h *SignHandler) setupSignStreams(ctx context.Context) (pb.SignService_SignClient, pb.SignService_SignClient, func(), error) {
+	bobStream, bobConn, err := h.setupSignStream(ctx, h.config.BobGRPCAddress)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf(response.ErrMsgFailedSetupStreams)
+	}
+
+	aliceStream, aliceConn, err := h.setupSignStream(ctx, h.config.AliceGRPCAddress)
+	if err != nil {
+		bobConn.Close()
+		return nil, nil, nil, fmt.Errorf(response.ErrMsgFailedSetupStreams)
+	}
+
+	closeConns := func() {
+		bobConn.Close()
+		aliceConn.Close()
+	}
+	return bobStream, aliceStream, closeConns, nil
+}
+
+func (h *SignHandler) setupSignStream(ctx context.Context, address string) (pb.SignService_SignClient, *grpc.ClientConn, error) {
+	conn, err := dialParty(ctx, h.config, address)
+	if err != nil {
+		return nil, nil, fmt.Errorf(response.ErrMsgFailedConnectGRPC)
+	}
+	stream, err := pb.NewSignServiceClient(conn).Sign(ctx)
+	if err != nil {
+		conn.Close()
+		return nil, nil, err
+	}
+	return stream, conn, nil
 }
 
 func (h *SignHandler) receiveSignMessages(stream pb.SignService_SignClient, msgChan chan<- *pb.SignMessage, errChan chan<- error) {
@@ -250,3 +497,21 @@ func (h *SignHandler) receiveSignMessages(stream pb.SignService_SignClient, msgC
 		msgChan <- resp
 	}
 }
+
+// encodeP256Signature 는 P-256 서명을 r || s (고정 64바이트) 와 ASN.1 DER 로 인코딩합니다.
+func encodeP256Signature(r, s []byte) ([]byte, []byte, error) {
+	if len(r) > 32 || len(s) > 32 {
+		return nil, nil, fmt.Errorf("invalid p256 signature length")
+	}
+	raw := make([]byte, 64)
+	copy(raw[32-len(r):32], r)
+	copy(raw[64-len(s):], s)
+
+	der, err := asn1.Marshal(struct {
+		R, S *big.Int
+	}{new(big.Int).SetBytes(r), new(big.Int).SetBytes(s)})
+	if err != nil {
+		return nil, nil, err
+	}
+	return raw, der, nil
+}
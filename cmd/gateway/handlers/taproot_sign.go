@@ -0,0 +1,359 @@
+package handlers
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"tecdsa/cmd/gateway/config"
+	"tecdsa/pkg/database/repository"
+	"tecdsa/pkg/network"
+	"tecdsa/pkg/response"
+	"tecdsa/pkg/service"
+	"tecdsa/pkg/transaction"
+	"tecdsa/pkg/utils"
+	pb "tecdsa/proto/taproot"
+
+	"github.com/coinbase/kryptology/pkg/core/curves"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+type TaprootSignRequest struct {
+	Address string `json:"address"`
+	// TxOrigin 은 BIP341 서명 해시(32바이트)의 base64 입니다.
+	TxOrigin   string                           `json:"tx_origin"`
+	RequestID  string                           `json:"request_id,omitempty"`
+	UnsignedTx *transaction.UnsignedTransaction `json:"unsigned_tx,omitempty"`
+}
+
+type TaprootSignResponse struct {
+	// Signature 는 64바이트 BIP340 서명(R.x || s)의 base64 입니다.
+	Signature string `json:"signature"`
+	// Signatures 는 입력이 여러 개인 트랜잭션의 입력별 서명입니다. Signature 는 첫 번째 입력의 서명입니다.
+	Signatures []string `json:"signatures,omitempty"`
+	SignedTx   string   `json:"signed_tx,omitempty"`
+	Duration   int32    `json:"duration"`
+	RequestID  string   `json:"request_id"`
+}
+
+type taprootSignRequestContext struct {
+	startTime time.Time
+	// txOrigins 는 서명 세션마다 파티에게 보낼 tx_origin 입니다. 입력이 여러 개면 입력마다 하나씩입니다.
+	txOrigins  []string
+	unsignedTx *transaction.UnsignedTransaction
+	network    network.Network
+	publicKey  curves.Point
+}
+
+type TaprootSignHandler struct {
+	clientSecurityRepo repository.ClientSecurityRepository
+	keyRepo            repository.KeyRepository
+	keyAddressRepo     repository.KeyAddressRepository
+	config             *config.Config
+	networkService     *service.NetworkService
+	requestContexts    map[string]*taprootSignRequestContext
+	mutex              sync.Mutex
+}
+
+func NewTaprootSignHandler(cfg *config.Config, repo repository.ClientSecurityRepository, keyRepo repository.KeyRepository, keyAddressRepo repository.KeyAddressRepository, networkService *service.NetworkService) *TaprootSignHandler {
+	return &TaprootSignHandler{
+		clientSecurityRepo: repo,
+		keyRepo:            keyRepo,
+		keyAddressRepo:     keyAddressRepo,
+		config:             cfg,
+		networkService:     networkService,
+		requestContexts:    make(map[string]*taprootSignRequestContext),
+	}
+}
+
+func (h *TaprootSignHandler) Serve(w http.ResponseWriter, r *http.Request) {
+	req, requestID, err := h.parseAndValidateRequest(r)
+	if err != nil {
+		response.SendResponse(w, response.NewErrorResponse(response.ErrCodeBadRequest, err.Error()))
+		return
+	}
+
+	clientIP := utils.GetClientIP(r)
+	clientSecurity, err := h.clientSecurityRepo.FindByIP(clientIP)
+	if err != nil {
+		response.SendResponse(w, response.NewErrorResponse(response.ErrCodeInternalServerError, response.ErrMsgFailedRetrieveClientSecurity))
+		return
+	}
+
+	reqCtx := &taprootSignRequestContext{startTime: time.Now()}
+	if err := h.prepareRequest(&req, reqCtx); err != nil {
+		response.SendResponse(w, response.NewErrorResponse(response.ErrCodeBadRequest, err.Error()))
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Minute)
+	defer cancel()
+
+	if err := h.storeRequestContext(requestID, reqCtx); err != nil {
+		response.SendResponse(w, response.NewErrorResponse(response.ErrCodeBadRequest, err.Error()))
+		return
+	}
+	defer h.removeRequestContext(requestID)
+
+	// 입력이 여러 개면 입력마다 서명 세션을 차례로 실행합니다.
+	signatures := make([][]byte, 0, len(reqCtx.txOrigins))
+	for i, txOrigin := range reqCtx.txOrigins {
+		sessionID := requestID
+		if len(reqCtx.txOrigins) > 1 {
+			sessionID = fmt.Sprintf("%s-%d", requestID, i)
+		}
+		signature, errCode, err := h.signTxOrigin(ctx, req.Address, txOrigin, sessionID, clientSecurity.ID)
+		if err != nil {
+			response.SendResponse(w, response.NewErrorResponse(errCode, err.Error()))
+			return
+		}
+		signatures = append(signatures, signature)
+	}
+
+	if err := h.sendResponse(w, reqCtx, requestID, signatures); err != nil {
+		response.SendResponse(w, response.NewErrorResponse(response.ErrCodeSigning, err.Error()))
+	}
+}
+
+// signTxOrigin 은 tx_origin 하나를 서명해 64바이트 서명을 돌려줍니다. 실패하면 응답에 사용할 오류 코드를 함께 돌려줍니다.
+func (h *TaprootSignHandler) signTxOrigin(ctx context.Context, address, txOrigin, sessionID string, clientSecurityID uint32) ([]byte, string, error) {
+	ctx = metadata.NewOutgoingContext(ctx, metadata.New(map[string]string{
+		"request_id":         sessionID,
+		"address":            address,
+		"tx_origin":          txOrigin,
+		"client_security_id": fmt.Sprintf("%d", clientSecurityID),
+	}))
+
+	bobStream, aliceStream, closeConns, err := h.setupStreams(ctx)
+	if err != nil {
+		return nil, response.ErrCodeInternalServerError, fmt.Errorf(response.ErrMsgFailedSetupStreams)
+	}
+	defer closeConns()
+	defer bobStream.CloseSend()
+	defer aliceStream.CloseSend()
+
+	signature, err := h.performSigning(bobStream, aliceStream)
+	if err != nil {
+		return nil, response.ErrCodeSigning, err
+	}
+	return signature, "", nil
+}
+
+func (h *TaprootSignHandler) parseAndValidateRequest(r *http.Request) (TaprootSignRequest, string, error) {
+	var req TaprootSignRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return req, "", fmt.Errorf(response.ErrMsgInvalidRequestBody)
+	}
+
+	requestID := strings.TrimSpace(req.RequestID)
+	if requestID == "" {
+		requestID = uuid.New().String()
+	}
+
+	if req.Address == "" || (req.TxOrigin == "" && req.UnsignedTx == nil) {
+		return req, "", fmt.Errorf(response.ErrMsgInvalidSignRequest)
+	}
+
+	return req, requestID, nil
+}
+
+// prepareRequest 는 주소가 P2TR 인지 확인하고, 미서명 트랜잭션이 있으면 입력마다 BIP341 서명 해시를 tx_origin 으로 사용합니다.
+func (h *TaprootSignHandler) prepareRequest(req *TaprootSignRequest, reqCtx *taprootSignRequestContext) error {
+	keyAddress, err := h.keyAddressRepo.FindByAddress(req.Address)
+	if err != nil {
+		return fmt.Errorf(response.ErrMsgKeyNotFound)
+	}
+	if keyAddress.AddressType != network.P2TR {
+		return fmt.Errorf(response.ErrMsgNotTaprootAddress)
+	}
+
+	if req.UnsignedTx == nil {
+		txOrigin, err := base64.StdEncoding.DecodeString(req.TxOrigin)
+		if err != nil || len(txOrigin) != 32 {
+			return fmt.Errorf(response.ErrMsgInvalidSignRequest)
+		}
+		reqCtx.txOrigins = []string{req.TxOrigin}
+		return nil
+	}
+
+	if req.UnsignedTx.NetworkID != keyAddress.Network {
+		return fmt.Errorf(response.ErrMsgUnsupportedNetwork)
+	}
+
+	key, err := h.keyRepo.FindByID(uint(keyAddress.KeyID))
+	if err != nil {
+		return fmt.Errorf(response.ErrMsgKeyNotFound)
+	}
+
+	net, err := h.networkService.GetNetworkByID(keyAddress.Network)
+	if err != nil {
+		return fmt.Errorf(response.ErrMsgUnsupportedNetwork)
+	}
+
+	publicKeyBytes, err := hex.DecodeString(key.PublicKey)
+	if err != nil {
+		return fmt.Errorf(response.ErrMsgFailedCreateSigningPayload)
+	}
+	point, err := curves.K256().Point.FromAffineCompressed(publicKeyBytes)
+	if err != nil {
+		return fmt.Errorf(response.ErrMsgFailedCreateSigningPayload)
+	}
+
+	payloads, err := h.networkService.CreateSigningPayloads(net, req.UnsignedTx, point)
+	if err != nil {
+		return fmt.Errorf("%s: %v", response.ErrMsgFailedCreateSigningPayload, err)
+	}
+
+	for _, payload := range payloads {
+		reqCtx.txOrigins = append(reqCtx.txOrigins, base64.StdEncoding.EncodeToString(payload))
+	}
+	reqCtx.unsignedTx = req.UnsignedTx
+	reqCtx.network = net
+	reqCtx.publicKey = point
+	return nil
+}
+
+func (h *TaprootSignHandler) storeRequestContext(requestID string, reqCtx *taprootSignRequestContext) error {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	if _, exists := h.requestContexts[requestID]; exists {
+		return fmt.Errorf(response.ErrMsgDuplicateRequestID)
+	}
+
+	h.requestContexts[requestID] = reqCtx
+
+	return nil
+}
+
+func (h *TaprootSignHandler) removeRequestContext(requestID string) {
+	h.mutex.Lock()
+	delete(h.requestContexts, requestID)
+	h.mutex.Unlock()
+}
+
+func (h *TaprootSignHandler) performSigning(bobStream, aliceStream pb.TaprootSignService_SignClient) ([]byte, error) {
+	bobChan := make(chan *pb.TaprootSignMessage)
+	aliceChan := make(chan *pb.TaprootSignMessage)
+	// 두 수신 고루틴이 모두 오류를 보낼 수 있으므로, 먼저 끝난 쪽만 읽어도 나머지가 막히지 않도록 버퍼를 둡니다.
+	errorChan := make(chan error, 2)
+
+	go h.receiveMessages(bobStream, bobChan, errorChan)
+	go h.receiveMessages(aliceStream, aliceChan, errorChan)
+
+	err := aliceStream.Send(&pb.TaprootSignMessage{
+		Msg: &pb.TaprootSignMessage_TaprootSignGatewayTo1Output{
+			TaprootSignGatewayTo1Output: &pb.TaprootSignGatewayTo1Output{},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf(response.ErrMsgFailedStartSigning)
+	}
+
+	for {
+		select {
+		case bobResp := <-bobChan:
+			if signResp, ok := bobResp.Msg.(*pb.TaprootSignMessage_TaprootSignRound4ToGatewayOutput); ok {
+				sig := signResp.TaprootSignRound4ToGatewayOutput.Signature
+				if len(sig) != 64 {
+					return nil, fmt.Errorf(response.ErrMsgFailedDuringSigning)
+				}
+				return sig, nil
+			}
+			if err := aliceStream.Send(bobResp); err != nil {
+				return nil, fmt.Errorf(response.ErrMsgFailedDuringSigning)
+			}
+		case aliceResp := <-aliceChan:
+			if err := bobStream.Send(aliceResp); err != nil {
+				return nil, fmt.Errorf(response.ErrMsgFailedDuringSigning)
+			}
+		case <-errorChan:
+			return nil, fmt.Errorf(response.ErrMsgFailedDuringSigning)
+		}
+	}
+}
+
+// sendResponse 는 서명 결과를 응답으로 보냅니다. 미서명 트랜잭션이면 모든 서명을 결합한 트랜잭션을 함께 보냅니다.
+func (h *TaprootSignHandler) sendResponse(w http.ResponseWriter, reqCtx *taprootSignRequestContext, requestID string, sigs [][]byte) error {
+	var signedTx string
+	if reqCtx.unsignedTx != nil {
+		signatures := make([]*transaction.Signature, len(sigs))
+		for i, sig := range sigs {
+			signatures[i] = &transaction.Signature{R: sig[:32], S: sig[32:]}
+		}
+		var err error
+		signedTx, err = h.networkService.AssembleSignedTransactionInputs(reqCtx.network, reqCtx.unsignedTx, reqCtx.publicKey, signatures)
+		if err != nil {
+			return fmt.Errorf(response.ErrMsgFailedAssembleTransaction)
+		}
+	}
+
+	duration := time.Since(reqCtx.startTime)
+
+	signResponse := TaprootSignResponse{
+		Signature: base64.StdEncoding.EncodeToString(sigs[0]),
+		SignedTx:  signedTx,
+		Duration:  int32(duration.Milliseconds()),
+		RequestID: requestID,
+	}
+	if len(sigs) > 1 {
+		for _, sig := range sigs {
+			signResponse.Signatures = append(signResponse.Signatures, base64.StdEncoding.EncodeToString(sig))
+		}
+	}
+
+	response.SendResponse(w, response.NewSuccessResponse(http.StatusOK, signResponse))
+	return nil
+}
+
+// setupStreams 는 두 파티와 서명 스트림을 엽니다. 서명이 끝나면 호출자가 closeConns 로 연결을 닫아야 합니다.
+func (h *TaprootSignHandler) setupStreams(ctx context.Context) (pb.TaprootSignService_SignClient, pb.TaprootSignService_SignClient, func(), error) {
+	bobStream, bobConn, err := h.setupStream(ctx, h.config.BobGRPCAddress)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf(response.ErrMsgFailedSetupStreams)
+	}
+
+	aliceStream, aliceConn, err := h.setupStream(ctx, h.config.AliceGRPCAddress)
+	if err != nil {
+		bobConn.Close()
+		return nil, nil, nil, fmt.Errorf(response.ErrMsgFailedSetupStreams)
+	}
+
+	closeConns := func() {
+		bobConn.Close()
+		aliceConn.Close()
+	}
+	return bobStream, aliceStream, closeConns, nil
+}
+
+func (h *TaprootSignHandler) setupStream(ctx context.Context, address string) (pb.TaprootSignService_SignClient, *grpc.ClientConn, error) {
+	conn, err := dialParty(ctx, h.config, address)
+	if err != nil {
+		return nil, nil, fmt.Errorf(response.ErrMsgFailedConnectGRPC)
+	}
+	stream, err := pb.NewTaprootSignServiceClient(conn).Sign(ctx)
+	if err != nil {
+		conn.Close()
+		return nil, nil, err
+	}
+	return stream, conn, nil
+}
+
+func (h *TaprootSignHandler) receiveMessages(stream pb.TaprootSignService_SignClient, msgChan chan<- *pb.TaprootSignMessage, errChan chan<- error) {
+	for {
+		resp, err := stream.Recv()
+		if err != nil {
+			errChan <- err
+			return
+		}
+		msgChan <- resp
+	}
+}
@@ -1,15 +1,17 @@
 package main
 
 import (
-	"fmt"
 	"log"
 	"net/http"
 	"os"
+	"strconv"
+	"time"
 
 	"tecdsa/cmd/gateway/config"
 	"tecdsa/cmd/gateway/server"
 	"tecdsa/pkg/database"
 	"tecdsa/pkg/database/repository"
+	"tecdsa/pkg/network"
 
 	"gorm.io/gorm"
 )
@@ -18,6 +20,13 @@ func main() {
 	// 설정 로드
 	cfg := loadConfig()
 
+	// 네트워크 설정 로드 (NETWORKS_CONFIG 가 없으면 기본 네트워크만 사용)
+	if path := os.Getenv("NETWORKS_CONFIG"); path != "" {
+		if err := network.LoadNetworks(path); err != nil {
+			log.Fatalf("Failed to load network config: %v", err)
+		}
+	}
+
 	// 전역 설정에 Bob과 Alice 주소 설정
 	config.SetAddresses(cfg.BobGRPCAddress, cfg.AliceGRPCAddress)
 
@@ -26,14 +35,22 @@ func main() {
 
 	// 리포지토리 생성
 	ipPublicKeyRepo := repository.NewClientSecurityRepository(db)
+	keyRepo := repository.NewKeyRepository(db)
+	keyAddressRepo := repository.NewKeyAddressRepository(db)
+	presignRepo := repository.NewPresignatureRepository(db)
+	contractABIRepo := repository.NewContractABIRepository(db)
+	nonceRepo := repository.NewNonceRepository(db)
+	requestNonceRepo := repository.NewRequestNonceRepository(db)
 
 	// HTTP 서버 시작
-	startHTTPServer(cfg, ipPublicKeyRepo)
+	startHTTPServer(cfg, ipPublicKeyRepo, keyRepo, keyAddressRepo, presignRepo, contractABIRepo, nonceRepo, requestNonceRepo)
 }
 
 func loadConfig() *config.Config {
 	cfg := &config.Config{
+		DBDriver:         os.Getenv("DB_DRIVER"),
 		DBHost:           os.Getenv("DB_HOST"),
+		DBPort:           os.Getenv("DB_PORT"),
 		DBUser:           os.Getenv("DB_USER"),
 		DBPassword:       os.Getenv("DB_PASSWORD"),
 		DBName:           os.Getenv("DB_NAME"),
@@ -41,13 +58,48 @@ func loadConfig() *config.Config {
 		BobGRPCAddress:   os.Getenv("BOB_GRPC_ADDRESS"),
 		AliceGRPCAddress: os.Getenv("ALICE_GRPC_ADDRESS"),
 	}
+
+	if poolSize := os.Getenv("PRESIGN_POOL_SIZE"); poolSize != "" {
+		size, err := strconv.Atoi(poolSize)
+		if err != nil || size < 0 {
+			log.Fatalf("Invalid PRESIGN_POOL_SIZE: %s", poolSize)
+		}
+		cfg.PresignPoolSize = size
+	}
+
+	if requireSigned := os.Getenv("REQUIRE_SIGNED_REQUESTS"); requireSigned != "" {
+		required, err := strconv.ParseBool(requireSigned)
+		if err != nil {
+			log.Fatalf("Invalid REQUIRE_SIGNED_REQUESTS: %s", requireSigned)
+		}
+		cfg.RequireSignedRequests = required
+	}
+
+	if ttl := os.Getenv("NONCE_RESERVATION_TTL"); ttl != "" {
+		duration, err := time.ParseDuration(ttl)
+		if err != nil || duration < 0 {
+			log.Fatalf("Invalid NONCE_RESERVATION_TTL: %s", ttl)
+		}
+		cfg.NonceReservationTTL = duration
+	}
+
+	targets, err := config.ParseKeyPoolTargets(os.Getenv("KEY_POOL"))
+	if err != nil {
+		log.Fatalf("Invalid KEY_POOL: %v", err)
+	}
+	cfg.KeyPoolTargets = targets
 	return cfg
 }
 
 func connectDatabase(cfg *config.Config) *gorm.DB {
-	dsn := fmt.Sprintf("%s:%s@tcp(%s:3306)/%s?charset=utf8mb4&parseTime=True&loc=Local",
-		cfg.DBUser, cfg.DBPassword, cfg.DBHost, cfg.DBName)
-	db, err := database.NewDatabase(dsn)
+	db, err := database.Connect(database.Config{
+		Driver:   cfg.DBDriver,
+		Host:     cfg.DBHost,
+		Port:     cfg.DBPort,
+		User:     cfg.DBUser,
+		Password: cfg.DBPassword,
+		Name:     cfg.DBName,
+	})
 	if err != nil {
 		log.Fatalf("Failed to connect to database: %v", err)
 	}
@@ -56,8 +108,12 @@ func connectDatabase(cfg *config.Config) *gorm.DB {
 	return db
 }
 
-func startHTTPServer(cfg *config.Config, ipPublicKeyRepo repository.ClientSecurityRepository) {
-	srv := server.NewServer(cfg, ipPublicKeyRepo)
+func startHTTPServer(cfg *config.Config, ipPublicKeyRepo repository.ClientSecurityRepository, keyRepo repository.KeyRepository, keyAddressRepo repository.KeyAddressRepository, presignRepo repository.PresignatureRepository, contractABIRepo repository.ContractABIRepository, nonceRepo repository.NonceRepository, requestNonceRepo repository.RequestNonceRepository) {
+	srv := server.NewServer(cfg, ipPublicKeyRepo, keyRepo, keyAddressRepo, presignRepo, contractABIRepo, nonceRepo, requestNonceRepo)
+	srv.StartPresignPool()
+	if err := srv.StartKeyPool(); err != nil {
+		log.Fatalf("Failed to start key pool: %v", err)
+	}
 
 	log.Printf("Server listening on port %s", cfg.ServerPort)
 	if err := http.ListenAndServe(":"+cfg.ServerPort, srv); err != nil {
@@ -2,31 +2,65 @@
 package server
 
 import (
+	"bytes"
+	"errors"
+	"io"
 	"net/http"
 	"path/filepath"
+	"strings"
+	"time"
 
 	"tecdsa/cmd/gateway/config"
 	"tecdsa/cmd/gateway/handlers"
 
 	createUnsignedTxHandlers "tecdsa/cmd/gateway/handlers/create_unsigned_tx"
+	"tecdsa/pkg/auth"
 	"tecdsa/pkg/database/repository"
+	"tecdsa/pkg/network"
+	"tecdsa/pkg/response"
 	"tecdsa/pkg/service"
+	"tecdsa/pkg/utils"
 )
 
 type Server struct {
 	clientSecurityRepo repository.ClientSecurityRepository
-	mux                *http.ServeMux
-	config             *config.Config
-	networkService     *service.NetworkService
+	keyRepo            repository.KeyRepository
+	keyAddressRepo     repository.KeyAddressRepository
+	presignRepo        repository.PresignatureRepository
+	contractABIRepo    repository.ContractABIRepository
+	nonceManager       *service.NonceManager
+	keyPool            *handlers.KeyPool
+	presignPool        *handlers.PresignPool
+	// signer, taprootSigner 는 /sign, /sign_taproot 와 /sign/psbt 가 함께 사용하는 서명 핸들러입니다.
+	signer         *handlers.SignHandler
+	taprootSigner  *handlers.TaprootSignHandler
+	replayCache    *auth.ReplayCache
+	mux            *http.ServeMux
+	config         *config.Config
+	networkService *service.NetworkService
 }
 
-func NewServer(cfg *config.Config, clientSecurityRepo repository.ClientSecurityRepository) *Server {
+func NewServer(cfg *config.Config, clientSecurityRepo repository.ClientSecurityRepository, keyRepo repository.KeyRepository, keyAddressRepo repository.KeyAddressRepository, presignRepo repository.PresignatureRepository, contractABIRepo repository.ContractABIRepository, nonceRepo repository.NonceRepository, requestNonceRepo repository.RequestNonceRepository) *Server {
 	s := &Server{
 		clientSecurityRepo: clientSecurityRepo,
+		keyRepo:            keyRepo,
+		keyAddressRepo:     keyAddressRepo,
+		presignRepo:        presignRepo,
+		contractABIRepo:    contractABIRepo,
+		replayCache:        auth.NewReplayCache(requestNonceRepo),
 		mux:                http.NewServeMux(),
 		config:             cfg,
 		networkService:     service.NewNetworkService(),
 	}
+	chainNonce := cfg.ChainNonce
+	if chainNonce == nil {
+		chainNonce = network.EthereumPendingNonce
+	}
+	s.nonceManager = service.NewNonceManager(nonceRepo, chainNonce, cfg.NonceReservationTTL)
+	s.keyPool = handlers.NewKeyPool(cfg, keyRepo, keyAddressRepo, s.networkService)
+	s.presignPool = handlers.NewPresignPool(cfg, keyRepo, presignRepo)
+	s.signer = handlers.NewSignHandler(cfg, clientSecurityRepo, keyRepo, keyAddressRepo, presignRepo, s.networkService, s.nonceManager)
+	s.taprootSigner = handlers.NewTaprootSignHandler(cfg, clientSecurityRepo, keyRepo, keyAddressRepo, s.networkService)
 	s.routes()
 	return s
 }
@@ -35,12 +69,37 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	s.mux.ServeHTTP(w, r)
 }
 
+// StartPresignPool 은 설정된 크기만큼 presignature 를 미리 만들어 두는 백그라운드 작업을 시작합니다.
+func (s *Server) StartPresignPool() {
+	s.presignPool.Start()
+}
+
+// StartKeyPool 은 설정된 (네트워크, 주소 유형) 별로 키를 미리 생성해 두는 백그라운드 작업을 시작합니다.
+func (s *Server) StartKeyPool() error {
+	return s.keyPool.Start()
+}
+
+// Close 는 백그라운드 풀 작업을 멈춥니다.
+func (s *Server) Close() {
+	s.presignPool.Stop()
+	s.keyPool.Stop()
+}
+
 func (s *Server) routes() {
 	s.mux.HandleFunc("/register", s.methodHandler(http.MethodPost, s.registerClientSecurityHandler()))
-	s.mux.HandleFunc("/key_gen", s.methodHandler(http.MethodPost, s.keyGenHandler()))
-	s.mux.HandleFunc("/sign", s.methodHandler(http.MethodPost, s.signHandler()))
+	s.mux.HandleFunc("/key_gen", s.authenticate(s.methodHandler(http.MethodPost, s.keyGenHandler())))
+	s.mux.HandleFunc("/sign", s.authenticate(s.methodHandler(http.MethodPost, s.signHandler())))
+	s.mux.HandleFunc("/sign_taproot", s.authenticate(s.methodHandler(http.MethodPost, s.taprootSignHandler())))
+	s.mux.HandleFunc("/sign/psbt", s.authenticate(s.methodHandler(http.MethodPost, s.psbtSignHandler())))
+	s.mux.HandleFunc("/key_gen_ed25519", s.authenticate(s.methodHandler(http.MethodPost, s.eddsaKeyGenHandler())))
+	s.mux.HandleFunc("/sign_ed25519", s.authenticate(s.methodHandler(http.MethodPost, s.eddsaSignHandler())))
+	s.mux.HandleFunc("/key_pool", s.authenticate(s.methodHandler(http.MethodGet, s.keyPoolMetricsHandler())))
 	s.mux.HandleFunc("/networks", s.methodHandler(http.MethodGet, s.getAllNetworksHandler()))
-	s.mux.HandleFunc("/create_unsigned_tx/", s.methodHandler(http.MethodPost, s.createUnsignedTxHandler()))
+	s.mux.HandleFunc("/create_unsigned_tx/", s.authenticate(s.methodHandler(http.MethodPost, s.createUnsignedTxHandler())))
+	s.mux.HandleFunc("/keys/", s.authenticate(s.keysHandler()))
+	s.mux.HandleFunc("/abis", s.authenticate(s.contractABIHandler()))
+	s.mux.HandleFunc("/abis/", s.authenticate(s.contractABIHandler()))
+	s.mux.HandleFunc("/nonces/", s.authenticate(s.nonceHandler()))
 	s.mux.HandleFunc("/docs/", s.methodHandler(http.MethodGet, s.serveDocHandler()))
 
 }
@@ -55,18 +114,118 @@ func (s *Server) methodHandler(method string, h http.HandlerFunc) http.HandlerFu
 	}
 }
 
+// authenticate 는 요청 서명 헤더를 클라이언트가 등록한 공개키로 검증합니다.
+// 서명이 없는 요청은 RequireSignedRequests 를 끈 경우에만 받으며, 이전처럼 IP 로만 클라이언트를 식별합니다.
+func (s *Server) authenticate(h http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !auth.IsSigned(r) {
+			if s.config.RequireSignedRequests {
+				response.SendResponse(w, response.NewErrorResponse(response.ErrCodeUnauthorized, response.ErrMsgSignedRequestRequired))
+				return
+			}
+			h(w, r)
+			return
+		}
+
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			response.SendResponse(w, response.NewErrorResponse(response.ErrCodeBadRequest, response.ErrMsgInvalidRequestBody))
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		clientID, err := auth.ClientID(r)
+		if err != nil {
+			response.SendResponse(w, response.NewErrorResponse(response.ErrCodeUnauthorized, err.Error()))
+			return
+		}
+		clientSecurity, err := s.clientSecurityRepo.FindByID(uint(clientID))
+		if err != nil {
+			response.SendResponse(w, response.NewErrorResponse(response.ErrCodeUnauthorized))
+			return
+		}
+		// 핸들러는 IP 로 클라이언트를 찾으므로, 서명한 클라이언트가 요청 IP 의 클라이언트여야 합니다.
+		if clientSecurity.IP != utils.GetClientIP(r) {
+			response.SendResponse(w, response.NewErrorResponse(response.ErrCodeForbidden))
+			return
+		}
+		now := time.Now()
+		if err := auth.VerifyRequest(r, body, clientSecurity.PublicKey, now); err != nil {
+			response.SendResponse(w, response.NewErrorResponse(response.ErrCodeUnauthorized, err.Error()))
+			return
+		}
+		// 가로챈 요청을 타임스탬프 허용 범위 안에서 다시 보내는 것을 막습니다.
+		if err := s.replayCache.Check(r, clientID, now); err != nil {
+			if errors.Is(err, auth.ErrReplayedRequest) {
+				response.SendResponse(w, response.NewErrorResponse(response.ErrCodeUnauthorized, err.Error()))
+				return
+			}
+			response.SendResponse(w, response.NewErrorResponse(response.ErrCodeInternalServerError))
+			return
+		}
+		h(w, r)
+	}
+}
+
 func (s *Server) registerClientSecurityHandler() http.HandlerFunc {
 	handler := handlers.NewRegisterClientSecurityHandler(s.clientSecurityRepo)
 	return handler.Serve
 }
 
 func (s *Server) keyGenHandler() http.HandlerFunc {
-	handler := handlers.NewKeyGenHandler(s.config, s.clientSecurityRepo, s.networkService)
+	handler := handlers.NewKeyGenHandler(s.config, s.clientSecurityRepo, s.keyRepo, s.keyPool, s.networkService)
 	return handler.Serve
 }
 
 func (s *Server) signHandler() http.HandlerFunc {
-	handler := handlers.NewSignHandler(s.config, s.clientSecurityRepo, s.networkService)
+	return s.signer.Serve
+}
+
+func (s *Server) taprootSignHandler() http.HandlerFunc {
+	return s.taprootSigner.Serve
+}
+
+func (s *Server) psbtSignHandler() http.HandlerFunc {
+	handler := handlers.NewPSBTSignHandler(s.clientSecurityRepo, s.keyRepo, s.keyAddressRepo, s.networkService, s.signer, s.taprootSigner)
+	return handler.Serve
+}
+
+func (s *Server) eddsaKeyGenHandler() http.HandlerFunc {
+	handler := handlers.NewEddsaKeyGenHandler(s.config, s.clientSecurityRepo, s.keyRepo, s.networkService)
+	return handler.Serve
+}
+
+func (s *Server) eddsaSignHandler() http.HandlerFunc {
+	handler := handlers.NewEddsaSignHandler(s.config, s.clientSecurityRepo, s.keyRepo, s.keyAddressRepo, s.networkService)
+	return handler.Serve
+}
+
+// keysHandler 는 /keys/{id}/ 하위 경로를 각 핸들러로 나눕니다.
+func (s *Server) keysHandler() http.HandlerFunc {
+	xpubHandler := s.methodHandler(http.MethodGet, s.keyXpubHandler())
+	addressHandler := s.keyAddressHandler()
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(strings.TrimSuffix(r.URL.Path, "/"), "/addresses"):
+			addressHandler(w, r)
+		default:
+			xpubHandler(w, r)
+		}
+	}
+}
+
+func (s *Server) keyXpubHandler() http.HandlerFunc {
+	handler := handlers.NewKeyXpubHandler(s.clientSecurityRepo, s.keyRepo, s.networkService)
+	return handler.Serve
+}
+
+func (s *Server) keyAddressHandler() http.HandlerFunc {
+	handler := handlers.NewKeyAddressHandler(s.config, s.clientSecurityRepo, s.keyRepo, s.keyAddressRepo, s.networkService)
+	return handler.Serve
+}
+
+func (s *Server) keyPoolMetricsHandler() http.HandlerFunc {
+	handler := handlers.NewKeyPoolMetricsHandler(s.keyPool)
 	return handler.Serve
 }
 
@@ -81,6 +240,16 @@ func (s *Server) serveDocHandler() http.HandlerFunc {
 	return handler.Serve
 }
 func (s *Server) createUnsignedTxHandler() http.HandlerFunc {
-	handler := createUnsignedTxHandlers.NewCreateUnsignedTxHandler(s.networkService)
+	handler := createUnsignedTxHandlers.NewCreateUnsignedTxHandler(s.networkService, s.clientSecurityRepo, s.keyRepo, s.keyAddressRepo, s.contractABIRepo, s.nonceManager)
+	return handler.Serve
+}
+
+func (s *Server) nonceHandler() http.HandlerFunc {
+	handler := handlers.NewNonceHandler(s.nonceManager, s.clientSecurityRepo, s.keyRepo, s.keyAddressRepo, s.networkService)
+	return handler.Serve
+}
+
+func (s *Server) contractABIHandler() http.HandlerFunc {
+	handler := handlers.NewContractABIHandler(s.contractABIRepo, s.networkService)
 	return handler.Serve
 }
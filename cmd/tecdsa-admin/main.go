@@ -0,0 +1,88 @@
+// tecdsa-admin 은 Alice 또는 Bob 데이터베이스의 쉐어를 검사하고 현재 형식으로 옮기는 관리 도구입니다.
+//
+//	$ DB_DRIVER=mysql DB_HOST=... tecdsa-admin -party alice -dry-run -report report.json
+//
+// 데이터베이스와 쉐어 저장소는 파티 서버와 같은 환경 변수 (DB_*, SHARE_STORE*) 로 설정합니다.
+// 손상되었거나 주소가 맞지 않는 쉐어가 있으면 종료 코드 1 로 끝납니다.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"io"
+	"log"
+	"os"
+
+	"tecdsa/pkg/database"
+	"tecdsa/pkg/service"
+	"tecdsa/pkg/shareaudit"
+	"tecdsa/pkg/sharestore"
+)
+
+func main() {
+	party := flag.String("party", "", "검사할 파티 데이터베이스 (alice, bob)")
+	dryRun := flag.Bool("dry-run", false, "쉐어를 다시 저장하지 않고 검사만 합니다")
+	reportPath := flag.String("report", "-", "JSON 보고서를 쓸 파일 (- 이면 표준 출력)")
+	flag.Parse()
+
+	db, err := database.Connect(database.Config{
+		Driver:   os.Getenv("DB_DRIVER"),
+		Host:     os.Getenv("DB_HOST"),
+		Port:     os.Getenv("DB_PORT"),
+		User:     os.Getenv("DB_USER"),
+		Password: os.Getenv("DB_PASSWORD"),
+		Name:     os.Getenv("DB_NAME"),
+	})
+	if err != nil {
+		log.Fatalf("Failed to connect to database: %v", err)
+	}
+	defer database.CloseDB(db)
+
+	shareStore, err := sharestore.New(sharestore.Config{
+		Type:  os.Getenv("SHARE_STORE"),
+		Path:  os.Getenv("SHARE_STORE_PATH"),
+		Key:   os.Getenv("SHARE_STORE_KEY"),
+		URL:   os.Getenv("SHARE_STORE_URL"),
+		Token: os.Getenv("SHARE_STORE_TOKEN"),
+	}, db)
+	if err != nil {
+		log.Fatalf("Failed to create share store: %v", err)
+	}
+
+	auditor, err := shareaudit.NewAuditor(db, shareStore, service.NewNetworkService(), shareaudit.Options{
+		Party:  *party,
+		DryRun: *dryRun,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	report, runErr := auditor.Run()
+	if err := writeReport(*reportPath, report); err != nil {
+		log.Fatalf("Failed to write report: %v", err)
+	}
+	if runErr != nil {
+		log.Fatalf("Audit stopped: %v", runErr)
+	}
+
+	log.Printf("%s: %d shares, %d ok, %d migrated, %d need migration, %d corrupt, %d address mismatch",
+		report.Party, report.Total, report.OK, report.Migrated, report.NeedsMigration, report.Corrupt, report.AddressMismatch)
+	if report.Failed() {
+		os.Exit(1)
+	}
+}
+
+func writeReport(path string, report *shareaudit.Report) error {
+	var w io.Writer = os.Stdout
+	if path != "-" {
+		f, err := os.Create(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(report)
+}
@@ -0,0 +1,93 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"tecdsa/pkg/network"
+
+	"github.com/pkg/errors"
+)
+
+// broadcast 는 서명된 트랜잭션을 네트워크에 전송하고 트랜잭션 해시를 돌려줍니다.
+// rpcURL 이 비어 있으면 네트워크의 기본 RPC 를 사용합니다.
+func broadcast(ctx context.Context, networkObj network.Network, signedTx string, rpcURL string) (string, error) {
+	switch {
+	case network.IsBitcoinNetwork(networkObj):
+		// 비트코인 계열 네트워크는 설정된 백엔드 (esplora, bitcoind, electrum) 로 전송합니다.
+		var backend network.BitcoinBackend
+		var err error
+		if rpcURL != "" {
+			backend, err = network.NewBitcoinBackendWithURL(networkObj, rpcURL)
+		} else {
+			backend, err = network.NewBitcoinBackend(networkObj)
+		}
+		if err != nil {
+			return "", errors.Wrapf(err, "%s has no broadcast backend; use -rpc", networkObj)
+		}
+		return backend.Broadcast(ctx, signedTx)
+	case network.IsSolanaNetwork(networkObj):
+		var signature string
+		err := callJSONRPC(ctx, rpcOrDefault(rpcURL, networkObj), "sendTransaction",
+			[]interface{}{signedTx, map[string]string{"encoding": "base64"}}, &signature)
+		return signature, err
+	case network.IsEthereumNetwork(networkObj):
+		if !strings.HasPrefix(signedTx, "0x") {
+			signedTx = "0x" + signedTx
+		}
+		var txHash string
+		err := callJSONRPC(ctx, rpcOrDefault(rpcURL, networkObj), "eth_sendRawTransaction", []interface{}{signedTx}, &txHash)
+		return txHash, err
+	default:
+		return "", fmt.Errorf("broadcasting is not supported for %s", networkObj)
+	}
+}
+
+func rpcOrDefault(rpcURL string, networkObj network.Network) string {
+	if rpcURL != "" {
+		return rpcURL
+	}
+	return networkObj.RPC()
+}
+
+// callJSONRPC 는 JSON-RPC 2.0 요청을 보내고 result 를 out 에 디코딩합니다.
+func callJSONRPC(ctx context.Context, rpcURL string, method string, params []interface{}, out interface{}) error {
+	body, err := json.Marshal(map[string]interface{}{
+		"jsonrpc": "2.0",
+		"id":      1,
+		"method":  method,
+		"params":  params,
+	})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, rpcURL, bytes.NewReader(body))
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return errors.Wrapf(err, "failed to call %s", method)
+	}
+	defer resp.Body.Close()
+
+	var rpcResp struct {
+		Result json.RawMessage `json:"result"`
+		Error  *struct {
+			Code    int    `json:"code"`
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&rpcResp); err != nil {
+		return errors.Wrapf(err, "failed to decode %s response (status %d)", method, resp.StatusCode)
+	}
+	if rpcResp.Error != nil {
+		return fmt.Errorf("%s failed: %s (code %d)", method, rpcResp.Error.Message, rpcResp.Error.Code)
+	}
+	return errors.WithStack(json.Unmarshal(rpcResp.Result, out))
+}
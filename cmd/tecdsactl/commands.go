@@ -0,0 +1,405 @@
+package main
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"tecdsa/pkg/auth"
+	"tecdsa/pkg/client"
+	"tecdsa/pkg/network"
+	"tecdsa/pkg/service"
+	"tecdsa/pkg/transaction"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	schemeAuto    = "auto"
+	schemeECDSA   = "ecdsa"
+	schemeEd25519 = "ed25519"
+	schemeTaproot = "taproot"
+)
+
+// taprootAddressPrefixes 는 witness v1 (P2TR) 주소의 접두사입니다.
+var taprootAddressPrefixes = []string{"bc1p", "tb1p", "bcrt1p"}
+
+func newFlagSet(name string) *flag.FlagSet {
+	return flag.NewFlagSet("tecdsactl "+name, flag.ExitOnError)
+}
+
+func runRegister(a *app, args []string) error {
+	fs := newFlagSet("register")
+	gateway := fs.String("gateway", "", "게이트웨이 주소 (기존 프로필이면 생략 가능)")
+	fs.Parse(args)
+
+	name := a.cfg.profileName(a.profileName)
+	profile := a.cfg.Profiles[name]
+	if profile == nil {
+		profile = &Profile{}
+	}
+	if *gateway != "" {
+		profile.Gateway = *gateway
+	}
+	if profile.Gateway == "" {
+		return errors.New("-gateway is required")
+	}
+
+	key, err := auth.GenerateKey()
+	if err != nil {
+		return err
+	}
+	publicKey, err := auth.MarshalPublicKey(key.Public())
+	if err != nil {
+		return err
+	}
+	clientID, err := client.New(profile.Gateway).Register(a.ctx, publicKey)
+	if err != nil {
+		return err
+	}
+
+	keyPEM, err := auth.MarshalPrivateKey(key)
+	if err != nil {
+		return err
+	}
+	profile.ClientID = clientID
+	profile.KeyFile = a.cfg.keyFilePath(name)
+	if err := os.MkdirAll(filepath.Dir(profile.KeyFile), 0o700); err != nil {
+		return errors.Wrap(err, "failed to create config directory")
+	}
+	if err := os.WriteFile(profile.KeyFile, keyPEM, 0o600); err != nil {
+		return errors.Wrap(err, "failed to write signing key")
+	}
+	a.cfg.Profiles[name] = profile
+	if a.cfg.CurrentProfile == "" {
+		a.cfg.CurrentProfile = name
+	}
+	if err := a.cfg.save(); err != nil {
+		return err
+	}
+
+	return a.out.printFields(map[string]interface{}{
+		"profile":   name,
+		"gateway":   profile.Gateway,
+		"client_id": profile.ClientID,
+		"key_file":  profile.KeyFile,
+	}, [][2]string{
+		{"profile", name},
+		{"gateway", profile.Gateway},
+		{"client_id", strconv.FormatUint(uint64(profile.ClientID), 10)},
+		{"key_file", profile.KeyFile},
+	})
+}
+
+func runProfile(a *app, args []string) error {
+	if len(args) == 0 {
+		return listProfiles(a)
+	}
+	switch args[0] {
+	case "use":
+		if len(args) != 2 {
+			return errors.New("usage: tecdsactl profile use <name>")
+		}
+		if _, ok := a.cfg.Profiles[args[1]]; !ok {
+			return fmt.Errorf("profile %q not found", args[1])
+		}
+		a.cfg.CurrentProfile = args[1]
+		if err := a.cfg.save(); err != nil {
+			return err
+		}
+		return listProfiles(a)
+	case "set":
+		fs := newFlagSet("profile set")
+		gateway := fs.String("gateway", "", "게이트웨이 주소")
+		fs.Parse(args[1:])
+		if *gateway == "" {
+			return errors.New("-gateway is required")
+		}
+		name := a.cfg.profileName(a.profileName)
+		profile := a.cfg.Profiles[name]
+		if profile == nil {
+			profile = &Profile{}
+			a.cfg.Profiles[name] = profile
+		}
+		profile.Gateway = *gateway
+		if a.cfg.CurrentProfile == "" {
+			a.cfg.CurrentProfile = name
+		}
+		if err := a.cfg.save(); err != nil {
+			return err
+		}
+		return listProfiles(a)
+	default:
+		return fmt.Errorf("unknown profile command: %s (use, set)", args[0])
+	}
+}
+
+func listProfiles(a *app) error {
+	current := a.cfg.profileName("")
+	var rows [][]string
+	for _, name := range a.cfg.profileNames() {
+		profile := a.cfg.Profiles[name]
+		marker := ""
+		if name == current {
+			marker = "*"
+		}
+		clientID := ""
+		if profile.ClientID != 0 {
+			clientID = strconv.FormatUint(uint64(profile.ClientID), 10)
+		}
+		rows = append(rows, []string{marker, name, profile.Gateway, clientID})
+	}
+	return a.out.printRows(a.cfg, []string{"CURRENT", "NAME", "GATEWAY", "CLIENT_ID"}, rows)
+}
+
+func runNetworks(a *app, args []string) error {
+	newFlagSet("networks").Parse(args)
+	c, err := a.client()
+	if err != nil {
+		return err
+	}
+	networks, err := c.Networks(a.ctx)
+	if err != nil {
+		return err
+	}
+
+	networkService := service.NewNetworkService()
+	var rows [][]string
+	for _, info := range networks {
+		curve, chainID := "", ""
+		if networkObj, err := networkService.GetNetworkByID(int32(info.ID)); err == nil {
+			curve = networkObj.Curve().String()
+		}
+		if info.ChainID != nil {
+			chainID = strconv.FormatInt(*info.ChainID, 10)
+		}
+		rows = append(rows, []string{strconv.Itoa(info.ID), info.Name, info.Family, curve, chainID})
+	}
+	return a.out.printRows(map[string]interface{}{"networks": networks}, []string{"ID", "NAME", "FAMILY", "CURVE", "CHAIN_ID"}, rows)
+}
+
+func runKeyGen(a *app, args []string) error {
+	fs := newFlagSet("keygen")
+	networkID := fs.Int("network", 0, "네트워크 ID (tecdsactl networks)")
+	addressType := fs.Int("address-type", 0, "비트코인 주소 유형 (0: P2PKH, 1: P2SH-P2WPKH, 2: P2WPKH, 3: P2TR)")
+	curve := fs.Int("curve", -1, "키 곡선 (0: secp256k1, 2: P-256). 생략하면 네트워크의 곡선")
+	fs.Parse(args)
+
+	networkObj, err := service.NewNetworkService().GetNetworkByID(int32(*networkID))
+	if err != nil {
+		return err
+	}
+	c, err := a.client()
+	if err != nil {
+		return err
+	}
+
+	var resp *client.KeyGenResponse
+	if networkObj.Curve() == network.Ed25519 {
+		resp, err = c.KeyGenEd25519(a.ctx, client.EddsaKeyGenRequest{Network: int32(*networkID)})
+	} else {
+		req := client.KeyGenRequest{Network: int32(*networkID), AddressType: int32(*addressType)}
+		if *curve >= 0 {
+			curveID := int32(*curve)
+			req.Curve = &curveID
+		}
+		resp, err = c.KeyGen(a.ctx, req)
+	}
+	if err != nil {
+		return err
+	}
+
+	return a.out.printFields(resp, [][2]string{
+		{"key_id", strconv.FormatUint(uint64(resp.KeyID), 10)},
+		{"address", resp.Address},
+		{"address_type", strconv.Itoa(int(resp.AddressType))},
+		{"curve", resp.Curve},
+		{"public_key", resp.Publickey},
+		{"request_id", resp.RequestID},
+		{"duration", fmt.Sprintf("%dms", resp.Duration)},
+	})
+}
+
+func runBuildTx(a *app, args []string) error {
+	fs := newFlagSet("build-tx")
+	networkID := fs.Int("network", 0, "네트워크 ID")
+	from := fs.String("from", "", "보내는 주소")
+	to := fs.String("to", "", "받는 주소")
+	amount := fs.String("amount", "", "금액 (최소 단위: satoshi, wei, lamports. ERC-20 토큰은 소수점 단위)")
+	fields := fs.String("fields", "", `네트워크별 추가 필드 JSON (예: '{"gasLimit": 50000}', '{"token": "0x..."}')`)
+	fs.Parse(args)
+
+	req := map[string]interface{}{}
+	if *fields != "" {
+		if err := json.Unmarshal([]byte(*fields), &req); err != nil {
+			return errors.Wrap(err, "invalid -fields")
+		}
+	}
+	req["from"] = *from
+	req["to"] = *to
+	req["amount"] = *amount
+
+	c, err := a.client()
+	if err != nil {
+		return err
+	}
+	unsignedTx, err := c.CreateUnsignedTx(a.ctx, int32(*networkID), req)
+	if err != nil {
+		return err
+	}
+
+	extra := ""
+	if unsignedTx.Extra != nil {
+		data, _ := json.Marshal(unsignedTx.Extra)
+		extra = string(data)
+	}
+	return a.out.printFields(unsignedTx, [][2]string{
+		{"network_id", strconv.Itoa(int(unsignedTx.NetworkID))},
+		{"unsigned_tx", unsignedTx.UnSignedTxEncodedBase64},
+		{"extra", extra},
+	})
+}
+
+func runSign(a *app, args []string) error {
+	fs := newFlagSet("sign")
+	address := fs.String("address", "", "서명할 키의 주소")
+	txFile := fs.String("tx", "", "build-tx -o json 으로 만든 미서명 트랜잭션 JSON 파일 (- 이면 표준 입력)")
+	message := fs.String("message", "", "트랜잭션 대신 서명할 tx_origin (base64)")
+	scheme := fs.String("scheme", schemeAuto, "서명 방식 (auto, ecdsa, ed25519, taproot)")
+	fs.Parse(args)
+
+	if *address == "" {
+		return errors.New("-address is required")
+	}
+	if (*txFile == "") == (*message == "") {
+		return errors.New("exactly one of -tx or -message is required")
+	}
+	var unsignedTx *transaction.UnsignedTransaction
+	if *txFile != "" {
+		data, err := readInput(*txFile)
+		if err != nil {
+			return err
+		}
+		unsignedTx = &transaction.UnsignedTransaction{}
+		if err := json.Unmarshal(data, unsignedTx); err != nil {
+			return errors.Wrap(err, "invalid unsigned transaction")
+		}
+	} else if _, err := base64.StdEncoding.DecodeString(*message); err != nil {
+		return errors.Wrap(err, "-message must be base64")
+	}
+
+	c, err := a.client()
+	if err != nil {
+		return err
+	}
+	req := client.SignRequest{Address: *address, TxOrigin: *message, UnsignedTx: unsignedTx}
+	switch resolveScheme(*scheme, *address, unsignedTx) {
+	case schemeEd25519:
+		resp, err := c.SignEd25519(a.ctx, req)
+		if err != nil {
+			return err
+		}
+		return a.out.printFields(resp, schnorrSignatureFields(resp))
+	case schemeTaproot:
+		resp, err := c.SignTaproot(a.ctx, req)
+		if err != nil {
+			return err
+		}
+		return a.out.printFields(resp, schnorrSignatureFields(resp))
+	case schemeECDSA:
+		resp, err := c.Sign(a.ctx, req)
+		if err != nil {
+			return err
+		}
+		v := ""
+		if resp.V != nil {
+			v = strconv.FormatUint(*resp.V, 10)
+		}
+		return a.out.printFields(resp, [][2]string{
+			{"v", v},
+			{"r", resp.R},
+			{"s", resp.S},
+			{"signature", resp.Signature},
+			{"signature_der", resp.SignatureDER},
+			{"signed_tx", resp.SignedTx},
+			{"request_id", resp.RequestID},
+			{"duration", fmt.Sprintf("%dms", resp.Duration)},
+		})
+	default:
+		return fmt.Errorf("unsupported signing scheme: %s", *scheme)
+	}
+}
+
+// resolveScheme 은 auto 일 때 트랜잭션의 네트워크와 주소로 서명 방식을 고릅니다.
+func resolveScheme(scheme string, address string, unsignedTx *transaction.UnsignedTransaction) string {
+	if scheme != schemeAuto {
+		return scheme
+	}
+	if unsignedTx != nil {
+		networkObj, err := service.NewNetworkService().GetNetworkByID(unsignedTx.NetworkID)
+		if err == nil && networkObj.Curve() == network.Ed25519 {
+			return schemeEd25519
+		}
+	}
+	for _, prefix := range taprootAddressPrefixes {
+		if strings.HasPrefix(strings.ToLower(address), prefix) {
+			return schemeTaproot
+		}
+	}
+	return schemeECDSA
+}
+
+func schnorrSignatureFields(resp *client.SchnorrSignResponse) [][2]string {
+	return [][2]string{
+		{"signature", resp.Signature},
+		{"signed_tx", resp.SignedTx},
+		{"request_id", resp.RequestID},
+		{"duration", fmt.Sprintf("%dms", resp.Duration)},
+	}
+}
+
+func runBroadcast(a *app, args []string) error {
+	fs := newFlagSet("broadcast")
+	networkID := fs.Int("network", 0, "네트워크 ID")
+	signedTx := fs.String("tx", "", "서명된 트랜잭션 (EVM, 비트코인: hex, 솔라나: base64). - 이면 표준 입력")
+	rpcURL := fs.String("rpc", "", "네트워크 RPC 주소 (기본: 네트워크의 기본 RPC)")
+	fs.Parse(args)
+
+	if *signedTx == "" {
+		return errors.New("-tx is required")
+	}
+	networkObj, err := service.NewNetworkService().GetNetworkByID(int32(*networkID))
+	if err != nil {
+		return err
+	}
+	tx := *signedTx
+	if tx == "-" {
+		data, err := readInput(tx)
+		if err != nil {
+			return err
+		}
+		tx = strings.TrimSpace(string(data))
+	}
+
+	txHash, err := broadcast(a.ctx, networkObj, tx, *rpcURL)
+	if err != nil {
+		return err
+	}
+	return a.out.printFields(map[string]string{"tx_hash": txHash}, [][2]string{{"tx_hash", txHash}})
+}
+
+// readInput 은 파일 또는 표준 입력 (-) 을 읽습니다.
+func readInput(path string) ([]byte, error) {
+	if path == "-" {
+		data, err := io.ReadAll(os.Stdin)
+		return data, errors.Wrap(err, "failed to read stdin")
+	}
+	data, err := os.ReadFile(path)
+	return data, errors.Wrapf(err, "failed to read %s", path)
+}
@@ -0,0 +1,123 @@
+package main
+
+import (
+	"crypto"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"tecdsa/pkg/auth"
+
+	"github.com/pkg/errors"
+)
+
+const defaultProfile = "default"
+
+// Config 는 로컬 설정 파일입니다. 게이트웨이마다 프로필을 하나씩 둡니다.
+type Config struct {
+	CurrentProfile string              `json:"current_profile"`
+	Profiles       map[string]*Profile `json:"profiles"`
+
+	path string
+}
+
+// Profile 은 게이트웨이 주소와 그 게이트웨이에 등록한 클라이언트입니다.
+type Profile struct {
+	Gateway  string `json:"gateway"`
+	ClientID uint32 `json:"client_id,omitempty"`
+	// KeyFile 은 요청 서명 키 (PKCS#8 PEM) 파일 경로입니다.
+	KeyFile string `json:"key_file,omitempty"`
+}
+
+// defaultConfigPath 는 TECDSACTL_CONFIG 또는 사용자 설정 디렉터리의 tecdsactl/config.json 입니다.
+func defaultConfigPath() string {
+	if path := os.Getenv("TECDSACTL_CONFIG"); path != "" {
+		return path
+	}
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		dir = "."
+	}
+	return filepath.Join(dir, "tecdsactl", "config.json")
+}
+
+// loadConfig 는 설정 파일을 읽습니다. 파일이 없으면 빈 설정을 돌려줍니다.
+func loadConfig(path string) (*Config, error) {
+	cfg := &Config{Profiles: map[string]*Profile{}, path: path}
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return cfg, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read config")
+	}
+	if err := json.Unmarshal(data, cfg); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse config %s", path)
+	}
+	if cfg.Profiles == nil {
+		cfg.Profiles = map[string]*Profile{}
+	}
+	return cfg, nil
+}
+
+func (c *Config) save() error {
+	if err := os.MkdirAll(filepath.Dir(c.path), 0o700); err != nil {
+		return errors.Wrap(err, "failed to create config directory")
+	}
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if err := os.WriteFile(c.path, append(data, '\n'), 0o600); err != nil {
+		return errors.Wrap(err, "failed to write config")
+	}
+	return nil
+}
+
+// profileName 은 -profile 로 지정한 프로필, 없으면 현재 프로필의 이름입니다.
+func (c *Config) profileName(name string) string {
+	if name != "" {
+		return name
+	}
+	if c.CurrentProfile != "" {
+		return c.CurrentProfile
+	}
+	return defaultProfile
+}
+
+func (c *Config) profile(name string) (*Profile, error) {
+	name = c.profileName(name)
+	profile, ok := c.Profiles[name]
+	if !ok {
+		return nil, fmt.Errorf("profile %q not found; run tecdsactl register -gateway <url>", name)
+	}
+	return profile, nil
+}
+
+func (c *Config) profileNames() []string {
+	names := make([]string, 0, len(c.Profiles))
+	for name := range c.Profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// keyFilePath 는 프로필의 서명 키를 저장할 경로입니다.
+func (c *Config) keyFilePath(name string) string {
+	return filepath.Join(filepath.Dir(c.path), name+".key")
+}
+
+// signingKey 는 프로필의 요청 서명 키를 읽습니다. 등록하지 않은 프로필이면 nil 입니다.
+func (p *Profile) signingKey() (crypto.Signer, error) {
+	if p.KeyFile == "" {
+		return nil, nil
+	}
+	data, err := os.ReadFile(p.KeyFile)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read signing key")
+	}
+	return auth.ParsePrivateKey(data)
+}
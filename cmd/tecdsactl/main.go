@@ -0,0 +1,124 @@
+// tecdsactl 은 게이트웨이 API 를 호출하는 명령줄 클라이언트입니다.
+//
+//	$ tecdsactl register -gateway http://localhost:8080
+//	$ tecdsactl keygen -network 4
+//	$ tecdsactl -o json build-tx -network 4 -from 0x... -to 0x... -amount 1000 > tx.json
+//	$ tecdsactl sign -address 0x... -tx tx.json
+//	$ tecdsactl broadcast -network 4 -tx 0x...
+//
+// 게이트웨이별 프로필은 설정 파일 (기본 $XDG_CONFIG_HOME/tecdsactl/config.json, TECDSACTL_CONFIG 로 변경) 에 저장하고,
+// register 로 등록한 클라이언트 키로 모든 요청에 서명합니다.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+
+	"tecdsa/pkg/client"
+	"tecdsa/pkg/network"
+)
+
+type command struct {
+	name  string
+	usage string
+	run   func(a *app, args []string) error
+}
+
+var commands = []command{
+	{"register", "게이트웨이에 클라이언트 키를 등록하고 프로필에 저장합니다", runRegister},
+	{"profile", "프로필을 조회, 선택 (use), 설정 (set) 합니다", runProfile},
+	{"networks", "게이트웨이가 지원하는 네트워크를 조회합니다", runNetworks},
+	{"keygen", "네트워크의 새 주소를 발급합니다", runKeyGen},
+	{"build-tx", "미서명 트랜잭션을 만듭니다", runBuildTx},
+	{"sign", "트랜잭션 또는 메시지에 서명합니다", runSign},
+	{"broadcast", "서명된 트랜잭션을 네트워크에 전송합니다", runBroadcast},
+}
+
+// app 은 명령이 공유하는 설정과 출력입니다.
+type app struct {
+	ctx         context.Context
+	cfg         *Config
+	profileName string
+	out         *printer
+}
+
+// client 는 현재 프로필의 게이트웨이에 서명된 요청을 보내는 클라이언트를 만듭니다.
+func (a *app) client() (*client.Client, error) {
+	profile, err := a.cfg.profile(a.profileName)
+	if err != nil {
+		return nil, err
+	}
+	key, err := profile.signingKey()
+	if err != nil {
+		return nil, err
+	}
+	if key == nil {
+		return client.New(profile.Gateway), nil
+	}
+	return client.New(profile.Gateway, client.WithSigner(profile.ClientID, key)), nil
+}
+
+func main() {
+	configPath := flag.String("config", defaultConfigPath(), "설정 파일 경로")
+	profileName := flag.String("profile", "", "사용할 프로필 (기본: 현재 프로필)")
+	output := flag.String("o", outputTable, "출력 형식 (table, json)")
+	networksPath := flag.String("networks", os.Getenv("NETWORKS_CONFIG"), "기본 네트워크 외에 사용할 네트워크 설정 파일")
+	flag.Usage = usage
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		usage()
+		os.Exit(2)
+	}
+	if *output != outputTable && *output != outputJSON {
+		fatal(fmt.Errorf("unsupported output format: %s", *output))
+	}
+	if *networksPath != "" {
+		if err := network.LoadNetworks(*networksPath); err != nil {
+			fatal(err)
+		}
+	}
+
+	cfg, err := loadConfig(*configPath)
+	if err != nil {
+		fatal(err)
+	}
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	a := &app{
+		ctx:         ctx,
+		cfg:         cfg,
+		profileName: *profileName,
+		out:         &printer{w: os.Stdout, format: *output},
+	}
+
+	name := flag.Arg(0)
+	for _, cmd := range commands {
+		if cmd.name == name {
+			if err := cmd.run(a, flag.Args()[1:]); err != nil {
+				fatal(err)
+			}
+			return
+		}
+	}
+	fmt.Fprintf(os.Stderr, "unknown command: %s\n\n", name)
+	usage()
+	os.Exit(2)
+}
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage: tecdsactl [-config path] [-profile name] [-o table|json] <command> [flags]\n\nCommands:\n")
+	for _, cmd := range commands {
+		fmt.Fprintf(os.Stderr, "  %-10s %s\n", cmd.name, cmd.usage)
+	}
+	fmt.Fprintf(os.Stderr, "\nGlobal flags:\n")
+	flag.PrintDefaults()
+}
+
+func fatal(err error) {
+	fmt.Fprintf(os.Stderr, "tecdsactl: %v\n", err)
+	os.Exit(1)
+}
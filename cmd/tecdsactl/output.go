@@ -0,0 +1,54 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+)
+
+const (
+	outputJSON  = "json"
+	outputTable = "table"
+)
+
+// printer 는 명령 결과를 JSON 또는 표로 출력합니다.
+type printer struct {
+	w      io.Writer
+	format string
+}
+
+// printFields 는 값 하나를 필드 이름과 값의 두 열 표로 출력합니다.
+func (p *printer) printFields(value interface{}, fields [][2]string) error {
+	if p.format == outputJSON {
+		return p.printJSON(value)
+	}
+	tw := tabwriter.NewWriter(p.w, 0, 4, 2, ' ', 0)
+	for _, field := range fields {
+		if field[1] == "" {
+			continue
+		}
+		fmt.Fprintf(tw, "%s\t%s\n", strings.ToUpper(field[0]), field[1])
+	}
+	return tw.Flush()
+}
+
+// printRows 는 목록을 머리글이 있는 표로 출력합니다.
+func (p *printer) printRows(value interface{}, header []string, rows [][]string) error {
+	if p.format == outputJSON {
+		return p.printJSON(value)
+	}
+	tw := tabwriter.NewWriter(p.w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, strings.Join(header, "\t"))
+	for _, row := range rows {
+		fmt.Fprintln(tw, strings.Join(row, "\t"))
+	}
+	return tw.Flush()
+}
+
+func (p *printer) printJSON(value interface{}) error {
+	enc := json.NewEncoder(p.w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(value)
+}
@@ -0,0 +1,106 @@
+package auth
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ErrReplayedRequest 는 이미 받은 (클라이언트, 논스) 의 요청을 다시 받았을 때의 오류입니다.
+var ErrReplayedRequest = errors.New("request has already been received")
+
+// ReplayStore 는 서명을 검증한 요청의 (클라이언트, 논스) 를 만료 시각까지 기록합니다.
+// 게이트웨이는 데이터베이스 (repository.RequestNonceRepository) 에 기록하므로, 다시 시작하거나 여러 게이트웨이가
+// 같은 데이터베이스를 사용해도 재전송을 거부합니다.
+type ReplayStore interface {
+	// Record 는 (clientID, nonce) 를 expiresAt 까지 기록합니다. now 에 만료되지 않은 같은 기록이 있으면 false 를 돌려줍니다.
+	Record(clientID uint32, nonce string, expiresAt, now time.Time) (bool, error)
+	// DeleteExpired 는 now 전에 만료된 기록을 지웁니다.
+	DeleteExpired(now time.Time) error
+}
+
+// ReplayCache 는 서명을 검증한 요청의 (클라이언트, 논스) 를 타임스탬프 허용 범위가 끝날 때까지 기억합니다.
+// 범위가 끝난 요청은 VerifyRequest 가 타임스탬프로 거부하므로 그 뒤에는 잊어도 됩니다.
+type ReplayCache struct {
+	store  ReplayStore
+	mutex  sync.Mutex
+	nextGC time.Time
+}
+
+// NewReplayCache 는 store 에 논스를 기록하는 ReplayCache 를 만듭니다.
+// store 가 nil 이면 프로세스 메모리에만 기억하므로, 다시 시작하면 잊고 다른 프로세스로 보낸 재전송은 막지 못합니다.
+func NewReplayCache(store ReplayStore) *ReplayCache {
+	if store == nil {
+		store = &memoryReplayStore{seen: make(map[replayKey]time.Time)}
+	}
+	return &ReplayCache{store: store}
+}
+
+// Check 는 VerifyRequest 로 검증한 요청의 논스를 기록합니다. 허용 범위 안에서 이미 받은 논스면 ErrReplayedRequest 를 돌려줍니다.
+func (c *ReplayCache) Check(req *http.Request, clientID uint32, now time.Time) error {
+	unix, err := strconv.ParseInt(req.Header.Get(HeaderTimestamp), 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid %s header", HeaderTimestamp)
+	}
+	expires := time.Unix(unix, 0).Add(MaxClockSkew)
+
+	c.mutex.Lock()
+	gc := now.After(c.nextGC)
+	if gc {
+		c.nextGC = now.Add(MaxClockSkew)
+	}
+	c.mutex.Unlock()
+	if gc {
+		if err := c.store.DeleteExpired(now); err != nil {
+			return err
+		}
+	}
+
+	recorded, err := c.store.Record(clientID, req.Header.Get(HeaderNonce), expires, now)
+	if err != nil {
+		return err
+	}
+	if !recorded {
+		return ErrReplayedRequest
+	}
+	return nil
+}
+
+type replayKey struct {
+	clientID uint32
+	nonce    string
+}
+
+// memoryReplayStore 는 프로세스 메모리에 논스를 기억하는 ReplayStore 입니다.
+type memoryReplayStore struct {
+	mutex sync.Mutex
+	seen  map[replayKey]time.Time
+}
+
+func (s *memoryReplayStore) Record(clientID uint32, nonce string, expiresAt, now time.Time) (bool, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	key := replayKey{clientID: clientID, nonce: nonce}
+	if exp, ok := s.seen[key]; ok && !now.After(exp) {
+		return false, nil
+	}
+	s.seen[key] = expiresAt
+	return true, nil
+}
+
+func (s *memoryReplayStore) DeleteExpired(now time.Time) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for k, exp := range s.seen {
+		if now.After(exp) {
+			delete(s.seen, k)
+		}
+	}
+	return nil
+}
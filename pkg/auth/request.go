@@ -0,0 +1,185 @@
+// Package auth 는 클라이언트가 등록한 키로 게이트웨이 요청에 서명하고, 게이트웨이가 그 서명을 검증합니다.
+//
+// 서명 대상은 메서드, 경로 (쿼리 포함), 타임스탬프, 논스, 본문의 SHA-256 을 줄바꿈으로 이은 문자열이며,
+// 클라이언트 ID, 타임스탬프, 논스, 서명을 각각 X-Client-Id, X-Timestamp, X-Nonce, X-Signature 헤더로 보냅니다.
+// 논스는 요청마다 새로 만들며, 게이트웨이는 타임스탬프 허용 범위 안에서 같은 논스를 다시 받으면 재전송으로 보고 거부합니다 (ReplayCache, ReplayStore).
+package auth
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	HeaderClientID  = "X-Client-Id"
+	HeaderTimestamp = "X-Timestamp"
+	HeaderNonce     = "X-Nonce"
+	HeaderSignature = "X-Signature"
+)
+
+// maxNonceLength 는 X-Nonce 헤더의 최대 길이입니다. 게이트웨이가 기억하는 논스의 크기를 제한합니다.
+const maxNonceLength = 64
+
+// MaxClockSkew 는 서명 타임스탬프와 게이트웨이 시각의 최대 차이입니다.
+const MaxClockSkew = 5 * time.Minute
+
+// GenerateKey 는 요청 서명에 사용할 P-256 키를 만듭니다.
+func GenerateKey() (*ecdsa.PrivateKey, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to generate request signing key")
+	}
+	return key, nil
+}
+
+// MarshalPublicKey 는 /register 에 보낼 PKIX PEM 공개키를 만듭니다.
+func MarshalPublicKey(key crypto.PublicKey) (string, error) {
+	der, err := x509.MarshalPKIXPublicKey(key)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to marshal public key")
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})), nil
+}
+
+// MarshalPrivateKey, ParsePrivateKey 는 서명 키를 PKCS#8 PEM 으로 저장하고 읽습니다.
+func MarshalPrivateKey(key crypto.Signer) ([]byte, error) {
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal private key")
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), nil
+}
+
+func ParsePrivateKey(data []byte) (crypto.Signer, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("failed to parse PEM block containing the private key")
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse private key")
+	}
+	switch key := key.(type) {
+	case *ecdsa.PrivateKey:
+		return key, nil
+	case ed25519.PrivateKey:
+		return key, nil
+	default:
+		return nil, fmt.Errorf("unsupported private key type %T", key)
+	}
+}
+
+// SignRequest 는 req 에 서명 헤더를 설정합니다. body 는 req 로 보낼 본문과 같아야 합니다.
+func SignRequest(req *http.Request, body []byte, clientID uint32, key crypto.Signer, now time.Time) error {
+	timestamp := strconv.FormatInt(now.Unix(), 10)
+	nonceBytes := make([]byte, 16)
+	if _, err := rand.Read(nonceBytes); err != nil {
+		return errors.Wrap(err, "failed to generate request nonce")
+	}
+	nonce := hex.EncodeToString(nonceBytes)
+	message := signingString(req, timestamp, nonce, body)
+
+	var signature []byte
+	var err error
+	switch key.(type) {
+	case ed25519.PrivateKey:
+		signature, err = key.Sign(rand.Reader, message, crypto.Hash(0))
+	default:
+		digest := sha256.Sum256(message)
+		signature, err = key.Sign(rand.Reader, digest[:], crypto.SHA256)
+	}
+	if err != nil {
+		return errors.Wrap(err, "failed to sign request")
+	}
+
+	req.Header.Set(HeaderClientID, strconv.FormatUint(uint64(clientID), 10))
+	req.Header.Set(HeaderTimestamp, timestamp)
+	req.Header.Set(HeaderNonce, nonce)
+	req.Header.Set(HeaderSignature, base64.StdEncoding.EncodeToString(signature))
+	return nil
+}
+
+// IsSigned 는 요청에 서명 헤더가 있는지 확인합니다.
+func IsSigned(req *http.Request) bool {
+	return req.Header.Get(HeaderSignature) != ""
+}
+
+// ClientID 는 서명 헤더의 클라이언트 ID 를 돌려줍니다.
+func ClientID(req *http.Request) (uint32, error) {
+	id, err := strconv.ParseUint(req.Header.Get(HeaderClientID), 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s header", HeaderClientID)
+	}
+	return uint32(id), nil
+}
+
+// VerifyRequest 는 요청 서명을 클라이언트가 등록한 PEM 공개키로 검증합니다.
+func VerifyRequest(req *http.Request, body []byte, publicKeyPEM string, now time.Time) error {
+	timestamp := req.Header.Get(HeaderTimestamp)
+	unix, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid %s header", HeaderTimestamp)
+	}
+	if skew := now.Sub(time.Unix(unix, 0)); skew > MaxClockSkew || skew < -MaxClockSkew {
+		return errors.New("request timestamp is outside the allowed window")
+	}
+	nonce := req.Header.Get(HeaderNonce)
+	if nonce == "" || len(nonce) > maxNonceLength {
+		return fmt.Errorf("invalid %s header", HeaderNonce)
+	}
+	signature, err := base64.StdEncoding.DecodeString(req.Header.Get(HeaderSignature))
+	if err != nil {
+		return fmt.Errorf("invalid %s header", HeaderSignature)
+	}
+
+	block, _ := pem.Decode([]byte(publicKeyPEM))
+	if block == nil {
+		return errors.New("client has no valid registered public key")
+	}
+	publicKey, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return errors.Wrap(err, "failed to parse registered public key")
+	}
+
+	message := signingString(req, timestamp, nonce, body)
+	var ok bool
+	switch publicKey := publicKey.(type) {
+	case *ecdsa.PublicKey:
+		digest := sha256.Sum256(message)
+		ok = ecdsa.VerifyASN1(publicKey, digest[:], signature)
+	case ed25519.PublicKey:
+		ok = ed25519.Verify(publicKey, message, signature)
+	default:
+		return fmt.Errorf("unsupported public key type %T", publicKey)
+	}
+	if !ok {
+		return errors.New("invalid request signature")
+	}
+	return nil
+}
+
+func signingString(req *http.Request, timestamp string, nonce string, body []byte) []byte {
+	bodyHash := sha256.Sum256(body)
+	return []byte(strings.Join([]string{
+		req.Method,
+		req.URL.RequestURI(),
+		timestamp,
+		nonce,
+		hex.EncodeToString(bodyHash[:]),
+	}, "\n"))
+}
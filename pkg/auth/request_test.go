@@ -0,0 +1,111 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignAndVerifyRequest(t *testing.T) {
+	ecdsaKey, err := GenerateKey()
+	require.NoError(t, err)
+	_, ed25519Key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	now := time.Unix(1700000000, 0)
+	body := []byte(`{"address":"0xabc"}`)
+
+	for name, key := range map[string]crypto.Signer{"ecdsa": ecdsaKey, "ed25519": ed25519Key} {
+		t.Run(name, func(t *testing.T) {
+			// 저장했다가 다시 읽은 키로 서명합니다.
+			keyPEM, err := MarshalPrivateKey(key)
+			require.NoError(t, err)
+			signer, err := ParsePrivateKey(keyPEM)
+			require.NoError(t, err)
+			publicKey, err := MarshalPublicKey(signer.Public())
+			require.NoError(t, err)
+
+			req := httptest.NewRequest(http.MethodPost, "/sign?debug=1", nil)
+			require.NoError(t, SignRequest(req, body, 7, signer, now))
+			assert.True(t, IsSigned(req))
+			clientID, err := ClientID(req)
+			require.NoError(t, err)
+			assert.Equal(t, uint32(7), clientID)
+
+			require.NoError(t, VerifyRequest(req, body, publicKey, now.Add(time.Minute)))
+
+			// 본문, 경로, 메서드가 바뀌면 검증에 실패합니다.
+			assert.Error(t, VerifyRequest(req, []byte(`{"address":"0xdef"}`), publicKey, now))
+			tampered := req.Clone(req.Context())
+			tampered.URL.RawQuery = ""
+			assert.Error(t, VerifyRequest(tampered, body, publicKey, now))
+			tampered = req.Clone(req.Context())
+			tampered.Method = http.MethodPut
+			assert.Error(t, VerifyRequest(tampered, body, publicKey, now))
+
+			// 논스는 서명에 포함되며, 없거나 너무 길면 거부합니다.
+			require.Len(t, req.Header.Get(HeaderNonce), 32)
+			tampered = req.Clone(req.Context())
+			tampered.Header.Set(HeaderNonce, "00000000000000000000000000000000")
+			assert.Error(t, VerifyRequest(tampered, body, publicKey, now))
+			tampered.Header.Del(HeaderNonce)
+			assert.ErrorContains(t, VerifyRequest(tampered, body, publicKey, now), HeaderNonce)
+			tampered.Header.Set(HeaderNonce, strings.Repeat("a", maxNonceLength+1))
+			assert.ErrorContains(t, VerifyRequest(tampered, body, publicKey, now), HeaderNonce)
+
+			// 같은 요청을 다시 서명하면 논스가 바뀝니다.
+			again := httptest.NewRequest(http.MethodPost, "/sign?debug=1", nil)
+			require.NoError(t, SignRequest(again, body, 7, signer, now))
+			assert.NotEqual(t, req.Header.Get(HeaderNonce), again.Header.Get(HeaderNonce))
+
+			// 허용 범위를 벗어난 타임스탬프는 거부합니다.
+			assert.Error(t, VerifyRequest(req, body, publicKey, now.Add(MaxClockSkew+time.Second)))
+			assert.Error(t, VerifyRequest(req, body, publicKey, now.Add(-MaxClockSkew-time.Second)))
+
+			// 다른 클라이언트의 키로는 검증되지 않습니다.
+			otherKey, err := GenerateKey()
+			require.NoError(t, err)
+			otherPublicKey, err := MarshalPublicKey(otherKey.Public())
+			require.NoError(t, err)
+			assert.Error(t, VerifyRequest(req, body, otherPublicKey, now))
+		})
+	}
+}
+
+func TestReplayCache(t *testing.T) {
+	key, err := GenerateKey()
+	require.NoError(t, err)
+	now := time.Unix(1700000000, 0)
+	newRequest := func() *http.Request {
+		req := httptest.NewRequest(http.MethodPost, "/sign", nil)
+		require.NoError(t, SignRequest(req, nil, 7, key, now))
+		return req
+	}
+
+	cache := NewReplayCache(nil)
+	req := newRequest()
+	require.NoError(t, cache.Check(req, 7, now))
+
+	// 허용 범위 안에서 같은 (클라이언트, 논스) 는 거부합니다.
+	assert.ErrorIs(t, cache.Check(req, 7, now.Add(time.Second)), ErrReplayedRequest)
+	assert.ErrorIs(t, cache.Check(req, 7, now.Add(MaxClockSkew)), ErrReplayedRequest)
+	// 다른 클라이언트나 다른 논스는 받습니다.
+	assert.NoError(t, cache.Check(req, 8, now))
+	assert.NoError(t, cache.Check(newRequest(), 7, now))
+
+	// 범위가 지나면 논스를 잊습니다. 이때 요청은 VerifyRequest 가 타임스탬프로 거부합니다.
+	later := now.Add(MaxClockSkew + time.Second)
+	assert.NoError(t, cache.Check(newRequest(), 9, later))
+	store := cache.store.(*memoryReplayStore)
+	store.mutex.Lock()
+	assert.Len(t, store.seen, 1)
+	store.mutex.Unlock()
+}
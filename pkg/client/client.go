@@ -0,0 +1,397 @@
+// Package client 는 게이트웨이 HTTP API 의 Go 클라이언트입니다.
+//
+//	c := client.New("http://localhost:8080", client.WithSigner(clientID, key))
+//	key, err := c.KeyGen(ctx, client.KeyGenRequest{Network: 4})
+//	sig, err := c.Sign(ctx, client.SignRequest{Address: key.Address, TxOrigin: txOrigin})
+//	err = client.VerifyECDSA(key, digest, sig)
+//
+// 게이트웨이 오류는 *Error 로 돌려주며 errors.Is(err, client.ErrNotFound) 처럼 오류 코드로 비교할 수 있습니다.
+package client
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"tecdsa/pkg/auth"
+	"tecdsa/pkg/transaction"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// RetryPolicy 는 일시적인 오류 (연결 실패, 429, 502, 503, 504) 를 재시도하는 방법입니다.
+// 재시도 사이의 대기는 InitialBackoff 부터 두 배씩 늘어나고 MaxBackoff 를 넘지 않습니다.
+type RetryPolicy struct {
+	// MaxAttempts 는 첫 시도를 포함한 최대 시도 횟수입니다. 1 이하면 재시도하지 않습니다.
+	MaxAttempts    int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+}
+
+// DefaultRetryPolicy 는 New 가 사용하는 재시도 정책입니다.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts:    3,
+	InitialBackoff: 500 * time.Millisecond,
+	MaxBackoff:     5 * time.Second,
+}
+
+// backoff 는 attempt 번째 (1부터) 시도가 실패한 뒤 기다릴 시간입니다.
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	wait := p.InitialBackoff
+	for i := 1; i < attempt && wait < p.MaxBackoff; i++ {
+		wait *= 2
+	}
+	if p.MaxBackoff > 0 && wait > p.MaxBackoff {
+		wait = p.MaxBackoff
+	}
+	return wait
+}
+
+// Client 는 게이트웨이 API 를 호출합니다. 여러 고루틴에서 함께 사용할 수 있습니다.
+type Client struct {
+	baseURL    string
+	httpClient *http.Client
+	clientID   uint32
+	signer     crypto.Signer
+	retry      RetryPolicy
+}
+
+// Option 은 New 의 선택 설정입니다.
+type Option func(*Client)
+
+// WithHTTPClient 는 요청에 사용할 http.Client 를 바꿉니다.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) {
+		c.httpClient = httpClient
+	}
+}
+
+// WithSigner 는 /register 로 등록한 클라이언트 ID 와 키로 모든 요청에 서명합니다 (auth.SignRequest).
+func WithSigner(clientID uint32, signer crypto.Signer) Option {
+	return func(c *Client) {
+		c.clientID = clientID
+		c.signer = signer
+	}
+}
+
+// WithRetryPolicy 는 재시도 정책을 바꿉니다.
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(c *Client) {
+		c.retry = policy
+	}
+}
+
+// New 는 baseURL (예: http://localhost:8080) 의 게이트웨이 클라이언트를 만듭니다.
+func New(baseURL string, opts ...Option) *Client {
+	c := &Client{
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		// 키 생성과 서명은 두 파티의 프로토콜을 기다리므로 넉넉하게 둡니다.
+		httpClient: &http.Client{Timeout: 5 * time.Minute},
+		retry:      DefaultRetryPolicy,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// Register 는 publicKey (auth.MarshalPublicKey 의 PEM) 를 등록하고 클라이언트 ID 를 돌려줍니다.
+// 게이트웨이는 요청한 IP 로 클라이언트를 구분하므로 IP 당 한 번만 등록할 수 있습니다 (ErrConflict).
+func (c *Client) Register(ctx context.Context, publicKey string) (uint32, error) {
+	// /register 는 다른 경로와 달리 응답을 data 로 감싸지 않습니다.
+	var resp registerResponse
+	if err := c.do(ctx, http.MethodPost, "/register", registerRequest{PublicKey: publicKey}, &resp, false, false); err != nil {
+		return 0, err
+	}
+	return resp.ID, nil
+}
+
+// KeyGen 은 ECDSA (secp256k1, P-256) 키를 만들고 네트워크의 주소를 발급합니다.
+// 같은 키를 두 번 만들지 않도록 요청이 게이트웨이에 닿지 않은 경우에만 재시도합니다.
+func (c *Client) KeyGen(ctx context.Context, req KeyGenRequest) (*KeyGenResponse, error) {
+	if req.RequestID == "" {
+		req.RequestID = uuid.NewString()
+	}
+	resp := &KeyGenResponse{}
+	if err := c.do(ctx, http.MethodPost, "/key_gen", req, resp, true, false); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// KeyGenEd25519 는 Ed25519 키를 만들고 네트워크 (솔라나) 의 주소를 발급합니다.
+func (c *Client) KeyGenEd25519(ctx context.Context, req EddsaKeyGenRequest) (*KeyGenResponse, error) {
+	if req.RequestID == "" {
+		req.RequestID = uuid.NewString()
+	}
+	resp := &KeyGenResponse{}
+	if err := c.do(ctx, http.MethodPost, "/key_gen_ed25519", req, resp, true, false); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// Sign 은 ECDSA 키로 tx_origin 또는 미서명 트랜잭션에 서명합니다.
+func (c *Client) Sign(ctx context.Context, req SignRequest) (*SignResponse, error) {
+	resp := &SignResponse{}
+	if err := c.sign(ctx, "/sign", req, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// SignTaproot 은 P2TR 주소의 키로 BIP340 서명을 만듭니다.
+func (c *Client) SignTaproot(ctx context.Context, req SignRequest) (*SchnorrSignResponse, error) {
+	resp := &SchnorrSignResponse{}
+	if err := c.sign(ctx, "/sign_taproot", req, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// SignEd25519 는 Ed25519 키로 서명합니다.
+func (c *Client) SignEd25519(ctx context.Context, req SignRequest) (*SchnorrSignResponse, error) {
+	resp := &SchnorrSignResponse{}
+	if err := c.sign(ctx, "/sign_ed25519", req, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// SignPSBT 는 PSBT 의 입력 중 게이트웨이 키가 소유한 입력을 모두 서명합니다.
+func (c *Client) SignPSBT(ctx context.Context, req PSBTSignRequest) (*PSBTSignResponse, error) {
+	if req.RequestID == "" {
+		req.RequestID = uuid.NewString()
+	}
+	resp := &PSBTSignResponse{}
+	if err := c.do(ctx, http.MethodPost, "/sign/psbt", req, resp, true, true); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// sign 은 서명 요청을 보냅니다. 같은 메시지에 다시 서명해도 안전하므로 일시적인 오류는 재시도하고,
+// 요청 ID 를 미리 정해 두어 앞선 시도가 아직 진행 중이면 게이트웨이가 중복으로 거부합니다.
+func (c *Client) sign(ctx context.Context, path string, req SignRequest, out interface{}) error {
+	if req.RequestID == "" {
+		req.RequestID = uuid.NewString()
+	}
+	return c.do(ctx, http.MethodPost, path, req, out, true, true)
+}
+
+// Networks 는 게이트웨이가 지원하는 네트워크를 돌려줍니다.
+func (c *Client) Networks(ctx context.Context) ([]NetworkInfo, error) {
+	var resp struct {
+		Networks []NetworkInfo `json:"networks"`
+	}
+	if err := c.do(ctx, http.MethodGet, "/networks", nil, &resp, true, true); err != nil {
+		return nil, err
+	}
+	return resp.Networks, nil
+}
+
+// KeyPool 은 키 풀의 네트워크, 주소 유형별 상태를 돌려줍니다.
+func (c *Client) KeyPool(ctx context.Context) ([]KeyPoolMetrics, error) {
+	var resp struct {
+		Pools []KeyPoolMetrics `json:"pools"`
+	}
+	if err := c.do(ctx, http.MethodGet, "/key_pool", nil, &resp, true, true); err != nil {
+		return nil, err
+	}
+	return resp.Pools, nil
+}
+
+// CreateUnsignedTx 는 네트워크의 미서명 트랜잭션을 만듭니다. txRequest 의 필드는 네트워크마다 다릅니다
+// (예: network.EthereumTxRequest).
+func (c *Client) CreateUnsignedTx(ctx context.Context, networkID int32, txRequest interface{}) (*transaction.UnsignedTransaction, error) {
+	resp := &transaction.UnsignedTransaction{}
+	if err := c.do(ctx, http.MethodPost, fmt.Sprintf("/create_unsigned_tx/%d", networkID), txRequest, resp, true, true); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// KeyXpub 은 비트코인 키의 확장 공개키와 디스크립터를 돌려줍니다.
+func (c *Client) KeyXpub(ctx context.Context, keyID uint32) (*KeyXpubResponse, error) {
+	resp := &KeyXpubResponse{}
+	if err := c.do(ctx, http.MethodGet, fmt.Sprintf("/keys/%d/xpub", keyID), nil, resp, true, true); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// KeyAddresses 는 키에 등록된 주소 목록을 돌려줍니다.
+func (c *Client) KeyAddresses(ctx context.Context, keyID uint32) ([]KeyAddress, error) {
+	var resp []KeyAddress
+	if err := c.do(ctx, http.MethodGet, fmt.Sprintf("/keys/%d/addresses", keyID), nil, &resp, true, true); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// AddKeyAddress 는 키에 다른 네트워크나 주소 유형의 주소를 추가합니다. 이미 있으면 기존 주소를 돌려줍니다.
+func (c *Client) AddKeyAddress(ctx context.Context, keyID uint32, networkID int32, addressType int32) (*KeyAddress, error) {
+	resp := &KeyAddress{}
+	req := addKeyAddressRequest{Network: networkID, AddressType: addressType}
+	if err := c.do(ctx, http.MethodPost, fmt.Sprintf("/keys/%d/addresses", keyID), req, resp, true, true); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// RegisterContractABI 는 /create_unsigned_tx 의 컨트랙트 호출에 사용할 ABI 를 등록합니다.
+func (c *Client) RegisterContractABI(ctx context.Context, req RegisterContractABIRequest) (*ContractABI, error) {
+	resp := &ContractABI{}
+	if err := c.do(ctx, http.MethodPost, "/abis", req, resp, true, false); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// ContractABIs 는 등록된 컨트랙트 ABI 목록을 돌려줍니다.
+func (c *Client) ContractABIs(ctx context.Context) ([]ContractABI, error) {
+	var resp []ContractABI
+	if err := c.do(ctx, http.MethodGet, "/abis", nil, &resp, true, true); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// ContractABI 는 이름으로 등록된 컨트랙트 ABI 를 돌려줍니다.
+func (c *Client) ContractABI(ctx context.Context, name string) (*ContractABI, error) {
+	resp := &ContractABI{}
+	if err := c.do(ctx, http.MethodGet, "/abis/"+url.PathEscape(name), nil, resp, true, true); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// NonceState 는 EVM 계정의 체인 논스와 게이트웨이가 할당한 논스를 돌려줍니다.
+func (c *Client) NonceState(ctx context.Context, networkID int32, address string) (*NonceState, error) {
+	resp := &NonceState{}
+	if err := c.do(ctx, http.MethodGet, fmt.Sprintf("/nonces/%d/%s", networkID, address), nil, resp, true, true); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// ReleaseNonce 는 브로드캐스트에 실패한 트랜잭션의 논스를 해제해 다음 트랜잭션이 다시 사용하게 합니다.
+func (c *Client) ReleaseNonce(ctx context.Context, networkID int32, address string, nonce uint64) error {
+	path := fmt.Sprintf("/nonces/%d/%s/release", networkID, address)
+	return c.do(ctx, http.MethodPost, path, releaseNonceRequest{Nonce: nonce}, nil, true, false)
+}
+
+// ResyncNonce 는 계정에 할당한 논스를 지우고 체인의 pending 논스부터 다시 할당하게 합니다.
+func (c *Client) ResyncNonce(ctx context.Context, networkID int32, address string) (*NonceState, error) {
+	resp := &NonceState{}
+	if err := c.do(ctx, http.MethodPost, fmt.Sprintf("/nonces/%d/%s/resync", networkID, address), nil, resp, true, true); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// do 는 req 를 JSON 으로 보내고 응답을 out 에 디코딩합니다. wrapped 이면 응답의 data 를 디코딩합니다.
+// idempotent 가 아닌 요청은 게이트웨이에 닿지 않은 연결 실패만 재시도합니다.
+func (c *Client) do(ctx context.Context, method, path string, req interface{}, out interface{}, wrapped, idempotent bool) error {
+	var body []byte
+	if req != nil {
+		var err error
+		if body, err = json.Marshal(req); err != nil {
+			return errors.WithStack(err)
+		}
+	}
+
+	for attempt := 1; ; attempt++ {
+		respBody, err := c.send(ctx, method, path, body)
+		if err == nil {
+			return decode(respBody, out, wrapped)
+		}
+		if attempt >= c.retry.MaxAttempts || ctx.Err() != nil || !retryable(err, idempotent) {
+			return err
+		}
+		timer := time.NewTimer(c.retry.backoff(attempt))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+	}
+}
+
+// send 는 요청 한 번을 보내고 성공한 응답의 본문을 돌려줍니다. 서명은 시도마다 새로 만듭니다.
+func (c *Client) send(ctx context.Context, method, path string, body []byte) ([]byte, error) {
+	httpReq, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, bytes.NewReader(body))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if body != nil {
+		httpReq.Header.Set("Content-Type", "application/json")
+	}
+	if c.signer != nil {
+		if err := auth.SignRequest(httpReq, body, c.clientID, c.signer, time.Now()); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := c.httpClient.Do(httpReq)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to call %s", path)
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read %s response", path)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, newError(resp.StatusCode, respBody)
+	}
+	return respBody, nil
+}
+
+func decode(body []byte, out interface{}, wrapped bool) error {
+	if out == nil {
+		return nil
+	}
+	if !wrapped {
+		return errors.Wrap(json.Unmarshal(body, out), "failed to decode response")
+	}
+	return errors.Wrap(json.Unmarshal(body, &struct {
+		Data interface{} `json:"data"`
+	}{Data: out}), "failed to decode response")
+}
+
+// retryable 은 실패한 시도를 다시 보내도 되는지 확인합니다.
+func retryable(err error, idempotent bool) bool {
+	var gatewayErr *Error
+	if errors.As(err, &gatewayErr) {
+		if !idempotent {
+			return false
+		}
+		switch gatewayErr.StatusCode {
+		case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+			return true
+		}
+		return false
+	}
+
+	// 요청을 만들거나 서명하다 실패한 경우는 다시 보내도 같습니다.
+	var urlErr *url.Error
+	if !errors.As(err, &urlErr) {
+		return false
+	}
+	var opErr *net.OpError
+	if errors.As(err, &opErr) && opErr.Op == "dial" {
+		return true
+	}
+	return idempotent
+}
@@ -0,0 +1,235 @@
+package client
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"tecdsa/cmd/gateway/config"
+	"tecdsa/pkg/auth"
+	"tecdsa/pkg/network"
+	"tecdsa/pkg/response"
+	"tecdsa/test/harness"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientAgainstGateway(t *testing.T) {
+	// 논스 관리자는 RPC 대신 고정된 체인 논스를 사용합니다.
+	h, err := harness.Start(func(cfg *config.Config) {
+		cfg.ChainNonce = func(network.Network, string) (uint64, error) { return 3, nil }
+	})
+	require.NoError(t, err)
+	t.Cleanup(h.Close)
+	ctx := context.Background()
+
+	signer, err := auth.GenerateKey()
+	require.NoError(t, err)
+	publicKey, err := auth.MarshalPublicKey(signer.Public())
+	require.NoError(t, err)
+	clientID, err := New(h.Gateway.URL).Register(ctx, publicKey)
+	require.NoError(t, err)
+	c := New(h.Gateway.URL, WithSigner(clientID, signer))
+
+	// 같은 IP 는 다시 등록할 수 없습니다.
+	_, err = c.Register(ctx, publicKey)
+	assert.ErrorIs(t, err, ErrConflict)
+
+	networks, err := c.Networks(ctx)
+	require.NoError(t, err)
+	assert.NotEmpty(t, networks)
+
+	message := make([]byte, 64)
+	_, err = rand.Read(message)
+	require.NoError(t, err)
+	txOrigin := base64.StdEncoding.EncodeToString(message)
+
+	t.Run("secp256k1", func(t *testing.T) {
+		key, err := c.KeyGen(ctx, KeyGenRequest{Network: 4})
+		require.NoError(t, err)
+		sig, err := c.Sign(ctx, SignRequest{Address: key.Address, TxOrigin: txOrigin})
+		require.NoError(t, err)
+
+		digest, err := MessageDigest(4, message)
+		require.NoError(t, err)
+		require.NoError(t, VerifyECDSA(key, digest, sig))
+
+		flipped := *sig.V ^ 1
+		sig.V = &flipped
+		assert.Error(t, VerifyECDSA(key, digest, sig))
+	})
+
+	t.Run("p256", func(t *testing.T) {
+		key, err := c.KeyGen(ctx, KeyGenRequest{Network: 10})
+		require.NoError(t, err)
+		sig, err := c.Sign(ctx, SignRequest{Address: key.Address, TxOrigin: txOrigin})
+		require.NoError(t, err)
+
+		digest, err := MessageDigest(10, message)
+		require.NoError(t, err)
+		require.NoError(t, VerifyECDSA(key, digest, sig))
+		otherDigest := sha256.Sum256(digest)
+		assert.Error(t, VerifyECDSA(key, otherDigest[:], sig))
+	})
+
+	t.Run("ed25519", func(t *testing.T) {
+		key, err := c.KeyGenEd25519(ctx, EddsaKeyGenRequest{Network: 8})
+		require.NoError(t, err)
+		sig, err := c.SignEd25519(ctx, SignRequest{Address: key.Address, TxOrigin: txOrigin})
+		require.NoError(t, err)
+
+		require.NoError(t, VerifyEd25519(key, message, sig))
+		assert.Error(t, VerifyEd25519(key, message[1:], sig))
+	})
+
+	t.Run("taproot", func(t *testing.T) {
+		key, err := c.KeyGen(ctx, KeyGenRequest{Network: 2, AddressType: 3})
+		require.NoError(t, err)
+		sighash := sha256.Sum256(message)
+		sig, err := c.SignTaproot(ctx, SignRequest{Address: key.Address, TxOrigin: base64.StdEncoding.EncodeToString(sighash[:])})
+		require.NoError(t, err)
+
+		require.NoError(t, VerifyTaproot(key, sighash[:], sig))
+
+		xpub, err := c.KeyXpub(ctx, key.KeyID)
+		require.NoError(t, err)
+		assert.NotEmpty(t, xpub.Xpub)
+		added, err := c.AddKeyAddress(ctx, key.KeyID, 2, 2)
+		require.NoError(t, err)
+		addresses, err := c.KeyAddresses(ctx, key.KeyID)
+		require.NoError(t, err)
+		assert.Contains(t, addresses, *added)
+	})
+
+	t.Run("contract abis", func(t *testing.T) {
+		abi := json.RawMessage(`[{"type":"function","name":"stake","inputs":[{"name":"amount","type":"uint256"}],"outputs":[],"stateMutability":"nonpayable"}]`)
+		contract := "0x00000000219ab540356cbb839cbe05303d7705fa"
+		registered, err := c.RegisterContractABI(ctx, RegisterContractABIRequest{Name: "staking", ABI: abi, Network: 5, Address: contract})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"stake(uint256)"}, registered.Methods)
+		// 주소는 체크섬 형식으로 저장합니다.
+		assert.Equal(t, "0x00000000219ab540356cBB839Cbe05303d7705Fa", registered.Address)
+
+		_, err = c.RegisterContractABI(ctx, RegisterContractABIRequest{Name: "staking", ABI: abi})
+		assert.ErrorIs(t, err, ErrBadRequest)
+		_, err = c.RegisterContractABI(ctx, RegisterContractABIRequest{Name: "broken", ABI: json.RawMessage(`{}`)})
+		assert.ErrorIs(t, err, ErrBadRequest)
+		_, err = c.RegisterContractABI(ctx, RegisterContractABIRequest{Name: "bitcoin", ABI: abi, Network: 2, Address: contract})
+		assert.ErrorIs(t, err, ErrBadRequest)
+
+		found, err := c.ContractABI(ctx, "staking")
+		require.NoError(t, err)
+		assert.JSONEq(t, string(abi), string(found.ABI))
+		abis, err := c.ContractABIs(ctx)
+		require.NoError(t, err)
+		require.Len(t, abis, 1)
+		assert.Equal(t, "staking", abis[0].Name)
+		_, err = c.ContractABI(ctx, "missing")
+		assert.ErrorIs(t, err, ErrNotFound)
+
+		// ABI 는 RPC 에 연결하기 전에 정합니다.
+		from := "0x8ba1f109551bD432803012645Ac136ddd64DBA72"
+		_, err = c.CreateUnsignedTx(ctx, 5, network.EthereumTxRequest{From: from, To: contract, ABIName: "missing", Method: "stake"})
+		assert.ErrorIs(t, err, ErrNotFound)
+		_, err = c.CreateUnsignedTx(ctx, 4, network.EthereumTxRequest{From: from, To: contract, ABIName: "staking", Method: "stake"})
+		assert.ErrorIs(t, err, ErrBadRequest)
+		_, err = c.CreateUnsignedTx(ctx, 5, network.EthereumTxRequest{From: from, To: contract, ABI: abi, ABIName: "staking", Method: "stake"})
+		assert.ErrorIs(t, err, ErrBadRequest)
+	})
+
+	t.Run("nonces", func(t *testing.T) {
+		// 논스는 클라이언트의 키 주소만 관리할 수 있으며, 체크섬이 없는 주소도 받습니다.
+		key, err := c.KeyGen(ctx, KeyGenRequest{Network: 5})
+		require.NoError(t, err)
+		from := strings.ToLower(key.Address)
+		state, err := c.NonceState(ctx, 5, from)
+		require.NoError(t, err)
+		assert.Equal(t, key.Address, state.Address)
+		assert.Equal(t, uint64(3), state.ChainNonce)
+		assert.Equal(t, uint64(3), state.NextNonce)
+		assert.Empty(t, state.Reservations)
+
+		assert.ErrorIs(t, c.ReleaseNonce(ctx, 5, from, 3), ErrNotFound)
+		state, err = c.ResyncNonce(ctx, 5, from)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(3), state.NextNonce)
+
+		_, err = c.NonceState(ctx, 2, from)
+		assert.ErrorIs(t, err, ErrBadRequest)
+		_, err = c.NonceState(ctx, 5, "0x1234")
+		assert.ErrorIs(t, err, ErrBadRequest)
+		_, err = c.NonceState(ctx, 5, "0x8ba1f109551bd432803012645ac136ddd64dba72")
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		_, err := c.KeyXpub(ctx, 9999)
+		assert.ErrorIs(t, err, ErrNotFound)
+		assert.Equal(t, response.ErrCodeNotFound, ErrorCode(err))
+
+		_, err = c.KeyGen(ctx, KeyGenRequest{Network: 999})
+		assert.ErrorIs(t, err, ErrBadRequest)
+		var gatewayErr *Error
+		require.ErrorAs(t, err, &gatewayErr)
+		assert.Equal(t, http.StatusBadRequest, gatewayErr.StatusCode)
+		assert.NotEmpty(t, gatewayErr.Message)
+	})
+}
+
+func TestRetry(t *testing.T) {
+	var calls, failures atomic.Int32
+	failures.Store(2)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) <= failures.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		response.SendResponse(w, response.NewSuccessResponse(http.StatusOK, map[string]interface{}{
+			"networks": []NetworkInfo{{ID: 4, Name: "Ethereum"}},
+		}))
+	}))
+	defer server.Close()
+
+	policy := RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond, MaxBackoff: time.Millisecond}
+	c := New(server.URL, WithRetryPolicy(policy))
+	ctx := context.Background()
+
+	// 조회는 일시적인 오류를 재시도합니다.
+	networks, err := c.Networks(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []NetworkInfo{{ID: 4, Name: "Ethereum"}}, networks)
+	assert.Equal(t, int32(3), calls.Load())
+
+	// 재시도 횟수를 넘기면 마지막 오류를 돌려줍니다.
+	calls.Store(0)
+	failures.Store(5)
+	_, err = c.Networks(ctx)
+	var gatewayErr *Error
+	require.ErrorAs(t, err, &gatewayErr)
+	assert.Equal(t, http.StatusServiceUnavailable, gatewayErr.StatusCode)
+	assert.Equal(t, int32(3), calls.Load())
+
+	// 키 생성은 게이트웨이가 응답했다면 재시도하지 않습니다.
+	calls.Store(0)
+	_, err = c.KeyGen(ctx, KeyGenRequest{Network: 4})
+	assert.Error(t, err)
+	assert.Equal(t, int32(1), calls.Load())
+
+	// 컨텍스트가 취소되면 기다리지 않고 멈춥니다.
+	calls.Store(0)
+	c = New(server.URL, WithRetryPolicy(RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Hour}))
+	cancelCtx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+	_, err = c.Networks(cancelCtx)
+	assert.Error(t, err)
+	assert.Equal(t, int32(1), calls.Load())
+}
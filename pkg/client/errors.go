@@ -0,0 +1,88 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"tecdsa/pkg/response"
+
+	"github.com/pkg/errors"
+)
+
+// ErrCodeConflict 는 /register 가 이미 등록된 IP 에 돌려주는 409 의 오류 코드입니다.
+// 게이트웨이의 오류 코드 목록에는 없고 클라이언트가 상태 코드로 붙입니다.
+const ErrCodeConflict = "CONFLICT"
+
+// 게이트웨이 오류 코드별 오류입니다. errors.Is(err, client.ErrNotFound) 처럼 비교합니다.
+var (
+	ErrBadRequest    = errors.New("bad request")
+	ErrUnauthorized  = errors.New("unauthorized")
+	ErrForbidden     = errors.New("forbidden")
+	ErrNotFound      = errors.New("not found")
+	ErrConflict      = errors.New("conflict")
+	ErrInternal      = errors.New("internal server error")
+	ErrKeyGeneration = errors.New("key generation failed")
+	ErrSigning       = errors.New("signing failed")
+)
+
+var codeErrors = map[string]error{
+	response.ErrCodeBadRequest:          ErrBadRequest,
+	response.ErrCodeUnauthorized:        ErrUnauthorized,
+	response.ErrCodeForbidden:           ErrForbidden,
+	response.ErrCodeNotFound:            ErrNotFound,
+	ErrCodeConflict:                     ErrConflict,
+	response.ErrCodeInternalServerError: ErrInternal,
+	response.ErrCodeKeyGeneration:       ErrKeyGeneration,
+	response.ErrCodeSigning:             ErrSigning,
+}
+
+// statusCodes 는 본문이 response.ErrorResponse 가 아닌 오류 (/register 등) 의 상태 코드별 오류 코드입니다.
+var statusCodes = map[int]string{
+	http.StatusBadRequest:          response.ErrCodeBadRequest,
+	http.StatusUnauthorized:        response.ErrCodeUnauthorized,
+	http.StatusForbidden:           response.ErrCodeForbidden,
+	http.StatusNotFound:            response.ErrCodeNotFound,
+	http.StatusConflict:            ErrCodeConflict,
+	http.StatusInternalServerError: response.ErrCodeInternalServerError,
+}
+
+// Error 는 게이트웨이가 응답한 오류입니다.
+type Error struct {
+	StatusCode int
+	// Code 는 response.ErrCode* 또는 ErrCodeConflict 입니다. 알 수 없는 상태 코드면 비어 있습니다.
+	Code    string
+	Message string
+}
+
+func (e *Error) Error() string {
+	if e.Code == "" {
+		return fmt.Sprintf("gateway returned %d: %s", e.StatusCode, e.Message)
+	}
+	return fmt.Sprintf("gateway returned %s: %s", e.Code, e.Message)
+}
+
+// Is 는 오류 코드에 해당하는 ErrNotFound 등과 비교합니다.
+func (e *Error) Is(target error) bool {
+	err, ok := codeErrors[e.Code]
+	return ok && err == target
+}
+
+// ErrorCode 는 err 가 게이트웨이 오류이면 오류 코드를, 아니면 빈 문자열을 돌려줍니다.
+func ErrorCode(err error) string {
+	var gatewayErr *Error
+	if errors.As(err, &gatewayErr) {
+		return gatewayErr.Code
+	}
+	return ""
+}
+
+// newError 는 성공하지 않은 응답의 본문을 *Error 로 바꿉니다.
+func newError(statusCode int, body []byte) *Error {
+	errResp := &response.ErrorResponse{}
+	if err := json.Unmarshal(body, errResp); err == nil && errResp.ErrorCode != "" {
+		return &Error{StatusCode: statusCode, Code: errResp.ErrorCode, Message: errResp.Message}
+	}
+	return &Error{StatusCode: statusCode, Code: statusCodes[statusCode], Message: strings.TrimSpace(string(body))}
+}
@@ -0,0 +1,190 @@
+package client
+
+import (
+	"encoding/json"
+
+	"tecdsa/pkg/network"
+	"tecdsa/pkg/transaction"
+)
+
+// KeyGenRequest 는 /key_gen 요청입니다.
+type KeyGenRequest struct {
+	// RequestID 가 비어 있으면 클라이언트가 만들어 보냅니다.
+	RequestID string `json:"request_id,omitempty"`
+	Network   int32  `json:"network"`
+	// AddressType 은 비트코인 주소 유형입니다 (0: P2PKH, 1: P2SH-P2WPKH, 2: P2WPKH, 3: P2TR).
+	AddressType int32 `json:"address_type,omitempty"`
+	// Curve 는 키의 곡선입니다 (0: secp256k1, 2: P-256). 생략하면 네트워크의 곡선을 사용합니다.
+	Curve *int32 `json:"curve,omitempty"`
+}
+
+// EddsaKeyGenRequest 는 /key_gen_ed25519 요청입니다.
+type EddsaKeyGenRequest struct {
+	RequestID string `json:"request_id,omitempty"`
+	Network   int32  `json:"network"`
+}
+
+// KeyGenResponse 는 /key_gen, /key_gen_ed25519 응답입니다.
+type KeyGenResponse struct {
+	RequestID   string `json:"request_id"`
+	KeyID       uint32 `json:"key_id"`
+	Address     string `json:"address"`
+	AddressType int32  `json:"address_type"`
+	// Curve 는 secp256k1, p256, ed25519 중 하나입니다.
+	Curve string `json:"curve"`
+	// Publickey 는 압축 공개키 (Ed25519 는 32바이트 공개키) 의 hex 입니다.
+	Publickey string `json:"public_key"`
+	Duration  int32  `json:"duration"`
+}
+
+// SignRequest 는 /sign, /sign_taproot, /sign_ed25519 요청입니다.
+// TxOrigin (base64) 과 UnsignedTx 중 하나를 채웁니다.
+type SignRequest struct {
+	Address    string                           `json:"address"`
+	TxOrigin   string                           `json:"tx_origin"`
+	RequestID  string                           `json:"request_id,omitempty"`
+	UnsignedTx *transaction.UnsignedTransaction `json:"unsigned_tx,omitempty"`
+}
+
+// SignResponse 는 /sign 응답입니다.
+type SignResponse struct {
+	// V 는 secp256k1 서명의 복구 ID (0, 1) 입니다. P-256 서명에는 없습니다.
+	V *uint64 `json:"v,omitempty"`
+	R string  `json:"r"`
+	S string  `json:"s"`
+	// Signature 는 P-256 서명의 r || s 의 base64 입니다.
+	Signature string `json:"signature,omitempty"`
+	// SignatureDER 는 P-256 서명의 ASN.1 DER 인코딩의 base64 입니다.
+	SignatureDER string `json:"signature_der,omitempty"`
+	// Signatures 는 입력이 여러 개인 비트코인 트랜잭션의 입력별 서명입니다. V, R, S 는 첫 번째 입력의 서명입니다.
+	Signatures []InputSignature `json:"signatures,omitempty"`
+	SignedTx   string           `json:"signed_tx,omitempty"`
+	Duration   int32            `json:"duration"`
+	RequestID  string           `json:"request_id"`
+}
+
+// InputSignature 는 트랜잭션 입력 하나의 서명입니다.
+type InputSignature struct {
+	Input int     `json:"input"`
+	V     *uint64 `json:"v,omitempty"`
+	R     string  `json:"r"`
+	S     string  `json:"s"`
+}
+
+// SchnorrSignResponse 는 /sign_taproot (BIP340) 과 /sign_ed25519 응답입니다.
+type SchnorrSignResponse struct {
+	// Signature 는 64바이트 서명의 base64 입니다.
+	Signature string `json:"signature"`
+	// Signatures 는 입력이 여러 개인 Taproot 트랜잭션의 입력별 서명입니다.
+	Signatures []string `json:"signatures,omitempty"`
+	SignedTx   string   `json:"signed_tx,omitempty"`
+	Duration   int32    `json:"duration"`
+	RequestID  string   `json:"request_id"`
+}
+
+// PSBTSignRequest 는 /sign/psbt 요청입니다. PSBT 는 BIP174 PSBT 의 base64 입니다.
+type PSBTSignRequest struct {
+	Network   int32  `json:"network"`
+	PSBT      string `json:"psbt"`
+	Finalize  bool   `json:"finalize,omitempty"`
+	RequestID string `json:"request_id,omitempty"`
+}
+
+// PSBTSignResponse 는 /sign/psbt 응답입니다. SignedTx 는 모든 입력이 완성되었을 때만 있습니다.
+type PSBTSignResponse struct {
+	PSBT         string `json:"psbt"`
+	SignedInputs []int  `json:"signed_inputs"`
+	Complete     bool   `json:"complete"`
+	SignedTx     string `json:"signed_tx,omitempty"`
+	Duration     int32  `json:"duration"`
+	RequestID    string `json:"request_id"`
+}
+
+// NetworkInfo 는 게이트웨이가 지원하는 네트워크입니다.
+// Family 는 체인 계열 (bitcoin, evm, solana, neo) 이며, ChainID 는 EVM 네트워크에만 있습니다.
+type NetworkInfo struct {
+	ID          int      `json:"id"`
+	Name        string   `json:"name"`
+	Family      string   `json:"family,omitempty"`
+	ChainID     *int64   `json:"chain_id,omitempty"`
+	ExplorerURL string   `json:"explorer_url,omitempty"`
+	Features    []string `json:"features,omitempty"`
+}
+
+// KeyPoolMetrics 는 네트워크, 주소 유형별 키 풀 상태입니다.
+type KeyPoolMetrics struct {
+	Network             int32   `json:"network"`
+	AddressType         int32   `json:"address_type"`
+	Target              int     `json:"target"`
+	Depth               int64   `json:"depth"`
+	GeneratedTotal      int64   `json:"generated_total"`
+	FailedTotal         int64   `json:"failed_total"`
+	AssignedTotal       int64   `json:"assigned_total"`
+	RefillRatePerMinute float64 `json:"refill_rate_per_minute"`
+}
+
+// KeyXpubResponse 는 /keys/{id}/xpub 응답입니다.
+type KeyXpubResponse struct {
+	KeyID       uint32                      `json:"key_id"`
+	Network     int32                       `json:"network"`
+	PublicKey   string                      `json:"public_key"`
+	Xpub        string                      `json:"xpub"`
+	Descriptors []network.BitcoinDescriptor `json:"descriptors"`
+}
+
+// KeyAddress 는 키에 등록된 주소입니다.
+type KeyAddress struct {
+	KeyID       uint32 `json:"key_id"`
+	Network     int32  `json:"network"`
+	AddressType int32  `json:"address_type"`
+	Address     string `json:"address"`
+}
+
+// RegisterContractABIRequest 는 /abis 에 등록할 컨트랙트 ABI 입니다. Network, Address 를 주면 해당 컨트랙트에만 사용합니다.
+type RegisterContractABIRequest struct {
+	Name    string          `json:"name"`
+	ABI     json.RawMessage `json:"abi"`
+	Network int32           `json:"network,omitempty"`
+	Address string          `json:"address,omitempty"`
+}
+
+// ContractABI 는 게이트웨이에 등록된 컨트랙트 ABI 입니다. 목록 조회에서는 ABI 가 비어 있습니다.
+type ContractABI struct {
+	Name    string          `json:"name"`
+	Network int32           `json:"network,omitempty"`
+	Address string          `json:"address,omitempty"`
+	Methods []string        `json:"methods"`
+	ABI     json.RawMessage `json:"abi,omitempty"`
+}
+
+// NonceState 는 게이트웨이가 EVM 계정에 할당한 논스 상태입니다.
+type NonceState struct {
+	Network      int32              `json:"network"`
+	Address      string             `json:"address"`
+	ChainNonce   uint64             `json:"chain_nonce"`
+	NextNonce    uint64             `json:"next_nonce"`
+	Reservations []NonceReservation `json:"reservations"`
+}
+
+// NonceReservation 은 할당한 논스와 상태(reserved, signed, released)입니다.
+type NonceReservation struct {
+	Nonce  uint64 `json:"nonce"`
+	Status string `json:"status"`
+}
+
+type releaseNonceRequest struct {
+	Nonce uint64 `json:"nonce"`
+}
+
+type addKeyAddressRequest struct {
+	Network     int32 `json:"network"`
+	AddressType int32 `json:"address_type,omitempty"`
+}
+
+type registerRequest struct {
+	PublicKey string `json:"public_key"`
+}
+
+type registerResponse struct {
+	ID uint32 `json:"id"`
+}
@@ -0,0 +1,135 @@
+package client
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	"tecdsa/pkg/network"
+	"tecdsa/pkg/schnorr"
+	"tecdsa/pkg/service"
+
+	"github.com/coinbase/kryptology/pkg/core/curves"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+)
+
+// MessageDigest 는 /sign 이 tx_origin 에 서명할 때 사용하는 네트워크의 메시지 해시를 계산합니다
+// (비트코인: double SHA-256, EVM: Keccak256, Neo: SHA-256).
+func MessageDigest(networkID int32, txOrigin []byte) ([]byte, error) {
+	networkService := service.NewNetworkService()
+	networkObj, err := networkService.GetNetworkByID(networkID)
+	if err != nil {
+		return nil, err
+	}
+	h := networkService.NewMessageHash(networkObj)
+	h.Write(txOrigin)
+	return h.Sum(nil), nil
+}
+
+// VerifyECDSA 는 /sign 의 서명을 키의 공개키로 검증합니다. digest 는 서명한 32바이트 해시입니다.
+// secp256k1 서명은 복구 ID (V) 로 복구한 공개키도 키의 공개키와 같은지 확인합니다.
+func VerifyECDSA(key *KeyGenResponse, digest []byte, sig *SignResponse) error {
+	publicKey, err := hex.DecodeString(key.Publickey)
+	if err != nil {
+		return errors.Wrap(err, "invalid public key")
+	}
+	rs, err := decodeRS(sig)
+	if err != nil {
+		return err
+	}
+
+	switch key.Curve {
+	case network.Secp256k1.String():
+		if !crypto.VerifySignature(publicKey, digest, rs) {
+			return errors.New("invalid secp256k1 signature")
+		}
+		if sig.V == nil {
+			return nil
+		}
+		recovered, err := crypto.Ecrecover(digest, append(rs, byte(*sig.V)))
+		if err != nil {
+			return errors.Wrap(err, "failed to recover public key")
+		}
+		expected, err := crypto.DecompressPubkey(publicKey)
+		if err != nil {
+			return errors.Wrap(err, "invalid public key")
+		}
+		if !bytes.Equal(recovered, crypto.FromECDSAPub(expected)) {
+			return errors.New("recovery id does not match the public key")
+		}
+		return nil
+	case network.P256.String():
+		x, y := elliptic.UnmarshalCompressed(elliptic.P256(), publicKey)
+		if x == nil {
+			return errors.New("invalid p256 public key")
+		}
+		pub := &ecdsa.PublicKey{Curve: elliptic.P256(), X: x, Y: y}
+		if !ecdsa.Verify(pub, digest, new(big.Int).SetBytes(rs[:32]), new(big.Int).SetBytes(rs[32:])) {
+			return errors.New("invalid p256 signature")
+		}
+		return nil
+	default:
+		return fmt.Errorf("unsupported ECDSA curve: %s", key.Curve)
+	}
+}
+
+// decodeRS 는 응답의 r, s (base64) 를 32바이트씩 이어 붙입니다.
+func decodeRS(sig *SignResponse) ([]byte, error) {
+	rs := make([]byte, 64)
+	for i, value := range []string{sig.R, sig.S} {
+		b, err := base64.StdEncoding.DecodeString(value)
+		if err != nil || len(b) > 32 {
+			return nil, errors.New("invalid signature encoding")
+		}
+		copy(rs[32*(i+1)-len(b):32*(i+1)], b)
+	}
+	return rs, nil
+}
+
+// VerifyEd25519 는 /sign_ed25519 의 서명을 키의 공개키로 검증합니다. message 는 서명한 메시지 자체입니다.
+func VerifyEd25519(key *KeyGenResponse, message []byte, sig *SchnorrSignResponse) error {
+	publicKey, err := hex.DecodeString(key.Publickey)
+	if err != nil || len(publicKey) != ed25519.PublicKeySize {
+		return errors.New("invalid ed25519 public key")
+	}
+	signature, err := base64.StdEncoding.DecodeString(sig.Signature)
+	if err != nil {
+		return errors.Wrap(err, "invalid signature encoding")
+	}
+	if !ed25519.Verify(publicKey, message, signature) {
+		return errors.New("invalid ed25519 signature")
+	}
+	return nil
+}
+
+// VerifyTaproot 은 /sign_taproot 의 BIP340 서명을 키 (내부 키) 의 P2TR 출력 키로 검증합니다.
+// sighash 는 서명한 32바이트 BIP341 서명 해시입니다.
+func VerifyTaproot(key *KeyGenResponse, sighash []byte, sig *SchnorrSignResponse) error {
+	publicKey, err := hex.DecodeString(key.Publickey)
+	if err != nil {
+		return errors.Wrap(err, "invalid public key")
+	}
+	curve := curves.K256()
+	internalKey, err := curve.Point.FromAffineCompressed(publicKey)
+	if err != nil {
+		return errors.Wrap(err, "invalid secp256k1 public key")
+	}
+	outputKey, err := schnorr.TaprootOutputKey(curve, internalKey)
+	if err != nil {
+		return err
+	}
+	signature, err := base64.StdEncoding.DecodeString(sig.Signature)
+	if err != nil {
+		return errors.Wrap(err, "invalid signature encoding")
+	}
+	if !schnorr.Verify(curve, outputKey, sighash, signature) {
+		return errors.New("invalid taproot signature")
+	}
+	return nil
+}
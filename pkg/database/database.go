@@ -1,27 +1,135 @@
 package database
 
 import (
+	"crypto/rand"
 	"fmt"
+	"strings"
 
 	"tecdsa/pkg/database/models"
+	"tecdsa/pkg/network"
 
 	_ "github.com/jinzhu/gorm/dialects/mysql"
 	"gorm.io/driver/mysql"
+	"gorm.io/driver/postgres"
+	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
 )
 
+const (
+	DriverMySQL    = "mysql"
+	DriverPostgres = "postgres"
+	DriverSQLite   = "sqlite"
+)
+
+// Config 는 데이터베이스 연결 설정입니다.
+// Driver 가 비어 있으면 MySQL 을, Port 가 비어 있으면 드라이버의 기본 포트를 사용합니다.
+// SQLite 는 Name 을 데이터베이스 파일 경로로 사용합니다.
+type Config struct {
+	Driver   string
+	Host     string
+	Port     string
+	User     string
+	Password string
+	Name     string
+}
+
 func NewDatabase(dsn string) (*gorm.DB, error) {
-	db, err := gorm.Open(mysql.Open(dsn), &gorm.Config{})
+	return Open(mysql.Open(dsn))
+}
+
+// Connect 는 설정한 드라이버로 데이터베이스에 연결하고 스키마를 마이그레이션합니다.
+func Connect(cfg Config) (*gorm.DB, error) {
+	dialector, err := NewDialector(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return Open(dialector)
+}
+
+// NewDialector 는 설정에 맞는 GORM 다이얼렉트를 만듭니다.
+func NewDialector(cfg Config) (gorm.Dialector, error) {
+	switch cfg.Driver {
+	case "", DriverMySQL:
+		dsn := fmt.Sprintf("%s:%s@tcp(%s:%s)/%s?charset=utf8mb4&parseTime=True&loc=Local",
+			cfg.User, cfg.Password, cfg.Host, portOrDefault(cfg.Port, "3306"), cfg.Name)
+		return mysql.Open(dsn), nil
+	case DriverPostgres:
+		dsn := fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=disable",
+			cfg.Host, portOrDefault(cfg.Port, "5432"), cfg.User, cfg.Password, cfg.Name)
+		return postgres.Open(dsn), nil
+	case DriverSQLite:
+		if cfg.Name == "" {
+			return nil, fmt.Errorf("sqlite database path is required")
+		}
+		return sqlite.Open(sqliteDSN(cfg.Name)), nil
+	default:
+		return nil, fmt.Errorf("unsupported database driver: %s", cfg.Driver)
+	}
+}
+
+// sqliteDSN 은 동시에 쓰는 요청이 "database is locked" 로 바로 실패하지 않도록
+// 트랜잭션을 시작할 때 쓰기 잠금을 잡고 (_txlock=immediate), 잠금을 잠시 기다리게 (_busy_timeout) 합니다.
+func sqliteDSN(path string) string {
+	separator := "?"
+	if strings.Contains(path, "?") {
+		separator = "&"
+	}
+	return path + separator + "_busy_timeout=5000&_txlock=immediate"
+}
+
+func portOrDefault(port, defaultPort string) string {
+	if port == "" {
+		return defaultPort
+	}
+	return port
+}
+
+// Open 은 주어진 GORM 다이얼렉트로 연결하고 스키마를 마이그레이션합니다.
+// 테스트 하네스는 SQLite 다이얼렉트로 인메모리 데이터베이스를 엽니다.
+func Open(dialector gorm.Dialector) (*gorm.DB, error) {
+	db, err := gorm.Open(dialector, &gorm.Config{})
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect database: %v", err)
 	}
 
 	// Auto Migrate
-	db.AutoMigrate(&models.ParitalSecretShare{}, &models.ClientSecurity{})
+	if err := db.AutoMigrate(&models.ParitalSecretShare{}, &models.ClientSecurity{}, &models.Key{}, &models.KeyAddress{}, &models.Presignature{}, &models.ShareSecret{}, &models.ContractABI{}, &models.NonceReservation{}, &models.RequestNonce{}); err != nil {
+		return nil, fmt.Errorf("failed to migrate database: %v", err)
+	}
+	if err := backfillChainCodes(db); err != nil {
+		return nil, fmt.Errorf("failed to migrate database: %v", err)
+	}
+	if err := backfillNonceReservedAt(db); err != nil {
+		return nil, fmt.Errorf("failed to migrate database: %v", err)
+	}
 
 	return db, nil
 }
 
+// backfillChainCodes 는 체인코드 없이 만든 이전 secp256k1 키에 xpub 내보내기에 사용할 체인코드를 정합니다.
+// 여러 게이트웨이가 같은 데이터베이스로 동시에 시작해도 먼저 저장한 체인코드를 바꾸지 않도록, 체인코드가 없을 때만 저장합니다.
+func backfillChainCodes(db *gorm.DB) error {
+	var keys []*models.Key
+	if err := db.Where("chain_code IS NULL AND curve = ?", int32(network.Secp256k1)).Find(&keys).Error; err != nil {
+		return err
+	}
+	for _, key := range keys {
+		chainCode := make([]byte, 32)
+		if _, err := rand.Read(chainCode); err != nil {
+			return err
+		}
+		if err := db.Model(&models.Key{}).Where("id = ? AND chain_code IS NULL", key.ID).Update("chain_code", chainCode).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// backfillNonceReservedAt 은 할당 시각 없이 만든 이전 논스의 할당 시각을 마지막으로 바꾼 시각으로 정해, 만료된 할당을 다시 사용하게 합니다.
+func backfillNonceReservedAt(db *gorm.DB) error {
+	return db.Model(&models.NonceReservation{}).Where("reserved_at IS NULL").UpdateColumn("reserved_at", gorm.Expr("updated_at")).Error
+}
+
 func CloseDB(db *gorm.DB) error {
 	sqlDB, err := db.DB()
 	if err != nil {
@@ -0,0 +1,350 @@
+package database
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"tecdsa/pkg/database/models"
+	"tecdsa/pkg/database/repository"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/mysql"
+	"gorm.io/driver/postgres"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func TestNewDialector(t *testing.T) {
+	dialector, err := NewDialector(Config{Host: "db", User: "root", Password: "password", Name: "gateway"})
+	require.NoError(t, err)
+	assert.Equal(t, "mysql", dialector.Name())
+	assert.Equal(t, "root:password@tcp(db:3306)/gateway?charset=utf8mb4&parseTime=True&loc=Local", dialector.(*mysql.Dialector).DSN)
+
+	dialector, err = NewDialector(Config{Driver: DriverPostgres, Host: "db", Port: "6543", User: "root", Password: "password", Name: "gateway"})
+	require.NoError(t, err)
+	assert.Equal(t, "postgres", dialector.Name())
+	assert.Equal(t, "host=db port=6543 user=root password=password dbname=gateway sslmode=disable", dialector.(*postgres.Dialector).DSN)
+
+	dialector, err = NewDialector(Config{Driver: DriverSQLite, Name: "gateway.db"})
+	require.NoError(t, err)
+	assert.Equal(t, "sqlite", dialector.Name())
+	assert.Equal(t, "gateway.db?_busy_timeout=5000&_txlock=immediate", dialector.(*sqlite.Dialector).DSN)
+	dialector, err = NewDialector(Config{Driver: DriverSQLite, Name: "file:gateway.db?cache=shared"})
+	require.NoError(t, err)
+	assert.Equal(t, "file:gateway.db?cache=shared&_busy_timeout=5000&_txlock=immediate", dialector.(*sqlite.Dialector).DSN)
+
+	_, err = NewDialector(Config{Driver: DriverSQLite})
+	assert.Error(t, err)
+	_, err = NewDialector(Config{Driver: "oracle"})
+	assert.Error(t, err)
+}
+
+// 스키마 테스트는 SQLite 파일 데이터베이스에서 항상 실행하고,
+// MySQL, PostgreSQL 은 TEST_MYSQL_DSN, TEST_POSTGRES_DSN 을 지정한 경우에만 실행합니다.
+func TestSchema(t *testing.T) {
+	t.Run("sqlite", func(t *testing.T) {
+		dialector, err := NewDialector(Config{Driver: DriverSQLite, Name: filepath.Join(t.TempDir(), "tecdsa.db")})
+		require.NoError(t, err)
+		testSchema(t, dialector)
+	})
+	t.Run("mysql", func(t *testing.T) {
+		dsn := os.Getenv("TEST_MYSQL_DSN")
+		if dsn == "" {
+			t.Skip("TEST_MYSQL_DSN is not set")
+		}
+		testSchema(t, mysql.Open(dsn))
+	})
+	t.Run("postgres", func(t *testing.T) {
+		dsn := os.Getenv("TEST_POSTGRES_DSN")
+		if dsn == "" {
+			t.Skip("TEST_POSTGRES_DSN is not set")
+		}
+		testSchema(t, postgres.Open(dsn))
+	})
+}
+
+func testSchema(t *testing.T, dialector gorm.Dialector) {
+	// 이전 실행이 남긴 테이블을 지우고 빈 데이터베이스에서 시작합니다.
+	db, err := Open(dialector)
+	require.NoError(t, err)
+	dropTables(t, db)
+	require.NoError(t, CloseDB(db))
+
+	db, err = Open(dialector)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		dropTables(t, db)
+		CloseDB(db)
+	})
+
+	// 체인코드 없이 만든 이전 키는 다시 열 때 체인코드를 채우고, 이미 있는 체인코드는 바꾸지 않습니다.
+	legacyKey := &models.Key{PublicKey: "02cc", Network: 1}
+	require.NoError(t, db.Create(legacyKey).Error)
+	eddsaKey := &models.Key{PublicKey: "dd", Network: 8, Curve: 1}
+	require.NoError(t, db.Create(eddsaKey).Error)
+	keyWithChainCode := &models.Key{PublicKey: "02dd", Network: 1, ChainCode: []byte{0x00, 0x01, 0xff}}
+	require.NoError(t, db.Create(keyWithChainCode).Error)
+
+	// 이미 마이그레이션된 스키마에 다시 마이그레이션해도 성공해야 합니다.
+	again, err := Open(dialector)
+	require.NoError(t, err)
+	require.NoError(t, CloseDB(again))
+
+	require.NoError(t, db.First(legacyKey, legacyKey.ID).Error)
+	assert.Len(t, legacyKey.ChainCode, 32)
+	require.NoError(t, db.First(eddsaKey, eddsaKey.ID).Error)
+	assert.Nil(t, eddsaKey.ChainCode)
+	require.NoError(t, db.First(keyWithChainCode, keyWithChainCode.ID).Error)
+	assert.Equal(t, []byte{0x00, 0x01, 0xff}, keyWithChainCode.ChainCode)
+	backfilled := legacyKey.ChainCode
+	again, err = Open(dialector)
+	require.NoError(t, err)
+	require.NoError(t, CloseDB(again))
+	require.NoError(t, db.First(legacyKey, legacyKey.ID).Error)
+	assert.Equal(t, backfilled, legacyKey.ChainCode)
+	require.NoError(t, db.Delete(&models.Key{}, []uint32{legacyKey.ID, eddsaKey.ID, keyWithChainCode.ID}).Error)
+
+	for _, model := range schemaModels() {
+		assert.True(t, db.Migrator().HasTable(model))
+	}
+	assert.True(t, db.Migrator().HasIndex(&models.KeyAddress{}, "idx_key_network_address_type"))
+	assert.True(t, db.Migrator().HasIndex(&models.Presignature{}, "idx_presignature_key_status"))
+
+	// 바이너리 컬럼은 모든 드라이버에서 그대로 저장되어야 합니다.
+	share := make([]byte, 70000)
+	for i := range share {
+		share[i] = byte(i)
+	}
+	shareRepo := repository.NewPartialSecretShareRepository(db)
+	require.NoError(t, shareRepo.Create(1, "address-1", share, 4, 0, 0, 0))
+	stored, err := shareRepo.FindByKeyID(1)
+	require.NoError(t, err)
+	assert.Equal(t, share, stored.Share)
+
+	keyRepo := repository.NewKeyRepository(db)
+	key := &models.Key{PublicKey: "02aa", Network: 4}
+	require.NoError(t, db.Create(key).Error)
+
+	// 키 풀 할당은 할당되지 않은 키를 한 번만 가져가야 합니다.
+	claimed, err := keyRepo.ClaimUnassigned(4, 0, 0, 7)
+	require.NoError(t, err)
+	assert.Equal(t, key.ID, claimed.ID)
+	_, err = keyRepo.ClaimUnassigned(4, 0, 0, 8)
+	assert.Error(t, err)
+
+	// 키를 완성하면 생성 네트워크의 주소가 등록되고, 같은 키에 다른 네트워크의 주소를 추가할 수 있습니다.
+	assert.True(t, db.Migrator().HasIndex(&models.KeyAddress{}, "idx_network_address"))
+	keyAddressRepo := repository.NewKeyAddressRepository(db)
+	evmKey, err := keyRepo.Create(4, 0, 0, nil, 7)
+	require.NoError(t, err)
+	evmAddress := "0x8ba1f109551bD432803012645Ac136ddd64DBA72"
+	require.NoError(t, keyRepo.Complete(evmKey.ID, "02bb", evmAddress))
+	created, err := keyAddressRepo.FindByKeyAndNetwork(evmKey.ID, 4, 0)
+	require.NoError(t, err)
+	assert.Equal(t, evmAddress, created.Address)
+	// EVM 네트워크는 주소가 같습니다.
+	_, err = keyAddressRepo.Create(evmKey.ID, 6, 0, evmAddress)
+	require.NoError(t, err)
+	_, err = keyAddressRepo.Create(evmKey.ID, 2, 2, "tb1qtestaddress")
+	require.NoError(t, err)
+	// (키, 네트워크, 주소 유형) 과 (네트워크, 주소) 는 한 번만 등록할 수 있습니다.
+	_, err = keyAddressRepo.Create(evmKey.ID, 6, 0, "0x0000000000000000000000000000000000000001")
+	assert.Error(t, err)
+	_, err = keyAddressRepo.Create(key.ID, 6, 0, evmAddress)
+	assert.Error(t, err)
+
+	keyAddresses, err := keyAddressRepo.FindByKeyID(evmKey.ID)
+	require.NoError(t, err)
+	require.Len(t, keyAddresses, 3)
+	assert.Equal(t, []int32{4, 6, 2}, []int32{keyAddresses[0].Network, keyAddresses[1].Network, keyAddresses[2].Network})
+	found, err := keyAddressRepo.FindByAddress(evmAddress)
+	require.NoError(t, err)
+	assert.Equal(t, evmKey.ID, found.KeyID)
+	assert.Equal(t, int32(4), found.Network)
+	found, err = keyAddressRepo.FindByNetworkAndAddress(6, evmAddress)
+	require.NoError(t, err)
+	assert.Equal(t, int32(6), found.Network)
+	_, err = keyAddressRepo.FindByNetworkAndAddress(5, evmAddress)
+	assert.Error(t, err)
+	foundKey, err := keyRepo.FindByAddress(evmAddress)
+	require.NoError(t, err)
+	assert.Equal(t, evmKey.ID, foundKey.ID)
+
+	presignRepo := repository.NewPresignatureRepository(db)
+	require.NoError(t, presignRepo.Create("presign-1", key.ID, "digest", []byte{0x01}))
+	count, err := presignRepo.CountAvailable(key.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+	presignature, err := presignRepo.ClaimAvailable(key.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "presign-1", presignature.PresignID)
+	assert.Equal(t, []byte{0x01}, presignature.Data)
+
+	// presignature 는 한 번만 사용할 수 있고, 사용하면 데이터를 지웁니다.
+	require.NoError(t, presignRepo.Create("presign-2", key.ID, "digest", []byte{0x02}))
+	consumed, err := presignRepo.Consume("presign-2")
+	require.NoError(t, err)
+	assert.Equal(t, []byte{0x02}, consumed.Data)
+	_, err = presignRepo.Consume("presign-2")
+	assert.ErrorContains(t, err, "already been used")
+	_, err = presignRepo.Consume("presign-1")
+	assert.ErrorContains(t, err, "already been used")
+	_, err = presignRepo.Consume("unknown")
+	assert.Error(t, err)
+	findPresignature := func(presignID string) *models.Presignature {
+		var record models.Presignature
+		require.NoError(t, db.Where("presign_id = ?", presignID).First(&record).Error)
+		return &record
+	}
+	assert.Equal(t, models.PresignatureUsed, findPresignature("presign-2").Status)
+	assert.Nil(t, findPresignature("presign-2").Data)
+
+	// 동시에 사용해도 한 요청만 성공합니다.
+	require.NoError(t, presignRepo.Create("presign-3", key.ID, "digest", []byte{0x03}))
+	assert.Equal(t, 1, countSuccesses(8, func() error {
+		_, err := presignRepo.Consume("presign-3")
+		return err
+	}))
+	for i := 0; i < 3; i++ {
+		require.NoError(t, presignRepo.Create(fmt.Sprintf("pool-%d", i), key.ID, "digest", []byte{byte(i)}))
+	}
+	var claimedPresignMutex sync.Mutex
+	claimedPresigns := map[string]bool{}
+	assert.Equal(t, 3, countSuccesses(8, func() error {
+		presignature, err := presignRepo.ClaimAvailable(key.ID)
+		if err != nil {
+			return err
+		}
+		claimedPresignMutex.Lock()
+		defer claimedPresignMutex.Unlock()
+		claimedPresigns[presignature.PresignID] = true
+		return nil
+	}))
+	assert.Len(t, claimedPresigns, 3)
+
+	// 무효로 만들면 사용하지 않은 presignature 만 바뀝니다.
+	require.NoError(t, presignRepo.Create("presign-4", key.ID, "digest", []byte{0x04}))
+	require.NoError(t, presignRepo.InvalidateByKeyID(key.ID))
+	count, err = presignRepo.CountAvailable(key.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), count)
+	_, err = presignRepo.Consume("presign-4")
+	assert.ErrorContains(t, err, "already been used or invalidated")
+	assert.Equal(t, models.PresignatureInvalidated, findPresignature("presign-4").Status)
+	assert.Nil(t, findPresignature("presign-4").Data)
+	assert.Equal(t, models.PresignatureUsed, findPresignature("presign-2").Status)
+
+	abiRepo := repository.NewContractABIRepository(db)
+	_, err = abiRepo.Create("erc20", 4, "0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48", `[]`)
+	require.NoError(t, err)
+	_, err = abiRepo.Create("erc20", 5, "", `[]`)
+	assert.Error(t, err)
+	contractABI, err := abiRepo.FindByContract(4, "0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48")
+	require.NoError(t, err)
+	assert.Equal(t, "erc20", contractABI.Name)
+
+	// 논스는 체인 논스부터 차례로 할당하고, 해제한 논스를 먼저 다시 할당합니다.
+	assert.True(t, db.Migrator().HasIndex(&models.NonceReservation{}, "idx_nonce_account_nonce"))
+	nonceRepo := repository.NewNonceRepository(db)
+	account := "0x8ba1f109551bD432803012645Ac136ddd64DBA72"
+	notExpired := time.Now().Add(-time.Hour)
+	for _, expected := range []uint64{5, 6, 7} {
+		nonce, err := nonceRepo.Reserve(4, account, 5, notExpired)
+		require.NoError(t, err)
+		assert.Equal(t, expected, nonce)
+	}
+	updated, err := nonceRepo.UpdateStatus(4, account, 6, []int32{models.NonceReserved, models.NonceSigned}, models.NonceReleased)
+	require.NoError(t, err)
+	assert.True(t, updated)
+	nonce, err := nonceRepo.Reserve(4, account, 5, notExpired)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(6), nonce)
+	// 체인 논스보다 작은 논스는 지웁니다.
+	nonce, err = nonceRepo.Reserve(4, account, 7, notExpired)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(8), nonce)
+	reservations, err := nonceRepo.FindByAccount(4, account)
+	require.NoError(t, err)
+	require.Len(t, reservations, 2)
+	assert.Equal(t, uint64(7), reservations[0].Nonce)
+	require.NoError(t, nonceRepo.DeleteByAccount(4, account))
+	nonce, err = nonceRepo.Reserve(4, account, 7, notExpired)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(7), nonce)
+
+	// 만료 시간 전에 할당한 뒤 서명하지 않은 논스는 다시 할당하고, 서명한 논스는 다시 할당하지 않습니다.
+	expired := time.Now().Add(time.Minute)
+	nonce, err = nonceRepo.Reserve(4, account, 7, expired)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(7), nonce)
+	updated, err = nonceRepo.UpdateStatus(4, account, 7, []int32{models.NonceReserved}, models.NonceSigned)
+	require.NoError(t, err)
+	assert.True(t, updated)
+	nonce, err = nonceRepo.Reserve(4, account, 7, expired)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(8), nonce)
+
+	// 할당 시각이 없는 이전 논스는 마이그레이션에서 마지막으로 바꾼 시각으로 정합니다.
+	require.NoError(t, db.Model(&models.NonceReservation{}).Where("nonce = ?", 8).Update("reserved_at", nil).Error)
+	require.NoError(t, backfillNonceReservedAt(db))
+	reservations, err = nonceRepo.FindByAccount(4, account)
+	require.NoError(t, err)
+	require.Len(t, reservations, 2)
+	assert.False(t, reservations[1].ReservedAt.IsZero())
+	assert.True(t, reservations[1].ReservedAt.Equal(reservations[1].UpdatedAt))
+
+	// 요청 논스는 만료 전까지 한 번만 기록하고, 만료된 논스는 다시 기록할 수 있습니다.
+	assert.True(t, db.Migrator().HasIndex(&models.RequestNonce{}, "idx_request_nonce_client_nonce"))
+	requestNonceRepo := repository.NewRequestNonceRepository(db)
+	now := time.Now()
+	recorded, err := requestNonceRepo.Record(7, "nonce", now.Add(time.Minute), now)
+	require.NoError(t, err)
+	assert.True(t, recorded)
+	recorded, err = requestNonceRepo.Record(7, "nonce", now.Add(time.Minute), now.Add(time.Second))
+	require.NoError(t, err)
+	assert.False(t, recorded)
+	recorded, err = requestNonceRepo.Record(8, "nonce", now.Add(time.Minute), now)
+	require.NoError(t, err)
+	assert.True(t, recorded)
+	recorded, err = requestNonceRepo.Record(7, "nonce", now.Add(3*time.Minute), now.Add(2*time.Minute))
+	require.NoError(t, err)
+	assert.True(t, recorded)
+	require.NoError(t, requestNonceRepo.DeleteExpired(now.Add(2*time.Minute)))
+	var requestNonces int64
+	require.NoError(t, db.Model(&models.RequestNonce{}).Count(&requestNonces).Error)
+	assert.Equal(t, int64(1), requestNonces)
+}
+
+// countSuccesses 는 fn 을 n 개의 고루틴에서 동시에 실행하고 성공한 횟수를 돌려줍니다.
+func countSuccesses(n int, fn func() error) int {
+	var wg sync.WaitGroup
+	var successes int32
+	start := make(chan struct{})
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-start
+			if fn() == nil {
+				atomic.AddInt32(&successes, 1)
+			}
+		}()
+	}
+	close(start)
+	wg.Wait()
+	return int(successes)
+}
+
+func schemaModels() []interface{} {
+	return []interface{}{&models.ParitalSecretShare{}, &models.ClientSecurity{}, &models.Key{}, &models.KeyAddress{}, &models.Presignature{}, &models.ShareSecret{}, &models.ContractABI{}, &models.NonceReservation{}, &models.RequestNonce{}}
+}
+
+func dropTables(t *testing.T, db *gorm.DB) {
+	require.NoError(t, db.Migrator().DropTable(schemaModels()...))
+}
@@ -0,0 +1,13 @@
+package models
+
+import "gorm.io/gorm"
+
+// ContractABI 는 게이트웨이에 등록한 신뢰할 수 있는 컨트랙트 ABI 입니다.
+// /create_unsigned_tx 는 Name 으로 ABI 를 찾고, Network, Address 가 있으면 해당 컨트랙트의 호출을 해석할 때 사용합니다.
+type ContractABI struct {
+	gorm.Model
+	Name    string `gorm:"type:varchar(100);unique;not null"`
+	Network int32  `gorm:"default:0;index:idx_contract_abi_network_address"`
+	Address string `gorm:"type:varchar(42);index:idx_contract_abi_network_address"`
+	ABI     string `gorm:"type:text;not null"`
+}
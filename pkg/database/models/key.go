@@ -0,0 +1,14 @@
+package models
+
+import "gorm.io/gorm"
+
+type Key struct {
+	gorm.Model
+	ID               uint32 `gorm:"primaryKey"`
+	PublicKey        string `gorm:"type:varchar(130);not null"`
+	Network          int32  `gorm:"not null"`
+	AddressType      int32  `gorm:"default:0"`
+	Curve            int32  `gorm:"default:0"`
+	ChainCode        []byte `gorm:"type:bytes"`
+	ClientSecurityID uint   `gorm:"index"`
+}
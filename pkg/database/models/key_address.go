@@ -0,0 +1,15 @@
+package models
+
+import "gorm.io/gorm"
+
+// KeyAddress 는 하나의 키가 네트워크/주소 유형별로 가지는 주소입니다.
+// KeyID 는 게이트웨이가 발급한 키 ID 로 게이트웨이, Alice, Bob 에서 동일합니다.
+// EVM 네트워크들처럼 여러 네트워크에서 주소가 같을 수 있으므로 주소는 네트워크 안에서만 유일합니다.
+type KeyAddress struct {
+	gorm.Model
+	ID          uint32 `gorm:"primaryKey"`
+	KeyID       uint32 `gorm:"not null;uniqueIndex:idx_key_network_address_type"`
+	Network     int32  `gorm:"not null;uniqueIndex:idx_key_network_address_type;uniqueIndex:idx_network_address"`
+	AddressType int32  `gorm:"default:0;uniqueIndex:idx_key_network_address_type"`
+	Address     string `gorm:"type:varchar(200);not null;uniqueIndex:idx_network_address"`
+}
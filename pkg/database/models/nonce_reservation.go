@@ -0,0 +1,26 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	NonceReserved int32 = 0
+	NonceSigned   int32 = 1
+	NonceReleased int32 = 2
+)
+
+// NonceReservation 은 게이트웨이가 (네트워크, 주소) 의 미서명 트랜잭션에 할당한 논스입니다.
+// 서명이나 브로드캐스트에 실패해 해제한 논스 (NonceReleased) 와, 할당한 뒤 만료 시간이 지나도록 서명하지 않은 논스는
+// 다음 트랜잭션에 다시 할당합니다.
+type NonceReservation struct {
+	gorm.Model
+	Network int32  `gorm:"not null;uniqueIndex:idx_nonce_account_nonce"`
+	Address string `gorm:"type:varchar(42);not null;uniqueIndex:idx_nonce_account_nonce"`
+	Nonce   uint64 `gorm:"not null;uniqueIndex:idx_nonce_account_nonce"`
+	Status  int32  `gorm:"default:0"`
+	// ReservedAt 은 논스를 (다시) 할당한 시각입니다.
+	ReservedAt time.Time
+}
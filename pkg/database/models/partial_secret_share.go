@@ -5,7 +5,14 @@ import "gorm.io/gorm"
 type ParitalSecretShare struct {
 	gorm.Model
 	ID               uint32 `gorm:"primaryKey"`
+	KeyID            uint32 `gorm:"index"`
 	Address          string `gorm:"type:varchar(200);unique;not null"`
-	Share            []byte `gorm:"type:blob;not null"`
+	Share            []byte `gorm:"type:bytes;not null"`
+	Network          int32  `gorm:"default:0"`
+	AddressType      int32  `gorm:"default:0"`
+	Curve            int32  `gorm:"default:0"`
 	ClientSecurityID uint   `gorm:"index"`
+	// ShareRef 는 쉐어 저장소 (sharestore) 에 저장한 쉐어의 참조 ID 입니다.
+	// 비어 있으면 쉐어 저장소 도입 전에 Share 컬럼에 직접 저장한 쉐어입니다.
+	ShareRef string `gorm:"type:varchar(64)"`
 }
@@ -0,0 +1,23 @@
+package models
+
+import "gorm.io/gorm"
+
+const (
+	PresignatureAvailable   int32 = 0
+	PresignatureUsed        int32 = 1
+	PresignatureInvalidated int32 = 2
+)
+
+// Presignature 는 메시지와 무관하게 미리 계산해 둔 서명 재료입니다.
+// PresignID 는 게이트웨이가 발급한 ID 로 게이트웨이, Alice, Bob 에서 동일합니다.
+// 게이트웨이는 풀 관리를 위해 상태만 저장하고, 파티는 Data 에 자신의 presignature 를 저장합니다.
+type Presignature struct {
+	gorm.Model
+	ID        uint32 `gorm:"primaryKey"`
+	PresignID string `gorm:"type:varchar(64);unique;not null"`
+	KeyID     uint32 `gorm:"not null;index:idx_presignature_key_status"`
+	// ShareDigest 는 presignature 를 만든 쉐어의 SHA-256 입니다. 키가 리프레시되어 쉐어가 바뀌면 무효입니다.
+	ShareDigest string `gorm:"type:varchar(64)"`
+	Data        []byte `gorm:"type:bytes"`
+	Status      int32  `gorm:"default:0;index:idx_presignature_key_status"`
+}
@@ -0,0 +1,16 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// RequestNonce 는 게이트웨이가 서명을 검증한 요청의 (클라이언트, 논스) 입니다.
+// 게이트웨이를 다시 시작하거나 여러 게이트웨이를 띄워도 ExpiresAt 까지 같은 논스의 요청을 재전송으로 보고 거부합니다.
+type RequestNonce struct {
+	gorm.Model
+	ClientID  uint32    `gorm:"not null;uniqueIndex:idx_request_nonce_client_nonce"`
+	Nonce     string    `gorm:"type:varchar(64);not null;uniqueIndex:idx_request_nonce_client_nonce"`
+	ExpiresAt time.Time `gorm:"not null;index"`
+}
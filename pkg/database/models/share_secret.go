@@ -0,0 +1,12 @@
+package models
+
+import "gorm.io/gorm"
+
+// ShareSecret 은 SQL 쉐어 저장소가 쉐어 바이트를 보관하는 테이블입니다.
+// ShareRef 는 ParitalSecretShare.ShareRef 와 같습니다.
+type ShareSecret struct {
+	gorm.Model
+	ID       uint32 `gorm:"primaryKey"`
+	ShareRef string `gorm:"type:varchar(64);unique;not null"`
+	Share    []byte `gorm:"type:bytes;not null"`
+}
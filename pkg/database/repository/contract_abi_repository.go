@@ -0,0 +1,61 @@
+package repository
+
+import (
+	"tecdsa/pkg/database/models"
+
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+type ContractABIRepository interface {
+	Create(name string, network int32, address string, abi string) (*models.ContractABI, error)
+	FindByName(name string) (*models.ContractABI, error)
+	FindByContract(network int32, address string) (*models.ContractABI, error)
+	FindAll() ([]*models.ContractABI, error)
+}
+
+type contractABIRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewContractABIRepository(db *gorm.DB) ContractABIRepository {
+	return &contractABIRepositoryImpl{db: db}
+}
+
+func (r *contractABIRepositoryImpl) Create(name string, network int32, address string, abi string) (*models.ContractABI, error) {
+	record := &models.ContractABI{
+		Name:    name,
+		Network: network,
+		Address: address,
+		ABI:     abi,
+	}
+	if err := r.db.Create(record).Error; err != nil {
+		return nil, errors.Wrap(err, "failed to create contract abi")
+	}
+	return record, nil
+}
+
+func (r *contractABIRepositoryImpl) FindByName(name string) (*models.ContractABI, error) {
+	var record models.ContractABI
+	if err := r.db.Where("name = ?", name).First(&record).Error; err != nil {
+		return nil, errors.Wrap(err, "failed to find contract abi")
+	}
+	return &record, nil
+}
+
+// FindByContract 는 컨트랙트 주소에 등록한 ABI 를 찾습니다. 주소는 체크섬 형식 (common.Address.Hex) 으로 저장합니다.
+func (r *contractABIRepositoryImpl) FindByContract(network int32, address string) (*models.ContractABI, error) {
+	var record models.ContractABI
+	if err := r.db.Where("network = ? AND address = ?", network, address).Order("id").First(&record).Error; err != nil {
+		return nil, errors.Wrap(err, "failed to find contract abi")
+	}
+	return &record, nil
+}
+
+func (r *contractABIRepositoryImpl) FindAll() ([]*models.ContractABI, error) {
+	var records []*models.ContractABI
+	if err := r.db.Order("id").Find(&records).Error; err != nil {
+		return nil, errors.Wrap(err, "failed to find contract abis")
+	}
+	return records, nil
+}
@@ -0,0 +1,70 @@
+package repository
+
+import (
+	"tecdsa/pkg/database/models"
+
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+type KeyAddressRepository interface {
+	Create(keyID uint32, network int32, addressType int32, address string) (*models.KeyAddress, error)
+	FindByAddress(address string) (*models.KeyAddress, error)
+	FindByNetworkAndAddress(network int32, address string) (*models.KeyAddress, error)
+	FindByKeyID(keyID uint32) ([]*models.KeyAddress, error)
+	FindByKeyAndNetwork(keyID uint32, network int32, addressType int32) (*models.KeyAddress, error)
+}
+
+type keyAddressRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewKeyAddressRepository(db *gorm.DB) KeyAddressRepository {
+	return &keyAddressRepositoryImpl{db: db}
+}
+
+func (r *keyAddressRepositoryImpl) Create(keyID uint32, network int32, addressType int32, address string) (*models.KeyAddress, error) {
+	record := &models.KeyAddress{
+		KeyID:       keyID,
+		Network:     network,
+		AddressType: addressType,
+		Address:     address,
+	}
+	if err := r.db.Create(record).Error; err != nil {
+		return nil, errors.Wrap(err, "failed to create key address")
+	}
+	return record, nil
+}
+
+// FindByAddress 는 주소의 키를 찾습니다. 여러 네트워크에 같은 주소가 있으면 모두 같은 키이므로 먼저 등록한 것을 돌려줍니다.
+func (r *keyAddressRepositoryImpl) FindByAddress(address string) (*models.KeyAddress, error) {
+	var record models.KeyAddress
+	if err := r.db.Where("address = ?", address).Order("id").First(&record).Error; err != nil {
+		return nil, errors.Wrap(err, "failed to find key address")
+	}
+	return &record, nil
+}
+
+func (r *keyAddressRepositoryImpl) FindByNetworkAndAddress(network int32, address string) (*models.KeyAddress, error) {
+	var record models.KeyAddress
+	if err := r.db.Where("network = ? AND address = ?", network, address).First(&record).Error; err != nil {
+		return nil, errors.Wrap(err, "failed to find key address")
+	}
+	return &record, nil
+}
+
+func (r *keyAddressRepositoryImpl) FindByKeyID(keyID uint32) ([]*models.KeyAddress, error) {
+	var records []*models.KeyAddress
+	if err := r.db.Where("key_id = ?", keyID).Order("id").Find(&records).Error; err != nil {
+		return nil, errors.Wrap(err, "failed to find key addresses")
+	}
+	return records, nil
+}
+
+func (r *keyAddressRepositoryImpl) FindByKeyAndNetwork(keyID uint32, network int32, addressType int32) (*models.KeyAddress, error) {
+	var record models.KeyAddress
+	if err := r.db.Where("key_id = ? AND network = ? AND address_type = ?", keyID, network, addressType).First(&record).Error; err != nil {
+		return nil, errors.Wrap(err, "failed to find key address")
+	}
+	return &record, nil
+}
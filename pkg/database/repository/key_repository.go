@@ -0,0 +1,150 @@
+package repository
+
+import (
+	"tecdsa/pkg/database/models"
+
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+type KeyRepository interface {
+	Create(network int32, addressType int32, curve int32, chainCode []byte, clientSecurityID uint) (*models.Key, error)
+	Complete(id uint32, publicKey string, address string) error
+	Delete(id uint32) error
+	FindByID(id uint) (*models.Key, error)
+	FindByAddress(address string) (*models.Key, error)
+	FindCompletedByCurves(curves ...int32) ([]*models.Key, error)
+	CountUnassigned(network int32, addressType int32, curve int32) (int64, error)
+	ClaimUnassigned(network int32, addressType int32, curve int32, clientSecurityID uint) (*models.Key, error)
+	Release(id uint32, clientSecurityID uint) error
+}
+
+type keyRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewKeyRepository(db *gorm.DB) KeyRepository {
+	return &keyRepositoryImpl{db: db}
+}
+
+// Create 는 DKG 를 시작하기 전에 키 ID 를 발급받기 위해 공개키 없이 키를 등록합니다.
+func (r *keyRepositoryImpl) Create(network int32, addressType int32, curve int32, chainCode []byte, clientSecurityID uint) (*models.Key, error) {
+	record := &models.Key{
+		Network:          network,
+		AddressType:      addressType,
+		Curve:            curve,
+		ChainCode:        chainCode,
+		ClientSecurityID: clientSecurityID,
+	}
+	if err := r.db.Create(record).Error; err != nil {
+		return nil, errors.Wrap(err, "failed to create key")
+	}
+	return record, nil
+}
+
+// Complete 는 DKG 결과 공개키와 키 생성 네트워크의 주소를 함께 저장합니다.
+func (r *keyRepositoryImpl) Complete(id uint32, publicKey string, address string) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		var record models.Key
+		if err := tx.First(&record, id).Error; err != nil {
+			return errors.Wrap(err, "failed to find key by ID")
+		}
+		if err := tx.Model(&record).Update("public_key", publicKey).Error; err != nil {
+			return errors.Wrap(err, "failed to update key public key")
+		}
+		keyAddress := &models.KeyAddress{
+			KeyID:       record.ID,
+			Network:     record.Network,
+			AddressType: record.AddressType,
+			Address:     address,
+		}
+		if err := tx.Create(keyAddress).Error; err != nil {
+			return errors.Wrap(err, "failed to create key address")
+		}
+		return nil
+	})
+}
+
+func (r *keyRepositoryImpl) Delete(id uint32) error {
+	if err := r.db.Delete(&models.Key{}, id).Error; err != nil {
+		return errors.Wrap(err, "failed to delete key")
+	}
+	return nil
+}
+
+func (r *keyRepositoryImpl) FindByID(id uint) (*models.Key, error) {
+	var record models.Key
+	if err := r.db.First(&record, id).Error; err != nil {
+		return nil, errors.Wrap(err, "failed to find key by ID")
+	}
+	return &record, nil
+}
+
+// FindByAddress 는 키에 등록된 모든 네트워크 주소로 키를 찾습니다.
+func (r *keyRepositoryImpl) FindByAddress(address string) (*models.Key, error) {
+	var keyAddress models.KeyAddress
+	if err := r.db.Where("address = ?", address).First(&keyAddress).Error; err != nil {
+		return nil, errors.Wrap(err, "failed to find key by address")
+	}
+	return r.FindByID(uint(keyAddress.KeyID))
+}
+
+// FindCompletedByCurves 는 DKG 가 끝난 (공개키가 있는) 키 중 주어진 곡선의 키를 찾습니다.
+func (r *keyRepositoryImpl) FindCompletedByCurves(curves ...int32) ([]*models.Key, error) {
+	var records []*models.Key
+	if err := r.db.Where("public_key <> ? AND curve IN ?", "", curves).Order("id").Find(&records).Error; err != nil {
+		return nil, errors.Wrap(err, "failed to find keys")
+	}
+	return records, nil
+}
+
+// CountUnassigned 는 키 풀에서 DKG 가 끝났고 아직 클라이언트에 할당되지 않은 키의 수를 셉니다.
+func (r *keyRepositoryImpl) CountUnassigned(network int32, addressType int32, curve int32) (int64, error) {
+	var count int64
+	err := r.db.Model(&models.Key{}).
+		Where("public_key <> ? AND client_security_id = ? AND network = ? AND address_type = ? AND curve = ?", "", 0, network, addressType, curve).
+		Count(&count).Error
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to count unassigned keys")
+	}
+	return count, nil
+}
+
+// ClaimUnassigned 는 키 풀의 가장 오래된 키를 클라이언트에 할당합니다.
+// 할당되지 않은 상태일 때만 바꾸므로 같은 키가 두 클라이언트에 할당되지 않습니다.
+func (r *keyRepositoryImpl) ClaimUnassigned(network int32, addressType int32, curve int32, clientSecurityID uint) (*models.Key, error) {
+	var record models.Key
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Where("public_key <> ? AND client_security_id = ? AND network = ? AND address_type = ? AND curve = ?", "", 0, network, addressType, curve).
+			Order("id").First(&record).Error
+		if err != nil {
+			return errors.Wrap(err, "failed to find unassigned key")
+		}
+		result := tx.Model(&models.Key{}).
+			Where("id = ? AND client_security_id = ?", record.ID, 0).
+			Update("client_security_id", clientSecurityID)
+		if result.Error != nil {
+			return errors.Wrap(result.Error, "failed to assign key")
+		}
+		if result.RowsAffected != 1 {
+			return errors.New("key has already been assigned")
+		}
+		record.ClientSecurityID = clientSecurityID
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &record, nil
+}
+
+// Release 는 ClaimUnassigned 를 되돌려 키를 다시 풀에 넣습니다.
+func (r *keyRepositoryImpl) Release(id uint32, clientSecurityID uint) error {
+	err := r.db.Model(&models.Key{}).
+		Where("id = ? AND client_security_id = ?", id, clientSecurityID).
+		Update("client_security_id", 0).Error
+	if err != nil {
+		return errors.Wrap(err, "failed to release key")
+	}
+	return nil
+}
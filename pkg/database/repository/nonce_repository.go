@@ -0,0 +1,108 @@
+package repository
+
+import (
+	"time"
+
+	"tecdsa/pkg/database/models"
+
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+type NonceRepository interface {
+	Reserve(network int32, address string, chainNonce uint64, expiredBefore time.Time) (uint64, error)
+	UpdateStatus(network int32, address string, nonce uint64, from []int32, to int32) (bool, error)
+	FindByAccount(network int32, address string) ([]*models.NonceReservation, error)
+	DeleteByAccount(network int32, address string) error
+}
+
+type nonceRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewNonceRepository(db *gorm.DB) NonceRepository {
+	return &nonceRepositoryImpl{db: db}
+}
+
+// Reserve 는 계정의 다음 논스를 할당합니다. chainNonce 는 노드의 pending 논스입니다.
+// chainNonce 보다 작은 논스는 이미 사용되었으므로 지우고, 해제된 논스나 expiredBefore 전에 할당한 뒤 서명하지 않은 논스 중
+// 가장 작은 것을 다시 할당하며, 없으면 chainNonce 와 할당한 가장 큰 논스 + 1 중 큰 값을 할당합니다.
+// 동시에 같은 논스를 할당하면 유니크 인덱스 때문에 한쪽이 실패하므로 호출자가 다시 시도해야 합니다.
+func (r *nonceRepositoryImpl) Reserve(network int32, address string, chainNonce uint64, expiredBefore time.Time) (uint64, error) {
+	var nonce uint64
+	now := time.Now()
+	reusable := "status = ? OR (status = ? AND reserved_at < ?)"
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Unscoped().Where("network = ? AND address = ? AND nonce < ?", network, address, chainNonce).
+			Delete(&models.NonceReservation{}).Error; err != nil {
+			return errors.Wrap(err, "failed to delete used nonces")
+		}
+
+		var released models.NonceReservation
+		err := tx.Where("network = ? AND address = ?", network, address).
+			Where(reusable, models.NonceReleased, models.NonceReserved, expiredBefore).
+			Order("nonce").First(&released).Error
+		if err == nil {
+			result := tx.Model(&models.NonceReservation{}).
+				Where("id = ?", released.ID).
+				Where(reusable, models.NonceReleased, models.NonceReserved, expiredBefore).
+				Updates(map[string]interface{}{"status": models.NonceReserved, "reserved_at": now})
+			if result.Error != nil {
+				return errors.Wrap(result.Error, "failed to reserve released nonce")
+			}
+			if result.RowsAffected != 1 {
+				return errors.New("nonce has already been reserved")
+			}
+			nonce = released.Nonce
+			return nil
+		}
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.Wrap(err, "failed to find released nonce")
+		}
+
+		nonce = chainNonce
+		var last models.NonceReservation
+		if err := tx.Where("network = ? AND address = ?", network, address).Order("nonce desc").First(&last).Error; err == nil {
+			if last.Nonce+1 > nonce {
+				nonce = last.Nonce + 1
+			}
+		} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.Wrap(err, "failed to find last nonce")
+		}
+		record := &models.NonceReservation{Network: network, Address: address, Nonce: nonce, Status: models.NonceReserved, ReservedAt: now}
+		if err := tx.Create(record).Error; err != nil {
+			return errors.Wrap(err, "failed to reserve nonce")
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return nonce, nil
+}
+
+// UpdateStatus 는 논스의 상태가 from 중 하나일 때만 to 로 바꾸고, 바꿨는지 돌려줍니다.
+func (r *nonceRepositoryImpl) UpdateStatus(network int32, address string, nonce uint64, from []int32, to int32) (bool, error) {
+	result := r.db.Model(&models.NonceReservation{}).
+		Where("network = ? AND address = ? AND nonce = ? AND status IN ?", network, address, nonce, from).
+		Update("status", to)
+	if result.Error != nil {
+		return false, errors.Wrap(result.Error, "failed to update nonce status")
+	}
+	return result.RowsAffected == 1, nil
+}
+
+func (r *nonceRepositoryImpl) FindByAccount(network int32, address string) ([]*models.NonceReservation, error) {
+	var records []*models.NonceReservation
+	if err := r.db.Where("network = ? AND address = ?", network, address).Order("nonce").Find(&records).Error; err != nil {
+		return nil, errors.Wrap(err, "failed to find nonces")
+	}
+	return records, nil
+}
+
+func (r *nonceRepositoryImpl) DeleteByAccount(network int32, address string) error {
+	if err := r.db.Unscoped().Where("network = ? AND address = ?", network, address).Delete(&models.NonceReservation{}).Error; err != nil {
+		return errors.Wrap(err, "failed to delete nonces")
+	}
+	return nil
+}
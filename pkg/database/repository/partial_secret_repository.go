@@ -3,46 +3,111 @@ package repository
 import (
 	"fmt"
 	"tecdsa/pkg/database/models"
+	"tecdsa/pkg/sharestore"
 
 	"github.com/pkg/errors"
 	"gorm.io/gorm"
 )
 
 type ParitalSecretShareRepository interface {
-	Create(address string, share []byte, clientSecurityID uint) error
+	Create(keyID uint32, address string, share []byte, network int32, addressType int32, curve int32, clientSecurityID uint) error
 	FindByAddress(address string) (*models.ParitalSecretShare, error)
+	FindByKeyID(keyID uint32) (*models.ParitalSecretShare, error)
 	FindByClientSecurityID(clientSecurityID uint) ([]*models.ParitalSecretShare, error)
+	AssignClientSecurity(keyID uint32, clientSecurityID uint) error
+	ReleaseClientSecurity(keyID uint32, clientSecurityID uint) error
+	DeleteUnassigned(keyID uint32) error
 }
 
 type paritalSecretShareRepositoryImpl struct {
-	db *gorm.DB
+	db    *gorm.DB
+	store sharestore.ShareStore
 }
 
+// NewPartialSecretShareRepository 는 쉐어 바이트를 같은 데이터베이스의 SQL 쉐어 저장소에 저장합니다.
 func NewPartialSecretShareRepository(db *gorm.DB) ParitalSecretShareRepository {
-	return &paritalSecretShareRepositoryImpl{db: db}
+	return NewPartialSecretShareRepositoryWithStore(db, sharestore.NewSQLStore(db))
 }
 
-func (r *paritalSecretShareRepositoryImpl) Create(address string, share []byte, clientSecurityID uint) error {
+// NewPartialSecretShareRepositoryWithStore 는 쉐어 메타데이터는 db 에, 쉐어 바이트는 store 에 저장합니다.
+func NewPartialSecretShareRepositoryWithStore(db *gorm.DB, store sharestore.ShareStore) ParitalSecretShareRepository {
+	return &paritalSecretShareRepositoryImpl{db: db, store: store}
+}
+
+// Create 는 쉐어와 키 생성 네트워크의 주소를 함께 저장합니다.
+// keyID 가 0 이면 (게이트웨이가 키 ID 를 보내지 않은 경우) 쉐어만 저장합니다.
+// 쉐어 바이트는 쉐어 저장소에 먼저 저장하고, 메타데이터 저장에 실패하면 저장소에서 지웁니다.
+func (r *paritalSecretShareRepositoryImpl) Create(keyID uint32, address string, share []byte, network int32, addressType int32, curve int32, clientSecurityID uint) error {
+	shareRef, err := sharestore.NewRef()
+	if err != nil {
+		return err
+	}
+	if err := r.store.Put(shareRef, share); err != nil {
+		return errors.Wrap(err, "failed to store secret share")
+	}
+
 	secretRecord := models.ParitalSecretShare{
+		KeyID:            keyID,
 		Address:          address,
-		Share:            share,
+		Share:            []byte{},
+		ShareRef:         shareRef,
+		Network:          network,
+		AddressType:      addressType,
+		Curve:            curve,
 		ClientSecurityID: clientSecurityID,
 	}
 
-	if err := r.db.Create(&secretRecord).Error; err != nil {
-		fmt.Println("err: ", err)
-		return errors.Wrap(err, "failed to store secret in database")
+	err = r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&secretRecord).Error; err != nil {
+			fmt.Println("err: ", err)
+			return errors.Wrap(err, "failed to store secret in database")
+		}
+		if keyID == 0 {
+			return nil
+		}
+		keyAddress := models.KeyAddress{
+			KeyID:       keyID,
+			Network:     network,
+			AddressType: addressType,
+			Address:     address,
+		}
+		if err := tx.Create(&keyAddress).Error; err != nil {
+			return errors.Wrap(err, "failed to store key address in database")
+		}
+		return nil
+	})
+	if err != nil {
+		r.store.Delete(shareRef)
+		return err
 	}
-
 	return nil
 }
 
+// FindByAddress 는 키에 등록된 어떤 네트워크 주소로도 같은 쉐어를 찾습니다.
+// 키 ID 없이 저장된 이전 쉐어는 쉐어의 주소로 찾습니다.
 func (r *paritalSecretShareRepositoryImpl) FindByAddress(address string) (*models.ParitalSecretShare, error) {
+	var keyAddress models.KeyAddress
+	err := r.db.Where("address = ?", address).First(&keyAddress).Error
+	if err == nil {
+		return r.FindByKeyID(keyAddress.KeyID)
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.Wrap(err, "failed to retrieve key address from database")
+	}
+
 	var record models.ParitalSecretShare
 	if err := r.db.Where("address = ?", address).First(&record).Error; err != nil {
 		return nil, errors.Wrap(err, "failed to retrieve secret from database")
 	}
-	return &record, nil
+	return r.loadShare(&record)
+}
+
+func (r *paritalSecretShareRepositoryImpl) FindByKeyID(keyID uint32) (*models.ParitalSecretShare, error) {
+	var record models.ParitalSecretShare
+	if err := r.db.Where("key_id = ?", keyID).First(&record).Error; err != nil {
+		return nil, errors.Wrap(err, "failed to retrieve secret from database")
+	}
+	return r.loadShare(&record)
 }
 
 func (r *paritalSecretShareRepositoryImpl) FindByClientSecurityID(clientSecurityID uint) ([]*models.ParitalSecretShare, error) {
@@ -50,5 +115,92 @@ func (r *paritalSecretShareRepositoryImpl) FindByClientSecurityID(clientSecurity
 	if err := r.db.Where("client_security_id = ?", clientSecurityID).Find(&records).Error; err != nil {
 		return nil, errors.Wrap(err, "failed to retrieve secrets from database")
 	}
+	for _, record := range records {
+		if _, err := r.loadShare(record); err != nil {
+			return nil, err
+		}
+	}
 	return records, nil
 }
+
+// loadShare 는 쉐어 저장소에서 쉐어 바이트를 읽어 record.Share 에 채웁니다.
+// ShareRef 가 없는 이전 쉐어는 Share 컬럼의 값을 그대로 사용합니다.
+func (r *paritalSecretShareRepositoryImpl) loadShare(record *models.ParitalSecretShare) (*models.ParitalSecretShare, error) {
+	if record.ShareRef == "" {
+		return record, nil
+	}
+	share, err := r.store.Get(record.ShareRef)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to retrieve secret share")
+	}
+	record.Share = share
+	return record, nil
+}
+
+// AssignClientSecurity 는 풀에서 생성한 (클라이언트가 없는) 쉐어를 클라이언트에 할당합니다.
+// 이미 같은 클라이언트에 할당되어 있으면 성공으로 처리해 게이트웨이가 다시 요청할 수 있도록 합니다.
+func (r *paritalSecretShareRepositoryImpl) AssignClientSecurity(keyID uint32, clientSecurityID uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		var record models.ParitalSecretShare
+		if err := tx.Where("key_id = ?", keyID).First(&record).Error; err != nil {
+			return errors.Wrap(err, "failed to retrieve secret from database")
+		}
+		if record.ClientSecurityID == clientSecurityID {
+			return nil
+		}
+		if record.ClientSecurityID != 0 {
+			return errors.New("secret share is already assigned to another client")
+		}
+		result := tx.Model(&models.ParitalSecretShare{}).
+			Where("id = ? AND client_security_id = ?", record.ID, 0).
+			Update("client_security_id", clientSecurityID)
+		if result.Error != nil {
+			return errors.Wrap(result.Error, "failed to assign secret share")
+		}
+		if result.RowsAffected != 1 {
+			return errors.New("secret share is already assigned to another client")
+		}
+		return nil
+	})
+}
+
+// ReleaseClientSecurity 는 AssignClientSecurity 를 되돌려 쉐어를 다시 풀 상태로 만듭니다.
+func (r *paritalSecretShareRepositoryImpl) ReleaseClientSecurity(keyID uint32, clientSecurityID uint) error {
+	err := r.db.Model(&models.ParitalSecretShare{}).
+		Where("key_id = ? AND client_security_id = ?", keyID, clientSecurityID).
+		Update("client_security_id", 0).Error
+	if err != nil {
+		return errors.Wrap(err, "failed to release secret share")
+	}
+	return nil
+}
+
+// DeleteUnassigned 는 클라이언트에 할당되지 않은 키의 쉐어와 주소를 지웁니다. 키 풀의 DKG 가 중간에 실패했을 때 사용하며,
+// 클라이언트에 할당된 쉐어는 지우지 않습니다. 지울 쉐어가 없으면 아무것도 하지 않습니다.
+func (r *paritalSecretShareRepositoryImpl) DeleteUnassigned(keyID uint32) error {
+	var records []*models.ParitalSecretShare
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("key_id = ? AND client_security_id = ?", keyID, 0).Find(&records).Error; err != nil {
+			return errors.Wrap(err, "failed to retrieve secret from database")
+		}
+		if len(records) == 0 {
+			return nil
+		}
+		if err := tx.Unscoped().Delete(&records).Error; err != nil {
+			return errors.Wrap(err, "failed to delete secret share")
+		}
+		if err := tx.Unscoped().Where("key_id = ?", keyID).Delete(&models.KeyAddress{}).Error; err != nil {
+			return errors.Wrap(err, "failed to delete key addresses")
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, record := range records {
+		if record.ShareRef != "" {
+			r.store.Delete(record.ShareRef)
+		}
+	}
+	return nil
+}
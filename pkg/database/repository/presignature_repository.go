@@ -0,0 +1,115 @@
+package repository
+
+import (
+	"tecdsa/pkg/database/models"
+
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// maxClaimAttempts 는 ClaimAvailable 이 경쟁에서 졌을 때 다시 시도하는 최대 횟수입니다.
+const maxClaimAttempts = 5
+
+var errPresignatureUnavailable = errors.New("presignature has already been used or invalidated")
+
+type PresignatureRepository interface {
+	Create(presignID string, keyID uint32, shareDigest string, data []byte) error
+	Consume(presignID string) (*models.Presignature, error)
+	ClaimAvailable(keyID uint32) (*models.Presignature, error)
+	CountAvailable(keyID uint32) (int64, error)
+	InvalidateByKeyID(keyID uint32) error
+}
+
+type presignatureRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewPresignatureRepository(db *gorm.DB) PresignatureRepository {
+	return &presignatureRepositoryImpl{db: db}
+}
+
+func (r *presignatureRepositoryImpl) Create(presignID string, keyID uint32, shareDigest string, data []byte) error {
+	record := &models.Presignature{
+		PresignID:   presignID,
+		KeyID:       keyID,
+		ShareDigest: shareDigest,
+		Data:        data,
+		Status:      models.PresignatureAvailable,
+	}
+	if err := r.db.Create(record).Error; err != nil {
+		return errors.Wrap(err, "failed to create presignature")
+	}
+	return nil
+}
+
+// Consume 은 presignature 를 사용 완료로 바꾸고 저장된 데이터를 돌려줍니다.
+// 사용 가능 상태일 때만 바꾸므로 같은 presignature 는 한 번만 사용되며, 데이터는 바로 지웁니다.
+func (r *presignatureRepositoryImpl) Consume(presignID string) (*models.Presignature, error) {
+	var record models.Presignature
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("presign_id = ?", presignID).First(&record).Error; err != nil {
+			return errors.Wrap(err, "failed to find presignature")
+		}
+		return markUsed(tx, &record)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &record, nil
+}
+
+// ClaimAvailable 은 키의 가장 오래된 사용 가능한 presignature 를 사용 완료로 바꿔 돌려줍니다.
+// 다른 요청이 같은 presignature 를 먼저 가져가면 다음 presignature 로 다시 시도합니다.
+func (r *presignatureRepositoryImpl) ClaimAvailable(keyID uint32) (*models.Presignature, error) {
+	for attempt := 0; ; attempt++ {
+		var record models.Presignature
+		err := r.db.Transaction(func(tx *gorm.DB) error {
+			if err := tx.Where("key_id = ? AND status = ?", keyID, models.PresignatureAvailable).Order("id").First(&record).Error; err != nil {
+				return errors.Wrap(err, "failed to find available presignature")
+			}
+			return markUsed(tx, &record)
+		})
+		if err == nil {
+			return &record, nil
+		}
+		if errors.Cause(err) != errPresignatureUnavailable || attempt+1 >= maxClaimAttempts {
+			return nil, err
+		}
+	}
+}
+
+func (r *presignatureRepositoryImpl) CountAvailable(keyID uint32) (int64, error) {
+	var count int64
+	if err := r.db.Model(&models.Presignature{}).Where("key_id = ? AND status = ?", keyID, models.PresignatureAvailable).Count(&count).Error; err != nil {
+		return 0, errors.Wrap(err, "failed to count presignatures")
+	}
+	return count, nil
+}
+
+// InvalidateByKeyID 는 키의 사용하지 않은 presignature 를 모두 무효로 만들고 데이터를 지웁니다.
+func (r *presignatureRepositoryImpl) InvalidateByKeyID(keyID uint32) error {
+	err := r.db.Model(&models.Presignature{}).
+		Where("key_id = ? AND status = ?", keyID, models.PresignatureAvailable).
+		Updates(map[string]interface{}{"status": models.PresignatureInvalidated, "data": nil}).Error
+	if err != nil {
+		return errors.Wrap(err, "failed to invalidate presignatures")
+	}
+	return nil
+}
+
+// markUsed 는 다른 요청이 먼저 사용하지 않았을 때만 상태를 바꿉니다.
+func markUsed(tx *gorm.DB, record *models.Presignature) error {
+	if record.Status != models.PresignatureAvailable {
+		return errPresignatureUnavailable
+	}
+	result := tx.Model(&models.Presignature{}).
+		Where("id = ? AND status = ?", record.ID, models.PresignatureAvailable).
+		Updates(map[string]interface{}{"status": models.PresignatureUsed, "data": nil})
+	if result.Error != nil {
+		return errors.Wrap(result.Error, "failed to mark presignature as used")
+	}
+	if result.RowsAffected != 1 {
+		return errPresignatureUnavailable
+	}
+	return nil
+}
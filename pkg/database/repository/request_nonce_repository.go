@@ -0,0 +1,52 @@
+package repository
+
+import (
+	"time"
+
+	"tecdsa/pkg/database/models"
+
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+type RequestNonceRepository interface {
+	Record(clientID uint32, nonce string, expiresAt, now time.Time) (bool, error)
+	DeleteExpired(now time.Time) error
+}
+
+type requestNonceRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewRequestNonceRepository(db *gorm.DB) RequestNonceRepository {
+	return &requestNonceRepositoryImpl{db: db}
+}
+
+// Record 는 (clientID, nonce) 를 expiresAt 까지 기록합니다. now 에 만료되지 않은 같은 기록이 있으면 false 를 돌려줍니다.
+// 여러 게이트웨이가 같은 논스를 동시에 기록하면 유니크 인덱스 때문에 한쪽만 성공합니다.
+func (r *requestNonceRepositoryImpl) Record(clientID uint32, nonce string, expiresAt, now time.Time) (bool, error) {
+	// 만료된 같은 논스는 지워야 유니크 인덱스에 걸리지 않습니다.
+	if err := r.db.Unscoped().Where("client_id = ? AND nonce = ? AND expires_at < ?", clientID, nonce, now).
+		Delete(&models.RequestNonce{}).Error; err != nil {
+		return false, errors.Wrap(err, "failed to delete expired request nonce")
+	}
+
+	record := &models.RequestNonce{ClientID: clientID, Nonce: nonce, ExpiresAt: expiresAt}
+	if err := r.db.Create(record).Error; err != nil {
+		var count int64
+		if countErr := r.db.Model(&models.RequestNonce{}).Where("client_id = ? AND nonce = ?", clientID, nonce).
+			Count(&count).Error; countErr == nil && count > 0 {
+			return false, nil
+		}
+		return false, errors.Wrap(err, "failed to record request nonce")
+	}
+	return true, nil
+}
+
+// DeleteExpired 는 now 전에 만료된 기록을 지웁니다.
+func (r *requestNonceRepositoryImpl) DeleteExpired(now time.Time) error {
+	if err := r.db.Unscoped().Where("expires_at < ?", now).Delete(&models.RequestNonce{}).Error; err != nil {
+		return errors.Wrap(err, "failed to delete expired request nonces")
+	}
+	return nil
+}
@@ -0,0 +1,391 @@
+package deserializer
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/gob"
+	"testing"
+
+	eddsadkg "tecdsa/pkg/eddsa/dkg"
+	eddsasign "tecdsa/pkg/eddsa/sign"
+	"tecdsa/pkg/presign"
+	"tecdsa/pkg/schnorr"
+	pb "tecdsa/proto/payload"
+
+	"github.com/coinbase/kryptology/pkg/core/curves"
+	"github.com/coinbase/kryptology/pkg/tecdsa/dkls/v1/dkg"
+	"github.com/coinbase/kryptology/pkg/tecdsa/dkls/v1/sign"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+// runDkg 는 모든 라운드 메시지를 인코딩, 디코딩해 전달하며 DKLs 키 생성을 실행합니다.
+func runDkg(t *testing.T, curve *curves.Curve) (*dkg.AliceOutput, *dkg.BobOutput) {
+	alice := dkg.NewAlice(curve)
+	bob := dkg.NewBob(curve)
+
+	seed, err := bob.Round1GenerateRandomSeed()
+	require.NoError(t, err)
+	payload, err := EncodeDkgRound1Output(seed)
+	require.NoError(t, err)
+	decodedSeed, err := DecodeDkgRound2Input(payload)
+	require.NoError(t, err)
+
+	round2, err := alice.Round2CommitToProof(decodedSeed)
+	require.NoError(t, err)
+	payload, err = EncodeDkgRound2Output(round2)
+	require.NoError(t, err)
+	decodedRound2, err := DecodeDkgRound3Input(payload)
+	require.NoError(t, err)
+
+	proof3, err := bob.Round3SchnorrProve(decodedRound2)
+	require.NoError(t, err)
+	payload, err = EncodeDkgRound3Output(proof3)
+	require.NoError(t, err)
+	decodedProof3, err := DecodeDkgRound4Input(payload)
+	require.NoError(t, err)
+
+	proof4, err := alice.Round4VerifyAndReveal(decodedProof3)
+	require.NoError(t, err)
+	payload, err = EncodeDkgRound4Output(proof4)
+	require.NoError(t, err)
+	decodedProof4, err := DecodeDkgRound5Input(payload)
+	require.NoError(t, err)
+
+	proof5, err := bob.Round5DecommitmentAndStartOt(decodedProof4)
+	require.NoError(t, err)
+	payload, err = EncodeDkgRound5Output(proof5)
+	require.NoError(t, err)
+	decodedProof5, err := DecodeDkgRound6Input(payload)
+	require.NoError(t, err)
+
+	choices, err := alice.Round6DkgRound2Ot(decodedProof5)
+	require.NoError(t, err)
+	payload, err = EncodeDkgRound6Output(choices)
+	require.NoError(t, err)
+	decodedChoices, err := DecodeDkgRound7Input(payload)
+	require.NoError(t, err)
+
+	challenge, err := bob.Round7DkgRound3Ot(decodedChoices)
+	require.NoError(t, err)
+	payload, err = EncodeDkgRound7Output(challenge)
+	require.NoError(t, err)
+	decodedChallenge, err := DecodeDkgRound8Input(payload)
+	require.NoError(t, err)
+
+	responses, err := alice.Round8DkgRound4Ot(decodedChallenge)
+	require.NoError(t, err)
+	payload, err = EncodeDkgRound8Output(responses)
+	require.NoError(t, err)
+	decodedResponses, err := DecodeDkgRound9Input(payload)
+	require.NoError(t, err)
+
+	openings, err := bob.Round9DkgRound5Ot(decodedResponses)
+	require.NoError(t, err)
+	payload, err = EncodeDkgRound9Output(openings)
+	require.NoError(t, err)
+	decodedOpenings, err := DecodeDkgRound10Input(payload)
+	require.NoError(t, err)
+	require.NoError(t, alice.Round10DkgRound6Ot(decodedOpenings))
+
+	// 저장했다가 다시 읽은 쉐어로 서명할 수 있어야 합니다.
+	aliceShare, err := EncodeAliceDkgOutput(alice.Output())
+	require.NoError(t, err)
+	bobShare, err := EncodeBobDkgOutput(bob.Output())
+	require.NoError(t, err)
+	aliceOutput, err := DecodeAliceDkgResult(aliceShare)
+	require.NoError(t, err)
+	bobOutput, err := DecodeBobDkgResult(bobShare)
+	require.NoError(t, err)
+	return aliceOutput, bobOutput
+}
+
+func runSign(t *testing.T, curve *curves.Curve, aliceOutput *dkg.AliceOutput, bobOutput *dkg.BobOutput, message []byte) *curves.EcdsaSignature {
+	alice := sign.NewAlice(curve, sha256.New(), aliceOutput)
+	bob := sign.NewBob(curve, sha256.New(), bobOutput)
+
+	seed, err := alice.Round1GenerateRandomSeed()
+	require.NoError(t, err)
+	payload, err := EncodeSignRound1Payload(seed)
+	require.NoError(t, err)
+	decodedSeed, err := DecodeSignRound1Payload(payload)
+	require.NoError(t, err)
+
+	round2, err := bob.Round2Initialize(decodedSeed)
+	require.NoError(t, err)
+	payload, err = EncodeSignRound2Payload(round2)
+	require.NoError(t, err)
+	decodedRound2, err := DecodeSignRound2Payload(payload)
+	require.NoError(t, err)
+
+	round3, err := alice.Round3Sign(message, decodedRound2)
+	require.NoError(t, err)
+	payload, err = EncodeSignRound3Payload(round3)
+	require.NoError(t, err)
+	decodedRound3, err := DecodeSignRound3Payload(payload)
+	require.NoError(t, err)
+
+	// Round4Final 은 완성한 서명을 공개키로 검증합니다.
+	require.NoError(t, bob.Round4Final(message, decodedRound3))
+	return bob.Signature
+}
+
+func TestDklsKeyGenAndSign(t *testing.T) {
+	for _, curve := range []*curves.Curve{curves.K256(), curves.P256()} {
+		t.Run(curve.Name, func(t *testing.T) {
+			aliceOutput, bobOutput := runDkg(t, curve)
+			assert.True(t, aliceOutput.PublicKey.Equal(bobOutput.PublicKey))
+			assert.NotNil(t, runSign(t, curve, aliceOutput, bobOutput, []byte("message")))
+		})
+	}
+}
+
+func TestLegacyGobShares(t *testing.T) {
+	curve := curves.K256()
+	alice := dkg.NewAlice(curve)
+	bob := dkg.NewBob(curve)
+	runDkgWithoutEncoding(t, alice, bob)
+
+	// 이전 버전이 저장한 gob 쉐어를 읽을 수 있어야 합니다.
+	legacyAlice := encodeGob(t, alice.Output())
+	legacyBob := encodeGob(t, bob.Output())
+	assert.True(t, IsLegacy(legacyAlice))
+	assert.True(t, IsLegacy(legacyBob))
+
+	aliceOutput, err := DecodeAliceDkgResult(legacyAlice)
+	require.NoError(t, err)
+	bobOutput, err := DecodeBobDkgResult(legacyBob)
+	require.NoError(t, err)
+	assert.True(t, aliceOutput.PublicKey.Equal(alice.Output().PublicKey))
+
+	// 새 형식으로 옮긴 쉐어는 같은 값을 가집니다.
+	migrated, err := EncodeAliceDkgOutput(aliceOutput)
+	require.NoError(t, err)
+	assert.False(t, IsLegacy(migrated))
+	migratedOutput, err := DecodeAliceDkgResult(migrated)
+	require.NoError(t, err)
+	assert.True(t, migratedOutput.SecretKeyShare.Cmp(aliceOutput.SecretKeyShare) == 0)
+	assert.Equal(t, aliceOutput.SeedOtResult, migratedOutput.SeedOtResult)
+
+	assert.NotNil(t, runSign(t, curve, migratedOutput, bobOutput, []byte("message")))
+
+	// Envelope 이전의 라운드 메시지도 읽습니다.
+	seed, err := DecodeSignRound1Payload(encodeGob(t, [32]byte{1, 2, 3}))
+	require.NoError(t, err)
+	assert.Equal(t, [32]byte{1, 2, 3}, seed)
+}
+
+func TestPresignRoundTrip(t *testing.T) {
+	curve := curves.K256()
+	aliceOutput, bobOutput := runDkg(t, curve)
+	alice := presign.NewAlice(curve, aliceOutput)
+	bob := presign.NewBob(curve, bobOutput)
+
+	seed, err := alice.Round1GenerateRandomSeed()
+	require.NoError(t, err)
+	payload, err := EncodePresignRound1Payload(seed)
+	require.NoError(t, err)
+	decodedSeed, err := DecodePresignRound1Payload(payload)
+	require.NoError(t, err)
+
+	round2, err := bob.Round2Initialize(decodedSeed)
+	require.NoError(t, err)
+	payload, err = EncodePresignRound2Payload(round2)
+	require.NoError(t, err)
+	decodedRound2, err := DecodePresignRound2Payload(payload)
+	require.NoError(t, err)
+
+	round3, alicePresignature, err := alice.Round3Presign(decodedRound2)
+	require.NoError(t, err)
+	payload, err = EncodePresignRound3Payload(round3)
+	require.NoError(t, err)
+	decodedRound3, err := DecodePresignRound3Payload(payload)
+	require.NoError(t, err)
+	bobPresignature, err := bob.Round4Presign(decodedRound3)
+	require.NoError(t, err)
+
+	stored, err := EncodeAlicePresignature(alicePresignature)
+	require.NoError(t, err)
+	alicePresignature, err = DecodeAlicePresignature(stored)
+	require.NoError(t, err)
+	stored, err = EncodeBobPresignature(bobPresignature)
+	require.NoError(t, err)
+	bobPresignature, err = DecodeBobPresignature(stored)
+	require.NoError(t, err)
+
+	message := []byte("message")
+	online, err := alicePresignature.Sign(curve, sha256.New(), message)
+	require.NoError(t, err)
+	payload, err = EncodePresignOnlinePayload(online)
+	require.NoError(t, err)
+	decodedOnline, err := DecodePresignOnlinePayload(payload)
+	require.NoError(t, err)
+
+	// Finalize 는 완성한 서명을 공개키로 검증합니다.
+	_, err = bobPresignature.Finalize(curve, sha256.New(), bobOutput.PublicKey, message, decodedOnline)
+	require.NoError(t, err)
+}
+
+func TestTaprootSignRoundTrip(t *testing.T) {
+	curve := curves.K256()
+	aliceOutput, bobOutput := runDkg(t, curve)
+	message := sha256.Sum256([]byte("message"))
+
+	alice, err := schnorr.NewAlice(curve, aliceOutput, message[:])
+	require.NoError(t, err)
+	bob, err := schnorr.NewBob(curve, bobOutput, message[:])
+	require.NoError(t, err)
+
+	round1, err := alice.Round1Commit()
+	require.NoError(t, err)
+	payload, err := EncodeTaprootSignRound1Payload(round1)
+	require.NoError(t, err)
+	decodedRound1, err := DecodeTaprootSignRound1Payload(payload)
+	require.NoError(t, err)
+
+	round2, err := bob.Round2Respond(decodedRound1)
+	require.NoError(t, err)
+	payload, err = EncodeTaprootSignRound2Payload(round2)
+	require.NoError(t, err)
+	decodedRound2, err := DecodeTaprootSignRound2Payload(payload)
+	require.NoError(t, err)
+
+	round3, err := alice.Round3Sign(decodedRound2)
+	require.NoError(t, err)
+	payload, err = EncodeTaprootSignRound3Payload(round3)
+	require.NoError(t, err)
+	decodedRound3, err := DecodeTaprootSignRound3Payload(payload)
+	require.NoError(t, err)
+
+	signature, err := bob.Round4Sign(decodedRound3)
+	require.NoError(t, err)
+	outputKey, err := schnorr.TaprootOutputKey(curve, aliceOutput.PublicKey)
+	require.NoError(t, err)
+	assert.True(t, schnorr.Verify(curve, outputKey, message[:], signature))
+}
+
+func TestEddsaKeyGenAndSign(t *testing.T) {
+	curve := curves.ED25519()
+	aliceDkg := eddsadkg.NewAlice(curve)
+	bobDkg := eddsadkg.NewBob(curve)
+
+	round1, err := aliceDkg.Round1Commit()
+	require.NoError(t, err)
+	payload, err := EncodeEddsaDkgRound1Payload(round1)
+	require.NoError(t, err)
+	decodedRound1, err := DecodeEddsaDkgRound1Payload(payload)
+	require.NoError(t, err)
+
+	round2, err := bobDkg.Round2Prove(decodedRound1)
+	require.NoError(t, err)
+	payload, err = EncodeEddsaDkgRound2Payload(round2)
+	require.NoError(t, err)
+	decodedRound2, err := DecodeEddsaDkgRound2Payload(payload)
+	require.NoError(t, err)
+
+	round3, err := aliceDkg.Round3Reveal(decodedRound2)
+	require.NoError(t, err)
+	payload, err = EncodeEddsaDkgRound3Payload(round3)
+	require.NoError(t, err)
+	decodedRound3, err := DecodeEddsaDkgRound3Payload(payload)
+	require.NoError(t, err)
+	require.NoError(t, bobDkg.Round4Verify(decodedRound3))
+
+	aliceShare, err := EncodeEddsaDkgOutput(aliceDkg.Output())
+	require.NoError(t, err)
+	aliceOutput, err := DecodeEddsaDkgResult(aliceShare)
+	require.NoError(t, err)
+	bobShare, err := EncodeEddsaDkgOutput(bobDkg.Output())
+	require.NoError(t, err)
+	bobOutput, err := DecodeEddsaDkgResult(bobShare)
+	require.NoError(t, err)
+
+	message := []byte("message")
+	alice := eddsasign.NewAlice(curve, aliceOutput, message)
+	bob := eddsasign.NewBob(curve, bobOutput, message)
+
+	signRound1, err := alice.Round1Commit()
+	require.NoError(t, err)
+	payload, err = EncodeEddsaSignRound1Payload(signRound1)
+	require.NoError(t, err)
+	decodedSignRound1, err := DecodeEddsaSignRound1Payload(payload)
+	require.NoError(t, err)
+
+	signRound2, err := bob.Round2Respond(decodedSignRound1)
+	require.NoError(t, err)
+	payload, err = EncodeEddsaSignRound2Payload(signRound2)
+	require.NoError(t, err)
+	decodedSignRound2, err := DecodeEddsaSignRound2Payload(payload)
+	require.NoError(t, err)
+
+	signRound3, err := alice.Round3Sign(decodedSignRound2)
+	require.NoError(t, err)
+	payload, err = EncodeEddsaSignRound3Payload(signRound3)
+	require.NoError(t, err)
+	decodedSignRound3, err := DecodeEddsaSignRound3Payload(payload)
+	require.NoError(t, err)
+
+	signature, err := bob.Round4Sign(decodedSignRound3)
+	require.NoError(t, err)
+	publicKey := ed25519.PublicKey(bobOutput.PublicKey.ToAffineCompressed())
+	assert.True(t, ed25519.Verify(publicKey, message, signature))
+}
+
+func TestEnvelopeRejectsMismatchedPayloads(t *testing.T) {
+	payload, err := EncodeSignRound1Payload([32]byte{1})
+	require.NoError(t, err)
+
+	// 다른 라운드의 메시지는 거부합니다.
+	_, err = DecodeDkgRound2Input(payload)
+	assert.ErrorContains(t, err, "unexpected payload protocol")
+
+	// 알 수 없는 형식 버전은 거부합니다.
+	envelope := &pb.Envelope{}
+	require.NoError(t, proto.Unmarshal(payload[len(envelopeMagic):], envelope))
+	envelope.Version = FormatVersion + 1
+	future, err := proto.Marshal(envelope)
+	require.NoError(t, err)
+	_, err = DecodeSignRound1Payload(append(append([]byte{}, envelopeMagic...), future...))
+	assert.ErrorContains(t, err, "unsupported payload format version")
+
+	// 곡선 원소가 있는 메시지는 곡선이 있어야 합니다.
+	body, err := proto.Marshal(&pb.PresignOnline{EtaSig: []byte{1}})
+	require.NoError(t, err)
+	noCurve, err := proto.Marshal(&pb.Envelope{Version: FormatVersion, Protocol: pb.Protocol_PRESIGN_ONLINE, Payload: body})
+	require.NoError(t, err)
+	_, err = DecodePresignOnlinePayload(append(append([]byte{}, envelopeMagic...), noCurve...))
+	assert.ErrorContains(t, err, "has no curve")
+}
+
+// runDkgWithoutEncoding 은 메시지를 인코딩하지 않고 DKLs 키 생성을 실행합니다.
+func runDkgWithoutEncoding(t *testing.T, alice *dkg.Alice, bob *dkg.Bob) {
+	seed, err := bob.Round1GenerateRandomSeed()
+	require.NoError(t, err)
+	round2, err := alice.Round2CommitToProof(seed)
+	require.NoError(t, err)
+	proof3, err := bob.Round3SchnorrProve(round2)
+	require.NoError(t, err)
+	proof4, err := alice.Round4VerifyAndReveal(proof3)
+	require.NoError(t, err)
+	proof5, err := bob.Round5DecommitmentAndStartOt(proof4)
+	require.NoError(t, err)
+	choices, err := alice.Round6DkgRound2Ot(proof5)
+	require.NoError(t, err)
+	challenge, err := bob.Round7DkgRound3Ot(choices)
+	require.NoError(t, err)
+	responses, err := alice.Round8DkgRound4Ot(challenge)
+	require.NoError(t, err)
+	openings, err := bob.Round9DkgRound5Ot(responses)
+	require.NoError(t, err)
+	require.NoError(t, alice.Round10DkgRound6Ot(openings))
+}
+
+// encodeGob 은 Envelope 이전 버전과 같은 방식으로 값을 gob 인코딩합니다.
+func encodeGob(t *testing.T, value interface{}) []byte {
+	registerTypes()
+	buf := bytes.NewBuffer([]byte{})
+	require.NoError(t, gob.NewEncoder(buf).Encode(value))
+	return buf.Bytes()
+}
@@ -1,9 +1,10 @@
 package deserializer
 
 import (
-	"bytes"
 	"encoding/gob"
 
+	pb "tecdsa/proto/payload"
+
 	"github.com/coinbase/kryptology/pkg/core/curves"
 	"github.com/coinbase/kryptology/pkg/ot/base/simplest"
 	"github.com/coinbase/kryptology/pkg/tecdsa/dkls/v1/dkg"
@@ -11,225 +12,362 @@ import (
 	"github.com/pkg/errors"
 )
 
+// registerTypes 는 Envelope 이전에 gob 으로 저장된 쉐어와 메시지를 디코딩하기 위해 곡선 타입을 등록합니다.
 func registerTypes() {
 	gob.Register(&curves.ScalarK256{})
 	gob.Register(&curves.PointK256{})
 	gob.Register(&curves.ScalarP256{})
 	gob.Register(&curves.PointP256{})
+	gob.Register(&curves.ScalarEd25519{})
+	gob.Register(&curves.PointEd25519{})
 }
 
 func EncodeAliceDkgOutput(result *dkg.AliceOutput) ([]byte, error) {
-	registerTypes()
-	buf := bytes.NewBuffer([]byte{})
-	enc := gob.NewEncoder(buf)
-	if err := enc.Encode(result); err != nil {
-		return nil, errors.WithStack(err)
+	curve, err := pointCurve(result.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	msg := &pb.DklsAliceShare{
+		PublicKey:      encodePoint(result.PublicKey),
+		SecretKeyShare: encodeScalar(result.SecretKeyShare),
+	}
+	if result.SeedOtResult != nil {
+		msg.SeedOtResult = &pb.SeedOtReceiverOutput{
+			PackedRandomChoiceBits:   result.SeedOtResult.PackedRandomChoiceBits,
+			RandomChoiceBits:         make([]uint32, len(result.SeedOtResult.RandomChoiceBits)),
+			OneTimePadDecryptionKeys: make([][]byte, len(result.SeedOtResult.OneTimePadDecryptionKey)),
+		}
+		for i, bit := range result.SeedOtResult.RandomChoiceBits {
+			msg.SeedOtResult.RandomChoiceBits[i] = uint32(bit)
+		}
+		for i, key := range result.SeedOtResult.OneTimePadDecryptionKey {
+			msg.SeedOtResult.OneTimePadDecryptionKeys[i] = append([]byte{}, key[:]...)
+		}
 	}
-	return buf.Bytes(), nil
+	return encodeEnvelope(curve, pb.Protocol_DKLS_ALICE_SHARE, msg)
 }
 
 func EncodeBobDkgOutput(result *dkg.BobOutput) ([]byte, error) {
-	registerTypes()
-	buf := bytes.NewBuffer([]byte{})
-	enc := gob.NewEncoder(buf)
-	if err := enc.Encode(result); err != nil {
-		return nil, errors.WithStack(err)
+	curve, err := pointCurve(result.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	msg := &pb.DklsBobShare{
+		PublicKey:      encodePoint(result.PublicKey),
+		SecretKeyShare: encodeScalar(result.SecretKeyShare),
+	}
+	if result.SeedOtResult != nil {
+		msg.SeedOtResult = &pb.SeedOtSenderOutput{
+			OneTimePadEncryptionKeys: make([]*pb.BytesPair, len(result.SeedOtResult.OneTimePadEncryptionKeys)),
+		}
+		for i, keys := range result.SeedOtResult.OneTimePadEncryptionKeys {
+			msg.SeedOtResult.OneTimePadEncryptionKeys[i] = encodeDigestPair(keys)
+		}
 	}
-	return buf.Bytes(), nil
+	return encodeEnvelope(curve, pb.Protocol_DKLS_BOB_SHARE, msg)
 }
 
+// DecodeAliceDkgResult 는 Alice 의 쉐어를 디코딩합니다. Envelope 이전의 gob 쉐어도 읽습니다.
 func DecodeAliceDkgResult(m []byte) (*dkg.AliceOutput, error) {
-	registerTypes()
-	buf := bytes.NewBuffer(m)
-	dec := gob.NewDecoder(buf)
 	decoded := new(dkg.AliceOutput)
-	if err := dec.Decode(&decoded); err != nil {
-		return nil, errors.WithStack(err)
+	if IsLegacy(m) {
+		if err := decodeGob(m, &decoded); err != nil {
+			return nil, err
+		}
+		return decoded, nil
+	}
+
+	msg := &pb.DklsAliceShare{}
+	curve, err := decodeCurveEnvelope(m, pb.Protocol_DKLS_ALICE_SHARE, msg)
+	if err != nil {
+		return nil, err
+	}
+	if decoded.PublicKey, err = decodePoint(curve, msg.PublicKey); err != nil {
+		return nil, err
+	}
+	if decoded.SecretKeyShare, err = decodeScalar(curve, msg.SecretKeyShare); err != nil {
+		return nil, err
+	}
+	if msg.SeedOtResult != nil {
+		decoded.SeedOtResult = &simplest.ReceiverOutput{
+			PackedRandomChoiceBits:  msg.SeedOtResult.PackedRandomChoiceBits,
+			RandomChoiceBits:        make([]int, len(msg.SeedOtResult.RandomChoiceBits)),
+			OneTimePadDecryptionKey: make([]simplest.OneTimePadDecryptionKey, len(msg.SeedOtResult.OneTimePadDecryptionKeys)),
+		}
+		for i, bit := range msg.SeedOtResult.RandomChoiceBits {
+			decoded.SeedOtResult.RandomChoiceBits[i] = int(bit)
+		}
+		for i, key := range msg.SeedOtResult.OneTimePadDecryptionKeys {
+			if decoded.SeedOtResult.OneTimePadDecryptionKey[i], err = decodeDigest(key); err != nil {
+				return nil, err
+			}
+		}
 	}
 	return decoded, nil
 }
 
+// DecodeBobDkgResult 는 Bob 의 쉐어를 디코딩합니다. Envelope 이전의 gob 쉐어도 읽습니다.
 func DecodeBobDkgResult(m []byte) (*dkg.BobOutput, error) {
-	registerTypes()
-
-	buf := bytes.NewBuffer(m)
-	dec := gob.NewDecoder(buf)
 	decoded := new(dkg.BobOutput)
-	if err := dec.Decode(&decoded); err != nil {
-		return nil, errors.WithStack(err)
+	if IsLegacy(m) {
+		if err := decodeGob(m, &decoded); err != nil {
+			return nil, err
+		}
+		return decoded, nil
+	}
+
+	msg := &pb.DklsBobShare{}
+	curve, err := decodeCurveEnvelope(m, pb.Protocol_DKLS_BOB_SHARE, msg)
+	if err != nil {
+		return nil, err
+	}
+	if decoded.PublicKey, err = decodePoint(curve, msg.PublicKey); err != nil {
+		return nil, err
+	}
+	if decoded.SecretKeyShare, err = decodeScalar(curve, msg.SecretKeyShare); err != nil {
+		return nil, err
+	}
+	if msg.SeedOtResult != nil {
+		decoded.SeedOtResult = &simplest.SenderOutput{
+			OneTimePadEncryptionKeys: make([]simplest.OneTimePadEncryptionKeys, len(msg.SeedOtResult.OneTimePadEncryptionKeys)),
+		}
+		for i, keys := range msg.SeedOtResult.OneTimePadEncryptionKeys {
+			if decoded.SeedOtResult.OneTimePadEncryptionKeys[i], err = decodeDigestPair(keys); err != nil {
+				return nil, err
+			}
+		}
 	}
 	return decoded, nil
 }
 
 func EncodeDkgRound1Output(commitment [32]byte) ([]byte, error) {
-	registerTypes()
-	buf := bytes.NewBuffer([]byte{})
-	enc := gob.NewEncoder(buf)
-	if err := enc.Encode(&commitment); err != nil {
-		return nil, errors.WithStack(err)
-	}
-	return buf.Bytes(), nil
+	return encodeDigest(pb.Protocol_DKLS_DKG_ROUND1, commitment)
 }
 
 func DecodeDkgRound2Input(payload []byte) ([32]byte, error) {
-	buf := bytes.NewBuffer(payload)
-	dec := gob.NewDecoder(buf)
-	decoded := [32]byte{}
-	if err := dec.Decode(&decoded); err != nil {
-		return [32]byte{}, errors.WithStack(err)
-	}
-	return decoded, nil
+	return decodeDigestPayload(payload, pb.Protocol_DKLS_DKG_ROUND1)
 }
 
 func EncodeDkgRound2Output(output *dkg.Round2Output) ([]byte, error) {
-	buf := bytes.NewBuffer([]byte{})
-	enc := gob.NewEncoder(buf)
-	if err := enc.Encode(output); err != nil {
-		return nil, errors.WithStack(err)
-	}
-	return buf.Bytes(), nil
+	return encodeEnvelope(pb.Curve_CURVE_UNSPECIFIED, pb.Protocol_DKLS_DKG_ROUND2, &pb.DklsDkgRound2{
+		Seed:       output.Seed[:],
+		Commitment: output.Commitment,
+	})
 }
 
 func DecodeDkgRound3Input(payload []byte) (*dkg.Round2Output, error) {
-	buf := bytes.NewBuffer(payload)
-	dec := gob.NewDecoder(buf)
 	decoded := new(dkg.Round2Output)
-	if err := dec.Decode(decoded); err != nil {
-		return nil, errors.WithStack(err)
+	if IsLegacy(payload) {
+		if err := decodeGob(payload, decoded); err != nil {
+			return nil, err
+		}
+		return decoded, nil
 	}
+
+	msg := &pb.DklsDkgRound2{}
+	if _, err := decodeEnvelope(payload, pb.Protocol_DKLS_DKG_ROUND2, msg); err != nil {
+		return nil, err
+	}
+	seed, err := decodeDigest(msg.Seed)
+	if err != nil {
+		return nil, err
+	}
+	decoded.Seed = seed
+	decoded.Commitment = msg.Commitment
 	return decoded, nil
 }
 
 func EncodeDkgRound3Output(proof *schnorr.Proof) ([]byte, error) {
-	buf := bytes.NewBuffer([]byte{})
-	enc := gob.NewEncoder(buf)
-	if err := enc.Encode(proof); err != nil {
-		return nil, errors.WithStack(err)
-	}
-	return buf.Bytes(), nil
+	return encodeSchnorrProof(pb.Protocol_DKLS_DKG_ROUND3, proof)
 }
 
 func DecodeDkgRound4Input(payload []byte) (*schnorr.Proof, error) {
-	registerTypes()
-	buf := bytes.NewBuffer(payload)
-	dec := gob.NewDecoder(buf)
-	decoded := new(schnorr.Proof)
-	if err := dec.Decode(decoded); err != nil {
-		return nil, errors.WithStack(err)
-	}
-	return decoded, nil
+	return decodeSchnorrProofPayload(payload, pb.Protocol_DKLS_DKG_ROUND3)
 }
 
 func EncodeDkgRound4Output(proof *schnorr.Proof) ([]byte, error) {
-	buf := bytes.NewBuffer([]byte{})
-	enc := gob.NewEncoder(buf)
-	if err := enc.Encode(proof); err != nil {
-		return nil, errors.WithStack(err)
-	}
-	return buf.Bytes(), nil
+	return encodeSchnorrProof(pb.Protocol_DKLS_DKG_ROUND4, proof)
 }
 
 func DecodeDkgRound5Input(payload []byte) (*schnorr.Proof, error) {
-	buf := bytes.NewBuffer(payload)
-	dec := gob.NewDecoder(buf)
-	decoded := new(schnorr.Proof)
-	if err := dec.Decode(decoded); err != nil {
-		return nil, errors.WithStack(err)
-	}
-	return decoded, nil
+	return decodeSchnorrProofPayload(payload, pb.Protocol_DKLS_DKG_ROUND4)
 }
 
 func EncodeDkgRound5Output(proof *schnorr.Proof) ([]byte, error) {
-	buf := bytes.NewBuffer([]byte{})
-	enc := gob.NewEncoder(buf)
-	if err := enc.Encode(proof); err != nil {
-		return nil, errors.WithStack(err)
-	}
-	return buf.Bytes(), nil
+	return encodeSchnorrProof(pb.Protocol_DKLS_DKG_ROUND5, proof)
 }
 
 func DecodeDkgRound6Input(payload []byte) (*schnorr.Proof, error) {
-	buf := bytes.NewBuffer(payload)
-	dec := gob.NewDecoder(buf)
-	decoded := new(schnorr.Proof)
-	if err := dec.Decode(decoded); err != nil {
-		return nil, errors.WithStack(err)
-	}
-	return decoded, nil
+	return decodeSchnorrProofPayload(payload, pb.Protocol_DKLS_DKG_ROUND5)
 }
 
 func EncodeDkgRound6Output(choices []simplest.ReceiversMaskedChoices) ([]byte, error) {
-	buf := bytes.NewBuffer([]byte{})
-	enc := gob.NewEncoder(buf)
-	if err := enc.Encode(choices); err != nil {
-		return nil, errors.WithStack(err)
-	}
-	return buf.Bytes(), nil
+	return encodeEnvelope(pb.Curve_CURVE_UNSPECIFIED, pb.Protocol_DKLS_DKG_ROUND6, &pb.BytesList{Values: choices})
 }
 
 func DecodeDkgRound7Input(payload []byte) ([]simplest.ReceiversMaskedChoices, error) {
-	buf := bytes.NewBuffer(payload)
-	dec := gob.NewDecoder(buf)
 	decoded := []simplest.ReceiversMaskedChoices{}
-	if err := dec.Decode(&decoded); err != nil {
-		return nil, errors.WithStack(err)
+	if IsLegacy(payload) {
+		if err := decodeGob(payload, &decoded); err != nil {
+			return nil, err
+		}
+		return decoded, nil
 	}
-	return decoded, nil
+
+	msg := &pb.BytesList{}
+	if _, err := decodeEnvelope(payload, pb.Protocol_DKLS_DKG_ROUND6, msg); err != nil {
+		return nil, err
+	}
+	return append(decoded, msg.Values...), nil
 }
 
 func EncodeDkgRound7Output(challenge []simplest.OtChallenge) ([]byte, error) {
-	buf := bytes.NewBuffer([]byte{})
-	enc := gob.NewEncoder(buf)
-	if err := enc.Encode(challenge); err != nil {
-		return nil, errors.WithStack(err)
-	}
-	return buf.Bytes(), nil
+	return encodeDigests(pb.Protocol_DKLS_DKG_ROUND7, challenge)
 }
 
 func DecodeDkgRound8Input(payload []byte) ([]simplest.OtChallenge, error) {
-	buf := bytes.NewBuffer(payload)
-	dec := gob.NewDecoder(buf)
-	decoded := []simplest.OtChallenge{}
-	if err := dec.Decode(&decoded); err != nil {
-		return nil, errors.WithStack(err)
-	}
-	return decoded, nil
+	return decodeDigestsPayload(payload, pb.Protocol_DKLS_DKG_ROUND7)
 }
 
 func EncodeDkgRound8Output(responses []simplest.OtChallengeResponse) ([]byte, error) {
-	buf := bytes.NewBuffer([]byte{})
-	enc := gob.NewEncoder(buf)
-	if err := enc.Encode(responses); err != nil {
-		return nil, errors.WithStack(err)
-	}
-	return buf.Bytes(), nil
+	return encodeDigests(pb.Protocol_DKLS_DKG_ROUND8, responses)
 }
 
 func DecodeDkgRound9Input(payload []byte) ([]simplest.OtChallengeResponse, error) {
-	buf := bytes.NewBuffer(payload)
-	dec := gob.NewDecoder(buf)
-	decoded := []simplest.OtChallengeResponse{}
-	if err := dec.Decode(&decoded); err != nil {
-		return nil, errors.WithStack(err)
-	}
-	return decoded, nil
+	return decodeDigestsPayload(payload, pb.Protocol_DKLS_DKG_ROUND8)
 }
 
 func EncodeDkgRound9Output(opening []simplest.ChallengeOpening) ([]byte, error) {
-	buf := bytes.NewBuffer([]byte{})
-	enc := gob.NewEncoder(buf)
-	if err := enc.Encode(opening); err != nil {
-		return nil, errors.WithStack(err)
+	msg := &pb.BytesPairList{Pairs: make([]*pb.BytesPair, len(opening))}
+	for i, pair := range opening {
+		msg.Pairs[i] = encodeDigestPair(pair)
 	}
-	return buf.Bytes(), nil
+	return encodeEnvelope(pb.Curve_CURVE_UNSPECIFIED, pb.Protocol_DKLS_DKG_ROUND9, msg)
 }
 
 func DecodeDkgRound10Input(payload []byte) ([]simplest.ChallengeOpening, error) {
-	buf := bytes.NewBuffer(payload)
-	dec := gob.NewDecoder(buf)
 	decoded := []simplest.ChallengeOpening{}
-	if err := dec.Decode(&decoded); err != nil {
-		return nil, errors.WithStack(err)
+	if IsLegacy(payload) {
+		if err := decodeGob(payload, &decoded); err != nil {
+			return nil, err
+		}
+		return decoded, nil
+	}
+
+	msg := &pb.BytesPairList{}
+	if _, err := decodeEnvelope(payload, pb.Protocol_DKLS_DKG_ROUND9, msg); err != nil {
+		return nil, err
+	}
+	for _, pair := range msg.Pairs {
+		opening, err := decodeDigestPair(pair)
+		if err != nil {
+			return nil, err
+		}
+		decoded = append(decoded, opening)
+	}
+	return decoded, nil
+}
+
+// encodeDigest 는 32 바이트 값 하나를 보내는 메시지 (커밋먼트, 시드) 를 인코딩합니다.
+func encodeDigest(protocol pb.Protocol, value [32]byte) ([]byte, error) {
+	return encodeEnvelope(pb.Curve_CURVE_UNSPECIFIED, protocol, &pb.Bytes{Value: value[:]})
+}
+
+func decodeDigestPayload(payload []byte, protocol pb.Protocol) ([32]byte, error) {
+	if IsLegacy(payload) {
+		decoded := [32]byte{}
+		err := decodeGob(payload, &decoded)
+		return decoded, err
+	}
+
+	msg := &pb.Bytes{}
+	if _, err := decodeEnvelope(payload, protocol, msg); err != nil {
+		return [32]byte{}, err
+	}
+	return decodeDigest(msg.Value)
+}
+
+func encodeDigests(protocol pb.Protocol, values [][32]byte) ([]byte, error) {
+	msg := &pb.BytesList{Values: make([][]byte, len(values))}
+	for i, value := range values {
+		msg.Values[i] = append([]byte{}, value[:]...)
+	}
+	return encodeEnvelope(pb.Curve_CURVE_UNSPECIFIED, protocol, msg)
+}
+
+func decodeDigestsPayload(payload []byte, protocol pb.Protocol) ([][32]byte, error) {
+	decoded := [][32]byte{}
+	if IsLegacy(payload) {
+		if err := decodeGob(payload, &decoded); err != nil {
+			return nil, err
+		}
+		return decoded, nil
+	}
+
+	msg := &pb.BytesList{}
+	if _, err := decodeEnvelope(payload, protocol, msg); err != nil {
+		return nil, err
+	}
+	for _, value := range msg.Values {
+		digest, err := decodeDigest(value)
+		if err != nil {
+			return nil, err
+		}
+		decoded = append(decoded, digest)
 	}
 	return decoded, nil
 }
+
+func encodeSchnorrProof(protocol pb.Protocol, proof *schnorr.Proof) ([]byte, error) {
+	curve, err := pointCurve(proof.Statement)
+	if err != nil {
+		return nil, err
+	}
+	return encodeEnvelope(curve, protocol, schnorrProofToProto(proof))
+}
+
+func decodeSchnorrProofPayload(payload []byte, protocol pb.Protocol) (*schnorr.Proof, error) {
+	if IsLegacy(payload) {
+		decoded := new(schnorr.Proof)
+		if err := decodeGob(payload, decoded); err != nil {
+			return nil, err
+		}
+		return decoded, nil
+	}
+
+	msg := &pb.SchnorrProof{}
+	curve, err := decodeCurveEnvelope(payload, protocol, msg)
+	if err != nil {
+		return nil, err
+	}
+	return schnorrProofFromProto(curve, msg)
+}
+
+func schnorrProofToProto(proof *schnorr.Proof) *pb.SchnorrProof {
+	if proof == nil {
+		return nil
+	}
+	return &pb.SchnorrProof{
+		C:         encodeScalar(proof.C),
+		S:         encodeScalar(proof.S),
+		Statement: encodePoint(proof.Statement),
+	}
+}
+
+func schnorrProofFromProto(curve *curves.Curve, msg *pb.SchnorrProof) (*schnorr.Proof, error) {
+	if msg == nil {
+		return nil, errors.New("missing schnorr proof")
+	}
+	proof := &schnorr.Proof{}
+	var err error
+	if proof.C, err = decodeScalar(curve, msg.C); err != nil {
+		return nil, err
+	}
+	if proof.S, err = decodeScalar(curve, msg.S); err != nil {
+		return nil, err
+	}
+	if proof.Statement, err = decodePoint(curve, msg.Statement); err != nil {
+		return nil, err
+	}
+	return proof, nil
+}
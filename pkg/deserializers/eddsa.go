@@ -0,0 +1,215 @@
+package deserializer
+
+import (
+	eddsadkg "tecdsa/pkg/eddsa/dkg"
+	eddsasign "tecdsa/pkg/eddsa/sign"
+	pb "tecdsa/proto/payload"
+)
+
+func EncodeEddsaDkgOutput(output *eddsadkg.Output) ([]byte, error) {
+	curve, err := pointCurve(output.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	return encodeEnvelope(curve, pb.Protocol_EDDSA_SHARE, &pb.EddsaShare{
+		SecretKeyShare:     encodeScalar(output.SecretKeyShare),
+		PeerPublicKeyShare: encodePoint(output.PeerPublicKeyShare),
+		PublicKey:          encodePoint(output.PublicKey),
+	})
+}
+
+// DecodeEddsaDkgResult 는 Ed25519 쉐어를 디코딩합니다. Envelope 이전의 gob 쉐어도 읽습니다.
+func DecodeEddsaDkgResult(payload []byte) (*eddsadkg.Output, error) {
+	decoded := &eddsadkg.Output{}
+	if IsLegacy(payload) {
+		if err := decodeGob(payload, &decoded); err != nil {
+			return nil, err
+		}
+		return decoded, nil
+	}
+
+	msg := &pb.EddsaShare{}
+	curve, err := decodeCurveEnvelope(payload, pb.Protocol_EDDSA_SHARE, msg)
+	if err != nil {
+		return nil, err
+	}
+	if decoded.SecretKeyShare, err = decodeScalar(curve, msg.SecretKeyShare); err != nil {
+		return nil, err
+	}
+	if decoded.PeerPublicKeyShare, err = decodePoint(curve, msg.PeerPublicKeyShare); err != nil {
+		return nil, err
+	}
+	if decoded.PublicKey, err = decodePoint(curve, msg.PublicKey); err != nil {
+		return nil, err
+	}
+	return decoded, nil
+}
+
+func EncodeEddsaDkgRound1Payload(output *eddsadkg.Round1Output) ([]byte, error) {
+	return encodeDigest(pb.Protocol_EDDSA_DKG_ROUND1, output.Commitment)
+}
+
+func DecodeEddsaDkgRound1Payload(payload []byte) (*eddsadkg.Round1Output, error) {
+	decoded := &eddsadkg.Round1Output{}
+	if IsLegacy(payload) {
+		if err := decodeGob(payload, &decoded); err != nil {
+			return nil, err
+		}
+		return decoded, nil
+	}
+
+	commitment, err := decodeDigestPayload(payload, pb.Protocol_EDDSA_DKG_ROUND1)
+	if err != nil {
+		return nil, err
+	}
+	decoded.Commitment = commitment
+	return decoded, nil
+}
+
+func EncodeEddsaDkgRound2Payload(output *eddsadkg.Round2Output) ([]byte, error) {
+	return encodeEnvelope(pb.Curve_CURVE_ED25519, pb.Protocol_EDDSA_DKG_ROUND2, &pb.EddsaDkgRound{
+		PublicKeyShare: output.PublicKeyShare,
+		Proof:          eddsaProofToProto(output.Proof),
+	})
+}
+
+func DecodeEddsaDkgRound2Payload(payload []byte) (*eddsadkg.Round2Output, error) {
+	decoded := &eddsadkg.Round2Output{}
+	if IsLegacy(payload) {
+		if err := decodeGob(payload, &decoded); err != nil {
+			return nil, err
+		}
+		return decoded, nil
+	}
+
+	msg := &pb.EddsaDkgRound{}
+	if _, err := decodeEnvelope(payload, pb.Protocol_EDDSA_DKG_ROUND2, msg); err != nil {
+		return nil, err
+	}
+	decoded.PublicKeyShare = msg.PublicKeyShare
+	decoded.Proof = eddsaProofFromProto(msg.Proof)
+	return decoded, nil
+}
+
+func EncodeEddsaDkgRound3Payload(output *eddsadkg.Round3Output) ([]byte, error) {
+	return encodeEnvelope(pb.Curve_CURVE_ED25519, pb.Protocol_EDDSA_DKG_ROUND3, &pb.EddsaDkgRound{
+		PublicKeyShare: output.PublicKeyShare,
+		Proof:          eddsaProofToProto(output.Proof),
+		Salt:           output.Salt[:],
+	})
+}
+
+func DecodeEddsaDkgRound3Payload(payload []byte) (*eddsadkg.Round3Output, error) {
+	decoded := &eddsadkg.Round3Output{}
+	if IsLegacy(payload) {
+		if err := decodeGob(payload, &decoded); err != nil {
+			return nil, err
+		}
+		return decoded, nil
+	}
+
+	msg := &pb.EddsaDkgRound{}
+	if _, err := decodeEnvelope(payload, pb.Protocol_EDDSA_DKG_ROUND3, msg); err != nil {
+		return nil, err
+	}
+	salt, err := decodeDigest(msg.Salt)
+	if err != nil {
+		return nil, err
+	}
+	decoded.PublicKeyShare = msg.PublicKeyShare
+	decoded.Salt = salt
+	decoded.Proof = eddsaProofFromProto(msg.Proof)
+	return decoded, nil
+}
+
+func EncodeEddsaSignRound1Payload(output *eddsasign.Round1Output) ([]byte, error) {
+	return encodeEnvelope(pb.Curve_CURVE_UNSPECIFIED, pb.Protocol_EDDSA_SIGN_ROUND1, &pb.NonceCommitment{
+		Seed:            output.Seed[:],
+		NonceCommitment: output.NonceCommitment[:],
+	})
+}
+
+func DecodeEddsaSignRound1Payload(payload []byte) (*eddsasign.Round1Output, error) {
+	decoded := &eddsasign.Round1Output{}
+	if IsLegacy(payload) {
+		if err := decodeGob(payload, &decoded); err != nil {
+			return nil, err
+		}
+		return decoded, nil
+	}
+
+	msg := &pb.NonceCommitment{}
+	if _, err := decodeEnvelope(payload, pb.Protocol_EDDSA_SIGN_ROUND1, msg); err != nil {
+		return nil, err
+	}
+	var err error
+	if decoded.Seed, err = decodeDigest(msg.Seed); err != nil {
+		return nil, err
+	}
+	if decoded.NonceCommitment, err = decodeDigest(msg.NonceCommitment); err != nil {
+		return nil, err
+	}
+	return decoded, nil
+}
+
+func EncodeEddsaSignRound2Payload(output *eddsasign.Round2Output) ([]byte, error) {
+	return encodeEnvelope(pb.Curve_CURVE_ED25519, pb.Protocol_EDDSA_SIGN_ROUND2, &pb.PartialSignature{
+		Nonce: output.Nonce,
+	})
+}
+
+func DecodeEddsaSignRound2Payload(payload []byte) (*eddsasign.Round2Output, error) {
+	decoded := &eddsasign.Round2Output{}
+	if IsLegacy(payload) {
+		if err := decodeGob(payload, &decoded); err != nil {
+			return nil, err
+		}
+		return decoded, nil
+	}
+
+	msg := &pb.PartialSignature{}
+	if _, err := decodeEnvelope(payload, pb.Protocol_EDDSA_SIGN_ROUND2, msg); err != nil {
+		return nil, err
+	}
+	decoded.Nonce = msg.Nonce
+	return decoded, nil
+}
+
+func EncodeEddsaSignRound3Payload(output *eddsasign.Round3Output) ([]byte, error) {
+	return encodeEnvelope(pb.Curve_CURVE_ED25519, pb.Protocol_EDDSA_SIGN_ROUND3, &pb.PartialSignature{
+		Nonce:            output.Nonce,
+		PartialSignature: output.PartialSignature,
+	})
+}
+
+func DecodeEddsaSignRound3Payload(payload []byte) (*eddsasign.Round3Output, error) {
+	decoded := &eddsasign.Round3Output{}
+	if IsLegacy(payload) {
+		if err := decodeGob(payload, &decoded); err != nil {
+			return nil, err
+		}
+		return decoded, nil
+	}
+
+	msg := &pb.PartialSignature{}
+	if _, err := decodeEnvelope(payload, pb.Protocol_EDDSA_SIGN_ROUND3, msg); err != nil {
+		return nil, err
+	}
+	decoded.Nonce = msg.Nonce
+	decoded.PartialSignature = msg.PartialSignature
+	return decoded, nil
+}
+
+func eddsaProofToProto(proof *eddsadkg.Proof) *pb.EddsaProof {
+	if proof == nil {
+		return nil
+	}
+	return &pb.EddsaProof{Commitment: proof.Commitment, Response: proof.Response}
+}
+
+func eddsaProofFromProto(msg *pb.EddsaProof) *eddsadkg.Proof {
+	if msg == nil {
+		return nil
+	}
+	return &eddsadkg.Proof{Commitment: msg.Commitment, Response: msg.Response}
+}
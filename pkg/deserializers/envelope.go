@@ -0,0 +1,230 @@
+package deserializer
+
+import (
+	"bytes"
+	"encoding/gob"
+	"fmt"
+
+	pb "tecdsa/proto/payload"
+
+	"github.com/coinbase/kryptology/pkg/core/curves"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+)
+
+// FormatVersion 은 현재 인코딩하는 Envelope 의 형식 버전입니다.
+// 스키마를 호환되지 않게 바꾸면 올리고, 이전 버전의 디코딩을 유지합니다.
+const FormatVersion = 1
+
+// envelopeMagic 은 Envelope 앞에 붙이는 바이트입니다.
+// gob 스트림의 첫 바이트는 메시지 길이라 0 이 될 수 없으므로 이전 gob 데이터와 구분됩니다.
+var envelopeMagic = []byte{0x00, 'T', 'E', 'C'}
+
+// IsLegacy 는 payload 가 Envelope 이전의 gob 인코딩인지 확인합니다.
+// 저장된 쉐어를 새 형식으로 옮길 때 사용합니다.
+func IsLegacy(payload []byte) bool {
+	return !bytes.HasPrefix(payload, envelopeMagic)
+}
+
+// PayloadVersion 은 payload 의 Envelope 형식 버전을 돌려줍니다. gob 인코딩이면 0 입니다.
+func PayloadVersion(payload []byte) (uint32, error) {
+	if IsLegacy(payload) {
+		return 0, nil
+	}
+	envelope := &pb.Envelope{}
+	if err := proto.Unmarshal(payload[len(envelopeMagic):], envelope); err != nil {
+		return 0, errors.Wrap(err, "failed to decode envelope")
+	}
+	return envelope.Version, nil
+}
+
+// encodeEnvelope 는 msg 를 protocol 메시지로 직렬화해 Envelope 로 감쌉니다.
+func encodeEnvelope(curve pb.Curve, protocol pb.Protocol, msg proto.Message) ([]byte, error) {
+	body, err := proto.Marshal(msg)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	envelope, err := proto.Marshal(&pb.Envelope{
+		Version:  FormatVersion,
+		Curve:    curve,
+		Protocol: protocol,
+		Payload:  body,
+	})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return append(append([]byte{}, envelopeMagic...), envelope...), nil
+}
+
+// decodeEnvelope 는 Envelope 를 풀어 msg 에 디코딩하고 payload 의 곡선을 돌려줍니다.
+// 곡선 원소가 없는 메시지는 nil 곡선을 돌려줍니다.
+func decodeEnvelope(payload []byte, protocol pb.Protocol, msg proto.Message) (*curves.Curve, error) {
+	envelope := &pb.Envelope{}
+	if err := proto.Unmarshal(payload[len(envelopeMagic):], envelope); err != nil {
+		return nil, errors.Wrap(err, "failed to decode envelope")
+	}
+	if envelope.Version != FormatVersion {
+		return nil, fmt.Errorf("unsupported payload format version %d", envelope.Version)
+	}
+	if envelope.Protocol != protocol {
+		return nil, fmt.Errorf("unexpected payload protocol %s, want %s", envelope.Protocol, protocol)
+	}
+	if err := proto.Unmarshal(envelope.Payload, msg); err != nil {
+		return nil, errors.Wrapf(err, "failed to decode %s payload", protocol)
+	}
+	if envelope.Curve == pb.Curve_CURVE_UNSPECIFIED {
+		return nil, nil
+	}
+	return curveFromProto(envelope.Curve)
+}
+
+// decodeCurveEnvelope 는 곡선 원소가 있는 메시지의 Envelope 를 풉니다.
+func decodeCurveEnvelope(payload []byte, protocol pb.Protocol, msg proto.Message) (*curves.Curve, error) {
+	curve, err := decodeEnvelope(payload, protocol, msg)
+	if err != nil {
+		return nil, err
+	}
+	if curve == nil {
+		return nil, fmt.Errorf("%s payload has no curve", protocol)
+	}
+	return curve, nil
+}
+
+// decodeGob 은 Envelope 이전에 gob 으로 인코딩된 payload 를 디코딩합니다.
+func decodeGob(payload []byte, value interface{}) error {
+	registerTypes()
+	dec := gob.NewDecoder(bytes.NewBuffer(payload))
+	if err := dec.Decode(value); err != nil {
+		return errors.WithStack(err)
+	}
+	return nil
+}
+
+func curveToProto(name string) (pb.Curve, error) {
+	switch name {
+	case curves.K256Name:
+		return pb.Curve_CURVE_SECP256K1, nil
+	case curves.P256Name:
+		return pb.Curve_CURVE_P256, nil
+	case curves.ED25519Name:
+		return pb.Curve_CURVE_ED25519, nil
+	default:
+		return pb.Curve_CURVE_UNSPECIFIED, fmt.Errorf("unsupported curve %s", name)
+	}
+}
+
+func curveFromProto(curve pb.Curve) (*curves.Curve, error) {
+	switch curve {
+	case pb.Curve_CURVE_SECP256K1:
+		return curves.K256(), nil
+	case pb.Curve_CURVE_P256:
+		return curves.P256(), nil
+	case pb.Curve_CURVE_ED25519:
+		return curves.ED25519(), nil
+	default:
+		return nil, fmt.Errorf("unsupported curve %s", curve)
+	}
+}
+
+// pointCurve, scalarCurve 는 인코딩할 곡선 원소가 속한 곡선을 찾습니다.
+func pointCurve(point curves.Point) (pb.Curve, error) {
+	if point == nil {
+		return pb.Curve_CURVE_UNSPECIFIED, errors.New("missing curve point")
+	}
+	return curveToProto(point.CurveName())
+}
+
+func scalarCurve(scalar curves.Scalar) (pb.Curve, error) {
+	if scalar == nil {
+		return pb.Curve_CURVE_UNSPECIFIED, errors.New("missing curve scalar")
+	}
+	return curveToProto(scalar.Point().CurveName())
+}
+
+func encodePoint(point curves.Point) []byte {
+	if point == nil {
+		return nil
+	}
+	return point.ToAffineCompressed()
+}
+
+func encodeScalar(scalar curves.Scalar) []byte {
+	if scalar == nil {
+		return nil
+	}
+	return scalar.Bytes()
+}
+
+func encodeScalars(scalars []curves.Scalar) [][]byte {
+	encoded := make([][]byte, len(scalars))
+	for i, scalar := range scalars {
+		encoded[i] = encodeScalar(scalar)
+	}
+	return encoded
+}
+
+// decodePoint, decodeScalar 는 빈 값을 nil 로 디코딩합니다.
+func decodePoint(curve *curves.Curve, value []byte) (curves.Point, error) {
+	if len(value) == 0 {
+		return nil, nil
+	}
+	point, err := curve.Point.FromAffineCompressed(value)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode curve point")
+	}
+	return point, nil
+}
+
+func decodeScalar(curve *curves.Curve, value []byte) (curves.Scalar, error) {
+	if len(value) == 0 {
+		return nil, nil
+	}
+	scalar, err := curve.Scalar.SetBytes(value)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode curve scalar")
+	}
+	return scalar, nil
+}
+
+func decodeScalars(curve *curves.Curve, values [][]byte, scalars []curves.Scalar) error {
+	if len(values) != len(scalars) {
+		return fmt.Errorf("expected %d scalars, got %d", len(scalars), len(values))
+	}
+	for i, value := range values {
+		scalar, err := decodeScalar(curve, value)
+		if err != nil {
+			return err
+		}
+		scalars[i] = scalar
+	}
+	return nil
+}
+
+// decodeDigest 는 32 바이트 고정 길이 값을 디코딩합니다.
+func decodeDigest(value []byte) ([32]byte, error) {
+	digest := [32]byte{}
+	if len(value) != len(digest) {
+		return digest, fmt.Errorf("expected %d bytes, got %d", len(digest), len(value))
+	}
+	copy(digest[:], value)
+	return digest, nil
+}
+
+func encodeDigestPair(pair [2][32]byte) *pb.BytesPair {
+	return &pb.BytesPair{First: pair[0][:], Second: pair[1][:]}
+}
+
+func decodeDigestPair(pair *pb.BytesPair) ([2][32]byte, error) {
+	decoded := [2][32]byte{}
+	if pair == nil {
+		return decoded, errors.New("missing bytes pair")
+	}
+	var err error
+	if decoded[0], err = decodeDigest(pair.First); err != nil {
+		return decoded, err
+	}
+	if decoded[1], err = decodeDigest(pair.Second); err != nil {
+		return decoded, err
+	}
+	return decoded, nil
+}
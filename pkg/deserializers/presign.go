@@ -0,0 +1,226 @@
+package deserializer
+
+import (
+	"fmt"
+
+	"tecdsa/pkg/presign"
+	"tecdsa/pkg/schnorr"
+	pb "tecdsa/proto/payload"
+
+	"github.com/coinbase/kryptology/pkg/ot/base/simplest"
+	"github.com/pkg/errors"
+)
+
+func EncodePresignRound1Payload(value [simplest.DigestSize]byte) ([]byte, error) {
+	return encodeDigest(pb.Protocol_PRESIGN_ROUND1, value)
+}
+
+func DecodePresignRound1Payload(payload []byte) ([simplest.DigestSize]byte, error) {
+	return decodeDigestPayload(payload, pb.Protocol_PRESIGN_ROUND1)
+}
+
+func EncodePresignRound2Payload(value *presign.Round2Output) ([]byte, error) {
+	curve, err := pointCurve(value.DB)
+	if err != nil {
+		return nil, err
+	}
+	return encodeEnvelope(curve, pb.Protocol_PRESIGN_ROUND2, &pb.DklsSignRound2{
+		KosRound1: kosRound1sToProto(value.MultiplyRound1[:]),
+		Db:        encodePoint(value.DB),
+		Seed:      value.Seed[:],
+	})
+}
+
+func DecodePresignRound2Payload(payload []byte) (*presign.Round2Output, error) {
+	decoded := new(presign.Round2Output)
+	if IsLegacy(payload) {
+		if err := decodeGob(payload, &decoded); err != nil {
+			return nil, err
+		}
+		return decoded, nil
+	}
+
+	msg := &pb.DklsSignRound2{}
+	curve, err := decodeCurveEnvelope(payload, pb.Protocol_PRESIGN_ROUND2, msg)
+	if err != nil {
+		return nil, err
+	}
+	if err := kosRound1sFromProto(msg.KosRound1, decoded.MultiplyRound1[:]); err != nil {
+		return nil, err
+	}
+	if decoded.DB, err = decodePoint(curve, msg.Db); err != nil {
+		return nil, err
+	}
+	if decoded.Seed, err = decodeDigest(msg.Seed); err != nil {
+		return nil, err
+	}
+	return decoded, nil
+}
+
+func EncodePresignRound3Payload(value *presign.Round3Output) ([]byte, error) {
+	curve, err := pointCurve(value.RPrime)
+	if err != nil {
+		return nil, err
+	}
+	msg := &pb.DklsSignRound3{
+		MultiplyRound2: make([]*pb.MultiplyRound2, len(value.MultiplyRound2)),
+		RSchnorrProof:  schnorrProofToProto(value.RSchnorrProof),
+		RPrime:         encodePoint(value.RPrime),
+		EtaPhi:         encodeScalar(value.EtaPhi),
+	}
+	for i, multiply := range value.MultiplyRound2 {
+		if multiply == nil {
+			return nil, errors.New("missing multiply round 2 output")
+		}
+		msg.MultiplyRound2[i] = multiplyRound2ToProto(multiply.COTRound2Output, multiply.R, multiply.U)
+	}
+	return encodeEnvelope(curve, pb.Protocol_PRESIGN_ROUND3, msg)
+}
+
+func DecodePresignRound3Payload(payload []byte) (*presign.Round3Output, error) {
+	decoded := new(presign.Round3Output)
+	if IsLegacy(payload) {
+		if err := decodeGob(payload, &decoded); err != nil {
+			return nil, err
+		}
+		return decoded, nil
+	}
+
+	msg := &pb.DklsSignRound3{}
+	curve, err := decodeCurveEnvelope(payload, pb.Protocol_PRESIGN_ROUND3, msg)
+	if err != nil {
+		return nil, err
+	}
+	if len(msg.MultiplyRound2) != len(decoded.MultiplyRound2) {
+		return nil, fmt.Errorf("expected %d multiply outputs, got %d", len(decoded.MultiplyRound2), len(msg.MultiplyRound2))
+	}
+	for i, multiply := range msg.MultiplyRound2 {
+		output := &schnorr.MultiplyRound2Output{}
+		if output.COTRound2Output, output.U, err = multiplyRound2FromProto(curve, multiply, output.R[:]); err != nil {
+			return nil, err
+		}
+		decoded.MultiplyRound2[i] = output
+	}
+	if decoded.RSchnorrProof, err = schnorrProofFromProto(curve, msg.RSchnorrProof); err != nil {
+		return nil, err
+	}
+	if decoded.RPrime, err = decodePoint(curve, msg.RPrime); err != nil {
+		return nil, err
+	}
+	if decoded.EtaPhi, err = decodeScalar(curve, msg.EtaPhi); err != nil {
+		return nil, err
+	}
+	return decoded, nil
+}
+
+func EncodeAlicePresignature(value *presign.AlicePresignature) ([]byte, error) {
+	curve, err := pointCurve(value.R)
+	if err != nil {
+		return nil, err
+	}
+	return encodeEnvelope(curve, pb.Protocol_ALICE_PRESIGNATURE, &pb.AlicePresignature{
+		R:          encodePoint(value.R),
+		T0:         encodeScalar(value.T0),
+		T1:         encodeScalar(value.T1),
+		Gamma2Hash: encodeScalar(value.Gamma2Hash),
+	})
+}
+
+func DecodeAlicePresignature(payload []byte) (*presign.AlicePresignature, error) {
+	decoded := new(presign.AlicePresignature)
+	if IsLegacy(payload) {
+		if err := decodeGob(payload, &decoded); err != nil {
+			return nil, err
+		}
+		return decoded, nil
+	}
+
+	msg := &pb.AlicePresignature{}
+	curve, err := decodeCurveEnvelope(payload, pb.Protocol_ALICE_PRESIGNATURE, msg)
+	if err != nil {
+		return nil, err
+	}
+	if decoded.R, err = decodePoint(curve, msg.R); err != nil {
+		return nil, err
+	}
+	if decoded.T0, err = decodeScalar(curve, msg.T0); err != nil {
+		return nil, err
+	}
+	if decoded.T1, err = decodeScalar(curve, msg.T1); err != nil {
+		return nil, err
+	}
+	if decoded.Gamma2Hash, err = decodeScalar(curve, msg.Gamma2Hash); err != nil {
+		return nil, err
+	}
+	return decoded, nil
+}
+
+func EncodeBobPresignature(value *presign.BobPresignature) ([]byte, error) {
+	curve, err := pointCurve(value.R)
+	if err != nil {
+		return nil, err
+	}
+	return encodeEnvelope(curve, pb.Protocol_BOB_PRESIGNATURE, &pb.BobPresignature{
+		R:          encodePoint(value.R),
+		Theta:      encodeScalar(value.Theta),
+		T1:         encodeScalar(value.T1),
+		Gamma2Hash: encodeScalar(value.Gamma2Hash),
+	})
+}
+
+func DecodeBobPresignature(payload []byte) (*presign.BobPresignature, error) {
+	decoded := new(presign.BobPresignature)
+	if IsLegacy(payload) {
+		if err := decodeGob(payload, &decoded); err != nil {
+			return nil, err
+		}
+		return decoded, nil
+	}
+
+	msg := &pb.BobPresignature{}
+	curve, err := decodeCurveEnvelope(payload, pb.Protocol_BOB_PRESIGNATURE, msg)
+	if err != nil {
+		return nil, err
+	}
+	if decoded.R, err = decodePoint(curve, msg.R); err != nil {
+		return nil, err
+	}
+	if decoded.Theta, err = decodeScalar(curve, msg.Theta); err != nil {
+		return nil, err
+	}
+	if decoded.T1, err = decodeScalar(curve, msg.T1); err != nil {
+		return nil, err
+	}
+	if decoded.Gamma2Hash, err = decodeScalar(curve, msg.Gamma2Hash); err != nil {
+		return nil, err
+	}
+	return decoded, nil
+}
+
+func EncodePresignOnlinePayload(value *presign.OnlineOutput) ([]byte, error) {
+	curve, err := scalarCurve(value.EtaSig)
+	if err != nil {
+		return nil, err
+	}
+	return encodeEnvelope(curve, pb.Protocol_PRESIGN_ONLINE, &pb.PresignOnline{EtaSig: encodeScalar(value.EtaSig)})
+}
+
+func DecodePresignOnlinePayload(payload []byte) (*presign.OnlineOutput, error) {
+	decoded := new(presign.OnlineOutput)
+	if IsLegacy(payload) {
+		if err := decodeGob(payload, &decoded); err != nil {
+			return nil, err
+		}
+		return decoded, nil
+	}
+
+	msg := &pb.PresignOnline{}
+	curve, err := decodeCurveEnvelope(payload, pb.Protocol_PRESIGN_ONLINE, msg)
+	if err != nil {
+		return nil, err
+	}
+	if decoded.EtaSig, err = decodeScalar(curve, msg.EtaSig); err != nil {
+		return nil, err
+	}
+	return decoded, nil
+}
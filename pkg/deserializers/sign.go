@@ -1,67 +1,220 @@
 package deserializer
 
 import (
-	"bytes"
-	"encoding/gob"
+	"fmt"
 
+	pb "tecdsa/proto/payload"
+
+	"github.com/coinbase/kryptology/pkg/core/curves"
+	"github.com/coinbase/kryptology/pkg/ot/extension/kos"
 	"github.com/coinbase/kryptology/pkg/tecdsa/dkls/v1/sign"
 	"github.com/pkg/errors"
 )
 
 func EncodeSignRound1Payload(commitment [32]byte) ([]byte, error) {
-	registerTypes()
-	buf := bytes.NewBuffer([]byte{})
-	enc := gob.NewEncoder(buf)
-	if err := enc.Encode(&commitment); err != nil {
-		return nil, errors.WithStack(err)
-	}
-	return buf.Bytes(), nil
+	return encodeDigest(pb.Protocol_DKLS_SIGN_ROUND1, commitment)
 }
 
 func DecodeSignRound1Payload(payload []byte) ([32]byte, error) {
-	buf := bytes.NewBuffer(payload)
-	dec := gob.NewDecoder(buf)
-	decoded := [32]byte{}
-	if err := dec.Decode(&decoded); err != nil {
-		return [32]byte{}, errors.WithStack(err)
-	}
-	return decoded, nil
+	return decodeDigestPayload(payload, pb.Protocol_DKLS_SIGN_ROUND1)
 }
 
 func EncodeSignRound2Payload(output *sign.SignRound2Output) ([]byte, error) {
-	buf := bytes.NewBuffer([]byte{})
-	enc := gob.NewEncoder(buf)
-	if err := enc.Encode(output); err != nil {
-		return nil, errors.WithStack(err)
+	curve, err := pointCurve(output.DB)
+	if err != nil {
+		return nil, err
 	}
-	return buf.Bytes(), nil
+	return encodeEnvelope(curve, pb.Protocol_DKLS_SIGN_ROUND2, &pb.DklsSignRound2{
+		KosRound1: kosRound1sToProto(output.KosRound1Outputs[:]),
+		Db:        encodePoint(output.DB),
+		Seed:      output.Seed[:],
+	})
 }
 
 func DecodeSignRound2Payload(payload []byte) (*sign.SignRound2Output, error) {
-	buf := bytes.NewBuffer(payload)
-	dec := gob.NewDecoder(buf)
 	decoded := &sign.SignRound2Output{}
-	if err := dec.Decode(&decoded); err != nil {
-		return nil, errors.WithStack(err)
+	if IsLegacy(payload) {
+		if err := decodeGob(payload, &decoded); err != nil {
+			return nil, err
+		}
+		return decoded, nil
+	}
+
+	msg := &pb.DklsSignRound2{}
+	curve, err := decodeCurveEnvelope(payload, pb.Protocol_DKLS_SIGN_ROUND2, msg)
+	if err != nil {
+		return nil, err
+	}
+	if err := kosRound1sFromProto(msg.KosRound1, decoded.KosRound1Outputs[:]); err != nil {
+		return nil, err
+	}
+	if decoded.DB, err = decodePoint(curve, msg.Db); err != nil {
+		return nil, err
+	}
+	if decoded.Seed, err = decodeDigest(msg.Seed); err != nil {
+		return nil, err
 	}
 	return decoded, nil
 }
 
 func EncodeSignRound3Payload(output *sign.SignRound3Output) ([]byte, error) {
-	buf := bytes.NewBuffer([]byte{})
-	enc := gob.NewEncoder(buf)
-	if err := enc.Encode(output); err != nil {
-		return nil, errors.WithStack(err)
+	curve, err := pointCurve(output.RPrime)
+	if err != nil {
+		return nil, err
+	}
+	msg := &pb.DklsSignRound3{
+		MultiplyRound2: make([]*pb.MultiplyRound2, len(output.MultiplyRound2Outputs)),
+		RSchnorrProof:  schnorrProofToProto(output.RSchnorrProof),
+		RPrime:         encodePoint(output.RPrime),
+		EtaPhi:         encodeScalar(output.EtaPhi),
+		EtaSig:         encodeScalar(output.EtaSig),
 	}
-	return buf.Bytes(), nil
+	for i, multiply := range output.MultiplyRound2Outputs {
+		if multiply == nil {
+			return nil, errors.New("missing multiply round 2 output")
+		}
+		msg.MultiplyRound2[i] = multiplyRound2ToProto(multiply.COTRound2Output, multiply.R, multiply.U)
+	}
+	return encodeEnvelope(curve, pb.Protocol_DKLS_SIGN_ROUND3, msg)
 }
 
 func DecodeSignRound3Payload(payload []byte) (*sign.SignRound3Output, error) {
-	buf := bytes.NewBuffer(payload)
-	dec := gob.NewDecoder(buf)
 	decoded := &sign.SignRound3Output{}
-	if err := dec.Decode(&decoded); err != nil {
-		return nil, errors.WithStack(err)
+	if IsLegacy(payload) {
+		if err := decodeGob(payload, &decoded); err != nil {
+			return nil, err
+		}
+		return decoded, nil
+	}
+
+	msg := &pb.DklsSignRound3{}
+	curve, err := decodeCurveEnvelope(payload, pb.Protocol_DKLS_SIGN_ROUND3, msg)
+	if err != nil {
+		return nil, err
+	}
+	if len(msg.MultiplyRound2) != len(decoded.MultiplyRound2Outputs) {
+		return nil, fmt.Errorf("expected %d multiply outputs, got %d", len(decoded.MultiplyRound2Outputs), len(msg.MultiplyRound2))
+	}
+	for i, multiply := range msg.MultiplyRound2 {
+		output := &sign.MultiplyRound2Output{}
+		if output.COTRound2Output, output.U, err = multiplyRound2FromProto(curve, multiply, output.R[:]); err != nil {
+			return nil, err
+		}
+		decoded.MultiplyRound2Outputs[i] = output
+	}
+	if decoded.RSchnorrProof, err = schnorrProofFromProto(curve, msg.RSchnorrProof); err != nil {
+		return nil, err
+	}
+	if decoded.RPrime, err = decodePoint(curve, msg.RPrime); err != nil {
+		return nil, err
+	}
+	if decoded.EtaPhi, err = decodeScalar(curve, msg.EtaPhi); err != nil {
+		return nil, err
+	}
+	if decoded.EtaSig, err = decodeScalar(curve, msg.EtaSig); err != nil {
+		return nil, err
 	}
 	return decoded, nil
 }
+
+func kosRound1ToProto(output *kos.Round1Output) *pb.KosRound1 {
+	if output == nil {
+		return nil
+	}
+	msg := &pb.KosRound1{
+		U:      make([][]byte, len(output.U)),
+		WPrime: append([]byte{}, output.WPrime[:]...),
+		VPrime: append([]byte{}, output.VPrime[:]...),
+	}
+	for i := range output.U {
+		msg.U[i] = append([]byte{}, output.U[i][:]...)
+	}
+	return msg
+}
+
+func kosRound1FromProto(msg *pb.KosRound1) (*kos.Round1Output, error) {
+	if msg == nil {
+		return nil, errors.New("missing OT extension round 1 output")
+	}
+	output := &kos.Round1Output{}
+	if len(msg.U) != len(output.U) {
+		return nil, fmt.Errorf("expected %d OT extension rows, got %d", len(output.U), len(msg.U))
+	}
+	for i, row := range msg.U {
+		if len(row) != len(output.U[i]) {
+			return nil, fmt.Errorf("expected OT extension row of %d bytes, got %d", len(output.U[i]), len(row))
+		}
+		copy(output.U[i][:], row)
+	}
+	var err error
+	if output.WPrime, err = decodeDigest(msg.WPrime); err != nil {
+		return nil, err
+	}
+	if output.VPrime, err = decodeDigest(msg.VPrime); err != nil {
+		return nil, err
+	}
+	return output, nil
+}
+
+func kosRound1sToProto(outputs []*kos.Round1Output) []*pb.KosRound1 {
+	msgs := make([]*pb.KosRound1, len(outputs))
+	for i, output := range outputs {
+		msgs[i] = kosRound1ToProto(output)
+	}
+	return msgs
+}
+
+func kosRound1sFromProto(msgs []*pb.KosRound1, outputs []*kos.Round1Output) error {
+	if len(msgs) != len(outputs) {
+		return fmt.Errorf("expected %d OT extension outputs, got %d", len(outputs), len(msgs))
+	}
+	for i, msg := range msgs {
+		output, err := kosRound1FromProto(msg)
+		if err != nil {
+			return err
+		}
+		outputs[i] = output
+	}
+	return nil
+}
+
+// multiplyRound2ToProto 는 곱셈 서브 프로토콜의 두 번째 메시지를 인코딩합니다.
+// kryptology 의 sign.MultiplyRound2Output 과 pkg/schnorr 의 MultiplyRound2Output 이 같은 스키마를 사용합니다.
+func multiplyRound2ToProto(cot *kos.Round2Output, r [kos.L]curves.Scalar, u curves.Scalar) *pb.MultiplyRound2 {
+	msg := &pb.MultiplyRound2{
+		R: encodeScalars(r[:]),
+		U: encodeScalar(u),
+	}
+	if cot != nil {
+		msg.Tau = make([][]byte, 0, len(cot.Tau)*kos.OtWidth)
+		for _, row := range cot.Tau {
+			msg.Tau = append(msg.Tau, encodeScalars(row[:])...)
+		}
+	}
+	return msg
+}
+
+// multiplyRound2FromProto 는 r 을 채우고 OT extension 출력과 u 를 돌려줍니다.
+func multiplyRound2FromProto(curve *curves.Curve, msg *pb.MultiplyRound2, r []curves.Scalar) (*kos.Round2Output, curves.Scalar, error) {
+	if msg == nil {
+		return nil, nil, errors.New("missing multiply round 2 output")
+	}
+	if err := decodeScalars(curve, msg.R, r); err != nil {
+		return nil, nil, err
+	}
+	u, err := decodeScalar(curve, msg.U)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cot := &kos.Round2Output{}
+	if len(msg.Tau) != len(cot.Tau)*kos.OtWidth {
+		return nil, nil, fmt.Errorf("expected %d tau scalars, got %d", len(cot.Tau)*kos.OtWidth, len(msg.Tau))
+	}
+	for i := range cot.Tau {
+		if err := decodeScalars(curve, msg.Tau[i*kos.OtWidth:(i+1)*kos.OtWidth], cot.Tau[i][:]); err != nil {
+			return nil, nil, err
+		}
+	}
+	return cot, u, nil
+}
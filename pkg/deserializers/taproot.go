@@ -0,0 +1,112 @@
+package deserializer
+
+import (
+	"tecdsa/pkg/schnorr"
+	pb "tecdsa/proto/payload"
+
+	"github.com/pkg/errors"
+)
+
+func EncodeTaprootSignRound1Payload(output *schnorr.Round1Output) ([]byte, error) {
+	return encodeEnvelope(pb.Curve_CURVE_UNSPECIFIED, pb.Protocol_TAPROOT_SIGN_ROUND1, &pb.NonceCommitment{
+		Seed:            output.Seed[:],
+		NonceCommitment: output.NonceCommitment[:],
+	})
+}
+
+func DecodeTaprootSignRound1Payload(payload []byte) (*schnorr.Round1Output, error) {
+	decoded := &schnorr.Round1Output{}
+	if IsLegacy(payload) {
+		if err := decodeGob(payload, &decoded); err != nil {
+			return nil, err
+		}
+		return decoded, nil
+	}
+
+	msg := &pb.NonceCommitment{}
+	if _, err := decodeEnvelope(payload, pb.Protocol_TAPROOT_SIGN_ROUND1, msg); err != nil {
+		return nil, err
+	}
+	var err error
+	if decoded.Seed, err = decodeDigest(msg.Seed); err != nil {
+		return nil, err
+	}
+	if decoded.NonceCommitment, err = decodeDigest(msg.NonceCommitment); err != nil {
+		return nil, err
+	}
+	return decoded, nil
+}
+
+func EncodeTaprootSignRound2Payload(output *schnorr.Round2Output) ([]byte, error) {
+	return encodeEnvelope(pb.Curve_CURVE_UNSPECIFIED, pb.Protocol_TAPROOT_SIGN_ROUND2, &pb.TaprootSignRound2{
+		Seed:           output.Seed[:],
+		MultiplyRound1: kosRound1ToProto(output.MultiplyRound1),
+		Nonce:          output.Nonce,
+	})
+}
+
+func DecodeTaprootSignRound2Payload(payload []byte) (*schnorr.Round2Output, error) {
+	decoded := &schnorr.Round2Output{}
+	if IsLegacy(payload) {
+		if err := decodeGob(payload, &decoded); err != nil {
+			return nil, err
+		}
+		return decoded, nil
+	}
+
+	msg := &pb.TaprootSignRound2{}
+	if _, err := decodeEnvelope(payload, pb.Protocol_TAPROOT_SIGN_ROUND2, msg); err != nil {
+		return nil, err
+	}
+	var err error
+	if decoded.Seed, err = decodeDigest(msg.Seed); err != nil {
+		return nil, err
+	}
+	if decoded.MultiplyRound1, err = kosRound1FromProto(msg.MultiplyRound1); err != nil {
+		return nil, err
+	}
+	decoded.Nonce = msg.Nonce
+	return decoded, nil
+}
+
+func EncodeTaprootSignRound3Payload(output *schnorr.Round3Output) ([]byte, error) {
+	if output.MultiplyRound2 == nil {
+		return nil, errors.New("missing multiply round 2 output")
+	}
+	curve, err := scalarCurve(output.MultiplyRound2.U)
+	if err != nil {
+		return nil, err
+	}
+	multiply := output.MultiplyRound2
+	return encodeEnvelope(curve, pb.Protocol_TAPROOT_SIGN_ROUND3, &pb.TaprootSignRound3{
+		MultiplyRound2:          multiplyRound2ToProto(multiply.COTRound2Output, multiply.R, multiply.U),
+		Nonce:                   output.Nonce,
+		AdditiveShareCommitment: output.AdditiveShareCommitment,
+		PartialSignature:        output.PartialSignature,
+	})
+}
+
+func DecodeTaprootSignRound3Payload(payload []byte) (*schnorr.Round3Output, error) {
+	decoded := &schnorr.Round3Output{}
+	if IsLegacy(payload) {
+		if err := decodeGob(payload, &decoded); err != nil {
+			return nil, err
+		}
+		return decoded, nil
+	}
+
+	msg := &pb.TaprootSignRound3{}
+	curve, err := decodeCurveEnvelope(payload, pb.Protocol_TAPROOT_SIGN_ROUND3, msg)
+	if err != nil {
+		return nil, err
+	}
+	multiply := &schnorr.MultiplyRound2Output{}
+	if multiply.COTRound2Output, multiply.U, err = multiplyRound2FromProto(curve, msg.MultiplyRound2, multiply.R[:]); err != nil {
+		return nil, err
+	}
+	decoded.MultiplyRound2 = multiply
+	decoded.Nonce = msg.Nonce
+	decoded.AdditiveShareCommitment = msg.AdditiveShareCommitment
+	decoded.PartialSignature = msg.PartialSignature
+	return decoded, nil
+}
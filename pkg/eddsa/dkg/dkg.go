@@ -0,0 +1,249 @@
+// Package dkg 는 두 파티가 Ed25519 키를 덧셈 쉐어(x = xA + xB)로 나눠 생성하는 프로토콜입니다.
+//
+// 신뢰할 수 있는 딜러 없이 각 파티가 자신의 쉐어를 직접 만들고, 공개 쉐어에 대한
+// 지식 증명(Schnorr)을 교환합니다. Alice 는 Bob 의 공개 쉐어를 보기 전에 자신의 공개 쉐어를
+// 커밋하므로 어느 쪽도 공개키를 원하는 값으로 유도할 수 없습니다.
+//
+//	Alice Round1: 공개 쉐어 커밋
+//	Bob   Round2: 공개 쉐어 Xb, 지식 증명
+//	Alice Round3: 증명 검증, 공개 쉐어 Xa, 커밋 솔트, 지식 증명
+//	Bob   Round4: 커밋과 증명 검증
+package dkg
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/subtle"
+	"fmt"
+
+	"github.com/coinbase/kryptology/pkg/core/curves"
+	"github.com/pkg/errors"
+)
+
+const (
+	commitmentLabel = "tecdsa/eddsa/dkg-commitment"
+	proofLabel      = "tecdsa/eddsa/dkg-proof"
+)
+
+// Output 은 한 파티가 저장하는 Ed25519 키 쉐어입니다.
+type Output struct {
+	// SecretKeyShare 는 자신의 덧셈 쉐어입니다.
+	SecretKeyShare curves.Scalar
+	// PeerPublicKeyShare 는 상대 파티의 공개 쉐어입니다. 서명 시 상대의 부분 서명을 검증하는 데 씁니다.
+	PeerPublicKeyShare curves.Point
+	PublicKey          curves.Point
+}
+
+// Proof 는 공개 쉐어의 이산로그에 대한 지식 증명입니다.
+type Proof struct {
+	Commitment []byte
+	Response   []byte
+}
+
+type Round1Output struct {
+	Commitment [32]byte
+}
+
+type Round2Output struct {
+	PublicKeyShare []byte
+	Proof          *Proof
+}
+
+type Round3Output struct {
+	PublicKeyShare []byte
+	Salt           [32]byte
+	Proof          *Proof
+}
+
+type Alice struct {
+	curve       *curves.Curve
+	secretShare curves.Scalar
+	publicShare curves.Point
+	salt        [32]byte
+	commitment  [32]byte
+	output      *Output
+}
+
+type Bob struct {
+	curve       *curves.Curve
+	secretShare curves.Scalar
+	publicShare curves.Point
+	commitment  [32]byte
+	output      *Output
+}
+
+func NewAlice(curve *curves.Curve) *Alice {
+	return &Alice{curve: curve}
+}
+
+func NewBob(curve *curves.Curve) *Bob {
+	return &Bob{curve: curve}
+}
+
+// Round1Commit 은 Alice 의 쉐어를 만들고 공개 쉐어에 커밋합니다.
+func (alice *Alice) Round1Commit() (*Round1Output, error) {
+	if _, err := rand.Read(alice.salt[:]); err != nil {
+		return nil, errors.Wrap(err, "failed to generate commitment salt")
+	}
+	alice.secretShare = alice.curve.Scalar.Random(rand.Reader)
+	alice.publicShare = alice.curve.ScalarBaseMult(alice.secretShare)
+	alice.commitment = commit(alice.salt, alice.publicShare)
+	return &Round1Output{Commitment: alice.commitment}, nil
+}
+
+// Round2Prove 는 Bob 의 쉐어를 만들고 Alice 의 커밋에 묶인 지식 증명을 보냅니다.
+func (bob *Bob) Round2Prove(input *Round1Output) (*Round2Output, error) {
+	bob.commitment = input.Commitment
+	bob.secretShare = bob.curve.Scalar.Random(rand.Reader)
+	bob.publicShare = bob.curve.ScalarBaseMult(bob.secretShare)
+
+	proof, err := prove(bob.curve, bob.secretShare, bob.publicShare, bob.commitment[:])
+	if err != nil {
+		return nil, err
+	}
+	return &Round2Output{
+		PublicKeyShare: bob.publicShare.ToAffineCompressed(),
+		Proof:          proof,
+	}, nil
+}
+
+// Round3Reveal 은 Bob 의 증명을 검증하고 Alice 의 공개 쉐어를 공개합니다.
+func (alice *Alice) Round3Reveal(input *Round2Output) (*Round3Output, error) {
+	bobShare, err := alice.curve.Point.FromAffineCompressed(input.PublicKeyShare)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid public key share from bob")
+	}
+	if err := verify(alice.curve, bobShare, input.Proof, alice.commitment[:]); err != nil {
+		return nil, errors.Wrap(err, "failed to verify bob's proof")
+	}
+
+	proof, err := prove(alice.curve, alice.secretShare, alice.publicShare, aliceProofSession(alice.commitment, input.PublicKeyShare))
+	if err != nil {
+		return nil, err
+	}
+
+	alice.output, err = newOutput(alice.secretShare, alice.publicShare, bobShare)
+	if err != nil {
+		return nil, err
+	}
+	return &Round3Output{
+		PublicKeyShare: alice.publicShare.ToAffineCompressed(),
+		Salt:           alice.salt,
+		Proof:          proof,
+	}, nil
+}
+
+// Round4Verify 는 Alice 의 커밋과 증명을 검증하고 공개키를 계산합니다.
+func (bob *Bob) Round4Verify(input *Round3Output) error {
+	aliceShare, err := bob.curve.Point.FromAffineCompressed(input.PublicKeyShare)
+	if err != nil {
+		return errors.Wrap(err, "invalid public key share from alice")
+	}
+	commitment := commit(input.Salt, aliceShare)
+	if subtle.ConstantTimeCompare(commitment[:], bob.commitment[:]) != 1 {
+		return fmt.Errorf("alice's public key share does not match her commitment")
+	}
+	session := aliceProofSession(bob.commitment, bob.publicShare.ToAffineCompressed())
+	if err := verify(bob.curve, aliceShare, input.Proof, session); err != nil {
+		return errors.Wrap(err, "failed to verify alice's proof")
+	}
+
+	bob.output, err = newOutput(bob.secretShare, bob.publicShare, aliceShare)
+	return err
+}
+
+func (alice *Alice) Output() *Output {
+	return alice.output
+}
+
+func (bob *Bob) Output() *Output {
+	return bob.output
+}
+
+func newOutput(secretShare curves.Scalar, publicShare, peerShare curves.Point) (*Output, error) {
+	publicKey := publicShare.Add(peerShare)
+	if publicKey.IsIdentity() {
+		return nil, fmt.Errorf("public key is the identity")
+	}
+	return &Output{
+		SecretKeyShare:     secretShare,
+		PeerPublicKeyShare: peerShare,
+		PublicKey:          publicKey,
+	}, nil
+}
+
+func commit(salt [32]byte, publicShare curves.Point) [32]byte {
+	h := sha256.New()
+	h.Write([]byte(commitmentLabel))
+	h.Write(salt[:])
+	h.Write(publicShare.ToAffineCompressed())
+	var out [32]byte
+	copy(out[:], h.Sum(nil))
+	return out
+}
+
+func aliceProofSession(commitment [32]byte, bobShare []byte) []byte {
+	return append(append([]byte{}, commitment[:]...), bobShare...)
+}
+
+// prove 는 X = x*G 에 대한 Schnorr 지식 증명을 만듭니다.
+// kryptology 의 zkp/schnorr 는 32바이트 해시를 그대로 스칼라로 읽어 Ed25519 에서는
+// 대부분 실패하므로, SHA-512 를 모듈러 축약해 챌린지를 만듭니다.
+func prove(curve *curves.Curve, secret curves.Scalar, publicShare curves.Point, session []byte) (*Proof, error) {
+	k := curve.Scalar.Random(rand.Reader)
+	commitment := curve.ScalarBaseMult(k)
+	c, err := proofChallenge(curve, publicShare, commitment, session)
+	if err != nil {
+		return nil, err
+	}
+	return &Proof{
+		Commitment: commitment.ToAffineCompressed(),
+		Response:   k.Add(c.Mul(secret)).Bytes(),
+	}, nil
+}
+
+func verify(curve *curves.Curve, publicShare curves.Point, proof *Proof, session []byte) error {
+	if proof == nil {
+		return fmt.Errorf("missing proof")
+	}
+	if publicShare.IsIdentity() || !IsTorsionFree(curve, publicShare) {
+		return fmt.Errorf("public key share is not in the prime-order subgroup")
+	}
+	commitment, err := curve.Point.FromAffineCompressed(proof.Commitment)
+	if err != nil {
+		return errors.Wrap(err, "invalid proof commitment")
+	}
+	response, err := curve.Scalar.SetBytes(proof.Response)
+	if err != nil {
+		return errors.Wrap(err, "invalid proof response")
+	}
+	c, err := proofChallenge(curve, publicShare, commitment, session)
+	if err != nil {
+		return err
+	}
+	if !curve.ScalarBaseMult(response).Equal(commitment.Add(publicShare.Mul(c))) {
+		return fmt.Errorf("schnorr proof verification failed")
+	}
+	return nil
+}
+
+func proofChallenge(curve *curves.Curve, publicShare, commitment curves.Point, session []byte) (curves.Scalar, error) {
+	h := sha512.New()
+	h.Write([]byte(proofLabel))
+	h.Write(session)
+	h.Write(publicShare.ToAffineCompressed())
+	h.Write(commitment.ToAffineCompressed())
+	return curve.Scalar.SetBytesWide(h.Sum(nil))
+}
+
+// IsTorsionFree 는 점이 소수 위수 부분군에 있는지 확인합니다.
+// 작은 위수 성분이 섞인 공개 쉐어나 논스는 공개키와 서명을 검증 불가능하게 만듭니다.
+func IsTorsionFree(curve *curves.Curve, point curves.Point) bool {
+	eight := curve.Scalar.New(8)
+	inverse, err := eight.Invert()
+	if err != nil {
+		return false
+	}
+	return point.Mul(eight).Mul(inverse).Equal(point)
+}
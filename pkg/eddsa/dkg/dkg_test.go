@@ -0,0 +1,86 @@
+package dkg
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/coinbase/kryptology/pkg/core/curves"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// runRounds 는 DKG 를 끝까지 실행합니다. tamperRound2, tamperRound3 이 있으면 메시지를 상대에게 보내기 전에 바꿉니다.
+func runRounds(t *testing.T, tamperRound2 func(*Round2Output), tamperRound3 func(*Round3Output)) (*Alice, *Bob, error) {
+	curve := curves.ED25519()
+	alice := NewAlice(curve)
+	bob := NewBob(curve)
+
+	round1, err := alice.Round1Commit()
+	require.NoError(t, err)
+	round2, err := bob.Round2Prove(round1)
+	require.NoError(t, err)
+	if tamperRound2 != nil {
+		tamperRound2(round2)
+	}
+	round3, err := alice.Round3Reveal(round2)
+	if err != nil {
+		return alice, bob, err
+	}
+	if tamperRound3 != nil {
+		tamperRound3(round3)
+	}
+	return alice, bob, bob.Round4Verify(round3)
+}
+
+func TestDkg(t *testing.T) {
+	alice, bob, err := runRounds(t, nil, nil)
+	require.NoError(t, err)
+
+	curve := curves.ED25519()
+	aliceOutput, bobOutput := alice.Output(), bob.Output()
+	assert.True(t, aliceOutput.PublicKey.Equal(bobOutput.PublicKey))
+	// 공개키는 두 덧셈 쉐어의 합입니다.
+	assert.True(t, curve.ScalarBaseMult(aliceOutput.SecretKeyShare.Add(bobOutput.SecretKeyShare)).Equal(aliceOutput.PublicKey))
+	assert.True(t, curve.ScalarBaseMult(bobOutput.SecretKeyShare).Equal(aliceOutput.PeerPublicKeyShare))
+	assert.True(t, curve.ScalarBaseMult(aliceOutput.SecretKeyShare).Equal(bobOutput.PeerPublicKeyShare))
+}
+
+func TestDkgRejectsTamperedRound2(t *testing.T) {
+	curve := curves.ED25519()
+	randomPoint := func() []byte {
+		return curve.ScalarBaseMult(curve.Scalar.Random(rand.Reader)).ToAffineCompressed()
+	}
+
+	// Bob 의 증명 커밋을 바꾸면 Alice 가 거부합니다.
+	_, _, err := runRounds(t, func(round2 *Round2Output) {
+		round2.Proof.Commitment = randomPoint()
+	}, nil)
+	assert.ErrorContains(t, err, "failed to verify bob's proof")
+
+	// 증명과 다른 공개 쉐어도 거부합니다.
+	_, _, err = runRounds(t, func(round2 *Round2Output) {
+		round2.PublicKeyShare = randomPoint()
+	}, nil)
+	assert.ErrorContains(t, err, "failed to verify bob's proof")
+
+	// 곡선 위의 점이 아닌 커밋은 거부합니다.
+	_, _, err = runRounds(t, func(round2 *Round2Output) {
+		round2.Proof.Commitment = make([]byte, 31)
+	}, nil)
+	assert.Error(t, err)
+}
+
+func TestDkgRejectsTamperedRound3(t *testing.T) {
+	// 커밋과 다른 솔트나 공개 쉐어는 Bob 이 거부합니다.
+	_, bob, err := runRounds(t, nil, func(round3 *Round3Output) {
+		round3.Salt[0] ^= 0x01
+	})
+	assert.ErrorContains(t, err, "does not match her commitment")
+	assert.Nil(t, bob.Output())
+
+	curve := curves.ED25519()
+	_, _, err = runRounds(t, nil, func(round3 *Round3Output) {
+		round3.PublicKeyShare = curve.ScalarBaseMult(curve.Scalar.Random(rand.Reader)).ToAffineCompressed()
+	})
+	assert.ErrorContains(t, err, "does not match her commitment")
+}
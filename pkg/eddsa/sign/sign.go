@@ -0,0 +1,169 @@
+// Package sign 은 덧셈 쉐어로 나뉜 Ed25519 키로 RFC 8032 서명을 만드는 2자간 프로토콜입니다.
+//
+// 논스는 Alice 가 먼저 커밋하고, Bob 이 공개한 뒤 Alice 가 여는 순서로 교환합니다.
+// 결과 서명은 일반 Ed25519 서명과 같아 crypto/ed25519 로 검증됩니다.
+//
+//	Alice Round1: 세션 시드, 논스 커밋
+//	Bob   Round2: 논스 Rb
+//	Alice Round3: 논스 Ra, 부분 서명 sA
+//	Bob   Round4: 커밋과 부분 서명 검증, 최종 서명 (R || s)
+package sign
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/subtle"
+	"fmt"
+
+	"tecdsa/pkg/eddsa/dkg"
+
+	"github.com/coinbase/kryptology/pkg/core/curves"
+	"github.com/pkg/errors"
+)
+
+const nonceCommitmentLabel = "tecdsa/eddsa/nonce-commitment"
+
+type Round1Output struct {
+	Seed            [32]byte
+	NonceCommitment [32]byte
+}
+
+type Round2Output struct {
+	// Nonce 는 Bob 의 논스 점 Rb 입니다.
+	Nonce []byte
+}
+
+type Round3Output struct {
+	// Nonce 는 Alice 의 논스 점 Ra 입니다.
+	Nonce []byte
+	// PartialSignature 는 Alice 의 부분 서명 sA 입니다.
+	PartialSignature []byte
+}
+
+type Alice struct {
+	curve      *curves.Curve
+	output     *dkg.Output
+	message    []byte
+	seed       [32]byte
+	nonce      curves.Scalar
+	noncePoint curves.Point
+}
+
+type Bob struct {
+	curve      *curves.Curve
+	output     *dkg.Output
+	message    []byte
+	aliceRound *Round1Output
+	nonce      curves.Scalar
+	noncePoint curves.Point
+}
+
+// NewAlice 는 message 에 서명할 Alice 를 만듭니다. Ed25519 는 메시지 자체에 서명합니다.
+func NewAlice(curve *curves.Curve, output *dkg.Output, message []byte) *Alice {
+	return &Alice{curve: curve, output: output, message: message}
+}
+
+// NewBob 는 message 에 서명할 Bob 을 만듭니다.
+func NewBob(curve *curves.Curve, output *dkg.Output, message []byte) *Bob {
+	return &Bob{curve: curve, output: output, message: message}
+}
+
+// Round1Commit 은 Alice 의 논스를 만들고 커밋합니다.
+func (alice *Alice) Round1Commit() (*Round1Output, error) {
+	if _, err := rand.Read(alice.seed[:]); err != nil {
+		return nil, errors.Wrap(err, "failed to generate session seed")
+	}
+	alice.nonce = alice.curve.Scalar.Random(rand.Reader)
+	alice.noncePoint = alice.curve.ScalarBaseMult(alice.nonce)
+
+	return &Round1Output{
+		Seed:            alice.seed,
+		NonceCommitment: nonceCommitment(alice.seed, alice.noncePoint),
+	}, nil
+}
+
+// Round2Respond 는 Bob 의 논스를 만들어 공개합니다.
+func (bob *Bob) Round2Respond(input *Round1Output) (*Round2Output, error) {
+	bob.aliceRound = input
+	bob.nonce = bob.curve.Scalar.Random(rand.Reader)
+	bob.noncePoint = bob.curve.ScalarBaseMult(bob.nonce)
+	return &Round2Output{Nonce: bob.noncePoint.ToAffineCompressed()}, nil
+}
+
+// Round3Sign 은 Alice 의 부분 서명 sA = kA + e*xA 를 만듭니다.
+func (alice *Alice) Round3Sign(input *Round2Output) (*Round3Output, error) {
+	bobNonce, err := alice.curve.Point.FromAffineCompressed(input.Nonce)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid nonce from bob")
+	}
+	if !dkg.IsTorsionFree(alice.curve, bobNonce) {
+		return nil, fmt.Errorf("nonce from bob is not in the prime-order subgroup")
+	}
+
+	r := alice.noncePoint.Add(bobNonce)
+	e, err := challenge(alice.curve, r, alice.output.PublicKey, alice.message)
+	if err != nil {
+		return nil, err
+	}
+	partial := alice.nonce.Add(e.Mul(alice.output.SecretKeyShare))
+
+	return &Round3Output{
+		Nonce:            alice.noncePoint.ToAffineCompressed(),
+		PartialSignature: partial.Bytes(),
+	}, nil
+}
+
+// Round4Sign 은 Alice 의 논스와 부분 서명을 검증하고 64바이트 Ed25519 서명을 완성합니다.
+func (bob *Bob) Round4Sign(input *Round3Output) ([]byte, error) {
+	aliceNonce, err := bob.curve.Point.FromAffineCompressed(input.Nonce)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid nonce from alice")
+	}
+	commitment := nonceCommitment(bob.aliceRound.Seed, aliceNonce)
+	if subtle.ConstantTimeCompare(commitment[:], bob.aliceRound.NonceCommitment[:]) != 1 {
+		return nil, fmt.Errorf("alice's nonce does not match her commitment")
+	}
+
+	r := aliceNonce.Add(bob.noncePoint)
+	e, err := challenge(bob.curve, r, bob.output.PublicKey, bob.message)
+	if err != nil {
+		return nil, err
+	}
+
+	// Alice 의 부분 서명 검증: sA*G == Ra + e*Xa
+	alicePartial, err := bob.curve.Scalar.SetBytes(input.PartialSignature)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid partial signature from alice")
+	}
+	if !bob.curve.ScalarBaseMult(alicePartial).Equal(aliceNonce.Add(bob.output.PeerPublicKeyShare.Mul(e))) {
+		return nil, fmt.Errorf("alice's partial signature is invalid")
+	}
+
+	s := alicePartial.Add(bob.nonce.Add(e.Mul(bob.output.SecretKeyShare)))
+	signature := append(r.ToAffineCompressed(), s.Bytes()...)
+	if !ed25519.Verify(bob.output.PublicKey.ToAffineCompressed(), bob.message, signature) {
+		return nil, fmt.Errorf("failed to verify ed25519 signature")
+	}
+	return signature, nil
+}
+
+// challenge 는 RFC 8032 의 e = SHA-512(R || A || M) mod L 입니다.
+func challenge(curve *curves.Curve, r, publicKey curves.Point, message []byte) (curves.Scalar, error) {
+	h := sha512.New()
+	h.Write(r.ToAffineCompressed())
+	h.Write(publicKey.ToAffineCompressed())
+	h.Write(message)
+	return curve.Scalar.SetBytesWide(h.Sum(nil))
+}
+
+func nonceCommitment(seed [32]byte, nonce curves.Point) [32]byte {
+	h := sha256.New()
+	h.Write([]byte(nonceCommitmentLabel))
+	h.Write(seed[:])
+	h.Write(nonce.ToAffineCompressed())
+	var out [32]byte
+	copy(out[:], h.Sum(nil))
+	return out
+}
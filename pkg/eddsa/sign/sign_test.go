@@ -0,0 +1,79 @@
+package sign
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"testing"
+
+	"tecdsa/pkg/eddsa/dkg"
+
+	"github.com/coinbase/kryptology/pkg/core/curves"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func runDkg(t *testing.T, curve *curves.Curve) (*dkg.Output, *dkg.Output) {
+	alice := dkg.NewAlice(curve)
+	bob := dkg.NewBob(curve)
+	round1, err := alice.Round1Commit()
+	require.NoError(t, err)
+	round2, err := bob.Round2Prove(round1)
+	require.NoError(t, err)
+	round3, err := alice.Round3Reveal(round2)
+	require.NoError(t, err)
+	require.NoError(t, bob.Round4Verify(round3))
+	return alice.Output(), bob.Output()
+}
+
+// runSign 은 서명을 끝까지 실행합니다. tamper 가 있으면 Alice 의 Round3 메시지를 Bob 에게 보내기 전에 바꿉니다.
+func runSign(t *testing.T, curve *curves.Curve, aliceOutput, bobOutput *dkg.Output, message []byte, tamper func(*Round3Output)) ([]byte, error) {
+	alice := NewAlice(curve, aliceOutput, message)
+	bob := NewBob(curve, bobOutput, message)
+	round1, err := alice.Round1Commit()
+	require.NoError(t, err)
+	round2, err := bob.Round2Respond(round1)
+	require.NoError(t, err)
+	round3, err := alice.Round3Sign(round2)
+	require.NoError(t, err)
+	if tamper != nil {
+		tamper(round3)
+	}
+	return bob.Round4Sign(round3)
+}
+
+func TestSignVerifiesWithEd25519(t *testing.T) {
+	curve := curves.ED25519()
+	aliceOutput, bobOutput := runDkg(t, curve)
+	publicKey := ed25519.PublicKey(aliceOutput.PublicKey.ToAffineCompressed())
+
+	long := make([]byte, 1024)
+	_, err := rand.Read(long)
+	require.NoError(t, err)
+	for _, message := range [][]byte{{}, []byte("message"), long} {
+		signature, err := runSign(t, curve, aliceOutput, bobOutput, message, nil)
+		require.NoError(t, err)
+		require.Len(t, signature, ed25519.SignatureSize)
+		assert.True(t, ed25519.Verify(publicKey, message, signature))
+		assert.False(t, ed25519.Verify(publicKey, append(message, 0x00), signature))
+	}
+}
+
+func TestSignRejectsTamperedRound3(t *testing.T) {
+	curve := curves.ED25519()
+	aliceOutput, bobOutput := runDkg(t, curve)
+	message := []byte("message")
+
+	// 커밋과 다른 논스는 거부합니다.
+	_, err := runSign(t, curve, aliceOutput, bobOutput, message, func(round3 *Round3Output) {
+		round3.Nonce = curve.ScalarBaseMult(curve.Scalar.Random(rand.Reader)).ToAffineCompressed()
+	})
+	assert.ErrorContains(t, err, "does not match her commitment")
+
+	// 잘못된 부분 서명은 거부합니다.
+	_, err = runSign(t, curve, aliceOutput, bobOutput, message, func(round3 *Round3Output) {
+		partial, err := curve.Scalar.SetBytes(round3.PartialSignature)
+		require.NoError(t, err)
+		round3.PartialSignature = partial.Add(curve.Scalar.One()).Bytes()
+	})
+	assert.ErrorContains(t, err, "partial signature is invalid")
+}
@@ -2,12 +2,12 @@ package network
 
 import (
 	"bytes"
+	"context"
 	"encoding/base64"
-	"encoding/json"
+	"encoding/hex"
 	"fmt"
-	"io"
 	"math/big"
-	"net/http"
+	"strings"
 	"tecdsa/pkg/transaction"
 
 	"github.com/btcsuite/btcd/chaincfg"
@@ -23,6 +23,18 @@ type BitcoinTxRequest struct {
 	To     string `json:"to"`
 	Amount string `json:"amount"` // satoshi
 	Fee    *int64 `json:"fee,omitempty"`
+	// FeeRate 는 sat/vB 단위 수수료율입니다. Fee 와 FeeRate 가 모두 없으면 백엔드의 추정값을 사용합니다.
+	FeeRate *float64 `json:"feeRate,omitempty"`
+	// CoinSelection 은 입력을 고르는 방법 (bnb, largest_first, privacy) 입니다. 기본값은 bnb 입니다.
+	CoinSelection string `json:"coinSelection,omitempty"`
+	// MinConfirmations 는 입력으로 고를 UTXO 의 최소 컨펌 수입니다. 0 이면 미확인 UTXO 도 사용합니다.
+	MinConfirmations int64 `json:"minConfirmations,omitempty"`
+	// UTXOs 는 반드시 입력으로 사용할 UTXO ("txid:vout") 입니다. 부족하면 나머지를 CoinSelection 으로 고릅니다.
+	UTXOs []string `json:"utxos,omitempty"`
+	// PublicKey 는 보내는 주소의 압축 공개키 (hex) 입니다. P2SH-P2WPKH 주소의 입력별 서명 해시와 PSBT 의 키 출처에 사용합니다.
+	PublicKey string `json:"publicKey,omitempty"`
+	// PSBT 를 true 로 주면 extra.psbt 에 UTXO 정보와 키 출처를 채운 BIP174 PSBT (base64) 를 함께 돌려줍니다.
+	PSBT bool `json:"psbt,omitempty"`
 }
 
 type BitcoinOutput struct {
@@ -71,31 +83,42 @@ const (
 	   		더 낮은 트랜잭션 수수료를 가능하게 합니다.
 	*/
 	P2WPKH = 2
+	/*
+	   P2TR (Pay-to-Taproot):
+	   설명: 이는 SegWit v1 (Taproot) 주소 유형입니다.
+	   특징:
+	   		주소가 'bc1p'로 시작합니다 (메인넷의 경우).
+	   		BIP340 Schnorr 서명을 사용하므로 ECDSA 가 아닌 Taproot 서명 프로토콜로 서명해야 합니다.
+	   		스크립트 트리 없이 BIP341 키 경로 트윅을 적용한 출력 키를 사용합니다.
+	*/
+	P2TR = 3
 )
 
-func DeriveBitcoinAddress(point curves.Point, network Network) (string, error) {
+// DeriveBitcoinAddress 는 키 생성 시 선택한 주소 유형(P2PKH, P2SHP2WPKH, P2WPKH)으로 주소를 만듭니다.
+func DeriveBitcoinAddress(point curves.Point, network Network, addrType int) (string, error) {
+	if point.CurveName() != curves.K256Name {
+		return "", fmt.Errorf("bitcoin addresses require a secp256k1 key, got %s", point.CurveName())
+	}
 	pubKeyBytes := point.ToAffineCompressed()
 	if len(pubKeyBytes) == 0 {
 		return "", fmt.Errorf("failed to convert public key to bytes")
 	}
 
-	var params *chaincfg.Params
-	var addrType int
-	switch network {
-	case Bitcoin:
-		params = &chaincfg.MainNetParams
-		addrType = P2PKH // 메인넷
-	case BitcoinTestNet:
-		params = &chaincfg.TestNet3Params
-		addrType = P2PKH // 테스트넷
-	case BitcoinRegTest:
-		params = &chaincfg.RegressionNetParams
-		addrType = P2PKH // 로컬
-	default:
-		return "", fmt.Errorf("unsupported Bitcoin network: %v", network)
+	params, err := BitcoinParams(network)
+	if err != nil {
+		return "", err
 	}
 	fmt.Println("params:", params.Name)
 
+	address, err := bitcoinAddress(pubKeyBytes, params, addrType)
+	if err != nil {
+		return "", err
+	}
+
+	return address.EncodeAddress(), nil
+}
+
+func bitcoinAddress(pubKeyBytes []byte, params *chaincfg.Params, addrType int) (btcutil.Address, error) {
 	var address btcutil.Address
 	var err error
 
@@ -107,43 +130,72 @@ func DeriveBitcoinAddress(point curves.Point, network Network) (string, error) {
 		witnessProg := btcutil.Hash160(pubKeyBytes)
 		witnessAddress, err := btcutil.NewAddressWitnessPubKeyHash(witnessProg, params)
 		if err != nil {
-			return "", fmt.Errorf("failed to create witness address for P2SH-P2WPKH: %w", err)
+			return nil, fmt.Errorf("failed to create witness address for P2SH-P2WPKH: %w", err)
 		}
-		address, err = btcutil.NewAddressScriptHash(witnessAddress.ScriptAddress(), params)
+		redeemScript, err := txscript.PayToAddrScript(witnessAddress)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create redeem script for P2SH-P2WPKH: %w", err)
+		}
+		// 리딤 스크립트(0x0014<hash160>)의 해시가 P2SH 주소가 됩니다.
+		return btcutil.NewAddressScriptHash(redeemScript, params)
 	case P2WPKH:
 		witnessProg := btcutil.Hash160(pubKeyBytes)
 		address, err = btcutil.NewAddressWitnessPubKeyHash(witnessProg, params)
+	case P2TR:
+		return taprootAddress(pubKeyBytes, params)
 	default:
-		return "", fmt.Errorf("unsupported address type: %d", addrType)
+		return nil, fmt.Errorf("unsupported address type: %d", addrType)
 	}
 
 	if err != nil {
-		return "", fmt.Errorf("failed to create address: %w", err)
+		return nil, fmt.Errorf("failed to create address: %w", err)
 	}
 
-	return address.EncodeAddress(), nil
+	return address, nil
 }
 
+// bitcoinFeeTargetBlocks 는 수수료율을 추정할 때 목표로 하는 컨펌 블록 수입니다.
+const bitcoinFeeTargetBlocks = 6
+
 func CreateUnsignedBitcoinTransaction(req interface{}, network Network) (*transaction.UnsignedTransaction, error) {
 	btcReq, ok := req.(BitcoinTxRequest)
 	if !ok {
 		return nil, fmt.Errorf("invalid request type for Bitcoin transaction")
 	}
 
-	var params *chaincfg.Params
-	switch network {
-	case Bitcoin:
-		params = &chaincfg.MainNetParams
-	case BitcoinTestNet:
-		params = &chaincfg.TestNet3Params
-	default:
-		return nil, fmt.Errorf("unsupported Bitcoin network: %v", network)
+	backend, err := NewBitcoinBackend(network)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), bitcoinBackendTimeout)
+	defer cancel()
+	return buildBitcoinTransaction(ctx, backend, btcReq, network)
+}
+
+// buildBitcoinTransaction 은 백엔드에서 UTXO 와 수수료율을 받아 서명 전 트랜잭션을 만듭니다.
+func buildBitcoinTransaction(ctx context.Context, backend BitcoinBackend, btcReq BitcoinTxRequest, network Network) (*transaction.UnsignedTransaction, error) {
+	params, err := BitcoinParams(network)
+	if err != nil {
+		return nil, err
 	}
 
 	// Validate addresses
 	if !IsValidBitcoinAddress(btcReq.From, network) {
 		return nil, fmt.Errorf("invalid 'from' address: %s", btcReq.From)
 	}
+	// 서명 시 scriptSig / witness 형식은 보내는 주소의 유형을 따릅니다.
+	addrType, err := BitcoinAddressType(btcReq.From, network)
+	if err != nil {
+		return nil, err
+	}
+	fromAddress, err := decodeBitcoinAddress(btcReq.From, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode from address: %v", err)
+	}
+	fromPkScript, err := payToAddrScript(fromAddress)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create from pkScript: %v", err)
+	}
 	if !IsValidBitcoinAddress(btcReq.To, network) {
 		return nil, fmt.Errorf("invalid 'to' address: %s", btcReq.To)
 	}
@@ -154,78 +206,135 @@ func CreateUnsignedBitcoinTransaction(req interface{}, network Network) (*transa
 		return nil, fmt.Errorf("invalid amount: %s", btcReq.Amount)
 	}
 
+	if btcReq.FeeRate != nil && *btcReq.FeeRate <= 0 {
+		return nil, fmt.Errorf("invalid fee rate: %v", *btcReq.FeeRate)
+	}
+	if btcReq.MinConfirmations < 0 {
+		return nil, fmt.Errorf("invalid minConfirmations: %d", btcReq.MinConfirmations)
+	}
+
+	toAddress, err := decodeBitcoinAddress(btcReq.To, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode to address: %v", err)
+	}
+	pkScript, err := payToAddrScript(toAddress)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create pkScript: %v", err)
+	}
+	if !amount.IsInt64() || amount.Int64() < bitcoinDustThreshold(pkScript) {
+		return nil, fmt.Errorf("amount must be at least the dust threshold of %d satoshis", bitcoinDustThreshold(pkScript))
+	}
+
 	// Get UTXOs for the from address
-	utxos, err := GetUnspentTxs(btcReq.From)
+	utxos, err := backend.UnspentOutputs(ctx, btcReq.From)
+	if err != nil {
+		return nil, err
+	}
+	pinned, candidates, err := filterBitcoinUTXOs(ctx, backend, utxos, btcReq.UTXOs, btcReq.MinConfirmations)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get UTXOs: %v", err)
+		return nil, err
 	}
 
-	fmt.Printf("Found %d UTXOs\n", len(utxos))
+	// Calculate fee
+	var feeRate float64
+	if btcReq.Fee == nil {
+		if btcReq.FeeRate != nil {
+			feeRate = *btcReq.FeeRate
+		} else if feeRate, err = backend.EstimateFeeRate(ctx, bitcoinFeeTargetBlocks); err != nil {
+			return nil, err
+		}
+	}
 
-	tx := wire.NewMsgTx(wire.TxVersion)
+	strategy := btcReq.CoinSelection
+	if strategy == "" {
+		strategy = CoinSelectionBranchAndBound
+	}
+	selection, err := selectBitcoinCoins(bitcoinCoinSelectionRequest{
+		Strategy:     strategy,
+		Amount:       amount.Int64(),
+		AddressType:  addrType,
+		Outputs:      [][]byte{pkScript},
+		ChangeScript: fromPkScript,
+		FeeRate:      feeRate,
+		Fee:          btcReq.Fee,
+		Pinned:       pinned,
+		Candidates:   candidates,
+	})
+	if err != nil {
+		return nil, err
+	}
 
+	tx := wire.NewMsgTx(wire.TxVersion)
 	var totalInput int64
-	for _, utxo := range utxos {
-		if totalInput >= amount.Int64() {
-			break
-		}
+	var inputs []BitcoinTxInput
+	for _, utxo := range selection.Inputs {
 		hash, err := chainhash.NewHashFromStr(utxo.TxID)
 		if err != nil {
 			return nil, fmt.Errorf("failed to parse txid: %v", err)
 		}
-		outPoint := wire.NewOutPoint(hash, utxo.Vout)
-		txIn := wire.NewTxIn(outPoint, nil, nil)
-		tx.AddTxIn(txIn)
+		tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(hash, utxo.Vout), nil, nil))
 		totalInput += utxo.Value
+		inputs = append(inputs, BitcoinTxInput{
+			TxID:     utxo.TxID,
+			Vout:     utxo.Vout,
+			Amount:   utxo.Value,
+			PkScript: hex.EncodeToString(fromPkScript),
+		})
 	}
 
-	if totalInput < amount.Int64() {
-		return nil, fmt.Errorf("insufficient funds: total input %d is less than required amount %d", totalInput, amount.Int64())
+	// 잔돈이 더스트 한계보다 작으면 잔돈 출력 없이 수수료에 더해져 있습니다.
+	tx.AddTxOut(wire.NewTxOut(amount.Int64(), pkScript))
+	if selection.Change > 0 {
+		changeOut := wire.NewTxOut(selection.Change, fromPkScript)
+		tx.TxOut = append(tx.TxOut[:selection.ChangeIndex], append([]*wire.TxOut{changeOut}, tx.TxOut[selection.ChangeIndex:]...)...)
 	}
+	fee := selection.Fee
 
-	// Add the output
-	toAddress, err := btcutil.DecodeAddress(btcReq.To, params)
-	if err != nil {
-		return nil, fmt.Errorf("failed to decode to address: %v", err)
-	}
-	pkScript, err := txscript.PayToAddrScript(toAddress)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create pkScript: %v", err)
+	// 수수료가 입력 금액의 50%를 초과하지 않도록 합니다.
+	if fee > totalInput/2 {
+		return nil, fmt.Errorf("fee is too high: %d satoshis", fee)
 	}
-	tx.AddTxOut(wire.NewTxOut(amount.Int64(), pkScript))
 
-	// Calculate fee
-	estimatedSize := tx.SerializeSize() + 100 // Add some buffer for signatures
-	feeRate := int64(20)                      // 20 satoshis per byte
-	fee := int64(estimatedSize) * feeRate
-	if btcReq.Fee != nil {
-		fee = *btcReq.Fee
+	var pubKeyBytes []byte
+	if btcReq.PublicKey != "" {
+		if pubKeyBytes, err = bitcoinPublicKey(btcReq.PublicKey, btcReq.From, addrType, params); err != nil {
+			return nil, err
+		}
 	}
-
-	if totalInput < amount.Int64()+fee {
-		return nil, fmt.Errorf("insufficient funds: have %d satoshis, need %d satoshis", totalInput, amount.Int64()+fee)
+	// P2SH 주소에서는 공개키 해시를 알 수 없으므로 공개키가 있을 때만 서명 해시를 기록합니다.
+	var pubKeyHash []byte
+	switch addrType {
+	case P2WPKH:
+		pubKeyHash = fromAddress.ScriptAddress()
+	case P2SHP2WPKH:
+		if pubKeyBytes != nil {
+			pubKeyHash = btcutil.Hash160(pubKeyBytes)
+		}
 	}
-
-	// Add change output if necessary
-	changeAmount := totalInput - amount.Int64() - fee
-	if changeAmount > 546 { // Dust limit
-		fromAddress, err := btcutil.DecodeAddress(btcReq.From, params)
-		if err != nil {
-			return nil, fmt.Errorf("failed to decode from address: %v", err)
+	extra := BitcoinTxExtra{
+		From:          btcReq.From,
+		To:            btcReq.To,
+		Amount:        btcReq.Amount,
+		Fee:           fee,
+		FeeRate:       feeRate,
+		VSize:         selection.VSize,
+		CoinSelection: strategy,
+		AddressType:   addrType,
+		Inputs:        inputs,
+	}
+	if addrType != P2SHP2WPKH || pubKeyHash != nil {
+		if err := setBitcoinSigHashes(tx, &extra, pubKeyHash); err != nil {
+			return nil, err
 		}
-		changePkScript, err := txscript.PayToAddrScript(fromAddress)
+	}
+	if btcReq.PSBT {
+		psbt, err := newBitcoinTransactionPSBT(ctx, backend, tx, &extra, fromPkScript, pubKeyBytes)
 		if err != nil {
-			return nil, fmt.Errorf("failed to create change pkScript: %v", err)
+			return nil, err
+		}
+		if extra.PSBT, err = psbt.Encode(); err != nil {
+			return nil, fmt.Errorf("failed to encode PSBT: %v", err)
 		}
-		tx.AddTxOut(wire.NewTxOut(changeAmount, changePkScript))
-	} else {
-		// 잔액이 더스트 한계보다 작으면 수수료에 추가
-		fee += changeAmount
-	}
-
-	// 수수료가 입력 금액의 50%를 초과하지 않도록 합니다.
-	if fee > totalInput/2 {
-		return nil, fmt.Errorf("fee is too high: %d satoshis", fee)
 	}
 
 	var buf bytes.Buffer
@@ -237,42 +346,12 @@ func CreateUnsignedBitcoinTransaction(req interface{}, network Network) (*transa
 	unsignedTx := &transaction.UnsignedTransaction{
 		NetworkID:               network.ID(),
 		UnSignedTxEncodedBase64: base64.StdEncoding.EncodeToString(buf.Bytes()),
-		Extra: map[string]interface{}{
-			"from":   btcReq.From,
-			"to":     btcReq.To,
-			"amount": btcReq.Amount,
-			"fee":    fee,
-		},
+		Extra:                   extra,
 	}
 
 	return unsignedTx, nil
 }
 
-func GetUnspentTxs(address string) ([]UTXO, error) {
-	url := fmt.Sprintf("https://mempool.space/testnet/api/address/%s/utxo", address)
-	resp, err := http.Get(url)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get UTXOs: %v", err)
-	}
-	defer resp.Body.Close()
-
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read response body: %v", err)
-	}
-
-	// 디버깅을 위해 응답 내용 출력
-	fmt.Printf("Unspent Txs API Response: %s\n", string(body))
-
-	var utxos []UTXO
-	err = json.Unmarshal(body, &utxos)
-	if err != nil {
-		return nil, fmt.Errorf("failed to unmarshal UTXOs: %v", err)
-	}
-
-	return utxos, nil
-}
-
 // func combineSignatureWithTransaction(tx *wire.MsgTx, r, s *big.Int, pubKey *btcec.PublicKey) error {
 // 	for i := range tx.TxIn {
 // 		signature := ecdsa.Sign(pubKey, r, s)
@@ -288,21 +367,77 @@ func GetUnspentTxs(address string) ([]UTXO, error) {
 // 	return nil
 // }
 
+// bitcoinPublicKey 는 공개키 (압축, hex) 가 address 의 키인지 확인하고 공개키를 돌려줍니다.
+func bitcoinPublicKey(publicKey string, address string, addrType int, params *chaincfg.Params) ([]byte, error) {
+	pubKeyBytes, err := hex.DecodeString(publicKey)
+	if err != nil || len(pubKeyBytes) != 33 {
+		return nil, fmt.Errorf("invalid publicKey: expected a compressed public key in hex")
+	}
+	derived, err := bitcoinAddress(pubKeyBytes, params, addrType)
+	if err != nil {
+		return nil, err
+	}
+	if derived.EncodeAddress() != address {
+		return nil, fmt.Errorf("publicKey does not match the from address %s", address)
+	}
+	return pubKeyBytes, nil
+}
+
+// filterBitcoinUTXOs 는 주소의 UTXO 를 고정된 UTXO 와 컨펌 수를 만족하는 나머지 후보로 나눕니다.
+// 고정된 UTXO 는 컨펌 수와 관계없이 사용합니다.
+func filterBitcoinUTXOs(ctx context.Context, backend BitcoinBackend, utxos []UTXO, pins []string, minConfirmations int64) ([]UTXO, []UTXO, error) {
+	byOutPoint := make(map[string]UTXO, len(utxos))
+	for _, utxo := range utxos {
+		byOutPoint[fmt.Sprintf("%s:%d", strings.ToLower(utxo.TxID), utxo.Vout)] = utxo
+	}
+
+	pinned := make([]UTXO, 0, len(pins))
+	for _, pin := range pins {
+		txid, vout, err := parseOutPoint(pin)
+		if err != nil {
+			return nil, nil, err
+		}
+		key := fmt.Sprintf("%s:%d", txid, vout)
+		utxo, ok := byOutPoint[key]
+		if !ok {
+			return nil, nil, fmt.Errorf("utxo %s is not unspent for the from address", pin)
+		}
+		pinned = append(pinned, utxo)
+		delete(byOutPoint, key)
+	}
+
+	var tipHeight int64
+	if minConfirmations > 1 {
+		height, err := backend.TipHeight(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+		tipHeight = height
+	}
+	candidates := make([]UTXO, 0, len(utxos))
+	for _, utxo := range utxos {
+		if _, ok := byOutPoint[fmt.Sprintf("%s:%d", strings.ToLower(utxo.TxID), utxo.Vout)]; !ok {
+			continue
+		}
+		if minConfirmations > 0 && !utxo.Status.Confirmed {
+			continue
+		}
+		if minConfirmations > 1 && tipHeight-utxo.Status.BlockHeight+1 < minConfirmations {
+			continue
+		}
+		candidates = append(candidates, utxo)
+	}
+	return pinned, candidates, nil
+}
+
 func IsValidBitcoinAddress(address string, network Network) bool {
-	var params *chaincfg.Params
-	switch network {
-	case Bitcoin:
-		params = &chaincfg.MainNetParams
-	case BitcoinTestNet:
-		params = &chaincfg.TestNet3Params
-	case BitcoinRegTest:
-		params = &chaincfg.RegressionNetParams
-	default:
+	params, err := BitcoinParams(network)
+	if err != nil {
 		return false
 	}
 
 	// 주소 디코딩 시도
-	addr, err := btcutil.DecodeAddress(address, params)
+	addr, err := decodeBitcoinAddress(address, params)
 	if err != nil {
 		return false
 	}
@@ -322,6 +457,8 @@ func IsValidBitcoinAddress(address string, network Network) bool {
 		return true
 	case *btcutil.AddressWitnessScriptHash:
 		return true
+	case *AddressTaproot:
+		return true
 	default:
 		// 지원되지 않는 주소 유형
 		return false
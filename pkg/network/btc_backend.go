@@ -0,0 +1,146 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"time"
+)
+
+// 비트코인 계열 네트워크가 UTXO, 수수료, 트랜잭션을 조회할 백엔드 유형입니다. 네트워크 설정의 bitcoin.backend 로 고릅니다.
+const (
+	BitcoinBackendEsplora  = "esplora"
+	BitcoinBackendBitcoind = "bitcoind"
+	BitcoinBackendElectrum = "electrum"
+)
+
+// bitcoinBackendTimeout 은 트랜잭션을 만들 때 백엔드 요청 전체를 기다리는 시간입니다.
+const bitcoinBackendTimeout = 30 * time.Second
+
+// bitcoinHTTPClient 는 Esplora, bitcoind 백엔드가 사용하는 HTTP 클라이언트입니다.
+var bitcoinHTTPClient = &http.Client{Timeout: bitcoinBackendTimeout}
+
+// BitcoinBackend 는 비트코인 계열 네트워크의 노드나 인덱서입니다.
+type BitcoinBackend interface {
+	// UnspentOutputs 는 주소의 사용하지 않은 출력을 돌려줍니다.
+	UnspentOutputs(ctx context.Context, address string) ([]UTXO, error)
+	// EstimateFeeRate 는 targetBlocks 블록 안에 컨펌되기 위한 수수료율 (sat/vB) 을 추정합니다.
+	EstimateFeeRate(ctx context.Context, targetBlocks int) (float64, error)
+	// TipHeight 는 가장 최근 블록의 높이입니다. UTXO 의 컨펌 수를 계산할 때 사용합니다.
+	TipHeight(ctx context.Context) (int64, error)
+	// Transaction 은 트랜잭션과 컨펌 상태를 조회합니다.
+	Transaction(ctx context.Context, txid string) (*BitcoinTransaction, error)
+	// Broadcast 는 서명된 트랜잭션 (hex) 을 전송하고 트랜잭션 ID 를 돌려줍니다.
+	Broadcast(ctx context.Context, rawTx string) (string, error)
+}
+
+// BitcoinTransaction 은 백엔드에서 조회한 트랜잭션입니다.
+type BitcoinTransaction struct {
+	TxID   string     `json:"txid"`
+	Hex    string     `json:"hex"`
+	Status UTXOStatus `json:"status"`
+}
+
+// NewBitcoinBackend 는 네트워크 설정의 백엔드 유형과 RPC 주소로 백엔드를 만듭니다.
+// RPC 주소가 여러 개면 실패한 요청을 다음 주소로 다시 보냅니다.
+func NewBitcoinBackend(network Network) (BitcoinBackend, error) {
+	urls := network.RPCs()
+	if len(urls) == 0 {
+		return nil, fmt.Errorf("no bitcoin backend is configured for network: %s", network)
+	}
+
+	backends := make(fallbackBitcoinBackend, 0, len(urls))
+	for _, url := range urls {
+		backend, err := NewBitcoinBackendWithURL(network, url)
+		if err != nil {
+			return nil, err
+		}
+		backends = append(backends, backend)
+	}
+	if len(backends) == 1 {
+		return backends[0], nil
+	}
+	return backends, nil
+}
+
+// NewBitcoinBackendWithURL 은 네트워크 설정의 백엔드 유형으로 주어진 주소의 백엔드를 만듭니다.
+func NewBitcoinBackendWithURL(network Network, url string) (BitcoinBackend, error) {
+	params, err := BitcoinParams(network)
+	if err != nil {
+		return nil, err
+	}
+	switch kind := NetworkMetadata[network].bitcoinBackend; kind {
+	case "", BitcoinBackendEsplora:
+		return NewEsploraBackend(url), nil
+	case BitcoinBackendBitcoind:
+		return NewBitcoindBackend(url)
+	case BitcoinBackendElectrum:
+		return NewElectrumBackend(url, params)
+	default:
+		return nil, fmt.Errorf("unsupported bitcoin backend: %s", kind)
+	}
+}
+
+// fallbackBitcoinBackend 는 요청이 성공할 때까지 백엔드를 차례로 시도합니다.
+type fallbackBitcoinBackend []BitcoinBackend
+
+func (b fallbackBitcoinBackend) try(fn func(BitcoinBackend) error) error {
+	var lastErr error
+	for _, backend := range b {
+		if lastErr = fn(backend); lastErr == nil {
+			return nil
+		}
+	}
+	return lastErr
+}
+
+func (b fallbackBitcoinBackend) UnspentOutputs(ctx context.Context, address string) (utxos []UTXO, err error) {
+	err = b.try(func(backend BitcoinBackend) error {
+		utxos, err = backend.UnspentOutputs(ctx, address)
+		return err
+	})
+	return utxos, err
+}
+
+func (b fallbackBitcoinBackend) EstimateFeeRate(ctx context.Context, targetBlocks int) (rate float64, err error) {
+	err = b.try(func(backend BitcoinBackend) error {
+		rate, err = backend.EstimateFeeRate(ctx, targetBlocks)
+		return err
+	})
+	return rate, err
+}
+
+func (b fallbackBitcoinBackend) TipHeight(ctx context.Context) (height int64, err error) {
+	err = b.try(func(backend BitcoinBackend) error {
+		height, err = backend.TipHeight(ctx)
+		return err
+	})
+	return height, err
+}
+
+func (b fallbackBitcoinBackend) Transaction(ctx context.Context, txid string) (tx *BitcoinTransaction, err error) {
+	err = b.try(func(backend BitcoinBackend) error {
+		tx, err = backend.Transaction(ctx, txid)
+		return err
+	})
+	return tx, err
+}
+
+func (b fallbackBitcoinBackend) Broadcast(ctx context.Context, rawTx string) (txid string, err error) {
+	err = b.try(func(backend BitcoinBackend) error {
+		txid, err = backend.Broadcast(ctx, rawTx)
+		return err
+	})
+	return txid, err
+}
+
+// btcToSatoshi 는 bitcoind, Electrum 이 돌려주는 BTC 단위 금액을 사토시로 바꿉니다.
+func btcToSatoshi(amount float64) int64 {
+	return int64(math.Round(amount * 1e8))
+}
+
+// btcPerKBToSatPerVByte 는 BTC/kvB 수수료율을 sat/vB 로 바꿉니다.
+func btcPerKBToSatPerVByte(rate float64) float64 {
+	return rate * 1e8 / 1000
+}
@@ -0,0 +1,323 @@
+package network
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+)
+
+// 비트코인 트랜잭션의 입력을 고르는 방법입니다. 요청의 coinSelection 으로 고릅니다.
+const (
+	// CoinSelectionBranchAndBound 는 잔돈 출력이 필요 없는 입력 조합을 찾고, 없으면 CoinSelectionLargestFirst 로 고릅니다.
+	CoinSelectionBranchAndBound = "bnb"
+	// CoinSelectionLargestFirst 는 금액이 큰 UTXO 부터 필요한 만큼 고릅니다.
+	CoinSelectionLargestFirst = "largest_first"
+	// CoinSelectionPrivacy 는 UTXO 를 무작위로 고른 뒤 불필요한 입력을 빼고, 잔돈 출력의 위치를 섞습니다.
+	CoinSelectionPrivacy = "privacy"
+)
+
+// bnbMaxTries 는 branch-and-bound 탐색에서 방문할 최대 노드 수입니다.
+const bnbMaxTries = 100000
+
+// 트랜잭션 무게 (weight unit) 입니다. vsize 는 무게 / 4 를 올림한 값입니다.
+const (
+	// txOverheadWeight 는 버전, 락타임 (각 4 바이트) 의 무게입니다. 입출력 개수는 따로 더합니다.
+	txOverheadWeight = (4 + 4) * 4
+	// segwitMarkerWeight 는 세그윗 입력이 있을 때 붙는 marker, flag 의 무게입니다.
+	segwitMarkerWeight = 2
+	// txInBaseSize 는 outpoint (36), sequence (4) 크기입니다.
+	txInBaseSize = 36 + 4
+)
+
+// bitcoinInputWeight 는 주소 유형별 서명된 입력 하나의 무게입니다. ECDSA 서명은 최대 길이 (72 바이트) 로 계산합니다.
+var bitcoinInputWeight = map[int]int64{
+	// scriptSig: <sig 72> <pubkey 33>
+	P2PKH: (txInBaseSize + 1 + 1 + 72 + 1 + 33) * 4,
+	// scriptSig: <0014{20}>, witness: <sig 72> <pubkey 33>
+	P2SHP2WPKH: (txInBaseSize+1+23)*4 + 1 + 1 + 72 + 1 + 33,
+	// witness: <sig 72> <pubkey 33>
+	P2WPKH: (txInBaseSize+1)*4 + 1 + 1 + 72 + 1 + 33,
+	// witness: <schnorr sig 64>
+	P2TR: (txInBaseSize+1)*4 + 1 + 1 + 64,
+}
+
+// bitcoinCoinSelectionRequest 는 입력 선택에 필요한 값입니다.
+type bitcoinCoinSelectionRequest struct {
+	// Strategy 는 CoinSelection* 중 하나입니다. 비어 있으면 CoinSelectionBranchAndBound 입니다.
+	Strategy string
+	Amount   int64
+	// AddressType 은 보내는 주소 (모든 입력) 의 유형입니다.
+	AddressType int
+	// Outputs 는 잔돈을 제외한 출력의 스크립트입니다.
+	Outputs      [][]byte
+	ChangeScript []byte
+	// FeeRate 는 sat/vB 수수료율입니다. Fee 가 있으면 무시합니다.
+	FeeRate float64
+	Fee     *int64
+	// Pinned 는 반드시 사용할 UTXO 입니다.
+	Pinned []UTXO
+	// Candidates 는 필요하면 더 고를 수 있는 UTXO 입니다.
+	Candidates []UTXO
+}
+
+// bitcoinCoinSelection 은 고른 입력과 수수료입니다.
+type bitcoinCoinSelection struct {
+	Inputs []UTXO
+	Fee    int64
+	// Change 는 잔돈 금액이며, 0 이면 잔돈 출력이 없습니다.
+	Change int64
+	// ChangeIndex 는 출력 중 잔돈의 위치입니다.
+	ChangeIndex int
+	VSize       int64
+}
+
+// selectBitcoinCoins 는 요청한 방법으로 입력을 고릅니다.
+func selectBitcoinCoins(req bitcoinCoinSelectionRequest) (*bitcoinCoinSelection, error) {
+	if _, ok := bitcoinInputWeight[req.AddressType]; !ok {
+		return nil, fmt.Errorf("unsupported address type: %d", req.AddressType)
+	}
+
+	// 수수료율로 계산할 때 입력 수수료보다 작은 UTXO 는 쓸수록 손해이므로 후보에서 뺍니다.
+	candidates := make([]UTXO, 0, len(req.Candidates))
+	for _, utxo := range req.Candidates {
+		if req.effectiveValue(utxo) > 0 {
+			candidates = append(candidates, utxo)
+		}
+	}
+
+	var selection *bitcoinCoinSelection
+	switch req.Strategy {
+	case "", CoinSelectionBranchAndBound:
+		selection = req.branchAndBound(candidates)
+		if selection == nil {
+			selection = req.largestFirst(candidates)
+		}
+	case CoinSelectionLargestFirst:
+		selection = req.largestFirst(candidates)
+	case CoinSelectionPrivacy:
+		selection = req.privacy(candidates)
+	default:
+		return nil, fmt.Errorf("unsupported coin selection: %s", req.Strategy)
+	}
+
+	if selection == nil {
+		var total int64
+		for _, utxo := range append(append([]UTXO{}, req.Pinned...), candidates...) {
+			total += utxo.Value
+		}
+		return nil, fmt.Errorf("insufficient funds: have %d satoshis, need %d satoshis plus fee", total, req.Amount)
+	}
+	return selection, nil
+}
+
+// evaluate 는 inputs 로 트랜잭션을 만들 수 있는지 확인하고 수수료와 잔돈을 계산합니다.
+// 잔돈이 더스트 한계보다 작으면 잔돈 출력을 만들지 않고 수수료에 더합니다.
+func (req bitcoinCoinSelectionRequest) evaluate(inputs []UTXO) *bitcoinCoinSelection {
+	var total int64
+	for _, utxo := range inputs {
+		total += utxo.Value
+	}
+
+	withChange := append(append([][]byte{}, req.Outputs...), req.ChangeScript)
+	vsize := req.vsize(len(inputs), withChange)
+	fee := req.fee(vsize)
+	change := total - req.Amount - fee
+	if change >= bitcoinDustThreshold(req.ChangeScript) {
+		return &bitcoinCoinSelection{Inputs: inputs, Fee: fee, Change: change, ChangeIndex: len(req.Outputs), VSize: vsize}
+	}
+
+	vsize = req.vsize(len(inputs), req.Outputs)
+	fee = req.fee(vsize)
+	if total < req.Amount+fee {
+		return nil
+	}
+	return &bitcoinCoinSelection{Inputs: inputs, Fee: total - req.Amount, VSize: vsize}
+}
+
+// vsize 는 입력 numInputs 개와 outputs 로 만든 서명된 트랜잭션의 가상 크기를 추정합니다.
+func (req bitcoinCoinSelectionRequest) vsize(numInputs int, outputs [][]byte) int64 {
+	weight := int64(txOverheadWeight) + int64(wire.VarIntSerializeSize(uint64(numInputs))+wire.VarIntSerializeSize(uint64(len(outputs))))*4
+	if req.AddressType != P2PKH {
+		weight += segwitMarkerWeight
+	}
+	weight += int64(numInputs) * bitcoinInputWeight[req.AddressType]
+	for _, pkScript := range outputs {
+		weight += bitcoinOutputSize(pkScript) * 4
+	}
+	return (weight + 3) / 4
+}
+
+func (req bitcoinCoinSelectionRequest) fee(vsize int64) int64 {
+	if req.Fee != nil {
+		return *req.Fee
+	}
+	return int64(math.Ceil(float64(vsize) * req.FeeRate))
+}
+
+// effectiveValue 는 UTXO 금액에서 그 UTXO 를 입력으로 쓰는 수수료를 뺀 값입니다.
+func (req bitcoinCoinSelectionRequest) effectiveValue(utxo UTXO) int64 {
+	if req.Fee != nil {
+		return utxo.Value
+	}
+	return utxo.Value - int64(math.Ceil(float64(bitcoinInputWeight[req.AddressType])/4*req.FeeRate))
+}
+
+// largestFirst 는 금액이 큰 후보부터 트랜잭션을 만들 수 있을 때까지 더합니다.
+func (req bitcoinCoinSelectionRequest) largestFirst(candidates []UTXO) *bitcoinCoinSelection {
+	sorted := append([]UTXO{}, candidates...)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Value > sorted[j].Value })
+
+	inputs := append([]UTXO{}, req.Pinned...)
+	if selection := req.evaluate(inputs); len(inputs) > 0 && selection != nil {
+		return selection
+	}
+	for _, utxo := range sorted {
+		inputs = append(inputs, utxo)
+		if selection := req.evaluate(inputs); selection != nil {
+			return selection
+		}
+	}
+	return nil
+}
+
+// privacy 는 후보를 무작위 순서로 더한 뒤, 빼도 되는 입력을 작은 것부터 뺍니다.
+// 불필요한 입력이 없으면 어느 출력이 잔돈인지 금액으로 추측하기 어렵고, 잔돈 위치도 무작위로 정합니다.
+func (req bitcoinCoinSelectionRequest) privacy(candidates []UTXO) *bitcoinCoinSelection {
+	shuffled := append([]UTXO{}, candidates...)
+	rand.Shuffle(len(shuffled), func(i, j int) { shuffled[i], shuffled[j] = shuffled[j], shuffled[i] })
+
+	inputs := append([]UTXO{}, req.Pinned...)
+	selection := req.evaluate(inputs)
+	if len(inputs) == 0 {
+		selection = nil
+	}
+	for _, utxo := range shuffled {
+		if selection != nil {
+			break
+		}
+		inputs = append(inputs, utxo)
+		selection = req.evaluate(inputs)
+	}
+	if selection == nil {
+		return nil
+	}
+
+	// 고정된 입력은 빼지 않습니다.
+	pinned, chosen := inputs[:len(req.Pinned)], append([]UTXO{}, inputs[len(req.Pinned):]...)
+	sort.SliceStable(chosen, func(i, j int) bool { return chosen[i].Value < chosen[j].Value })
+	for i := 0; i < len(chosen); {
+		without := append(append(append([]UTXO{}, pinned...), chosen[:i]...), chosen[i+1:]...)
+		if len(without) > 0 {
+			if pruned := req.evaluate(without); pruned != nil {
+				chosen = append(chosen[:i], chosen[i+1:]...)
+				selection = pruned
+				continue
+			}
+		}
+		i++
+	}
+
+	if selection.Change > 0 {
+		selection.ChangeIndex = rand.Intn(len(req.Outputs) + 1)
+	}
+	return selection
+}
+
+// branchAndBound 는 잔돈 출력 없이 수수료를 낼 수 있는 입력 조합 중 초과 금액이 가장 작은 조합을 찾습니다.
+// 초과 금액은 잔돈 출력을 만드는 비용보다 작아야 하며, 찾지 못하면 nil 을 돌려줍니다.
+func (req bitcoinCoinSelectionRequest) branchAndBound(candidates []UTXO) *bitcoinCoinSelection {
+	sorted := append([]UTXO{}, candidates...)
+	sort.SliceStable(sorted, func(i, j int) bool { return req.effectiveValue(sorted[i]) > req.effectiveValue(sorted[j]) })
+
+	// target 은 고정된 입력으로 채우고 남은 금액입니다. 입력 수수료는 유효 금액에 들어 있습니다.
+	noInputFee := req.fee(req.vsize(0, req.Outputs))
+	target := req.Amount + noInputFee
+	for _, utxo := range req.Pinned {
+		target -= req.effectiveValue(utxo)
+	}
+	// 초과 금액이 잔돈 출력의 수수료와 더스트 한계를 합친 것보다 작으면 잔돈을 만드는 것보다 수수료로 내는 편이 낫습니다.
+	costOfChange := bitcoinDustThreshold(req.ChangeScript)
+	if req.Fee == nil {
+		withChange := append(append([][]byte{}, req.Outputs...), req.ChangeScript)
+		costOfChange += req.fee(req.vsize(0, withChange)) - noInputFee
+	}
+
+	remaining := int64(0)
+	for _, utxo := range sorted {
+		remaining += req.effectiveValue(utxo)
+	}
+
+	var best []int
+	bestExcess := int64(math.MaxInt64)
+	var current []int
+	var currentValue int64
+	tries := 0
+	var search func(depth int, remaining int64)
+	search = func(depth int, remaining int64) {
+		tries++
+		if tries > bnbMaxTries || currentValue+remaining < target || currentValue > target+costOfChange {
+			return
+		}
+		if currentValue >= target {
+			if excess := currentValue - target; excess < bestExcess || (excess == bestExcess && len(current) < len(best)) {
+				best, bestExcess = append([]int{}, current...), excess
+			}
+			return
+		}
+		if depth == len(sorted) {
+			return
+		}
+		value := req.effectiveValue(sorted[depth])
+		current, currentValue = append(current, depth), currentValue+value
+		search(depth+1, remaining-value)
+		current, currentValue = current[:len(current)-1], currentValue-value
+		search(depth+1, remaining-value)
+	}
+	search(0, remaining)
+
+	if best == nil {
+		return nil
+	}
+	inputs := append([]UTXO{}, req.Pinned...)
+	for _, idx := range best {
+		inputs = append(inputs, sorted[idx])
+	}
+	if len(inputs) == 0 {
+		return nil
+	}
+	return req.evaluate(inputs)
+}
+
+// bitcoinOutputSize 는 출력 하나의 크기 (금액 8 바이트, 스크립트 길이, 스크립트) 입니다.
+func bitcoinOutputSize(pkScript []byte) int64 {
+	return int64(8 + wire.VarIntSerializeSize(uint64(len(pkScript))) + len(pkScript))
+}
+
+// bitcoinDustThreshold 는 Bitcoin Core 의 기본 더스트 기준 (3 sat/vB 로 출력을 만들고 쓰는 비용) 입니다.
+// 이보다 작은 출력은 노드가 전달하지 않습니다.
+func bitcoinDustThreshold(pkScript []byte) int64 {
+	spendSize := int64(txInBaseSize + 1 + 107)
+	if txscript.IsWitnessProgram(pkScript) {
+		spendSize = txInBaseSize + 1 + 107/4
+	}
+	return (bitcoinOutputSize(pkScript) + spendSize) * 3
+}
+
+// parseOutPoint 는 "txid:vout" 형식의 UTXO 를 읽습니다.
+func parseOutPoint(s string) (string, uint32, error) {
+	txid, voutStr, ok := strings.Cut(s, ":")
+	if !ok || len(txid) != 64 {
+		return "", 0, fmt.Errorf("invalid utxo %q: expected txid:vout", s)
+	}
+	vout, err := strconv.ParseUint(voutStr, 10, 32)
+	if err != nil {
+		return "", 0, fmt.Errorf("invalid utxo %q: %v", s, err)
+	}
+	return strings.ToLower(txid), uint32(vout), nil
+}
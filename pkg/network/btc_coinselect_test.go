@@ -0,0 +1,153 @@
+package network
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testP2WPKHScript = append([]byte{0x00, 0x14}, make([]byte, 20)...)
+	testP2PKHScript  = append(append([]byte{0x76, 0xa9, 0x14}, make([]byte, 20)...), 0x88, 0xac)
+)
+
+func testUTXOs(values ...int64) []UTXO {
+	utxos := make([]UTXO, len(values))
+	for i, value := range values {
+		utxos[i] = UTXO{TxID: fmt.Sprintf("%064x", i+1), Vout: uint32(i), Value: value, Status: UTXOStatus{Confirmed: true}}
+	}
+	return utxos
+}
+
+func sumUTXOs(utxos []UTXO) int64 {
+	var total int64
+	for _, utxo := range utxos {
+		total += utxo.Value
+	}
+	return total
+}
+
+func TestBitcoinSizeEstimates(t *testing.T) {
+	segwit := bitcoinCoinSelectionRequest{AddressType: P2WPKH}
+	assert.Equal(t, int64(141), segwit.vsize(1, [][]byte{testP2WPKHScript, testP2WPKHScript}))
+	legacy := bitcoinCoinSelectionRequest{AddressType: P2PKH}
+	assert.Equal(t, int64(226), legacy.vsize(1, [][]byte{testP2PKHScript, testP2PKHScript}))
+	taproot := bitcoinCoinSelectionRequest{AddressType: P2TR}
+	assert.Less(t, taproot.vsize(2, [][]byte{testP2WPKHScript}), segwit.vsize(2, [][]byte{testP2WPKHScript}))
+
+	assert.Equal(t, int64(546), bitcoinDustThreshold(testP2PKHScript))
+	assert.Equal(t, int64(294), bitcoinDustThreshold(testP2WPKHScript))
+}
+
+func TestSelectBitcoinCoins(t *testing.T) {
+	newRequest := func(strategy string, amount int64, utxos []UTXO) bitcoinCoinSelectionRequest {
+		return bitcoinCoinSelectionRequest{
+			Strategy:     strategy,
+			Amount:       amount,
+			AddressType:  P2WPKH,
+			Outputs:      [][]byte{testP2WPKHScript},
+			ChangeScript: testP2WPKHScript,
+			FeeRate:      1,
+			Candidates:   utxos,
+		}
+	}
+	// 유효 금액 (금액 - 입력 수수료 68) 은 6932, 3110, 19932, 10132 입니다.
+	utxos := testUTXOs(7000, 3178, 20000, 10200)
+
+	t.Run("branch and bound", func(t *testing.T) {
+		selection, err := selectBitcoinCoins(newRequest(CoinSelectionBranchAndBound, 10000, utxos))
+		require.NoError(t, err)
+		// 7000 + 3178 이 수수료까지 정확히 맞으므로 잔돈 출력이 없습니다.
+		assert.ElementsMatch(t, []UTXO{utxos[0], utxos[1]}, selection.Inputs)
+		assert.Zero(t, selection.Change)
+		assert.Equal(t, int64(178), selection.VSize)
+		assert.Equal(t, int64(178), selection.Fee)
+
+		// 잔돈 없는 조합이 없으면 큰 UTXO 부터 고릅니다.
+		selection, err = selectBitcoinCoins(newRequest(CoinSelectionBranchAndBound, 25000, utxos))
+		require.NoError(t, err)
+		assert.Equal(t, []UTXO{utxos[2], utxos[3]}, selection.Inputs)
+		assert.Equal(t, sumUTXOs(selection.Inputs)-25000-selection.Fee, selection.Change)
+		assert.Equal(t, int64(209), selection.Fee)
+	})
+
+	t.Run("largest first", func(t *testing.T) {
+		selection, err := selectBitcoinCoins(newRequest(CoinSelectionLargestFirst, 10000, utxos))
+		require.NoError(t, err)
+		assert.Equal(t, []UTXO{utxos[2]}, selection.Inputs)
+		assert.Equal(t, int64(141), selection.VSize)
+		assert.Equal(t, int64(20000-10000-141), selection.Change)
+		assert.Equal(t, 1, selection.ChangeIndex)
+	})
+
+	t.Run("privacy", func(t *testing.T) {
+		for i := 0; i < 20; i++ {
+			req := newRequest(CoinSelectionPrivacy, 12000, utxos)
+			selection, err := selectBitcoinCoins(req)
+			require.NoError(t, err)
+			// 어느 입력을 빼도 금액을 채울 수 없어야 합니다.
+			for j := range selection.Inputs {
+				without := append(append([]UTXO{}, selection.Inputs[:j]...), selection.Inputs[j+1:]...)
+				assert.Nil(t, req.evaluate(without))
+			}
+			assert.Contains(t, []int{0, 1}, selection.ChangeIndex)
+		}
+	})
+
+	t.Run("pinned", func(t *testing.T) {
+		for _, strategy := range []string{CoinSelectionBranchAndBound, CoinSelectionLargestFirst, CoinSelectionPrivacy} {
+			req := newRequest(strategy, 5000, utxos[:3])
+			req.Pinned = []UTXO{utxos[3]}
+			selection, err := selectBitcoinCoins(req)
+			require.NoError(t, err)
+			assert.Equal(t, []UTXO{utxos[3]}, selection.Inputs, strategy)
+
+			req = newRequest(strategy, 15000, utxos[:3])
+			req.Pinned = []UTXO{utxos[1]}
+			selection, err = selectBitcoinCoins(req)
+			require.NoError(t, err)
+			assert.Contains(t, selection.Inputs, utxos[1], strategy)
+			assert.GreaterOrEqual(t, sumUTXOs(selection.Inputs), int64(15000)+selection.Fee)
+		}
+	})
+
+	t.Run("fixed fee", func(t *testing.T) {
+		fee := int64(1000)
+		req := newRequest(CoinSelectionBranchAndBound, 9200, utxos)
+		req.Fee = &fee
+		selection, err := selectBitcoinCoins(req)
+		require.NoError(t, err)
+		assert.Equal(t, []UTXO{utxos[3]}, selection.Inputs)
+		assert.Zero(t, selection.Change)
+		assert.Equal(t, fee, selection.Fee)
+	})
+
+	t.Run("uneconomical and insufficient", func(t *testing.T) {
+		req := newRequest(CoinSelectionLargestFirst, 1000, testUTXOs(60, 2000))
+		req.FeeRate = 2
+		selection, err := selectBitcoinCoins(req)
+		require.NoError(t, err)
+		assert.Len(t, selection.Inputs, 1)
+
+		_, err = selectBitcoinCoins(newRequest(CoinSelectionLargestFirst, 50000, utxos))
+		assert.ErrorContains(t, err, "insufficient funds")
+		_, err = selectBitcoinCoins(newRequest("knapsack", 1000, utxos))
+		assert.ErrorContains(t, err, "unsupported coin selection")
+	})
+}
+
+func TestParseOutPoint(t *testing.T) {
+	txid := strings.Repeat("AB", 32)
+	parsed, vout, err := parseOutPoint(txid + ":3")
+	require.NoError(t, err)
+	assert.Equal(t, strings.ToLower(txid), parsed)
+	assert.Equal(t, uint32(3), vout)
+
+	for _, invalid := range []string{txid, "abcd:1", txid + ":-1", txid + ":x"} {
+		_, _, err := parseOutPoint(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
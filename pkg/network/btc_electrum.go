@@ -0,0 +1,202 @@
+package network
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/url"
+
+	"github.com/btcsuite/btcd/chaincfg"
+)
+
+// electrumProtocolVersion 은 server.version 으로 협상하는 Electrum 프로토콜 버전입니다.
+const electrumProtocolVersion = "1.4"
+
+// ElectrumBackend 는 Electrum 서버 (electrs, ElectrumX, Fulcrum 등) 를 사용하는 백엔드입니다.
+// 요청마다 새 연결을 열고 닫습니다.
+type ElectrumBackend struct {
+	address string
+	useTLS  bool
+	params  *chaincfg.Params
+}
+
+// NewElectrumBackend 는 서버 주소 (tcp://host:50001 또는 ssl://host:50002) 로 백엔드를 만듭니다.
+// 주소를 스크립트 해시로 바꿀 때 params 를 사용합니다.
+func NewElectrumBackend(serverURL string, params *chaincfg.Params) (*ElectrumBackend, error) {
+	parsed, err := url.Parse(serverURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid electrum server URL: %v", err)
+	}
+	if parsed.Host == "" || parsed.Port() == "" {
+		return nil, fmt.Errorf("invalid electrum server URL: %s", serverURL)
+	}
+	switch parsed.Scheme {
+	case "tcp":
+		return &ElectrumBackend{address: parsed.Host, params: params}, nil
+	case "ssl", "tls":
+		return &ElectrumBackend{address: parsed.Host, useTLS: true, params: params}, nil
+	default:
+		return nil, fmt.Errorf("unsupported electrum server scheme: %s", parsed.Scheme)
+	}
+}
+
+func (b *ElectrumBackend) UnspentOutputs(ctx context.Context, address string) ([]UTXO, error) {
+	scriptHash, err := b.scriptHash(address)
+	if err != nil {
+		return nil, err
+	}
+	var unspents []struct {
+		TxHash string `json:"tx_hash"`
+		TxPos  uint32 `json:"tx_pos"`
+		Height int64  `json:"height"`
+		Value  int64  `json:"value"`
+	}
+	if err := b.call(ctx, "blockchain.scripthash.listunspent", []interface{}{scriptHash}, &unspents); err != nil {
+		return nil, fmt.Errorf("failed to get UTXOs: %v", err)
+	}
+
+	utxos := make([]UTXO, 0, len(unspents))
+	for _, unspent := range unspents {
+		utxo := UTXO{TxID: unspent.TxHash, Vout: unspent.TxPos, Value: unspent.Value}
+		// 멤풀에 있는 출력은 높이가 0 (또는 부모가 미확인이면 -1) 입니다.
+		if unspent.Height > 0 {
+			utxo.Status = UTXOStatus{Confirmed: true, BlockHeight: unspent.Height}
+		}
+		utxos = append(utxos, utxo)
+	}
+	return utxos, nil
+}
+
+func (b *ElectrumBackend) EstimateFeeRate(ctx context.Context, targetBlocks int) (float64, error) {
+	var rate float64
+	if err := b.call(ctx, "blockchain.estimatefee", []interface{}{targetBlocks}, &rate); err != nil {
+		return 0, fmt.Errorf("failed to estimate fee: %v", err)
+	}
+	// 서버가 추정하지 못하면 -1 을 돌려줍니다.
+	if rate <= 0 {
+		return 0, fmt.Errorf("failed to estimate fee: no estimate for %d blocks", targetBlocks)
+	}
+	return btcPerKBToSatPerVByte(rate), nil
+}
+
+// TipHeight 는 blockchain.headers.subscribe 의 응답으로 최근 블록 높이를 얻습니다. 연결을 닫으므로 구독은 유지되지 않습니다.
+func (b *ElectrumBackend) TipHeight(ctx context.Context) (int64, error) {
+	var header struct {
+		Height int64 `json:"height"`
+	}
+	if err := b.call(ctx, "blockchain.headers.subscribe", []interface{}{}, &header); err != nil {
+		return 0, fmt.Errorf("failed to get tip height: %v", err)
+	}
+	return header.Height, nil
+}
+
+func (b *ElectrumBackend) Transaction(ctx context.Context, txid string) (*BitcoinTransaction, error) {
+	var result struct {
+		Hex           string `json:"hex"`
+		BlockHash     string `json:"blockhash"`
+		BlockTime     int64  `json:"blocktime"`
+		Confirmations int64  `json:"confirmations"`
+	}
+	if err := b.call(ctx, "blockchain.transaction.get", []interface{}{txid, true}, &result); err != nil {
+		return nil, fmt.Errorf("failed to get transaction: %v", err)
+	}
+
+	tx := &BitcoinTransaction{TxID: txid, Hex: result.Hex}
+	if result.Confirmations > 0 {
+		tx.Status = UTXOStatus{Confirmed: true, BlockHash: result.BlockHash, BlockTime: result.BlockTime}
+	}
+	return tx, nil
+}
+
+func (b *ElectrumBackend) Broadcast(ctx context.Context, rawTx string) (string, error) {
+	var txid string
+	if err := b.call(ctx, "blockchain.transaction.broadcast", []interface{}{rawTx}, &txid); err != nil {
+		return "", fmt.Errorf("failed to broadcast transaction: %v", err)
+	}
+	return txid, nil
+}
+
+// scriptHash 는 Electrum 이 주소 대신 사용하는 스크립트 해시 (출력 스크립트의 SHA256 을 뒤집은 hex) 를 계산합니다.
+func (b *ElectrumBackend) scriptHash(address string) (string, error) {
+	addr, err := decodeBitcoinAddress(address, b.params)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode address: %v", err)
+	}
+	pkScript, err := payToAddrScript(addr)
+	if err != nil {
+		return "", fmt.Errorf("failed to create pkScript: %v", err)
+	}
+	hash := sha256.Sum256(pkScript)
+	for i, j := 0, len(hash)-1; i < j; i, j = i+1, j-1 {
+		hash[i], hash[j] = hash[j], hash[i]
+	}
+	return hex.EncodeToString(hash[:]), nil
+}
+
+// call 은 server.version 으로 프로토콜을 협상한 뒤 요청을 보내고 같은 id 의 응답을 기다립니다.
+// 메시지는 줄바꿈으로 구분된 JSON-RPC 2.0 입니다.
+func (b *ElectrumBackend) call(ctx context.Context, method string, params []interface{}, out interface{}) error {
+	conn, err := b.dial(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+
+	encoder := json.NewEncoder(conn)
+	requests := []map[string]interface{}{
+		{"jsonrpc": "2.0", "id": 0, "method": "server.version", "params": []string{"tecdsa", electrumProtocolVersion}},
+		{"jsonrpc": "2.0", "id": 1, "method": method, "params": params},
+	}
+	for _, request := range requests {
+		if err := encoder.Encode(request); err != nil {
+			return fmt.Errorf("failed to send %s request: %v", request["method"], err)
+		}
+	}
+
+	scanner := bufio.NewScanner(conn)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		var resp struct {
+			ID     *int            `json:"id"`
+			Result json.RawMessage `json:"result"`
+			Error  *struct {
+				Code    int    `json:"code"`
+				Message string `json:"message"`
+			} `json:"error"`
+		}
+		if err := json.Unmarshal(scanner.Bytes(), &resp); err != nil {
+			return fmt.Errorf("failed to decode %s response: %v", method, err)
+		}
+		if resp.ID == nil {
+			// 구독 알림은 무시합니다.
+			continue
+		}
+		if resp.Error != nil {
+			return fmt.Errorf("%s failed: %s (code %d)", method, resp.Error.Message, resp.Error.Code)
+		}
+		if *resp.ID == 1 {
+			return json.Unmarshal(resp.Result, out)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to read %s response: %v", method, err)
+	}
+	return fmt.Errorf("connection closed before %s response", method)
+}
+
+func (b *ElectrumBackend) dial(ctx context.Context) (net.Conn, error) {
+	dialer := &net.Dialer{Timeout: bitcoinBackendTimeout}
+	if b.useTLS {
+		tlsDialer := &tls.Dialer{NetDialer: dialer}
+		return tlsDialer.DialContext(ctx, "tcp", b.address)
+	}
+	return dialer.DialContext(ctx, "tcp", b.address)
+}
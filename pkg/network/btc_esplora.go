@@ -0,0 +1,127 @@
+package network
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// EsploraBackend 는 Esplora REST API (mempool.space, blockstream.info 등) 를 사용하는 백엔드입니다.
+type EsploraBackend struct {
+	baseURL string
+}
+
+// NewEsploraBackend 는 API 주소 (예: https://mempool.space/api) 로 백엔드를 만듭니다.
+func NewEsploraBackend(baseURL string) *EsploraBackend {
+	return &EsploraBackend{baseURL: strings.TrimSuffix(baseURL, "/")}
+}
+
+func (b *EsploraBackend) UnspentOutputs(ctx context.Context, address string) ([]UTXO, error) {
+	var utxos []UTXO
+	if err := b.getJSON(ctx, "/address/"+address+"/utxo", &utxos); err != nil {
+		return nil, fmt.Errorf("failed to get UTXOs: %v", err)
+	}
+	return utxos, nil
+}
+
+// EstimateFeeRate 는 /fee-estimates 에서 targetBlocks 이하의 가장 가까운 목표의 수수료율을 고릅니다.
+func (b *EsploraBackend) EstimateFeeRate(ctx context.Context, targetBlocks int) (float64, error) {
+	var estimates map[string]float64
+	if err := b.getJSON(ctx, "/fee-estimates", &estimates); err != nil {
+		return 0, fmt.Errorf("failed to get fee estimates: %v", err)
+	}
+
+	targets := make([]int, 0, len(estimates))
+	for key := range estimates {
+		if target, err := strconv.Atoi(key); err == nil {
+			targets = append(targets, target)
+		}
+	}
+	if len(targets) == 0 {
+		return 0, fmt.Errorf("no fee estimates available")
+	}
+	sort.Ints(targets)
+	chosen := targets[0]
+	for _, target := range targets {
+		if target <= targetBlocks {
+			chosen = target
+		}
+	}
+	return estimates[strconv.Itoa(chosen)], nil
+}
+
+func (b *EsploraBackend) TipHeight(ctx context.Context) (int64, error) {
+	body, err := b.get(ctx, "/blocks/tip/height")
+	if err != nil {
+		return 0, fmt.Errorf("failed to get tip height: %v", err)
+	}
+	height, err := strconv.ParseInt(strings.TrimSpace(string(body)), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid tip height: %v", err)
+	}
+	return height, nil
+}
+
+func (b *EsploraBackend) Transaction(ctx context.Context, txid string) (*BitcoinTransaction, error) {
+	raw, err := b.get(ctx, "/tx/"+txid+"/hex")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transaction: %v", err)
+	}
+	tx := &BitcoinTransaction{TxID: txid, Hex: strings.TrimSpace(string(raw))}
+	if err := b.getJSON(ctx, "/tx/"+txid+"/status", &tx.Status); err != nil {
+		return nil, fmt.Errorf("failed to get transaction status: %v", err)
+	}
+	return tx, nil
+}
+
+func (b *EsploraBackend) Broadcast(ctx context.Context, rawTx string) (string, error) {
+	body, err := b.do(ctx, http.MethodPost, "/tx", strings.NewReader(rawTx))
+	if err != nil {
+		return "", fmt.Errorf("failed to broadcast transaction: %v", err)
+	}
+	return strings.TrimSpace(string(body)), nil
+}
+
+func (b *EsploraBackend) getJSON(ctx context.Context, path string, out interface{}) error {
+	body, err := b.get(ctx, path)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(body, out); err != nil {
+		return fmt.Errorf("failed to decode response: %v", err)
+	}
+	return nil
+}
+
+func (b *EsploraBackend) get(ctx context.Context, path string) ([]byte, error) {
+	return b.do(ctx, http.MethodGet, path, nil)
+}
+
+func (b *EsploraBackend) do(ctx context.Context, method, path string, body io.Reader) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, method, b.baseURL+path, body)
+	if err != nil {
+		return nil, err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "text/plain")
+	}
+	resp, err := bitcoinHTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %v", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s %s: %d %s", method, path, resp.StatusCode, strings.TrimSpace(string(respBody)))
+	}
+	return respBody, nil
+}
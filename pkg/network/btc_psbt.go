@@ -0,0 +1,622 @@
+package network
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"io"
+
+	"tecdsa/pkg/transaction"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/coinbase/kryptology/pkg/core/curves"
+)
+
+// BIP174 (PSBT v0) 와 BIP371 (Taproot) 의 키 유형입니다.
+const (
+	psbtGlobalUnsignedTx = 0x00
+	psbtGlobalVersion    = 0xfb
+
+	psbtInNonWitnessUTXO     = 0x00
+	psbtInWitnessUTXO        = 0x01
+	psbtInPartialSig         = 0x02
+	psbtInSighashType        = 0x03
+	psbtInRedeemScript       = 0x04
+	psbtInWitnessScript      = 0x05
+	psbtInBip32Derivation    = 0x06
+	psbtInFinalScriptSig     = 0x07
+	psbtInFinalScriptWitness = 0x08
+	psbtInTapKeySig          = 0x13
+	psbtInTapScriptSig       = 0x14
+	psbtInTapLeafScript      = 0x15
+	psbtInTapBip32Derivation = 0x16
+	psbtInTapInternalKey     = 0x17
+	psbtInTapMerkleRoot      = 0x18
+
+	psbtOutRedeemScript       = 0x00
+	psbtOutBip32Derivation    = 0x02
+	psbtOutTapInternalKey     = 0x05
+	psbtOutTapBip32Derivation = 0x07
+)
+
+// psbtMaxValueSize 는 키와 값 하나의 최대 크기입니다. 이전 트랜잭션 전체가 값이 될 수 있으므로 블록 크기로 제한합니다.
+const psbtMaxValueSize = wire.MaxBlockPayload
+
+var psbtMagic = []byte{0x70, 0x73, 0x62, 0x74, 0xff}
+
+// psbtSignerInputTypes 는 서명자가 채우는 입력 키 유형으로, 입력을 완성하면 UTXO 와 모르는 필드만 남기고 지웁니다 (BIP174).
+var psbtSignerInputTypes = map[byte]bool{
+	psbtInPartialSig:         true,
+	psbtInSighashType:        true,
+	psbtInRedeemScript:       true,
+	psbtInWitnessScript:      true,
+	psbtInBip32Derivation:    true,
+	psbtInTapKeySig:          true,
+	psbtInTapScriptSig:       true,
+	psbtInTapLeafScript:      true,
+	psbtInTapBip32Derivation: true,
+	psbtInTapInternalKey:     true,
+	psbtInTapMerkleRoot:      true,
+}
+
+type psbtPair struct {
+	key   []byte
+	value []byte
+}
+
+// psbtMap 은 PSBT 의 키-값 맵입니다. 모르는 필드도 그대로 돌려주도록 받은 순서대로 보관합니다.
+type psbtMap []psbtPair
+
+func (m psbtMap) get(key []byte) []byte {
+	for _, pair := range m {
+		if bytes.Equal(pair.key, key) {
+			return pair.value
+		}
+	}
+	return nil
+}
+
+func (m psbtMap) getType(keyType byte) []psbtPair {
+	var pairs []psbtPair
+	for _, pair := range m {
+		if pair.key[0] == keyType {
+			pairs = append(pairs, pair)
+		}
+	}
+	return pairs
+}
+
+func (m *psbtMap) set(key, value []byte) {
+	for i, pair := range *m {
+		if bytes.Equal(pair.key, key) {
+			(*m)[i].value = value
+			return
+		}
+	}
+	*m = append(*m, psbtPair{key: key, value: value})
+}
+
+func (m *psbtMap) deleteIf(remove func(keyType byte) bool) {
+	kept := (*m)[:0]
+	for _, pair := range *m {
+		if !remove(pair.key[0]) {
+			kept = append(kept, pair)
+		}
+	}
+	*m = kept
+}
+
+// BitcoinPSBT 는 BIP174 PSBT (버전 0) 입니다. 이 서비스가 서명할 수 있는 단일 키 입력 (P2PKH, P2SH-P2WPKH, P2WPKH, P2TR 키 경로) 을
+// 서명하고 완성하며, 나머지 필드는 해석하지 않고 그대로 유지합니다.
+type BitcoinPSBT struct {
+	UnsignedTx *wire.MsgTx
+	global     psbtMap
+	inputs     []psbtMap
+	outputs    []psbtMap
+}
+
+func newBitcoinPSBT(tx *wire.MsgTx) (*BitcoinPSBT, error) {
+	unsignedTx := tx.Copy()
+	for _, in := range unsignedTx.TxIn {
+		in.SignatureScript = nil
+		in.Witness = nil
+	}
+	var buf bytes.Buffer
+	if err := unsignedTx.SerializeNoWitness(&buf); err != nil {
+		return nil, fmt.Errorf("failed to serialize transaction: %v", err)
+	}
+	return &BitcoinPSBT{
+		UnsignedTx: unsignedTx,
+		global:     psbtMap{{key: []byte{psbtGlobalUnsignedTx}, value: buf.Bytes()}},
+		inputs:     make([]psbtMap, len(unsignedTx.TxIn)),
+		outputs:    make([]psbtMap, len(unsignedTx.TxOut)),
+	}, nil
+}
+
+// DecodeBitcoinPSBT 는 base64 로 인코딩된 PSBT 를 해석합니다.
+func DecodeBitcoinPSBT(encoded string) (*BitcoinPSBT, error) {
+	raw, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("invalid PSBT encoding: %v", err)
+	}
+	r := bytes.NewReader(raw)
+	magic := make([]byte, len(psbtMagic))
+	if _, err := io.ReadFull(r, magic); err != nil || !bytes.Equal(magic, psbtMagic) {
+		return nil, fmt.Errorf("invalid PSBT magic")
+	}
+
+	global, err := readPSBTMap(r)
+	if err != nil {
+		return nil, fmt.Errorf("invalid PSBT global map: %v", err)
+	}
+	if version := global.get([]byte{psbtGlobalVersion}); version != nil {
+		if len(version) != 4 || binary.LittleEndian.Uint32(version) != 0 {
+			return nil, fmt.Errorf("unsupported PSBT version")
+		}
+	}
+	rawTx := global.get([]byte{psbtGlobalUnsignedTx})
+	if rawTx == nil {
+		return nil, fmt.Errorf("PSBT has no unsigned transaction")
+	}
+	tx := wire.NewMsgTx(wire.TxVersion)
+	if err := tx.DeserializeNoWitness(bytes.NewReader(rawTx)); err != nil {
+		return nil, fmt.Errorf("invalid PSBT unsigned transaction: %v", err)
+	}
+	for i, in := range tx.TxIn {
+		if len(in.SignatureScript) > 0 || len(in.Witness) > 0 {
+			return nil, fmt.Errorf("PSBT unsigned transaction input %d has a signature", i)
+		}
+	}
+
+	p := &BitcoinPSBT{UnsignedTx: tx, global: global}
+	for i := range tx.TxIn {
+		input, err := readPSBTMap(r)
+		if err != nil {
+			return nil, fmt.Errorf("invalid PSBT input %d: %v", i, err)
+		}
+		p.inputs = append(p.inputs, input)
+	}
+	for i := range tx.TxOut {
+		output, err := readPSBTMap(r)
+		if err != nil {
+			return nil, fmt.Errorf("invalid PSBT output %d: %v", i, err)
+		}
+		p.outputs = append(p.outputs, output)
+	}
+	if r.Len() != 0 {
+		return nil, fmt.Errorf("PSBT has %d trailing bytes", r.Len())
+	}
+	return p, nil
+}
+
+func readPSBTMap(r io.Reader) (psbtMap, error) {
+	var m psbtMap
+	for {
+		key, err := wire.ReadVarBytes(r, 0, psbtMaxValueSize, "key")
+		if err != nil {
+			return nil, err
+		}
+		// 길이가 0 인 키는 맵의 끝입니다.
+		if len(key) == 0 {
+			return m, nil
+		}
+		value, err := wire.ReadVarBytes(r, 0, psbtMaxValueSize, "value")
+		if err != nil {
+			return nil, err
+		}
+		if m.get(key) != nil {
+			return nil, fmt.Errorf("duplicate key %x", key)
+		}
+		m = append(m, psbtPair{key: key, value: value})
+	}
+}
+
+// Encode 는 PSBT 를 base64 로 인코딩합니다.
+func (p *BitcoinPSBT) Encode() (string, error) {
+	var buf bytes.Buffer
+	buf.Write(psbtMagic)
+	maps := append(append([]psbtMap{p.global}, p.inputs...), p.outputs...)
+	for _, m := range maps {
+		for _, pair := range m {
+			if err := wire.WriteVarBytes(&buf, 0, pair.key); err != nil {
+				return "", err
+			}
+			if err := wire.WriteVarBytes(&buf, 0, pair.value); err != nil {
+				return "", err
+			}
+		}
+		buf.WriteByte(0x00)
+	}
+	return base64.StdEncoding.EncodeToString(buf.Bytes()), nil
+}
+
+// InputUTXO 는 입력이 사용하는 이전 출력입니다. witness UTXO 가 없으면 이전 트랜잭션 (non-witness UTXO) 에서 찾습니다.
+func (p *BitcoinPSBT) InputUTXO(idx int) (*wire.TxOut, error) {
+	input := p.inputs[idx]
+	if value := input.get([]byte{psbtInWitnessUTXO}); value != nil {
+		r := bytes.NewReader(value)
+		var amount int64
+		if err := binary.Read(r, binary.LittleEndian, &amount); err != nil {
+			return nil, fmt.Errorf("invalid witness UTXO for input %d: %v", idx, err)
+		}
+		pkScript, err := wire.ReadVarBytes(r, 0, psbtMaxValueSize, "pkScript")
+		if err != nil {
+			return nil, fmt.Errorf("invalid witness UTXO for input %d: %v", idx, err)
+		}
+		return wire.NewTxOut(amount, pkScript), nil
+	}
+	if value := input.get([]byte{psbtInNonWitnessUTXO}); value != nil {
+		prevTx := wire.NewMsgTx(wire.TxVersion)
+		if err := prevTx.Deserialize(bytes.NewReader(value)); err != nil {
+			return nil, fmt.Errorf("invalid non-witness UTXO for input %d: %v", idx, err)
+		}
+		outPoint := p.UnsignedTx.TxIn[idx].PreviousOutPoint
+		if prevTx.TxHash() != outPoint.Hash || int(outPoint.Index) >= len(prevTx.TxOut) {
+			return nil, fmt.Errorf("non-witness UTXO does not match input %d", idx)
+		}
+		return prevTx.TxOut[outPoint.Index], nil
+	}
+	return nil, fmt.Errorf("input %d has no UTXO information", idx)
+}
+
+// InputAddress 는 입력이 사용하는 이전 출력의 주소입니다.
+func (p *BitcoinPSBT) InputAddress(idx int, network Network) (string, error) {
+	params, err := BitcoinParams(network)
+	if err != nil {
+		return "", err
+	}
+	utxo, err := p.InputUTXO(idx)
+	if err != nil {
+		return "", err
+	}
+	address, err := bitcoinScriptAddress(utxo.PkScript, params)
+	if err != nil {
+		return "", fmt.Errorf("input %d: %v", idx, err)
+	}
+	return address.EncodeAddress(), nil
+}
+
+// IsInputFinalized 는 입력에 완성된 scriptSig 나 witness 가 있는지 나타냅니다.
+func (p *BitcoinPSBT) IsInputFinalized(idx int) bool {
+	input := p.inputs[idx]
+	return input.get([]byte{psbtInFinalScriptSig}) != nil || input.get([]byte{psbtInFinalScriptWitness}) != nil
+}
+
+// IsInputSigned 는 입력이 완성되었거나 키의 서명이 이미 있는지 나타냅니다.
+func (p *BitcoinPSBT) IsInputSigned(idx int, point curves.Point) bool {
+	if p.IsInputFinalized(idx) {
+		return true
+	}
+	input := p.inputs[idx]
+	pubKeyBytes := point.ToAffineCompressed()
+	return input.get(append([]byte{psbtInPartialSig}, pubKeyBytes...)) != nil || input.get([]byte{psbtInTapKeySig}) != nil
+}
+
+// SigningPayload 는 입력의 서명 해시 프리이미지를 만듭니다. CreateBitcoinSigningPayloads 와 같이 ECDSA 입력은 프리이미지,
+// P2TR 은 BIP341 서명 해시입니다. 키가 입력을 소유하지 않거나 SIGHASH_ALL (P2TR 은 SIGHASH_DEFAULT) 이 아니면 오류입니다.
+func (p *BitcoinPSBT) SigningPayload(idx int, network Network, point curves.Point) ([]byte, error) {
+	params, err := BitcoinParams(network)
+	if err != nil {
+		return nil, err
+	}
+	pubKeyBytes := point.ToAffineCompressed()
+	addrType, err := p.inputAddressType(idx, pubKeyBytes, params)
+	if err != nil {
+		return nil, err
+	}
+
+	if value := p.inputs[idx].get([]byte{psbtInSighashType}); value != nil {
+		expected := uint32(txscript.SigHashAll)
+		if addrType == P2TR {
+			expected = 0
+		}
+		if len(value) != 4 || binary.LittleEndian.Uint32(value) != expected {
+			return nil, fmt.Errorf("unsupported sighash type for input %d", idx)
+		}
+	}
+
+	// Taproot 서명 해시는 모든 입력의 금액과 scriptPubKey 가 필요합니다.
+	extra := &BitcoinTxExtra{AddressType: addrType, Inputs: make([]BitcoinTxInput, len(p.UnsignedTx.TxIn))}
+	for i := range p.UnsignedTx.TxIn {
+		if i != idx && addrType != P2TR {
+			continue
+		}
+		utxo, err := p.InputUTXO(i)
+		if err != nil {
+			return nil, err
+		}
+		extra.Inputs[i] = BitcoinTxInput{Amount: utxo.Value, PkScript: hex.EncodeToString(utxo.PkScript)}
+	}
+	return bitcoinSigHashPreimage(p.UnsignedTx, idx, extra, pubKeyBytes, network)
+}
+
+// AddSignature 는 입력에 부분 서명을 더합니다. ECDSA 입력은 공개키별 부분 서명, P2TR 은 키 경로 서명 (BIP371) 입니다.
+func (p *BitcoinPSBT) AddSignature(idx int, network Network, point curves.Point, signature *transaction.Signature) error {
+	params, err := BitcoinParams(network)
+	if err != nil {
+		return err
+	}
+	pubKeyBytes := point.ToAffineCompressed()
+	addrType, err := p.inputAddressType(idx, pubKeyBytes, params)
+	if err != nil {
+		return err
+	}
+	sig, err := bitcoinSignatureBytes(addrType, signature)
+	if err != nil {
+		return err
+	}
+
+	input := &p.inputs[idx]
+	switch addrType {
+	case P2TR:
+		input.set([]byte{psbtInTapKeySig}, sig)
+		if input.get([]byte{psbtInTapInternalKey}) == nil {
+			input.set([]byte{psbtInTapInternalKey}, pubKeyBytes[1:])
+		}
+	default:
+		input.set(append([]byte{psbtInPartialSig}, pubKeyBytes...), sig)
+		if addrType == P2SHP2WPKH && input.get([]byte{psbtInRedeemScript}) == nil {
+			redeemScript, err := p2wpkhScript(pubKeyBytes)
+			if err != nil {
+				return err
+			}
+			input.set([]byte{psbtInRedeemScript}, redeemScript)
+		}
+	}
+	return nil
+}
+
+// Finalize 는 서명이 있는 단일 키 입력의 scriptSig / witness 를 완성하고, 모든 입력이 완성되었는지 돌려줍니다.
+// 다른 서명자가 더한 부분 서명도 공개키가 입력의 키와 맞으면 사용합니다.
+func (p *BitcoinPSBT) Finalize() (bool, error) {
+	complete := true
+	for i := range p.inputs {
+		if p.IsInputFinalized(i) {
+			continue
+		}
+		finalized, err := p.finalizeInput(i)
+		if err != nil {
+			return false, err
+		}
+		complete = complete && finalized
+	}
+	return complete, nil
+}
+
+func (p *BitcoinPSBT) finalizeInput(idx int) (bool, error) {
+	utxo, err := p.InputUTXO(idx)
+	if err != nil {
+		return false, nil
+	}
+	input := &p.inputs[idx]
+
+	var addrType int
+	var pubKeyBytes, sig []byte
+	class := txscript.GetScriptClass(utxo.PkScript)
+	switch {
+	case isTaprootScript(utxo.PkScript):
+		addrType = P2TR
+		sig = input.get([]byte{psbtInTapKeySig})
+	case class == txscript.PubKeyHashTy:
+		addrType = P2PKH
+		pubKeyBytes, sig = input.partialSig(utxo.PkScript[3:23])
+	case class == txscript.WitnessV0PubKeyHashTy:
+		addrType = P2WPKH
+		pubKeyBytes, sig = input.partialSig(utxo.PkScript[2:])
+	case class == txscript.ScriptHashTy:
+		redeemScript := input.get([]byte{psbtInRedeemScript})
+		if txscript.GetScriptClass(redeemScript) != txscript.WitnessV0PubKeyHashTy {
+			return false, nil
+		}
+		if !bytes.Equal(btcutil.Hash160(redeemScript), utxo.PkScript[2:22]) {
+			return false, fmt.Errorf("redeem script does not match input %d", idx)
+		}
+		addrType = P2SHP2WPKH
+		pubKeyBytes, sig = input.partialSig(redeemScript[2:])
+	default:
+		return false, nil
+	}
+	if sig == nil {
+		return false, nil
+	}
+
+	txIn := &wire.TxIn{}
+	if err := setBitcoinInputScripts(txIn, addrType, pubKeyBytes, sig); err != nil {
+		return false, err
+	}
+	input.deleteIf(func(keyType byte) bool { return psbtSignerInputTypes[keyType] })
+	if len(txIn.SignatureScript) > 0 {
+		input.set([]byte{psbtInFinalScriptSig}, txIn.SignatureScript)
+	}
+	if len(txIn.Witness) > 0 {
+		var witness bytes.Buffer
+		if err := wire.WriteVarInt(&witness, 0, uint64(len(txIn.Witness))); err != nil {
+			return false, err
+		}
+		for _, item := range txIn.Witness {
+			if err := wire.WriteVarBytes(&witness, 0, item); err != nil {
+				return false, err
+			}
+		}
+		input.set([]byte{psbtInFinalScriptWitness}, witness.Bytes())
+	}
+	return true, nil
+}
+
+// partialSig 는 공개키 해시가 pubKeyHash 인 부분 서명을 찾습니다.
+func (m psbtMap) partialSig(pubKeyHash []byte) ([]byte, []byte) {
+	for _, pair := range m.getType(psbtInPartialSig) {
+		pubKeyBytes := pair.key[1:]
+		if bytes.Equal(btcutil.Hash160(pubKeyBytes), pubKeyHash) {
+			return pubKeyBytes, pair.value
+		}
+	}
+	return nil, nil
+}
+
+// Extract 는 모든 입력이 완성된 PSBT 에서 브로드캐스트할 수 있는 트랜잭션 (hex) 을 꺼냅니다.
+func (p *BitcoinPSBT) Extract() (string, error) {
+	tx := p.UnsignedTx.Copy()
+	for i, input := range p.inputs {
+		if !p.IsInputFinalized(i) {
+			return "", fmt.Errorf("input %d is not finalized", i)
+		}
+		tx.TxIn[i].SignatureScript = input.get([]byte{psbtInFinalScriptSig})
+		if value := input.get([]byte{psbtInFinalScriptWitness}); value != nil {
+			r := bytes.NewReader(value)
+			count, err := wire.ReadVarInt(r, 0)
+			if err != nil {
+				return "", fmt.Errorf("invalid final witness for input %d: %v", i, err)
+			}
+			witness := make(wire.TxWitness, 0, count)
+			for j := uint64(0); j < count; j++ {
+				item, err := wire.ReadVarBytes(r, 0, psbtMaxValueSize, "witness")
+				if err != nil {
+					return "", fmt.Errorf("invalid final witness for input %d: %v", i, err)
+				}
+				witness = append(witness, item)
+			}
+			tx.TxIn[i].Witness = witness
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := tx.Serialize(&buf); err != nil {
+		return "", fmt.Errorf("failed to serialize signed transaction: %v", err)
+	}
+	return hex.EncodeToString(buf.Bytes()), nil
+}
+
+// inputAddressType 은 키가 입력을 소유하는 주소 유형을 찾습니다.
+func (p *BitcoinPSBT) inputAddressType(idx int, pubKeyBytes []byte, params *chaincfg.Params) (int, error) {
+	if idx < 0 || idx >= len(p.inputs) {
+		return 0, fmt.Errorf("input index out of range: %d", idx)
+	}
+	utxo, err := p.InputUTXO(idx)
+	if err != nil {
+		return 0, err
+	}
+	for _, template := range bitcoinDescriptorTemplates {
+		address, err := bitcoinAddress(pubKeyBytes, params, template.addrType)
+		if err != nil {
+			return 0, err
+		}
+		pkScript, err := payToAddrScript(address)
+		if err != nil {
+			return 0, fmt.Errorf("failed to create pkScript: %v", err)
+		}
+		if bytes.Equal(pkScript, utxo.PkScript) {
+			return template.addrType, nil
+		}
+	}
+	return 0, fmt.Errorf("input %d is not owned by the key", idx)
+}
+
+// newBitcoinTransactionPSBT 는 buildBitcoinTransaction 이 만든 트랜잭션의 PSBT 를 만듭니다.
+// P2TR 이 아닌 입력에는 이전 트랜잭션 (non-witness UTXO) 을 백엔드에서 받아 넣고, SegWit 입력에는 witness UTXO 를 넣습니다.
+// 공개키가 있으면 입력과 잔돈 출력에 키 출처 (BIP32 지문, 깊이 0) 와 P2SH 리딤 스크립트를 채웁니다.
+func newBitcoinTransactionPSBT(ctx context.Context, backend BitcoinBackend, tx *wire.MsgTx, extra *BitcoinTxExtra, fromPkScript, pubKeyBytes []byte) (*BitcoinPSBT, error) {
+	p, err := newBitcoinPSBT(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	prevTxs := map[string][]byte{}
+	for i, in := range extra.Inputs {
+		input := &p.inputs[i]
+		if extra.AddressType != P2TR {
+			rawTx, ok := prevTxs[in.TxID]
+			if !ok {
+				prevTx, err := backend.Transaction(ctx, in.TxID)
+				if err != nil {
+					return nil, err
+				}
+				if rawTx, err = hex.DecodeString(prevTx.Hex); err != nil {
+					return nil, fmt.Errorf("invalid transaction hex for %s: %v", in.TxID, err)
+				}
+				prevTxs[in.TxID] = rawTx
+			}
+			input.set([]byte{psbtInNonWitnessUTXO}, rawTx)
+		}
+		if extra.AddressType != P2PKH {
+			var utxo bytes.Buffer
+			if err := wire.WriteTxOut(&utxo, 0, 0, wire.NewTxOut(in.Amount, fromPkScript)); err != nil {
+				return nil, fmt.Errorf("failed to serialize witness UTXO: %v", err)
+			}
+			input.set([]byte{psbtInWitnessUTXO}, utxo.Bytes())
+		}
+		if pubKeyBytes != nil {
+			if err := setPSBTKeyOrigin(input, extra.AddressType, pubKeyBytes, true); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if pubKeyBytes != nil {
+		for i, out := range tx.TxOut {
+			if bytes.Equal(out.PkScript, fromPkScript) {
+				if err := setPSBTKeyOrigin(&p.outputs[i], extra.AddressType, pubKeyBytes, false); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	return p, nil
+}
+
+// setPSBTKeyOrigin 은 입력 또는 출력 맵에 키 출처를 채웁니다. /keys/{id}/xpub 의 확장 공개키가 깊이 0 이므로
+// 지문은 공개키의 HASH160 앞 4바이트이고 파생 경로는 비어 있습니다.
+func setPSBTKeyOrigin(m *psbtMap, addrType int, pubKeyBytes []byte, isInput bool) error {
+	fingerprint := btcutil.Hash160(pubKeyBytes)[:4]
+	xOnly := pubKeyBytes[1:]
+
+	bip32Type, redeemType, tapBip32Type, tapInternalType := byte(psbtInBip32Derivation), byte(psbtInRedeemScript), byte(psbtInTapBip32Derivation), byte(psbtInTapInternalKey)
+	if !isInput {
+		bip32Type, redeemType, tapBip32Type, tapInternalType = psbtOutBip32Derivation, psbtOutRedeemScript, psbtOutTapBip32Derivation, psbtOutTapInternalKey
+	}
+
+	if addrType == P2TR {
+		// 키 경로만 사용하므로 리프 해시 수는 0 입니다.
+		m.set(append([]byte{tapBip32Type}, xOnly...), append([]byte{0x00}, fingerprint...))
+		m.set([]byte{tapInternalType}, xOnly)
+		return nil
+	}
+	m.set(append([]byte{bip32Type}, pubKeyBytes...), fingerprint)
+	if addrType == P2SHP2WPKH {
+		redeemScript, err := p2wpkhScript(pubKeyBytes)
+		if err != nil {
+			return err
+		}
+		m.set([]byte{redeemType}, redeemScript)
+	}
+	return nil
+}
+
+// bitcoinScriptAddress 는 출력 스크립트의 주소입니다. 이 서비스가 다루는 단일 키 유형과 P2SH 만 해석합니다.
+func bitcoinScriptAddress(pkScript []byte, params *chaincfg.Params) (btcutil.Address, error) {
+	if isTaprootScript(pkScript) {
+		return NewAddressTaproot(pkScript[2:], params)
+	}
+	class, addresses, _, err := txscript.ExtractPkScriptAddrs(pkScript, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse pkScript: %v", err)
+	}
+	switch class {
+	case txscript.PubKeyHashTy, txscript.ScriptHashTy, txscript.WitnessV0PubKeyHashTy:
+		if len(addresses) == 1 {
+			return addresses[0], nil
+		}
+	}
+	return nil, fmt.Errorf("unsupported pkScript: %x", pkScript)
+}
+
+// isTaprootScript 는 스크립트가 P2TR 출력 (OP_1 <32바이트>) 인지 확인합니다.
+func isTaprootScript(pkScript []byte) bool {
+	return len(pkScript) == 34 && pkScript[0] == txscript.OP_1 && pkScript[1] == txscript.OP_DATA_32
+}
@@ -0,0 +1,196 @@
+package network
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/hex"
+	"testing"
+
+	"tecdsa/pkg/transaction"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/coinbase/kryptology/pkg/core/curves"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newPSBTTestTransaction 은 from 주소로 60000, 50000 satoshi 를 보낸 이전 트랜잭션을 백엔드에 두고 PSBT 를 만듭니다.
+func newPSBTTestTransaction(t *testing.T, point curves.Point, addrType int) (*transaction.UnsignedTransaction, *BitcoinTxExtra, *BitcoinPSBT) {
+	from, err := DeriveBitcoinAddress(point, BitcoinTestNet, addrType)
+	require.NoError(t, err)
+	params, err := BitcoinParams(BitcoinTestNet)
+	require.NoError(t, err)
+	fromAddress, err := decodeBitcoinAddress(from, params)
+	require.NoError(t, err)
+	fromPkScript, err := payToAddrScript(fromAddress)
+	require.NoError(t, err)
+
+	prevTx := wire.NewMsgTx(wire.TxVersion)
+	prevTx.AddTxIn(wire.NewTxIn(&wire.OutPoint{}, []byte{txscript.OP_TRUE}, nil))
+	prevTx.AddTxOut(wire.NewTxOut(60000, fromPkScript))
+	prevTx.AddTxOut(wire.NewTxOut(50000, fromPkScript))
+	var buf bytes.Buffer
+	require.NoError(t, prevTx.Serialize(&buf))
+	txid := prevTx.TxHash().String()
+
+	backend := newFakeBitcoinBackend()
+	backend.txs[txid] = &BitcoinTransaction{TxID: txid, Hex: hex.EncodeToString(buf.Bytes())}
+	backend.utxos[from] = []UTXO{
+		{TxID: txid, Vout: 0, Value: 60000, Status: UTXOStatus{Confirmed: true}},
+		{TxID: txid, Vout: 1, Value: 50000, Status: UTXOStatus{Confirmed: true}},
+	}
+	req := BitcoinTxRequest{
+		From:      from,
+		To:        newBitcoinAddress(t, BitcoinTestNet, P2WPKH),
+		Amount:    "80000",
+		PublicKey: hex.EncodeToString(point.ToAffineCompressed()),
+		PSBT:      true,
+	}
+	unsignedTx, err := buildBitcoinTransaction(context.Background(), backend, req, BitcoinTestNet)
+	require.NoError(t, err)
+	_, extra, err := decodeBitcoinTx(unsignedTx)
+	require.NoError(t, err)
+	require.NotEmpty(t, extra.PSBT)
+
+	p, err := DecodeBitcoinPSBT(extra.PSBT)
+	require.NoError(t, err)
+	return unsignedTx, extra, p
+}
+
+func TestBitcoinPSBT(t *testing.T) {
+	for _, addrType := range []int{P2PKH, P2SHP2WPKH, P2WPKH} {
+		key, point, _ := newEthereumKey(t)
+		pubKeyBytes := point.ToAffineCompressed()
+		_, extra, p := newPSBTTestTransaction(t, point, addrType)
+
+		// 다시 인코딩해도 같은 PSBT 입니다.
+		encoded, err := p.Encode()
+		require.NoError(t, err)
+		assert.Equal(t, extra.PSBT, encoded)
+
+		// 입력과 잔돈 출력에 키 출처가 있습니다.
+		fingerprint := btcutil.Hash160(pubKeyBytes)[:4]
+		for i := range p.inputs {
+			address, err := p.InputAddress(i, BitcoinTestNet)
+			require.NoError(t, err)
+			assert.Equal(t, extra.From, address)
+			assert.Equal(t, fingerprint, p.inputs[i].get(append([]byte{psbtInBip32Derivation}, pubKeyBytes...)))
+			assert.Equal(t, addrType != P2PKH, p.inputs[i].get([]byte{psbtInWitnessUTXO}) != nil)
+			assert.NotNil(t, p.inputs[i].get([]byte{psbtInNonWitnessUTXO}))
+		}
+		var changeOrigins int
+		for _, output := range p.outputs {
+			if output.get(append([]byte{psbtOutBip32Derivation}, pubKeyBytes...)) != nil {
+				changeOrigins++
+			}
+		}
+		assert.Equal(t, 1, changeOrigins)
+
+		// 파티처럼 sha256d(프리이미지) 에 서명합니다.
+		for i := range p.inputs {
+			payload, err := p.SigningPayload(i, BitcoinTestNet, point)
+			require.NoError(t, err)
+			hash := chainhash.DoubleHashB(payload)
+			assert.Equal(t, extra.Inputs[i].SigHash, hex.EncodeToString(hash))
+
+			assert.False(t, p.IsInputSigned(i, point))
+			sig, err := crypto.Sign(hash, key)
+			require.NoError(t, err)
+			require.NoError(t, p.AddSignature(i, BitcoinTestNet, point, &transaction.Signature{R: sig[:32], S: sig[32:64], V: uint64(sig[64])}))
+			assert.True(t, p.IsInputSigned(i, point))
+
+			// 서명 하나만 있으면 아직 추출할 수 없습니다.
+			if i == 0 {
+				_, err := p.Extract()
+				assert.ErrorContains(t, err, "not finalized")
+			}
+		}
+
+		// 부분 서명이 있는 PSBT 도 다시 해석할 수 있습니다.
+		encoded, err = p.Encode()
+		require.NoError(t, err)
+		p, err = DecodeBitcoinPSBT(encoded)
+		require.NoError(t, err)
+
+		complete, err := p.Finalize()
+		require.NoError(t, err)
+		require.True(t, complete)
+		assert.Empty(t, p.inputs[0].getType(psbtInPartialSig))
+		assert.Empty(t, p.inputs[0].getType(psbtInBip32Derivation))
+
+		signedTx, err := p.Extract()
+		require.NoError(t, err)
+		raw, err := hex.DecodeString(signedTx)
+		require.NoError(t, err)
+		tx := wire.NewMsgTx(wire.TxVersion)
+		require.NoError(t, tx.Deserialize(bytes.NewReader(raw)))
+		sigHashes := txscript.NewTxSigHashes(tx)
+		for i, input := range extra.Inputs {
+			pkScript, err := hex.DecodeString(input.PkScript)
+			require.NoError(t, err)
+			engine, err := txscript.NewEngine(pkScript, tx, i, txscript.StandardVerifyFlags, nil, sigHashes, input.Amount)
+			require.NoError(t, err)
+			assert.NoError(t, engine.Execute(), "address type %d input %d", addrType, i)
+		}
+	}
+}
+
+func TestBitcoinPSBTTaproot(t *testing.T) {
+	_, point, _ := newEthereumKey(t)
+	_, extra, p := newPSBTTestTransaction(t, point, P2TR)
+
+	xOnly := point.ToAffineCompressed()[1:]
+	assert.Equal(t, xOnly, p.inputs[0].get([]byte{psbtInTapInternalKey}))
+	assert.Nil(t, p.inputs[0].get([]byte{psbtInNonWitnessUTXO}))
+
+	for i := range p.inputs {
+		payload, err := p.SigningPayload(i, BitcoinTestNet, point)
+		require.NoError(t, err)
+		assert.Equal(t, extra.Inputs[i].SigHash, hex.EncodeToString(payload))
+		sig := bytes.Repeat([]byte{byte(i + 1)}, 64)
+		require.NoError(t, p.AddSignature(i, BitcoinTestNet, point, &transaction.Signature{R: sig[:32], S: sig[32:]}))
+	}
+
+	complete, err := p.Finalize()
+	require.NoError(t, err)
+	require.True(t, complete)
+	signedTx, err := p.Extract()
+	require.NoError(t, err)
+	raw, err := hex.DecodeString(signedTx)
+	require.NoError(t, err)
+	tx := wire.NewMsgTx(wire.TxVersion)
+	require.NoError(t, tx.Deserialize(bytes.NewReader(raw)))
+	for i, in := range tx.TxIn {
+		assert.Equal(t, wire.TxWitness{bytes.Repeat([]byte{byte(i + 1)}, 64)}, in.Witness)
+	}
+}
+
+func TestBitcoinPSBTErrors(t *testing.T) {
+	_, point, _ := newEthereumKey(t)
+	_, _, p := newPSBTTestTransaction(t, point, P2WPKH)
+
+	_, other, _ := newEthereumKey(t)
+	_, err := p.SigningPayload(0, BitcoinTestNet, other)
+	assert.ErrorContains(t, err, "not owned")
+
+	sighashType := make([]byte, 4)
+	binary.LittleEndian.PutUint32(sighashType, uint32(txscript.SigHashSingle|txscript.SigHashAnyOneCanPay))
+	p.inputs[0].set([]byte{psbtInSighashType}, sighashType)
+	_, err = p.SigningPayload(0, BitcoinTestNet, point)
+	assert.ErrorContains(t, err, "unsupported sighash type")
+
+	// 서명이 없는 입력은 완성하지 않습니다.
+	complete, err := p.Finalize()
+	require.NoError(t, err)
+	assert.False(t, complete)
+
+	for _, invalid := range []string{"", "cHNidP8=", "bm90IGEgcHNidA==", "!"} {
+		_, err := DecodeBitcoinPSBT(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
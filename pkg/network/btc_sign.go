@@ -0,0 +1,380 @@
+package network
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"math/big"
+	"tecdsa/pkg/transaction"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/coinbase/kryptology/pkg/core/curves"
+)
+
+// BitcoinTxInput 은 서명 해시 계산에 필요한 이전 출력(UTXO) 정보입니다.
+type BitcoinTxInput struct {
+	TxID     string `json:"txid"`
+	Vout     uint32 `json:"vout"`
+	Amount   int64  `json:"amount"`
+	PkScript string `json:"pk_script"` // hex
+	// SigHash 는 이 입력에서 서명할 32바이트 해시 (hex) 입니다. ECDSA 입력은 레거시 또는 BIP143 프리이미지의 sha256d, P2TR 은 BIP341 서명 해시입니다.
+	// P2SH-P2WPKH 는 공개키가 있어야 계산할 수 있으므로 트랜잭션을 만들 때 publicKey 를 주지 않으면 비어 있습니다.
+	SigHash string `json:"sighash,omitempty"`
+}
+
+// BitcoinTxExtra 는 비트코인 미서명 트랜잭션의 Extra 필드입니다.
+type BitcoinTxExtra struct {
+	From   string `json:"from"`
+	To     string `json:"to"`
+	Amount string `json:"amount"`
+	Fee    int64  `json:"fee"`
+	// FeeRate 는 수수료 계산에 사용한 sat/vB 수수료율입니다. 수수료를 직접 지정하면 비어 있습니다.
+	FeeRate float64 `json:"fee_rate,omitempty"`
+	// VSize 는 서명 후 트랜잭션의 추정 가상 크기 (vB) 입니다.
+	VSize         int64            `json:"vsize,omitempty"`
+	CoinSelection string           `json:"coin_selection,omitempty"`
+	AddressType   int              `json:"address_type"`
+	Inputs        []BitcoinTxInput `json:"inputs"`
+	// PSBT 는 요청에 psbt 를 주었을 때 함께 만든 BIP174 PSBT (base64) 입니다.
+	PSBT string `json:"psbt,omitempty"`
+}
+
+// doubleSha256 은 파티가 메시지 다이제스트로 sha256d(preimage) 를 사용하도록 합니다.
+type doubleSha256 struct {
+	hash.Hash
+}
+
+func (d doubleSha256) Sum(b []byte) []byte {
+	first := d.Hash.Sum(nil)
+	second := sha256.Sum256(first)
+	return append(b, second[:]...)
+}
+
+// NewBitcoinMessageHash 는 비트코인 서명 해시 프리이미지에 사용할 해시 함수를 돌려줍니다.
+func NewBitcoinMessageHash() hash.Hash {
+	return doubleSha256{sha256.New()}
+}
+
+// BitcoinAddressType 은 주소 문자열에서 btc.go 의 주소 유형을 판별합니다.
+// P2SH 주소는 이 서비스가 발급하는 P2SH-P2WPKH 로 간주합니다.
+func BitcoinAddressType(address string, network Network) (int, error) {
+	params, err := BitcoinParams(network)
+	if err != nil {
+		return 0, err
+	}
+	addr, err := decodeBitcoinAddress(address, params)
+	if err != nil {
+		return 0, fmt.Errorf("failed to decode address: %v", err)
+	}
+
+	switch addr.(type) {
+	case *btcutil.AddressPubKeyHash:
+		return P2PKH, nil
+	case *btcutil.AddressScriptHash:
+		return P2SHP2WPKH, nil
+	case *btcutil.AddressWitnessPubKeyHash:
+		return P2WPKH, nil
+	case *AddressTaproot:
+		return P2TR, nil
+	default:
+		return 0, fmt.Errorf("unsupported address type for signing: %s", address)
+	}
+}
+
+func IsValidBitcoinAddressType(addrType int) bool {
+	return addrType == P2PKH || addrType == P2SHP2WPKH || addrType == P2WPKH || addrType == P2TR
+}
+
+func DecodeBitcoinTxExtra(extra interface{}) (*BitcoinTxExtra, error) {
+	raw, err := json.Marshal(extra)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode extra: %v", err)
+	}
+	var decoded BitcoinTxExtra
+	if err := json.Unmarshal(raw, &decoded); err != nil {
+		return nil, fmt.Errorf("failed to decode extra: %v", err)
+	}
+	return &decoded, nil
+}
+
+func decodeBitcoinTx(unsignedTx *transaction.UnsignedTransaction) (*wire.MsgTx, *BitcoinTxExtra, error) {
+	rawTx, err := base64.StdEncoding.DecodeString(unsignedTx.UnSignedTxEncodedBase64)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to decode unsigned transaction: %v", err)
+	}
+	tx := wire.NewMsgTx(wire.TxVersion)
+	if err := tx.Deserialize(bytes.NewReader(rawTx)); err != nil {
+		return nil, nil, fmt.Errorf("failed to deserialize unsigned transaction: %v", err)
+	}
+
+	extra, err := DecodeBitcoinTxExtra(unsignedTx.Extra)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(extra.Inputs) != len(tx.TxIn) {
+		return nil, nil, fmt.Errorf("input metadata count %d does not match transaction inputs %d", len(extra.Inputs), len(tx.TxIn))
+	}
+	if !IsValidBitcoinAddressType(extra.AddressType) {
+		return nil, nil, fmt.Errorf("unsupported address type: %d", extra.AddressType)
+	}
+	return tx, extra, nil
+}
+
+// CreateBitcoinSigningPayload 는 입력이 하나인 트랜잭션의 서명 해시 프리이미지를 만듭니다.
+func CreateBitcoinSigningPayload(unsignedTx *transaction.UnsignedTransaction, network Network, point curves.Point) ([]byte, error) {
+	payloads, err := CreateBitcoinSigningPayloads(unsignedTx, network, point)
+	if err != nil {
+		return nil, err
+	}
+	if len(payloads) != 1 {
+		return nil, fmt.Errorf("transaction has %d inputs; sign each input separately", len(payloads))
+	}
+	return payloads[0], nil
+}
+
+// CreateBitcoinSigningPayloads 는 입력마다 파티에게 전달할 서명 해시 프리이미지를 만듭니다.
+// 파티는 이 값에 sha256d 를 적용해 서명합니다. P2TR 은 BIP341 서명 해시(32바이트)를 그대로 돌려줍니다.
+func CreateBitcoinSigningPayloads(unsignedTx *transaction.UnsignedTransaction, network Network, point curves.Point) ([][]byte, error) {
+	tx, extra, err := decodeBitcoinTx(unsignedTx)
+	if err != nil {
+		return nil, err
+	}
+	payloads := make([][]byte, len(tx.TxIn))
+	for i := range tx.TxIn {
+		if payloads[i], err = bitcoinSigHashPreimage(tx, i, extra, point.ToAffineCompressed(), network); err != nil {
+			return nil, err
+		}
+	}
+	return payloads, nil
+}
+
+// AssembleSignedBitcoinTransaction 은 입력이 하나인 트랜잭션에 서명을 결합합니다.
+func AssembleSignedBitcoinTransaction(unsignedTx *transaction.UnsignedTransaction, network Network, point curves.Point, signature *transaction.Signature) (string, error) {
+	return AssembleSignedBitcoinTransactionInputs(unsignedTx, network, point, []*transaction.Signature{signature})
+}
+
+// AssembleSignedBitcoinTransactionInputs 는 입력 순서대로 받은 서명을 주소 유형에 맞는 scriptSig / witness 로 결합합니다.
+func AssembleSignedBitcoinTransactionInputs(unsignedTx *transaction.UnsignedTransaction, network Network, point curves.Point, signatures []*transaction.Signature) (string, error) {
+	tx, extra, err := decodeBitcoinTx(unsignedTx)
+	if err != nil {
+		return "", err
+	}
+	if len(signatures) != len(tx.TxIn) {
+		return "", fmt.Errorf("got %d signatures for %d inputs", len(signatures), len(tx.TxIn))
+	}
+
+	pubKeyBytes := point.ToAffineCompressed()
+	for i, signature := range signatures {
+		if err := setBitcoinInputSignature(tx, i, extra.AddressType, pubKeyBytes, signature); err != nil {
+			return "", fmt.Errorf("input %d: %v", i, err)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := tx.Serialize(&buf); err != nil {
+		return "", fmt.Errorf("failed to serialize signed transaction: %v", err)
+	}
+	return hex.EncodeToString(buf.Bytes()), nil
+}
+
+func setBitcoinInputSignature(tx *wire.MsgTx, idx int, addrType int, pubKeyBytes []byte, signature *transaction.Signature) error {
+	sig, err := bitcoinSignatureBytes(addrType, signature)
+	if err != nil {
+		return err
+	}
+	return setBitcoinInputScripts(tx.TxIn[idx], addrType, pubKeyBytes, sig)
+}
+
+// bitcoinSignatureBytes 는 서명을 scriptSig / witness 에 넣는 형식으로 직렬화합니다.
+// Taproot 는 64바이트 BIP340 서명(R.x || s, SIGHASH_DEFAULT), 나머지는 DER 서명 뒤에 SIGHASH_ALL 을 붙입니다.
+func bitcoinSignatureBytes(addrType int, signature *transaction.Signature) ([]byte, error) {
+	if addrType == P2TR {
+		if len(signature.R) > 32 || len(signature.S) > 32 {
+			return nil, fmt.Errorf("invalid schnorr signature")
+		}
+		sig := make([]byte, 64)
+		copy(sig[32-len(signature.R):32], signature.R)
+		copy(sig[64-len(signature.S):], signature.S)
+		return sig, nil
+	}
+
+	// btcec 의 DER 직렬화는 low-S 로 정규화합니다.
+	ecdsaSig := &btcec.Signature{
+		R: new(big.Int).SetBytes(signature.R),
+		S: new(big.Int).SetBytes(signature.S),
+	}
+	return append(ecdsaSig.Serialize(), byte(txscript.SigHashAll)), nil
+}
+
+// setBitcoinInputScripts 는 직렬화된 서명을 주소 유형에 맞는 scriptSig / witness 로 입력에 넣습니다.
+func setBitcoinInputScripts(txIn *wire.TxIn, addrType int, pubKeyBytes []byte, sig []byte) error {
+	switch addrType {
+	case P2PKH:
+		sigScript, err := txscript.NewScriptBuilder().AddData(sig).AddData(pubKeyBytes).Script()
+		if err != nil {
+			return fmt.Errorf("failed to create signature script: %v", err)
+		}
+		txIn.SignatureScript = sigScript
+		txIn.Witness = nil
+	case P2SHP2WPKH:
+		redeemScript, err := p2wpkhScript(pubKeyBytes)
+		if err != nil {
+			return err
+		}
+		sigScript, err := txscript.NewScriptBuilder().AddData(redeemScript).Script()
+		if err != nil {
+			return fmt.Errorf("failed to create signature script: %v", err)
+		}
+		txIn.SignatureScript = sigScript
+		txIn.Witness = wire.TxWitness{sig, pubKeyBytes}
+	case P2WPKH:
+		txIn.SignatureScript = nil
+		txIn.Witness = wire.TxWitness{sig, pubKeyBytes}
+	case P2TR:
+		txIn.SignatureScript = nil
+		txIn.Witness = wire.TxWitness{sig}
+	default:
+		return fmt.Errorf("unsupported address type: %d", addrType)
+	}
+	return nil
+}
+
+func p2wpkhScript(pubKeyBytes []byte) ([]byte, error) {
+	script, err := txscript.NewScriptBuilder().AddOp(txscript.OP_0).AddData(btcutil.Hash160(pubKeyBytes)).Script()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create witness program: %v", err)
+	}
+	return script, nil
+}
+
+// bitcoinSigHashPreimage 는 SIGHASH_ALL 기준 프리이미지를 만듭니다.
+// P2PKH 는 레거시 방식, SegWit 유형은 BIP143 방식을 따릅니다.
+func bitcoinSigHashPreimage(tx *wire.MsgTx, idx int, extra *BitcoinTxExtra, pubKeyBytes []byte, network Network) ([]byte, error) {
+	params, err := BitcoinParams(network)
+	if err != nil {
+		return nil, err
+	}
+
+	input := extra.Inputs[idx]
+	pkScript, err := hex.DecodeString(input.PkScript)
+	if err != nil {
+		return nil, fmt.Errorf("invalid pk_script for input %d: %v", idx, err)
+	}
+
+	// 서명할 키가 실제로 이 입력을 소유하는지 확인합니다.
+	address, err := bitcoinAddress(pubKeyBytes, params, extra.AddressType)
+	if err != nil {
+		return nil, err
+	}
+	expectedPkScript, err := payToAddrScript(address)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create pkScript: %v", err)
+	}
+	if !bytes.Equal(pkScript, expectedPkScript) {
+		return nil, fmt.Errorf("input %d is not owned by %s", idx, address.EncodeAddress())
+	}
+
+	return bitcoinInputPreimage(tx, idx, extra, btcutil.Hash160(pubKeyBytes))
+}
+
+// bitcoinInputPreimage 는 입력의 서명 해시 프리이미지를 만듭니다. SegWit v0 입력은 scriptCode 에 pubKeyHash 를 사용합니다.
+func bitcoinInputPreimage(tx *wire.MsgTx, idx int, extra *BitcoinTxExtra, pubKeyHash []byte) ([]byte, error) {
+	input := extra.Inputs[idx]
+	switch extra.AddressType {
+	case P2PKH:
+		pkScript, err := hex.DecodeString(input.PkScript)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pk_script for input %d: %v", idx, err)
+		}
+		return legacySigHashPreimage(tx, idx, pkScript)
+	case P2SHP2WPKH, P2WPKH:
+		return witnessV0SigHashPreimage(tx, idx, pubKeyHash, input.Amount)
+	case P2TR:
+		// Taproot 는 프리이미지가 아닌 32바이트 서명 해시 자체에 Schnorr 서명합니다.
+		return taprootSigHash(tx, idx, extra)
+	default:
+		return nil, fmt.Errorf("unsupported address type: %d", extra.AddressType)
+	}
+}
+
+// setBitcoinSigHashes 는 extra.Inputs 에 입력별 서명 해시를 기록합니다.
+func setBitcoinSigHashes(tx *wire.MsgTx, extra *BitcoinTxExtra, pubKeyHash []byte) error {
+	for i := range extra.Inputs {
+		preimage, err := bitcoinInputPreimage(tx, i, extra, pubKeyHash)
+		if err != nil {
+			return err
+		}
+		sigHash := preimage
+		if extra.AddressType != P2TR {
+			sigHash = chainhash.DoubleHashB(preimage)
+		}
+		extra.Inputs[i].SigHash = hex.EncodeToString(sigHash)
+	}
+	return nil
+}
+
+func legacySigHashPreimage(tx *wire.MsgTx, idx int, subScript []byte) ([]byte, error) {
+	txCopy := tx.Copy()
+	for i := range txCopy.TxIn {
+		txCopy.TxIn[i].Witness = nil
+		if i == idx {
+			txCopy.TxIn[i].SignatureScript = subScript
+		} else {
+			txCopy.TxIn[i].SignatureScript = nil
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := txCopy.SerializeNoWitness(&buf); err != nil {
+		return nil, fmt.Errorf("failed to serialize transaction: %v", err)
+	}
+	binary.Write(&buf, binary.LittleEndian, uint32(txscript.SigHashAll))
+	return buf.Bytes(), nil
+}
+
+func witnessV0SigHashPreimage(tx *wire.MsgTx, idx int, pubKeyHash []byte, amount int64) ([]byte, error) {
+	var prevouts, sequences, outputs bytes.Buffer
+	for _, in := range tx.TxIn {
+		prevouts.Write(in.PreviousOutPoint.Hash[:])
+		binary.Write(&prevouts, binary.LittleEndian, in.PreviousOutPoint.Index)
+		binary.Write(&sequences, binary.LittleEndian, in.Sequence)
+	}
+	for _, out := range tx.TxOut {
+		if err := wire.WriteTxOut(&outputs, 0, 0, out); err != nil {
+			return nil, fmt.Errorf("failed to serialize output: %v", err)
+		}
+	}
+
+	scriptCode, err := txscript.NewScriptBuilder().
+		AddOp(txscript.OP_DUP).AddOp(txscript.OP_HASH160).AddData(pubKeyHash).
+		AddOp(txscript.OP_EQUALVERIFY).AddOp(txscript.OP_CHECKSIG).Script()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create script code: %v", err)
+	}
+
+	in := tx.TxIn[idx]
+	var buf bytes.Buffer
+	binary.Write(&buf, binary.LittleEndian, uint32(tx.Version))
+	buf.Write(chainhash.DoubleHashB(prevouts.Bytes()))
+	buf.Write(chainhash.DoubleHashB(sequences.Bytes()))
+	buf.Write(in.PreviousOutPoint.Hash[:])
+	binary.Write(&buf, binary.LittleEndian, in.PreviousOutPoint.Index)
+	if err := wire.WriteVarBytes(&buf, 0, scriptCode); err != nil {
+		return nil, fmt.Errorf("failed to write script code: %v", err)
+	}
+	binary.Write(&buf, binary.LittleEndian, uint64(amount))
+	binary.Write(&buf, binary.LittleEndian, in.Sequence)
+	buf.Write(chainhash.DoubleHashB(outputs.Bytes()))
+	binary.Write(&buf, binary.LittleEndian, tx.LockTime)
+	binary.Write(&buf, binary.LittleEndian, uint32(txscript.SigHashAll))
+	return buf.Bytes(), nil
+}
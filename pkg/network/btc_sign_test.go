@@ -0,0 +1,109 @@
+package network
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"tecdsa/pkg/transaction"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignBitcoinTransactionInputs(t *testing.T) {
+	txid := strings.Repeat("cd", 32)
+
+	for _, addrType := range []int{P2PKH, P2SHP2WPKH, P2WPKH} {
+		key, point, _ := newEthereumKey(t)
+		from, err := DeriveBitcoinAddress(point, BitcoinTestNet, addrType)
+		require.NoError(t, err)
+		to := newBitcoinAddress(t, BitcoinTestNet, P2WPKH)
+
+		backend := newFakeBitcoinBackend()
+		backend.utxos[from] = []UTXO{
+			{TxID: txid, Vout: 0, Value: 60000, Status: UTXOStatus{Confirmed: true}},
+			{TxID: txid, Vout: 1, Value: 50000, Status: UTXOStatus{Confirmed: true}},
+		}
+		req := BitcoinTxRequest{From: from, To: to, Amount: "80000"}
+		if addrType == P2SHP2WPKH {
+			req.PublicKey = hex.EncodeToString(point.ToAffineCompressed())
+		}
+		unsignedTx, err := buildBitcoinTransaction(context.Background(), backend, req, BitcoinTestNet)
+		require.NoError(t, err)
+
+		payloads, err := CreateBitcoinSigningPayloads(unsignedTx, BitcoinTestNet, point)
+		require.NoError(t, err)
+		require.Len(t, payloads, 2)
+
+		_, extra, err := decodeBitcoinTx(unsignedTx)
+		require.NoError(t, err)
+
+		// 파티처럼 sha256d(프리이미지) 에 서명합니다. 기록된 서명 해시와 같아야 합니다.
+		signatures := make([]*transaction.Signature, len(payloads))
+		for i, payload := range payloads {
+			hash := chainhash.DoubleHashB(payload)
+			assert.Equal(t, hex.EncodeToString(hash), extra.Inputs[i].SigHash)
+			sig, err := crypto.Sign(hash, key)
+			require.NoError(t, err)
+			signatures[i] = &transaction.Signature{R: sig[:32], S: sig[32:64], V: uint64(sig[64])}
+		}
+
+		_, err = AssembleSignedBitcoinTransactionInputs(unsignedTx, BitcoinTestNet, point, signatures[:1])
+		assert.ErrorContains(t, err, "got 1 signatures for 2 inputs")
+		_, err = CreateBitcoinSigningPayload(unsignedTx, BitcoinTestNet, point)
+		assert.Error(t, err)
+
+		signedTx, err := AssembleSignedBitcoinTransactionInputs(unsignedTx, BitcoinTestNet, point, signatures)
+		require.NoError(t, err)
+
+		// 모든 입력이 스크립트 엔진의 검증을 통과해야 합니다.
+		raw, err := hex.DecodeString(signedTx)
+		require.NoError(t, err)
+		tx := wire.NewMsgTx(wire.TxVersion)
+		require.NoError(t, tx.Deserialize(bytes.NewReader(raw)))
+		sigHashes := txscript.NewTxSigHashes(tx)
+		for i, input := range extra.Inputs {
+			pkScript, err := hex.DecodeString(input.PkScript)
+			require.NoError(t, err)
+			engine, err := txscript.NewEngine(pkScript, tx, i, txscript.StandardVerifyFlags, nil, sigHashes, input.Amount)
+			require.NoError(t, err)
+			assert.NoError(t, engine.Execute(), "address type %d input %d", addrType, i)
+		}
+	}
+}
+
+func TestBitcoinSigHashesWithoutPublicKey(t *testing.T) {
+	_, point, _ := newEthereumKey(t)
+	from, err := DeriveBitcoinAddress(point, BitcoinTestNet, P2SHP2WPKH)
+	require.NoError(t, err)
+
+	backend := newFakeBitcoinBackend()
+	backend.utxos[from] = []UTXO{{TxID: strings.Repeat("cd", 32), Vout: 0, Value: 60000, Status: UTXOStatus{Confirmed: true}}}
+	req := BitcoinTxRequest{From: from, To: newBitcoinAddress(t, BitcoinTestNet, P2WPKH), Amount: "10000"}
+
+	// P2SH-P2WPKH 는 공개키가 없으면 서명 해시를 기록하지 않지만, 서명 페이로드는 만들 수 있습니다.
+	unsignedTx, err := buildBitcoinTransaction(context.Background(), backend, req, BitcoinTestNet)
+	require.NoError(t, err)
+	_, extra, err := decodeBitcoinTx(unsignedTx)
+	require.NoError(t, err)
+	assert.Empty(t, extra.Inputs[0].SigHash)
+	payload, err := CreateBitcoinSigningPayload(unsignedTx, BitcoinTestNet, point)
+	require.NoError(t, err)
+	assert.NotEmpty(t, payload)
+
+	_, other, _ := newEthereumKey(t)
+	req.PublicKey = hex.EncodeToString(other.ToAffineCompressed())
+	_, err = buildBitcoinTransaction(context.Background(), backend, req, BitcoinTestNet)
+	assert.ErrorContains(t, err, "does not match")
+	req.PublicKey = base64.StdEncoding.EncodeToString(point.ToAffineCompressed())
+	_, err = buildBitcoinTransaction(context.Background(), backend, req, BitcoinTestNet)
+	assert.ErrorContains(t, err, "invalid publicKey")
+}
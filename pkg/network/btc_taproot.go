@@ -0,0 +1,243 @@
+package network
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"tecdsa/pkg/schnorr"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/btcsuite/btcutil/bech32"
+	"github.com/coinbase/kryptology/pkg/core/curves"
+)
+
+// 사용 중인 btcutil 버전은 bech32m(BIP350) 과 witness v1 주소를 지원하지 않아 직접 구현합니다.
+const (
+	bech32mConst      = 0x2bc830a3
+	bech32Charset     = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+	taprootSigHashTag = "TapSighash"
+)
+
+// AddressTaproot 은 witness v1 (P2TR) 주소입니다. btcutil.Address 를 구현합니다.
+type AddressTaproot struct {
+	hrp            string
+	witnessProgram [32]byte
+}
+
+func NewAddressTaproot(witnessProg []byte, params *chaincfg.Params) (*AddressTaproot, error) {
+	if len(witnessProg) != 32 {
+		return nil, fmt.Errorf("witness program must be 32 bytes for p2tr")
+	}
+	addr := &AddressTaproot{hrp: strings.ToLower(params.Bech32HRPSegwit)}
+	copy(addr.witnessProgram[:], witnessProg)
+	return addr, nil
+}
+
+func (a *AddressTaproot) EncodeAddress() string {
+	encoded, err := encodeSegWitV1Address(a.hrp, a.witnessProgram[:])
+	if err != nil {
+		return ""
+	}
+	return encoded
+}
+
+func (a *AddressTaproot) ScriptAddress() []byte {
+	return a.witnessProgram[:]
+}
+
+func (a *AddressTaproot) IsForNet(params *chaincfg.Params) bool {
+	return a.hrp == params.Bech32HRPSegwit
+}
+
+func (a *AddressTaproot) String() string {
+	return a.EncodeAddress()
+}
+
+// taprootAddress 는 공개키의 BIP341 출력 키(스크립트 트리 없음)로 P2TR 주소를 만듭니다.
+func taprootAddress(pubKeyBytes []byte, params *chaincfg.Params) (*AddressTaproot, error) {
+	curve := curves.K256()
+	point, err := curve.Point.FromAffineCompressed(pubKeyBytes)
+	if err != nil {
+		return nil, fmt.Errorf("invalid public key: %v", err)
+	}
+	outputKey, err := schnorr.TaprootOutputKey(curve, point)
+	if err != nil {
+		return nil, err
+	}
+	return NewAddressTaproot(outputKey, params)
+}
+
+// decodeBitcoinAddress 는 btcutil.DecodeAddress 에 P2TR 주소 해석을 더한 것입니다.
+func decodeBitcoinAddress(address string, params *chaincfg.Params) (btcutil.Address, error) {
+	addr, err := btcutil.DecodeAddress(address, params)
+	if err == nil {
+		return addr, nil
+	}
+	if taproot, taprootErr := decodeTaprootAddress(address, params); taprootErr == nil {
+		return taproot, nil
+	}
+	return nil, err
+}
+
+// payToAddrScript 는 txscript.PayToAddrScript 에 P2TR (OP_1 <32바이트>) 을 더한 것입니다.
+func payToAddrScript(addr btcutil.Address) ([]byte, error) {
+	if taproot, ok := addr.(*AddressTaproot); ok {
+		return txscript.NewScriptBuilder().AddOp(txscript.OP_1).AddData(taproot.ScriptAddress()).Script()
+	}
+	return txscript.PayToAddrScript(addr)
+}
+
+func decodeTaprootAddress(address string, params *chaincfg.Params) (*AddressTaproot, error) {
+	hrp, version, program, err := decodeSegWitV1Address(address)
+	if err != nil {
+		return nil, err
+	}
+	if hrp != params.Bech32HRPSegwit {
+		return nil, fmt.Errorf("address %s is not for network %s", address, params.Name)
+	}
+	if version != 1 {
+		return nil, fmt.Errorf("unsupported witness version: %d", version)
+	}
+	return NewAddressTaproot(program, params)
+}
+
+func encodeSegWitV1Address(hrp string, program []byte) (string, error) {
+	converted, err := bech32.ConvertBits(program, 8, 5, true)
+	if err != nil {
+		return "", err
+	}
+	data := append([]byte{1}, converted...)
+	checksum := bech32mChecksum(hrp, data)
+
+	var sb strings.Builder
+	sb.WriteString(hrp)
+	sb.WriteByte('1')
+	for _, b := range append(data, checksum...) {
+		sb.WriteByte(bech32Charset[b])
+	}
+	return sb.String(), nil
+}
+
+func decodeSegWitV1Address(address string) (string, byte, []byte, error) {
+	if strings.ToLower(address) != address && strings.ToUpper(address) != address {
+		return "", 0, nil, fmt.Errorf("mixed case address")
+	}
+	address = strings.ToLower(address)
+	sep := strings.LastIndexByte(address, '1')
+	if sep < 1 || sep+7 > len(address) || len(address) > 90 {
+		return "", 0, nil, fmt.Errorf("invalid bech32m address")
+	}
+	hrp := address[:sep]
+	data := make([]byte, 0, len(address)-sep-1)
+	for _, c := range address[sep+1:] {
+		v := strings.IndexRune(bech32Charset, c)
+		if v < 0 {
+			return "", 0, nil, fmt.Errorf("invalid bech32m character: %q", c)
+		}
+		data = append(data, byte(v))
+	}
+	if bech32Polymod(hrp, data) != bech32mConst {
+		return "", 0, nil, fmt.Errorf("invalid bech32m checksum")
+	}
+	data = data[:len(data)-6]
+	if len(data) == 0 {
+		return "", 0, nil, fmt.Errorf("empty witness data")
+	}
+	program, err := bech32.ConvertBits(data[1:], 5, 8, false)
+	if err != nil {
+		return "", 0, nil, err
+	}
+	return hrp, data[0], program, nil
+}
+
+func bech32mChecksum(hrp string, data []byte) []byte {
+	values := append(append([]byte{}, data...), 0, 0, 0, 0, 0, 0)
+	polymod := bech32Polymod(hrp, values) ^ bech32mConst
+	checksum := make([]byte, 6)
+	for i := 0; i < 6; i++ {
+		checksum[i] = byte((polymod >> uint(5*(5-i))) & 31)
+	}
+	return checksum
+}
+
+func bech32Polymod(hrp string, values []byte) uint32 {
+	generator := [5]uint32{0x3b6a57b2, 0x26508e6d, 0x1ea119fa, 0x3d4233dd, 0x2a1462b3}
+	chk := uint32(1)
+	step := func(v byte) {
+		top := chk >> 25
+		chk = (chk&0x1ffffff)<<5 ^ uint32(v)
+		for i := 0; i < 5; i++ {
+			if (top>>uint(i))&1 == 1 {
+				chk ^= generator[i]
+			}
+		}
+	}
+	for i := 0; i < len(hrp); i++ {
+		step(hrp[i] >> 5)
+	}
+	step(0)
+	for i := 0; i < len(hrp); i++ {
+		step(hrp[i] & 31)
+	}
+	for _, v := range values {
+		step(v)
+	}
+	return chk
+}
+
+// taprootSigHash 는 키 경로 지출의 BIP341 서명 해시(SIGHASH_DEFAULT, annex 없음)입니다.
+// 모든 입력의 금액과 scriptPubKey 가 필요하므로 Extra 의 Inputs 를 사용합니다.
+func taprootSigHash(tx *wire.MsgTx, idx int, extra *BitcoinTxExtra) ([]byte, error) {
+	if len(extra.Inputs) != len(tx.TxIn) {
+		return nil, fmt.Errorf("input metadata count %d does not match tx inputs %d", len(extra.Inputs), len(tx.TxIn))
+	}
+
+	var prevouts, amounts, scriptPubKeys, sequences, outputs bytes.Buffer
+	for i, in := range tx.TxIn {
+		prevouts.Write(in.PreviousOutPoint.Hash[:])
+		binary.Write(&prevouts, binary.LittleEndian, in.PreviousOutPoint.Index)
+		binary.Write(&sequences, binary.LittleEndian, in.Sequence)
+
+		binary.Write(&amounts, binary.LittleEndian, uint64(extra.Inputs[i].Amount))
+		pkScript, err := hex.DecodeString(extra.Inputs[i].PkScript)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pk_script for input %d: %v", i, err)
+		}
+		if err := wire.WriteVarBytes(&scriptPubKeys, 0, pkScript); err != nil {
+			return nil, fmt.Errorf("failed to write pk_script: %v", err)
+		}
+	}
+	for _, out := range tx.TxOut {
+		if err := wire.WriteTxOut(&outputs, 0, 0, out); err != nil {
+			return nil, fmt.Errorf("failed to serialize output: %v", err)
+		}
+	}
+
+	var msg bytes.Buffer
+	msg.WriteByte(0x00) // sighash epoch
+	msg.WriteByte(0x00) // SIGHASH_DEFAULT
+	binary.Write(&msg, binary.LittleEndian, uint32(tx.Version))
+	binary.Write(&msg, binary.LittleEndian, tx.LockTime)
+	msg.Write(sha256Bytes(prevouts.Bytes()))
+	msg.Write(sha256Bytes(amounts.Bytes()))
+	msg.Write(sha256Bytes(scriptPubKeys.Bytes()))
+	msg.Write(sha256Bytes(sequences.Bytes()))
+	msg.Write(sha256Bytes(outputs.Bytes()))
+	msg.WriteByte(0x00) // spend_type: 키 경로, annex 없음
+	binary.Write(&msg, binary.LittleEndian, uint32(idx))
+
+	sigHash := schnorr.TaggedHash(taprootSigHashTag, msg.Bytes())
+	return sigHash[:], nil
+}
+
+func sha256Bytes(b []byte) []byte {
+	h := sha256.Sum256(b)
+	return h[:]
+}
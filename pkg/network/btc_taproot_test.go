@@ -0,0 +1,120 @@
+package network
+
+import (
+	"bytes"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaprootAddressBIP341(t *testing.T) {
+	// BIP341 wallet-test-vectors.json 의 scriptPubKey[0] (스크립트 트리 없음)
+	internalKey := "d6889cb081036e0faefa3a35157ad71086b123b2b144b649798b494c300a961d"
+	for _, prefix := range []string{"02", "03"} {
+		publicKey, err := hex.DecodeString(prefix + internalKey)
+		require.NoError(t, err)
+		addr, err := taprootAddress(publicKey, &chaincfg.MainNetParams)
+		require.NoError(t, err)
+		assert.Equal(t, "bc1p2wsldez5mud2yam29q22wgfh9439spgduvct83k3pm50fcxa5dps59h4z5", addr.EncodeAddress())
+
+		script, err := payToAddrScript(addr)
+		require.NoError(t, err)
+		assert.Equal(t, "512053a1f6e454df1aa2776a2814a721372d6258050de330b3c6d10ee8f4e0dda343", hex.EncodeToString(script))
+	}
+}
+
+func TestBech32mBIP350Vectors(t *testing.T) {
+	// BIP350 의 유효한 witness v1 이상 주소와 scriptPubKey
+	for _, vector := range []struct {
+		address      string
+		scriptPubKey string
+	}{
+		{"bc1pw508d6qejxtdg4y5r3zarvary0c5xw7kw508d6qejxtdg4y5r3zarvary0c5xw7kt5nd6y", "5128751e76e8199196d454941c45d1b3a323f1433bd6751e76e8199196d454941c45d1b3a323f1433bd6"},
+		{"BC1SW50QGDZ25J", "6002751e"},
+		{"bc1zw508d6qejxtdg4y5r3zarvaryvaxxpcs", "5210751e76e8199196d454941c45d1b3a323"},
+		{"tb1pqqqqp399et2xygdj5xreqhjjvcmzhxw4aywxecjdzew6hylgvsesf3hn0c", "5120000000c4a5cad46221b2a187905e5266362b99d5e91c6ce24d165dab93e86433"},
+		{"bc1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vqzk5jj0", "512079be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798"},
+	} {
+		hrp, version, program, err := decodeSegWitV1Address(vector.address)
+		require.NoError(t, err, vector.address)
+		script := append([]byte{0x50 + version, byte(len(program))}, program...)
+		assert.Equal(t, vector.scriptPubKey, hex.EncodeToString(script), vector.address)
+
+		if version != 1 || len(program) != 32 {
+			continue
+		}
+		// P2TR 주소는 다시 인코딩해도 같아야 합니다.
+		encoded, err := encodeSegWitV1Address(hrp, program)
+		require.NoError(t, err)
+		assert.Equal(t, strings.ToLower(vector.address), encoded)
+
+		params := &chaincfg.MainNetParams
+		if hrp == chaincfg.TestNet3Params.Bech32HRPSegwit {
+			params = &chaincfg.TestNet3Params
+		}
+		addr, err := decodeBitcoinAddress(vector.address, params)
+		require.NoError(t, err)
+		require.IsType(t, &AddressTaproot{}, addr)
+		script, err = payToAddrScript(addr)
+		require.NoError(t, err)
+		assert.Equal(t, vector.scriptPubKey, hex.EncodeToString(script))
+	}
+
+	// BIP350 의 유효하지 않은 주소는 모두 거부합니다.
+	for _, address := range []string{
+		"tc1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vq5zuyut",
+		"bc1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vqh2y7hd",
+		"tb1z0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vqglt7rf",
+		"BC1S0XLXVLHEMJA6C4DQV22UAPCTQUPFHLXM9H8Z3K2E72Q4K9HCZ7VQ54WELL",
+		"bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kemeawh",
+		"tb1q0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vq24jc47",
+		"bc1p38j9r5y49hruaue7wxjce0updqjuyyx0kh56v8s25huc6995vvpql3jow4",
+		"BC130XLXVLHEMJA6C4DQV22UAPCTQUPFHLXM9H8Z3K2E72Q4K9HCZ7VQ7ZWS8R",
+		"bc1pw5dgrnzv",
+		"bc1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7v8n0nx0muaewav253zgeav",
+		"BC1QR508D6QEJXTDG4Y5R3ZARVARYV98GJ9P",
+		"tb1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vq47Zagq",
+		"bc1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7v07qwwzcrf",
+		"tb1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vpggkg4j",
+		"bc1gmk9yu",
+	} {
+		for _, params := range []*chaincfg.Params{&chaincfg.MainNetParams, &chaincfg.TestNet3Params} {
+			_, err := decodeTaprootAddress(address, params)
+			assert.Error(t, err, address)
+		}
+	}
+}
+
+func TestTaprootSigHashBIP341(t *testing.T) {
+	// BIP341 wallet-test-vectors.json 의 keyPathSpending[0]. 입력 4 가 SIGHASH_DEFAULT 입니다.
+	rawTx, err := hex.DecodeString("02000000097de20cbff686da83a54981d2b9bab3586f4ca7e48f57f5b55963115f3b334e9c010000000000000000d7b7cab57b1393ace2d064f4d4a2cb8af6def61273e127517d44759b6dafdd990000000000fffffffff8e1f583384333689228c5d28eac13366be082dc57441760d957275419a418420000000000fffffffff0689180aa63b30cb162a73c6d2a38b7eeda2a83ece74310fda0843ad604853b0100000000feffffffaa5202bdf6d8ccd2ee0f0202afbbb7461d9264a25e5bfd3c5a52ee1239e0ba6c0000000000feffffff956149bdc66faa968eb2be2d2faa29718acbfe3941215893a2a3446d32acd050000000000000000000e664b9773b88c09c32cb70a2a3e4da0ced63b7ba3b22f848531bbb1d5d5f4c94010000000000000000e9aa6b8e6c9de67619e6a3924ae25696bb7b694bb677a632a74ef7eadfd4eabf0000000000ffffffffa778eb6a263dc090464cd125c466b5a99667720b1c110468831d058aa1b82af10100000000ffffffff0200ca9a3b000000001976a91406afd46bcdfd22ef94ac122aa11f241244a37ecc88ac807840cb0000000020ac9a87f5594be208f8532db38cff670c450ed2fea8fcdefcc9a663f78bab962b0065cd1d")
+	require.NoError(t, err)
+	tx := wire.NewMsgTx(wire.TxVersion)
+	require.NoError(t, tx.DeserializeNoWitness(bytes.NewReader(rawTx)))
+
+	extra := &BitcoinTxExtra{Inputs: []BitcoinTxInput{
+		{Amount: 420000000, PkScript: "512053a1f6e454df1aa2776a2814a721372d6258050de330b3c6d10ee8f4e0dda343"},
+		{Amount: 462000000, PkScript: "5120147c9c57132f6e7ecddba9800bb0c4449251c92a1e60371ee77557b6620f3ea3"},
+		{Amount: 294000000, PkScript: "76a914751e76e8199196d454941c45d1b3a323f1433bd688ac"},
+		{Amount: 504000000, PkScript: "5120e4d810fd50586274face62b8a807eb9719cef49c04177cc6b76a9a4251d5450e"},
+		{Amount: 630000000, PkScript: "512091b64d5324723a985170e4dc5a0f84c041804f2cd12660fa5dec09fc21783605"},
+		{Amount: 378000000, PkScript: "00147dd65592d0ab2fe0d0257d571abf032cd9db93dc"},
+		{Amount: 672000000, PkScript: "512075169f4001aa68f15bbed28b218df1d0a62cbbcf1188c6665110c293c907b831"},
+		{Amount: 546000000, PkScript: "5120712447206d7a5238acc7ff53fbe94a3b64539ad291c7cdbc490b7577e4b17df5"},
+		{Amount: 588000000, PkScript: "512077e30a5522dd9f894c3f8b8bd4c4b2cf82ca7da8a3ea6a239655c39c050ab220"},
+	}}
+
+	sigHash, err := taprootSigHash(tx, 4, extra)
+	require.NoError(t, err)
+	assert.Equal(t, "4f900a0bae3f1446fd48490c2958b5a023228f01661cda3496a11da502a7f7ef", hex.EncodeToString(sigHash))
+
+	// 모든 입력의 UTXO 정보가 있어야 합니다.
+	extra.Inputs = extra.Inputs[:8]
+	_, err = taprootSigHash(tx, 4, extra)
+	assert.ErrorContains(t, err, "does not match tx inputs")
+}
@@ -0,0 +1,242 @@
+package network
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"testing"
+
+	"tecdsa/pkg/transaction"
+
+	"github.com/coinbase/kryptology/pkg/core/curves"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeBitcoinBackend 는 메모리에 있는 UTXO 와 트랜잭션을 돌려주는 백엔드입니다.
+type fakeBitcoinBackend struct {
+	utxos     map[string][]UTXO
+	feeRate   float64
+	tipHeight int64
+	txs       map[string]*BitcoinTransaction
+	// broadcasted 는 Broadcast 로 받은 트랜잭션입니다.
+	broadcasted []string
+	// feeTargets 는 EstimateFeeRate 가 받은 목표 블록 수입니다.
+	feeTargets []int
+}
+
+func newFakeBitcoinBackend() *fakeBitcoinBackend {
+	return &fakeBitcoinBackend{
+		utxos:   map[string][]UTXO{},
+		feeRate: 10,
+		txs:     map[string]*BitcoinTransaction{},
+	}
+}
+
+func (b *fakeBitcoinBackend) UnspentOutputs(_ context.Context, address string) ([]UTXO, error) {
+	return b.utxos[address], nil
+}
+
+func (b *fakeBitcoinBackend) EstimateFeeRate(_ context.Context, targetBlocks int) (float64, error) {
+	b.feeTargets = append(b.feeTargets, targetBlocks)
+	if b.feeRate <= 0 {
+		return 0, fmt.Errorf("no fee estimate")
+	}
+	return b.feeRate, nil
+}
+
+func (b *fakeBitcoinBackend) TipHeight(context.Context) (int64, error) {
+	return b.tipHeight, nil
+}
+
+func (b *fakeBitcoinBackend) Transaction(_ context.Context, txid string) (*BitcoinTransaction, error) {
+	tx, ok := b.txs[txid]
+	if !ok {
+		return nil, fmt.Errorf("transaction not found: %s", txid)
+	}
+	return tx, nil
+}
+
+func (b *fakeBitcoinBackend) Broadcast(_ context.Context, rawTx string) (string, error) {
+	b.broadcasted = append(b.broadcasted, rawTx)
+	return fmt.Sprintf("%064x", len(b.broadcasted)), nil
+}
+
+func newBitcoinAddress(t *testing.T, net Network, addrType int) string {
+	_, point, _ := newEthereumKey(t)
+	address, err := DeriveBitcoinAddress(point, net, addrType)
+	require.NoError(t, err)
+	return address
+}
+
+func TestDeriveBitcoinAddress(t *testing.T) {
+	const generator = "0279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798"
+	for _, vector := range []struct {
+		name      string
+		publicKey string
+		network   Network
+		addrType  int
+		address   string
+	}{
+		// 비밀키 1 (생성점 G) 의 주소와 BIP173 예시
+		{"G P2PKH", generator, Bitcoin, P2PKH, "1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMH"},
+		{"G P2SH-P2WPKH", generator, Bitcoin, P2SHP2WPKH, "3JvL6Ymt8MVWiCNHC7oWU6nLeHNJKLZGLN"},
+		{"G P2WPKH", generator, Bitcoin, P2WPKH, "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4"},
+		{"G testnet P2PKH", generator, BitcoinTestNet, P2PKH, "mrCDrCybB6J1vRfbwM5hemdJz73FwDBC8r"},
+		{"G testnet P2WPKH", generator, BitcoinTestNet, P2WPKH, "tb1qw508d6qejxtdg4y5r3zarvary0c5xw7kxpjzsx"},
+		{"G regtest P2WPKH", generator, BitcoinRegTest, P2WPKH, "bcrt1qw508d6qejxtdg4y5r3zarvary0c5xw7kygt080"},
+		// BIP49, BIP84 테스트 벡터의 첫 번째 수신 주소
+		{"BIP49 testnet P2SH-P2WPKH", "03a1af804ac108a8a51782198c2d034b28bf90c8803f5a53f76276fa69a4eae77f", BitcoinTestNet, P2SHP2WPKH, "2Mww8dCYPUpKHofjgcXcBCEGmniw9CoaiD2"},
+		{"BIP84 P2WPKH", "0330d54fd0dd420a6e5f8d3624f5f3482cae350f79d5f0753bf5beef9c2d91af3c", Bitcoin, P2WPKH, "bc1qcr8te4kr609gcawutmrza0j4xv80jy8z306fyu"},
+	} {
+		t.Run(vector.name, func(t *testing.T) {
+			publicKey, err := hex.DecodeString(vector.publicKey)
+			require.NoError(t, err)
+			point, err := curves.K256().Point.FromAffineCompressed(publicKey)
+			require.NoError(t, err)
+
+			address, err := DeriveBitcoinAddress(point, vector.network, vector.addrType)
+			require.NoError(t, err)
+			assert.Equal(t, vector.address, address)
+		})
+	}
+
+	_, point, _ := newEthereumKey(t)
+	for _, addrType := range []int{-1, P2TR + 1} {
+		_, err := DeriveBitcoinAddress(point, Bitcoin, addrType)
+		assert.ErrorContains(t, err, "unsupported address type")
+	}
+	_, err := DeriveBitcoinAddress(point, Ethereum, P2PKH)
+	assert.Error(t, err)
+}
+
+func TestIsValidAddressType(t *testing.T) {
+	for _, addrType := range []int{P2PKH, P2SHP2WPKH, P2WPKH, P2TR} {
+		assert.True(t, IsValidAddressType(Bitcoin, addrType), addrType)
+		assert.True(t, IsValidAddressType(BitcoinTestNet, addrType), addrType)
+	}
+	assert.False(t, IsValidAddressType(Bitcoin, P2TR+1))
+	assert.False(t, IsValidAddressType(Bitcoin, -1))
+	// 비트코인이 아닌 네트워크는 기본 주소 유형만 받습니다.
+	assert.True(t, IsValidAddressType(Ethereum, 0))
+	assert.False(t, IsValidAddressType(Ethereum, P2WPKH))
+}
+
+func TestBuildBitcoinTransaction(t *testing.T) {
+	from := newBitcoinAddress(t, BitcoinTestNet, P2WPKH)
+	to := newBitcoinAddress(t, BitcoinTestNet, P2PKH)
+	txid := strings.Repeat("ab", 32)
+
+	newBackend := func() *fakeBitcoinBackend {
+		backend := newFakeBitcoinBackend()
+		backend.utxos[from] = []UTXO{
+			{TxID: txid, Vout: 0, Value: 60000, Status: UTXOStatus{Confirmed: true}},
+			{TxID: txid, Vout: 1, Value: 50000, Status: UTXOStatus{Confirmed: true}},
+		}
+		return backend
+	}
+
+	t.Run("estimated fee rate", func(t *testing.T) {
+		backend := newBackend()
+		unsignedTx, err := buildBitcoinTransaction(context.Background(), backend, BitcoinTxRequest{From: from, To: to, Amount: "80000"}, BitcoinTestNet)
+		require.NoError(t, err)
+		assert.Equal(t, []int{bitcoinFeeTargetBlocks}, backend.feeTargets)
+
+		tx, extra, err := decodeBitcoinTx(unsignedTx)
+		require.NoError(t, err)
+		require.Len(t, tx.TxIn, 2)
+		require.Len(t, extra.Inputs, 2)
+		require.Len(t, tx.TxOut, 2)
+		assert.Equal(t, int64(80000), tx.TxOut[0].Value)
+		assert.Equal(t, float64(10), extra.FeeRate)
+		assert.Greater(t, extra.Fee, int64(0))
+		assert.Equal(t, int64(110000-80000)-extra.Fee, tx.TxOut[1].Value)
+	})
+
+	t.Run("explicit fee rate", func(t *testing.T) {
+		backend := newBackend()
+		feeRate := 2.5
+		unsignedTx, err := buildBitcoinTransaction(context.Background(), backend, BitcoinTxRequest{From: from, To: to, Amount: "50000", FeeRate: &feeRate}, BitcoinTestNet)
+		require.NoError(t, err)
+		assert.Empty(t, backend.feeTargets)
+
+		tx, extra, err := decodeBitcoinTx(unsignedTx)
+		require.NoError(t, err)
+		assert.Equal(t, feeRate, extra.FeeRate)
+		require.Len(t, tx.TxIn, 1)
+		assert.Less(t, extra.Fee, int64(1000))
+	})
+
+	t.Run("explicit fee", func(t *testing.T) {
+		backend := newBackend()
+		fee := int64(1234)
+		unsignedTx, err := buildBitcoinTransaction(context.Background(), backend, BitcoinTxRequest{From: from, To: to, Amount: "50000", Fee: &fee}, BitcoinTestNet)
+		require.NoError(t, err)
+		assert.Empty(t, backend.feeTargets)
+
+		tx, extra, err := decodeBitcoinTx(unsignedTx)
+		require.NoError(t, err)
+		assert.Equal(t, fee, extra.Fee)
+		assert.Zero(t, extra.FeeRate)
+		assert.Equal(t, int64(60000-50000-1234), tx.TxOut[1].Value)
+	})
+
+	t.Run("min confirmations and pinned utxos", func(t *testing.T) {
+		backend := newBackend()
+		backend.tipHeight = 110
+		backend.utxos[from] = []UTXO{
+			{TxID: txid, Vout: 0, Value: 90000},
+			{TxID: txid, Vout: 1, Value: 60000, Status: UTXOStatus{Confirmed: true, BlockHeight: 109}},
+			{TxID: txid, Vout: 2, Value: 40000, Status: UTXOStatus{Confirmed: true, BlockHeight: 100}},
+		}
+		outPoints := func(unsignedTx *transaction.UnsignedTransaction) []uint32 {
+			tx, _, err := decodeBitcoinTx(unsignedTx)
+			require.NoError(t, err)
+			var vouts []uint32
+			for _, in := range tx.TxIn {
+				vouts = append(vouts, in.PreviousOutPoint.Index)
+			}
+			return vouts
+		}
+
+		unsignedTx, err := buildBitcoinTransaction(context.Background(), backend, BitcoinTxRequest{From: from, To: to, Amount: "50000", CoinSelection: CoinSelectionLargestFirst}, BitcoinTestNet)
+		require.NoError(t, err)
+		assert.Equal(t, []uint32{0}, outPoints(unsignedTx))
+		assert.Equal(t, CoinSelectionLargestFirst, unsignedTx.Extra.(BitcoinTxExtra).CoinSelection)
+
+		unsignedTx, err = buildBitcoinTransaction(context.Background(), backend, BitcoinTxRequest{From: from, To: to, Amount: "50000", CoinSelection: CoinSelectionLargestFirst, MinConfirmations: 1}, BitcoinTestNet)
+		require.NoError(t, err)
+		assert.Equal(t, []uint32{1}, outPoints(unsignedTx))
+
+		// 컨펌 수가 부족한 UTXO 는 고르지 않지만, 고정한 UTXO 는 컨펌 수와 관계없이 사용합니다.
+		_, err = buildBitcoinTransaction(context.Background(), backend, BitcoinTxRequest{From: from, To: to, Amount: "50000", MinConfirmations: 3}, BitcoinTestNet)
+		assert.ErrorContains(t, err, "insufficient funds")
+		unsignedTx, err = buildBitcoinTransaction(context.Background(), backend, BitcoinTxRequest{From: from, To: to, Amount: "80000", MinConfirmations: 3, UTXOs: []string{txid + ":1"}}, BitcoinTestNet)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []uint32{1, 2}, outPoints(unsignedTx))
+
+		_, err = buildBitcoinTransaction(context.Background(), backend, BitcoinTxRequest{From: from, To: to, Amount: "50000", UTXOs: []string{txid + ":7"}}, BitcoinTestNet)
+		assert.ErrorContains(t, err, "is not unspent")
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		backend := newBackend()
+		_, err := buildBitcoinTransaction(context.Background(), backend, BitcoinTxRequest{From: from, To: to, Amount: "200000"}, BitcoinTestNet)
+		assert.ErrorContains(t, err, "insufficient funds")
+
+		invalidRate := float64(0)
+		_, err = buildBitcoinTransaction(context.Background(), backend, BitcoinTxRequest{From: from, To: to, Amount: "1000", FeeRate: &invalidRate}, BitcoinTestNet)
+		assert.ErrorContains(t, err, "invalid fee rate")
+
+		backend.feeRate = 0
+		_, err = buildBitcoinTransaction(context.Background(), backend, BitcoinTxRequest{From: from, To: to, Amount: "1000"}, BitcoinTestNet)
+		assert.ErrorContains(t, err, "no fee estimate")
+
+		_, err = buildBitcoinTransaction(context.Background(), newFakeBitcoinBackend(), BitcoinTxRequest{From: from, To: to, Amount: "1000"}, BitcoinTestNet)
+		assert.ErrorContains(t, err, "insufficient funds")
+
+		_, err = buildBitcoinTransaction(context.Background(), backend, BitcoinTxRequest{From: from, To: to, Amount: "500"}, BitcoinTestNet)
+		assert.ErrorContains(t, err, "dust threshold")
+	})
+}
@@ -0,0 +1,145 @@
+package network
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcutil/hdkeychain"
+	"github.com/coinbase/kryptology/pkg/core/curves"
+)
+
+// BitcoinDescriptor 는 watch-only 지갑에서 가져다 쓸 수 있는 BIP380 출력 디스크립터입니다.
+type BitcoinDescriptor struct {
+	AddressType string `json:"address_type"`
+	Descriptor  string `json:"descriptor"`
+	Address     string `json:"address"`
+}
+
+// 디스크립터 함수와 btc.go 의 주소 유형을 짝지어 둡니다.
+var bitcoinDescriptorTemplates = []struct {
+	addrType int
+	name     string
+	format   string
+}{
+	{P2PKH, "P2PKH", "pkh(%s)"},
+	{P2SHP2WPKH, "P2SHP2WPKH", "sh(wpkh(%s))"},
+	{P2WPKH, "P2WPKH", "wpkh(%s)"},
+	{P2TR, "P2TR", "tr(%s)"},
+}
+
+const (
+	descriptorInputCharset    = "0123456789()[],'/*abcdefgh@:$%{}IJKLMNOPQRSTUVWXYZ&+-.;<=>?!^_|~ijklmnopqrstuvwxyzABCDEFGH`#\"\\ "
+	descriptorChecksumCharset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+)
+
+var descriptorGenerator = [5]uint64{0xf5dee51989, 0xa9fdca3312, 0x1bab10e32d, 0x3706b1677a, 0x644d626ffd}
+
+// BitcoinParams 는 비트코인 계열 네트워크의 체인 파라미터를 돌려줍니다.
+func BitcoinParams(network Network) (*chaincfg.Params, error) {
+	params := NetworkMetadata[network].bitcoinParams
+	if params == nil {
+		return nil, fmt.Errorf("unsupported Bitcoin network: %v", network)
+	}
+	return params, nil
+}
+
+// DeriveBitcoinXpub 는 DKG 공개키와 체인코드로 깊이 0 의 확장 공개키를 만듭니다.
+// 메인넷은 xpub, 테스트넷/레그테스트는 tpub 으로 인코딩됩니다.
+func DeriveBitcoinXpub(point curves.Point, chainCode []byte, network Network) (string, error) {
+	params, err := BitcoinParams(network)
+	if err != nil {
+		return "", err
+	}
+	if len(chainCode) != 32 {
+		return "", fmt.Errorf("invalid chain code length: %d", len(chainCode))
+	}
+
+	pubKeyBytes := point.ToAffineCompressed()
+	if len(pubKeyBytes) != 33 {
+		return "", fmt.Errorf("failed to convert public key to bytes")
+	}
+
+	parentFP := []byte{0x00, 0x00, 0x00, 0x00}
+	extendedKey := hdkeychain.NewExtendedKey(params.HDPublicKeyID[:], pubKeyBytes, chainCode, parentFP, 0, 0, false)
+	return extendedKey.String(), nil
+}
+
+// DeriveBitcoinDescriptors 는 btc.go 에서 지원하는 주소 유형별 디스크립터와 그 주소를 돌려줍니다.
+// 디스크립터는 깊이 0 의 xpub 을 그대로 사용하므로 DKG 키 자체의 주소와 일치합니다.
+func DeriveBitcoinDescriptors(point curves.Point, xpub string, network Network) ([]BitcoinDescriptor, error) {
+	params, err := BitcoinParams(network)
+	if err != nil {
+		return nil, err
+	}
+
+	pubKeyBytes := point.ToAffineCompressed()
+	descriptors := make([]BitcoinDescriptor, 0, len(bitcoinDescriptorTemplates))
+	for _, template := range bitcoinDescriptorTemplates {
+		address, err := bitcoinAddress(pubKeyBytes, params, template.addrType)
+		if err != nil {
+			return nil, err
+		}
+
+		descriptor, err := addDescriptorChecksum(fmt.Sprintf(template.format, xpub))
+		if err != nil {
+			return nil, err
+		}
+
+		descriptors = append(descriptors, BitcoinDescriptor{
+			AddressType: template.name,
+			Descriptor:  descriptor,
+			Address:     address.EncodeAddress(),
+		})
+	}
+
+	return descriptors, nil
+}
+
+// addDescriptorChecksum 은 BIP380 체크섬을 계산해 "#xxxxxxxx" 를 덧붙입니다.
+func addDescriptorChecksum(descriptor string) (string, error) {
+	var symbols []uint64
+	var groups []uint64
+	for _, c := range descriptor {
+		v := strings.IndexRune(descriptorInputCharset, c)
+		if v < 0 {
+			return "", fmt.Errorf("invalid descriptor character: %q", c)
+		}
+		symbols = append(symbols, uint64(v&31))
+		groups = append(groups, uint64(v>>5))
+		if len(groups) == 3 {
+			symbols = append(symbols, groups[0]*9+groups[1]*3+groups[2])
+			groups = groups[:0]
+		}
+	}
+	switch len(groups) {
+	case 1:
+		symbols = append(symbols, groups[0])
+	case 2:
+		symbols = append(symbols, groups[0]*3+groups[1])
+	}
+	symbols = append(symbols, 0, 0, 0, 0, 0, 0, 0, 0)
+
+	checksum := descriptorPolymod(symbols) ^ 1
+	var sb strings.Builder
+	sb.WriteString(descriptor)
+	sb.WriteByte('#')
+	for i := 0; i < 8; i++ {
+		sb.WriteByte(descriptorChecksumCharset[(checksum>>(5*(7-i)))&31])
+	}
+	return sb.String(), nil
+}
+
+func descriptorPolymod(symbols []uint64) uint64 {
+	chk := uint64(1)
+	for _, value := range symbols {
+		top := chk >> 35
+		chk = (chk&0x7ffffffff)<<5 ^ value
+		for i := 0; i < 5; i++ {
+			if (top>>i)&1 == 1 {
+				chk ^= descriptorGenerator[i]
+			}
+		}
+	}
+	return chk
+}
@@ -0,0 +1,99 @@
+package network
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/coinbase/kryptology/pkg/core/curves"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// BIP32 테스트 벡터 1 의 마스터 키 (시드 000102030405060708090a0b0c0d0e0f)
+const (
+	bip32TestPublicKey = "0339a36013301597daef41fbe593a02cc513d0b55527ec2df1050e2e8ff49c85c2"
+	bip32TestChainCode = "873dff81c02f525623fd1fe5167eac3a55a049de3d314bb42ee227ffed37d508"
+	bip32TestXpub      = "xpub661MyMwAqRbcFtXgS5sYJABqqG9YLmC4Q1Rdap9gSE8NqtwybGhePY2gZ29ESFjqJoCu1Rupje8YtGqsefD265TMg7usUDFdp6W1EGMcet8"
+	bip32TestTpub      = "tpubD6NzVbkrYhZ4XgiXtGrdW5XDAPFCL9h7we1vwNCpn8tGbBcgfVYjXyhWo4E1xkh56hjod1RhGjxbaTLV3X4FyWuejifB9jusQ46QzG87VKp"
+)
+
+func bip32TestKey(t *testing.T) (curves.Point, []byte) {
+	publicKey, err := hex.DecodeString(bip32TestPublicKey)
+	require.NoError(t, err)
+	point, err := curves.K256().Point.FromAffineCompressed(publicKey)
+	require.NoError(t, err)
+	chainCode, err := hex.DecodeString(bip32TestChainCode)
+	require.NoError(t, err)
+	return point, chainCode
+}
+
+func TestDeriveBitcoinXpub(t *testing.T) {
+	point, chainCode := bip32TestKey(t)
+
+	xpub, err := DeriveBitcoinXpub(point, chainCode, Bitcoin)
+	require.NoError(t, err)
+	assert.Equal(t, bip32TestXpub, xpub)
+
+	// 테스트넷과 레그테스트는 tpub 입니다.
+	for _, net := range []Network{BitcoinTestNet, BitcoinRegTest} {
+		tpub, err := DeriveBitcoinXpub(point, chainCode, net)
+		require.NoError(t, err)
+		assert.Equal(t, bip32TestTpub, tpub)
+	}
+
+	_, err = DeriveBitcoinXpub(point, chainCode[:31], Bitcoin)
+	assert.ErrorContains(t, err, "invalid chain code length")
+	_, err = DeriveBitcoinXpub(point, chainCode, Ethereum)
+	assert.Error(t, err)
+}
+
+func TestDescriptorChecksum(t *testing.T) {
+	// BIP380, Bitcoin Core doc/descriptors.md 의 예시
+	for _, expected := range []string{
+		"raw(deadbeef)#89f8spxm",
+		"sh(multi(2,[00000000/111'/222]xprvA1RpRA33e1JQ7ifknakTFpgNXPmW2YvmhqLQYMmrj4xJXXWYpDPS3xz7iAxn8L39njGVyuoseXzU6rcxFLJ8HFsTjSyQbLYnMpCqE2VbFWc,xprv9uPDJpEQgRQfDcW7BkF7eTya6RPxXeJCqCJGHuCJ4GiRVLzkTXBAJMu2qaMWPrS7AANYqdq6vcBcBUdJCVVFceUvJFjaPdGZ2y9WACViL4L/0))#ggrsrxfy",
+	} {
+		descriptor := expected[:strings.IndexByte(expected, '#')]
+		withChecksum, err := addDescriptorChecksum(descriptor)
+		require.NoError(t, err)
+		assert.Equal(t, expected, withChecksum)
+	}
+
+	_, err := addDescriptorChecksum("raw(Ü)")
+	assert.ErrorContains(t, err, "invalid descriptor character")
+}
+
+func TestDeriveBitcoinDescriptors(t *testing.T) {
+	point, _ := bip32TestKey(t)
+
+	descriptors, err := DeriveBitcoinDescriptors(point, bip32TestXpub, Bitcoin)
+	require.NoError(t, err)
+	require.Len(t, descriptors, len(bitcoinDescriptorTemplates))
+
+	functions := map[string]string{
+		"P2PKH":      "pkh(" + bip32TestXpub + ")",
+		"P2SHP2WPKH": "sh(wpkh(" + bip32TestXpub + "))",
+		"P2WPKH":     "wpkh(" + bip32TestXpub + ")",
+		"P2TR":       "tr(" + bip32TestXpub + ")",
+	}
+	for i, descriptor := range descriptors {
+		template := bitcoinDescriptorTemplates[i]
+		assert.Equal(t, template.name, descriptor.AddressType)
+
+		// 체크섬을 떼고 다시 계산해도 같아야 합니다.
+		body, checksum, found := strings.Cut(descriptor.Descriptor, "#")
+		require.True(t, found)
+		assert.Equal(t, functions[template.name], body)
+		assert.Len(t, checksum, 8)
+		recomputed, err := addDescriptorChecksum(body)
+		require.NoError(t, err)
+		assert.Equal(t, descriptor.Descriptor, recomputed)
+
+		// 디스크립터의 주소는 DKG 키 자체의 주소입니다.
+		address, err := DeriveBitcoinAddress(point, Bitcoin, template.addrType)
+		require.NoError(t, err)
+		assert.Equal(t, address, descriptor.Address)
+	}
+	assert.Equal(t, "15mKKb2eos1hWa6tisdPwwDC1a5J1y9nma", descriptors[0].Address)
+}
@@ -0,0 +1,43 @@
+package network
+
+import "github.com/coinbase/kryptology/pkg/core/curves"
+
+// Curve 는 키가 사용하는 타원곡선입니다.
+// 키와 쉐어에 함께 저장되어 키 생성/서명 프로토콜과 주소 파생에 쓰입니다.
+type Curve int32
+
+const (
+	Secp256k1 Curve = iota
+	Ed25519
+	P256
+)
+
+func (c Curve) String() string {
+	switch c {
+	case Secp256k1:
+		return "secp256k1"
+	case Ed25519:
+		return "ed25519"
+	case P256:
+		return "p256"
+	default:
+		return "unknown"
+	}
+}
+
+// KryptologyCurve 는 곡선에 해당하는 kryptology 곡선을 돌려줍니다.
+func (c Curve) KryptologyCurve() *curves.Curve {
+	switch c {
+	case Ed25519:
+		return curves.ED25519()
+	case P256:
+		return curves.P256()
+	default:
+		return curves.K256()
+	}
+}
+
+// IsEcdsaCurve 는 DKLs 2자간 ECDSA 키 생성/서명(/key_gen, /sign)을 지원하는 곡선인지 확인합니다.
+func (c Curve) IsEcdsaCurve() bool {
+	return c == Secp256k1 || c == P256
+}
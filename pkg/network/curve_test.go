@@ -0,0 +1,108 @@
+package network
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	crand "crypto/rand"
+	"crypto/sha256"
+	"math/big"
+	"testing"
+
+	"github.com/coinbase/kryptology/pkg/core/curves"
+	"github.com/coinbase/kryptology/pkg/tecdsa/dkls/v1/dkg"
+	"github.com/coinbase/kryptology/pkg/tecdsa/dkls/v1/sign"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// runDklsDkg 는 Alice 와 Bob 의 DKLs DKG 라운드를 실행합니다.
+func runDklsDkg(t *testing.T, curve *curves.Curve) (*dkg.AliceOutput, *dkg.BobOutput) {
+	alice := dkg.NewAlice(curve)
+	bob := dkg.NewBob(curve)
+	seed, err := bob.Round1GenerateRandomSeed()
+	require.NoError(t, err)
+	round2, err := alice.Round2CommitToProof(seed)
+	require.NoError(t, err)
+	proof3, err := bob.Round3SchnorrProve(round2)
+	require.NoError(t, err)
+	proof4, err := alice.Round4VerifyAndReveal(proof3)
+	require.NoError(t, err)
+	proof5, err := bob.Round5DecommitmentAndStartOt(proof4)
+	require.NoError(t, err)
+	choices, err := alice.Round6DkgRound2Ot(proof5)
+	require.NoError(t, err)
+	challenge, err := bob.Round7DkgRound3Ot(choices)
+	require.NoError(t, err)
+	responses, err := alice.Round8DkgRound4Ot(challenge)
+	require.NoError(t, err)
+	openings, err := bob.Round9DkgRound5Ot(responses)
+	require.NoError(t, err)
+	require.NoError(t, alice.Round10DkgRound6Ot(openings))
+	return alice.Output(), bob.Output()
+}
+
+// runDklsSign 은 게이트웨이 /sign 과 같은 순서로 DKLs 서명 라운드를 실행합니다.
+func runDklsSign(t *testing.T, curve *curves.Curve, aliceOutput *dkg.AliceOutput, bobOutput *dkg.BobOutput, message []byte) *curves.EcdsaSignature {
+	alice := sign.NewAlice(curve, sha256.New(), aliceOutput)
+	bob := sign.NewBob(curve, sha256.New(), bobOutput)
+	seed, err := alice.Round1GenerateRandomSeed()
+	require.NoError(t, err)
+	round2, err := bob.Round2Initialize(seed)
+	require.NoError(t, err)
+	round3, err := alice.Round3Sign(message, round2)
+	require.NoError(t, err)
+	require.NoError(t, bob.Round4Final(message, round3))
+	return bob.Signature
+}
+
+func TestP256DkgSignRoundTrip(t *testing.T) {
+	curve := P256.KryptologyCurve()
+	aliceOutput, bobOutput := runDklsDkg(t, curve)
+	require.True(t, aliceOutput.PublicKey.Equal(bobOutput.PublicKey))
+	assert.Equal(t, curves.P256Name, aliceOutput.PublicKey.CurveName())
+
+	// 게이트웨이와 무관한 crypto/ecdsa 로 DKG 공개키에 대해 검증합니다.
+	uncompressed := aliceOutput.PublicKey.ToAffineUncompressed()
+	publicKey := &ecdsa.PublicKey{
+		Curve: elliptic.P256(),
+		X:     new(big.Int).SetBytes(uncompressed[1:33]),
+		Y:     new(big.Int).SetBytes(uncompressed[33:]),
+	}
+	require.True(t, publicKey.Curve.IsOnCurve(publicKey.X, publicKey.Y))
+
+	message := []byte("p256 round trip")
+	signature := runDklsSign(t, curve, aliceOutput, bobOutput, message)
+	digest := sha256.Sum256(message)
+	assert.True(t, ecdsa.Verify(publicKey, digest[:], signature.R, signature.S))
+	otherDigest := sha256.Sum256(append(message, '!'))
+	assert.False(t, ecdsa.Verify(publicKey, otherDigest[:], signature.R, signature.S))
+
+	raw := make([]byte, 64)
+	signature.R.FillBytes(raw[:32])
+	signature.S.FillBytes(raw[32:])
+	assert.True(t, VerifyNeoSignature(aliceOutput.PublicKey, message, raw))
+}
+
+func TestP256KeyRejectedForSecp256k1Addresses(t *testing.T) {
+	point := curves.P256().ScalarBaseMult(curves.P256().Scalar.Random(crand.Reader))
+
+	// 같은 33바이트 압축 형식이라도 비트코인, EVM 주소로 만들지 않습니다.
+	for _, addrType := range []int{P2PKH, P2SHP2WPKH, P2WPKH, P2TR} {
+		_, err := DeriveBitcoinAddress(point, Bitcoin, addrType)
+		assert.ErrorContains(t, err, "require a secp256k1 key", addrType)
+	}
+	for _, net := range []Network{Ethereum, Avalanche_C_CHAIN} {
+		_, err := DeriveEthereumAddress(point, net, 0)
+		assert.ErrorContains(t, err, "require a secp256k1 key", net)
+	}
+
+	address, err := DeriveNeoAddress(point, Neo, 0)
+	require.NoError(t, err)
+	assert.Equal(t, byte('N'), address[0])
+}
+
+func TestIsEcdsaCurve(t *testing.T) {
+	assert.True(t, Secp256k1.IsEcdsaCurve())
+	assert.True(t, P256.IsEcdsaCurve())
+	assert.False(t, Ed25519.IsEcdsaCurve())
+}
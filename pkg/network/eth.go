@@ -4,20 +4,29 @@ import (
 	"context"
 	"encoding/base64"
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"math/big"
+	"sort"
 	"tecdsa/pkg/transaction"
+	"time"
 
 	"github.com/coinbase/kryptology/pkg/core/curves"
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	ethclient "github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/ethclient/gethclient"
 	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/pkg/errors"
 )
 
+// ethereumDialTimeout 은 RPC 주소마다 연결과 체인 ID 확인을 기다리는 시간입니다.
+const ethereumDialTimeout = 10 * time.Second
+
 type EthereumTxRequest struct {
 	From string `json:"from"`
 	To   string `json:"to"`
@@ -41,9 +50,129 @@ type EthereumTxRequest struct {
 	*/
 	GasLimit *uint64 `json:"gasLimit,omitempty"`
 	Data     string  `json:"data,omitempty"`
+	/*
+		Type
+		0: 레거시 (gasPrice), 1: EIP-2930 (gasPrice, accessList), 2: EIP-1559 (maxFeePerGas, maxPriorityFeePerGas)
+		생략하면 gasPrice 가 있을 때 레거시 (접근 목록이 있으면 EIP-2930), 없으면 EIP-1559 트랜잭션을 만듭니다
+		eip1559 기능이 없는 네트워크는 gasPrice 가 없어도 레거시 (접근 목록이 있으면 EIP-2930) 트랜잭션을 만듭니다
+	*/
+	Type *uint8 `json:"type,omitempty"`
+	/*
+		MaxFeePerGas, MaxPriorityFeePerGas
+		단위는 Wei 입니다. 생략하면 최근 블록의 수수료 기록 (eth_feeHistory) 으로 정합니다
+		팁은 최근 블록 팁의 중간값, 최대 수수료는 다음 블록 기본 수수료의 두 배에 팁을 더한 값입니다
+	*/
+	MaxFeePerGas         *string `json:"maxFeePerGas,omitempty"`
+	MaxPriorityFeePerGas *string `json:"maxPriorityFeePerGas,omitempty"`
+	/*
+		AccessList
+		트랜잭션이 접근할 주소와 스토리지 키입니다 (EIP-2930). 예: [{"address": "0x...", "storageKeys": ["0x..."]}]
+		CreateAccessList 가 true 이면 노드의 eth_createAccessList 로 만듭니다
+	*/
+	AccessList       types.AccessList `json:"accessList,omitempty"`
+	CreateAccessList bool             `json:"createAccessList,omitempty"`
+	/*
+		Token
+		ERC-20 토큰 컨트랙트 주소입니다. 주어지면 to 에게 amount 만큼 토큰을 보내는 transfer 호출을 만듭니다
+		amount 는 토큰의 decimals 에 따른 소수점 단위입니다 (예: USDC "1.5" = 1500000)
+	*/
+	Token string `json:"token,omitempty"`
+	/*
+		Collection, TokenID
+		ERC-721 컬렉션 컨트랙트 주소와 토큰 ID (10진수) 입니다. 주어지면 from 의 토큰을 to 에게 보내는 safeTransferFrom 호출을 만듭니다
+	*/
+	Collection string `json:"collection,omitempty"`
+	TokenID    string `json:"tokenId,omitempty"`
+	/*
+		ABI, ABIName, Method, Args
+		to 컨트랙트의 method 를 args (JSON 배열, 입력 순서) 로 호출하는 calldata 를 만듭니다. amount (wei) 는 생략할 수 있습니다
+		ABI 는 JSON ABI 이며, ABIName 을 주면 게이트웨이에 등록한 ABI 를 사용합니다
+		method 는 이름 (예: "stake") 또는 오버로드된 경우 시그니처 (예: "deposit(uint256,address)") 입니다
+	*/
+	ABI     json.RawMessage   `json:"abi,omitempty"`
+	ABIName string            `json:"abiName,omitempty"`
+	Method  string            `json:"method,omitempty"`
+	Args    []json.RawMessage `json:"args,omitempty"`
 }
 
-func DeriveEthereumAddress(point curves.Point, _ Network) (string, error) {
+// txType 은 요청의 트랜잭션 유형을 정합니다. eip1559 가 false 인 네트워크는 기본으로 레거시 트랜잭션을 만듭니다.
+func (r EthereumTxRequest) txType(eip1559 bool) (uint8, error) {
+	dynamicFee := r.MaxFeePerGas != nil || r.MaxPriorityFeePerGas != nil
+	accessList := len(r.AccessList) > 0 || r.CreateAccessList
+	if len(r.AccessList) > 0 && r.CreateAccessList {
+		return 0, fmt.Errorf("accessList and createAccessList cannot be used together")
+	}
+	if r.Type == nil {
+		if r.GasPrice != nil && !dynamicFee {
+			if accessList {
+				return types.AccessListTxType, nil
+			}
+			return types.LegacyTxType, nil
+		}
+		if !eip1559 {
+			if dynamicFee {
+				return 0, fmt.Errorf("the network does not support EIP-1559 transactions")
+			}
+			if accessList {
+				return types.AccessListTxType, nil
+			}
+			return types.LegacyTxType, nil
+		}
+		return types.DynamicFeeTxType, nil
+	}
+
+	switch *r.Type {
+	case types.LegacyTxType:
+		if dynamicFee {
+			return 0, fmt.Errorf("maxFeePerGas and maxPriorityFeePerGas are not allowed for legacy transactions")
+		}
+		if accessList {
+			return 0, fmt.Errorf("access lists are not allowed for legacy transactions")
+		}
+	case types.AccessListTxType:
+		if dynamicFee {
+			return 0, fmt.Errorf("maxFeePerGas and maxPriorityFeePerGas are not allowed for EIP-2930 transactions")
+		}
+	case types.DynamicFeeTxType:
+		if !eip1559 {
+			return 0, fmt.Errorf("the network does not support EIP-1559 transactions")
+		}
+		if r.GasPrice != nil {
+			return 0, fmt.Errorf("gasPrice is not allowed for EIP-1559 transactions")
+		}
+	default:
+		return 0, fmt.Errorf("unsupported transaction type: %d", *r.Type)
+	}
+	return *r.Type, nil
+}
+
+// EthereumTxExtra 는 EVM 미서명 트랜잭션의 Extra 필드입니다.
+type EthereumTxExtra struct {
+	Type                 uint8            `json:"type"`
+	ChainID              int64            `json:"chainId"`
+	From                 string           `json:"from,omitempty"`
+	Nonce                uint64           `json:"nonce"`
+	GasPrice             string           `json:"gasPrice,omitempty"`
+	MaxFeePerGas         string           `json:"maxFeePerGas,omitempty"`
+	MaxPriorityFeePerGas string           `json:"maxPriorityFeePerGas,omitempty"`
+	GasLimit             uint64           `json:"gasLimit"`
+	To                   string           `json:"to"`
+	Value                string           `json:"value"`
+	Data                 string           `json:"data"`
+	AccessList           types.AccessList `json:"accessList,omitempty"`
+	// Token, Summary 는 토큰 전송 트랜잭션의 전송 내용과 그 요약입니다 (예: "transfer 1.5 USDC to 0x...").
+	Token   *EthereumTokenTransfer `json:"token,omitempty"`
+	Summary string                 `json:"summary,omitempty"`
+	// Call 은 컨트랙트 호출 트랜잭션을 ABI 로 해석한 값입니다.
+	Call *EthereumContractCall `json:"call,omitempty"`
+	// SigningHash 는 트랜잭션 유형의 서명 해시 (keccak256(프리이미지)) 입니다.
+	SigningHash string `json:"signingHash"`
+}
+
+func DeriveEthereumAddress(point curves.Point, _ Network, _ int) (string, error) {
+	if point.CurveName() != curves.K256Name {
+		return "", fmt.Errorf("ethereum addresses require a secp256k1 key, got %s", point.CurveName())
+	}
 	pointToBytes := point.ToAffineUncompressed()
 	unmarshalPubKey, err := crypto.UnmarshalPubkey(pointToBytes)
 	if err != nil {
@@ -58,11 +187,85 @@ func VerifyEtherumSignature(point curves.Point, txOrigin []byte, signature []byt
 	return crypto.VerifySignature(publicKey, crypto.Keccak256(txOrigin), signature)
 }
 
+// ethereumBackend 는 트랜잭션을 만들 때 사용하는 RPC 메서드입니다. *ethereumRPC 가 구현합니다.
+type ethereumBackend interface {
+	PendingNonceAt(ctx context.Context, account common.Address) (uint64, error)
+	SuggestGasPrice(ctx context.Context) (*big.Int, error)
+	SuggestGasTipCap(ctx context.Context) (*big.Int, error)
+	FeeHistory(ctx context.Context, blockCount uint64, lastBlock *big.Int, rewardPercentiles []float64) (*ethereum.FeeHistory, error)
+	EstimateGas(ctx context.Context, msg ethereum.CallMsg) (uint64, error)
+	CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error)
+	CreateAccessList(ctx context.Context, msg ethereum.CallMsg) (*types.AccessList, uint64, string, error)
+}
+
+// ethereumRPC 는 표준 eth_ 메서드 (ethclient) 와 eth_createAccessList (gethclient) 를 함께 제공합니다.
+type ethereumRPC struct {
+	*ethclient.Client
+	geth *gethclient.Client
+}
+
+// dialEthereum 은 네트워크의 RPC 주소를 차례로 시도해 체인 ID 가 맞는 첫 노드에 연결합니다.
+func dialEthereum(network Network) (*ethereumRPC, error) {
+	if network.ChainID() == nil {
+		return nil, fmt.Errorf("invalid chain ID for network: %s", network)
+	}
+	urls := network.RPCs()
+	if len(urls) == 0 {
+		return nil, fmt.Errorf("no RPC endpoint is configured for network: %s", network)
+	}
+
+	var lastErr error
+	for _, url := range urls {
+		client, err := dialEthereumRPC(url, *network.ChainID())
+		if err == nil {
+			return client, nil
+		}
+		lastErr = err
+	}
+	return nil, fmt.Errorf("failed to connect to the Ethereum client: %v", lastErr)
+}
+
+func dialEthereumRPC(url string, chainID int64) (*ethereumRPC, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), ethereumDialTimeout)
+	defer cancel()
+
+	rpcClient, err := rpc.DialContext(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+	client := &ethereumRPC{Client: ethclient.NewClient(rpcClient), geth: gethclient.New(rpcClient)}
+	remoteChainID, err := client.ChainID(ctx)
+	if err != nil {
+		client.Close()
+		return nil, err
+	}
+	if remoteChainID.Cmp(big.NewInt(chainID)) != 0 {
+		client.Close()
+		return nil, fmt.Errorf("%s returned chain ID %s, expected %d", url, remoteChainID, chainID)
+	}
+	return client, nil
+}
+
+func (c *ethereumRPC) CreateAccessList(ctx context.Context, msg ethereum.CallMsg) (*types.AccessList, uint64, string, error) {
+	return c.geth.CreateAccessList(ctx, msg)
+}
+
 func CreateUnsignedEthereumTransaction(req interface{}, network Network) (*transaction.UnsignedTransaction, error) {
 	ethReq, ok := req.(EthereumTxRequest)
 	if !ok {
 		return nil, fmt.Errorf("invalid request type for Ethereum transaction")
 	}
+
+	client, err := dialEthereum(network)
+	if err != nil {
+		return nil, err
+	}
+	defer client.Close()
+
+	return buildEthereumTransaction(client, ethReq, network)
+}
+
+func buildEthereumTransaction(backend ethereumBackend, ethReq EthereumTxRequest, network Network) (*transaction.UnsignedTransaction, error) {
 	// Validate addresses
 	if !IsValidEthereumAddress(ethReq.From) {
 		return nil, fmt.Errorf("invalid 'from' address: %s", ethReq.From)
@@ -77,83 +280,378 @@ func CreateUnsignedEthereumTransaction(req interface{}, network Network) (*trans
 	}
 	chainIDBigInt := new(big.Int).SetInt64(*chainID)
 
-	client, err := ethclient.Dial(network.RPC())
+	txType, err := ethReq.txType(network.HasFeature(FeatureEIP1559))
 	if err != nil {
-		return nil, fmt.Errorf("failed to connect to the Ethereum client: %v", err)
+		return nil, err
 	}
-	defer client.Close()
 
-	to := common.HexToAddress(ethReq.To)
-	value, ok := new(big.Int).SetString(ethReq.Amount, 10)
-	if !ok {
-		return nil, fmt.Errorf("invalid value: %s", ethReq.Amount)
+	// 토큰 전송은 토큰 컨트랙트로 보내는 값 0 의 호출입니다.
+	var to common.Address
+	var value *big.Int
+	var data []byte
+	var token *EthereumTokenTransfer
+	var call *EthereumContractCall
+	switch {
+	case ethReq.isContractCall():
+		to = common.HexToAddress(ethReq.To)
+		if value, data, call, err = buildContractCall(ethReq); err != nil {
+			return nil, err
+		}
+	case ethReq.isTokenTransfer():
+		if to, data, token, err = buildTokenTransfer(backend, ethReq); err != nil {
+			return nil, err
+		}
+		value = new(big.Int)
+	default:
+		to = common.HexToAddress(ethReq.To)
+		var ok bool
+		if value, ok = new(big.Int).SetString(ethReq.Amount, 10); !ok {
+			return nil, fmt.Errorf("invalid value: %s", ethReq.Amount)
+		}
+		if data, err = getTransactionData(ethReq.Data); err != nil {
+			return nil, err
+		}
 	}
 
-	nonce, err := getNonce(client, ethReq.From, ethReq.Nonce)
+	nonce, err := getNonce(backend, ethReq.From, ethReq.Nonce)
 	if err != nil {
 		return nil, err
 	}
 
-	gasPrice, err := getGasPrice(client, ethReq.GasPrice)
+	from := common.HexToAddress(ethReq.From)
+	accessList, err := getAccessList(backend, ethReq, from, to, value, data)
 	if err != nil {
 		return nil, err
 	}
 
-	data, err := getTransactionData(ethReq.Data)
+	gasLimit, err := getGasLimit(backend, from, to, value, data, accessList, ethReq.GasLimit)
 	if err != nil {
 		return nil, err
 	}
 
-	gasLimit, err := getGasLimit(client, ethReq.To, data, ethReq.GasLimit)
-	if err != nil {
-		return nil, err
+	// Create transaction
+	var tx *types.Transaction
+	switch txType {
+	case types.LegacyTxType:
+		gasPrice, err := getGasPrice(backend, ethReq.GasPrice)
+		if err != nil {
+			return nil, err
+		}
+		tx = types.NewTx(&types.LegacyTx{
+			Nonce:    nonce,
+			GasPrice: gasPrice,
+			Gas:      gasLimit,
+			To:       &to,
+			Value:    value,
+			Data:     data,
+		})
+	case types.AccessListTxType:
+		gasPrice, err := getGasPrice(backend, ethReq.GasPrice)
+		if err != nil {
+			return nil, err
+		}
+		tx = types.NewTx(&types.AccessListTx{
+			ChainID:    chainIDBigInt,
+			Nonce:      nonce,
+			GasPrice:   gasPrice,
+			Gas:        gasLimit,
+			To:         &to,
+			Value:      value,
+			Data:       data,
+			AccessList: accessList,
+		})
+	case types.DynamicFeeTxType:
+		gasTipCap, gasFeeCap, err := getDynamicFees(backend, ethReq.MaxPriorityFeePerGas, ethReq.MaxFeePerGas)
+		if err != nil {
+			return nil, err
+		}
+		tx = types.NewTx(&types.DynamicFeeTx{
+			ChainID:    chainIDBigInt,
+			Nonce:      nonce,
+			GasTipCap:  gasTipCap,
+			GasFeeCap:  gasFeeCap,
+			Gas:        gasLimit,
+			To:         &to,
+			Value:      value,
+			Data:       data,
+			AccessList: accessList,
+		})
 	}
 
-	// Create transaction
-	tx := types.NewTransaction(nonce, to, value, gasLimit, gasPrice, data)
-
-	// RLP encoding
-	txHash, err := rlp.EncodeToBytes([]interface{}{
-		tx.Nonce(),
-		tx.GasPrice(),
-		tx.Gas(),
-		tx.To(),
-		tx.Value(),
-		tx.Data(),
-		chainIDBigInt, uint(0), uint(0),
-	})
+	preimage, err := ethereumSigningPreimage(tx, chainIDBigInt)
 	if err != nil {
-		return nil, fmt.Errorf("failed to RLP encode transaction: %v", err)
+		return nil, err
 	}
 
-	// Calculate transaction hash
-	// hash := tx.Hash().Hex()
+	extra := EthereumTxExtra{
+		Type:        tx.Type(),
+		ChainID:     *chainID,
+		From:        from.Hex(),
+		Nonce:       tx.Nonce(),
+		GasLimit:    tx.Gas(),
+		To:          tx.To().Hex(),
+		Value:       tx.Value().String(),
+		Data:        hex.EncodeToString(tx.Data()),
+		AccessList:  tx.AccessList(),
+		SigningHash: crypto.Keccak256Hash(preimage).Hex(),
+	}
+	if token != nil {
+		extra.Token = token
+		extra.Summary = tokenTransferSummary(token)
+	}
+	if call != nil {
+		extra.Call = call
+		extra.Summary = fmt.Sprintf("call %s on %s", call.Signature, to.Hex())
+	}
+	if tx.Type() != types.DynamicFeeTxType {
+		extra.GasPrice = tx.GasPrice().String()
+	} else {
+		extra.MaxFeePerGas = tx.GasFeeCap().String()
+		extra.MaxPriorityFeePerGas = tx.GasTipCap().String()
+	}
 
 	// Create UnsignedTransaction
 	unsignedTx := &transaction.UnsignedTransaction{
 		NetworkID:               network.ID(),
-		UnSignedTxEncodedBase64: base64.StdEncoding.EncodeToString(txHash),
-		Extra: map[string]interface{}{
-			"nonce":    tx.Nonce(),
-			"gasPrice": tx.GasPrice().String(),
-			"gasLimit": tx.Gas(),
-			"to":       tx.To().Hex(),
-			"value":    tx.Value().String(),
-			"data":     hex.EncodeToString(tx.Data()),
-		},
+		UnSignedTxEncodedBase64: base64.StdEncoding.EncodeToString(preimage),
+		Extra:                   extra,
 	}
 
 	return unsignedTx, nil
 }
 
-func getNonce(client *ethclient.Client, address string, providedNonce *uint64) (uint64, error) {
+// ethereumSigningPreimage 는 파티가 Keccak256 으로 해시해 서명할 프리이미지를 만듭니다.
+// 레거시는 EIP-155 RLP(nonce, gasPrice, gas, to, value, data, chainId, 0, 0),
+// 타입 트랜잭션은 유형 바이트 뒤에 유형별 필드의 RLP 를 붙입니다 (EIP-2718).
+func ethereumSigningPreimage(tx *types.Transaction, chainID *big.Int) ([]byte, error) {
+	var fields []interface{}
+	switch tx.Type() {
+	case types.LegacyTxType:
+		fields = []interface{}{tx.Nonce(), tx.GasPrice(), tx.Gas(), tx.To(), tx.Value(), tx.Data(), chainID, uint(0), uint(0)}
+	case types.AccessListTxType:
+		fields = []interface{}{chainID, tx.Nonce(), tx.GasPrice(), tx.Gas(), tx.To(), tx.Value(), tx.Data(), tx.AccessList()}
+	case types.DynamicFeeTxType:
+		fields = []interface{}{chainID, tx.Nonce(), tx.GasTipCap(), tx.GasFeeCap(), tx.Gas(), tx.To(), tx.Value(), tx.Data(), tx.AccessList()}
+	default:
+		return nil, fmt.Errorf("unsupported transaction type: %d", tx.Type())
+	}
+
+	encoded, err := rlp.EncodeToBytes(fields)
+	if err != nil {
+		return nil, fmt.Errorf("failed to RLP encode transaction: %v", err)
+	}
+	if tx.Type() == types.LegacyTxType {
+		return encoded, nil
+	}
+	return append([]byte{tx.Type()}, encoded...), nil
+}
+
+// decodeEthereumTx 는 미서명 트랜잭션의 프리이미지에서 트랜잭션을 복원합니다.
+// 파티가 서명하는 값이 프리이미지이므로 Extra 가 아닌 프리이미지를 기준으로 합니다.
+func decodeEthereumTx(unsignedTx *transaction.UnsignedTransaction, network Network) (*types.Transaction, *EthereumTxExtra, error) {
+	chainID := network.ChainID()
+	if chainID == nil {
+		return nil, nil, fmt.Errorf("invalid chain ID for network: %s", network)
+	}
+	preimage, err := base64.StdEncoding.DecodeString(unsignedTx.UnSignedTxEncodedBase64)
+	if err != nil || len(preimage) == 0 {
+		return nil, nil, fmt.Errorf("failed to decode unsigned transaction: %v", err)
+	}
+	extra, err := DecodeEthereumTxExtra(unsignedTx.Extra)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var tx *types.Transaction
+	var txChainID *big.Int
+	switch preimage[0] {
+	case types.AccessListTxType:
+		var fields struct {
+			ChainID    *big.Int
+			Nonce      uint64
+			GasPrice   *big.Int
+			Gas        uint64
+			To         *common.Address `rlp:"nil"`
+			Value      *big.Int
+			Data       []byte
+			AccessList types.AccessList
+		}
+		if err := rlp.DecodeBytes(preimage[1:], &fields); err != nil {
+			return nil, nil, fmt.Errorf("failed to decode unsigned transaction: %v", err)
+		}
+		txChainID = fields.ChainID
+		tx = types.NewTx(&types.AccessListTx{
+			ChainID:    fields.ChainID,
+			Nonce:      fields.Nonce,
+			GasPrice:   fields.GasPrice,
+			Gas:        fields.Gas,
+			To:         fields.To,
+			Value:      fields.Value,
+			Data:       fields.Data,
+			AccessList: fields.AccessList,
+		})
+	case types.DynamicFeeTxType:
+		var fields struct {
+			ChainID    *big.Int
+			Nonce      uint64
+			GasTipCap  *big.Int
+			GasFeeCap  *big.Int
+			Gas        uint64
+			To         *common.Address `rlp:"nil"`
+			Value      *big.Int
+			Data       []byte
+			AccessList types.AccessList
+		}
+		if err := rlp.DecodeBytes(preimage[1:], &fields); err != nil {
+			return nil, nil, fmt.Errorf("failed to decode unsigned transaction: %v", err)
+		}
+		txChainID = fields.ChainID
+		tx = types.NewTx(&types.DynamicFeeTx{
+			ChainID:    fields.ChainID,
+			Nonce:      fields.Nonce,
+			GasTipCap:  fields.GasTipCap,
+			GasFeeCap:  fields.GasFeeCap,
+			Gas:        fields.Gas,
+			To:         fields.To,
+			Value:      fields.Value,
+			Data:       fields.Data,
+			AccessList: fields.AccessList,
+		})
+	default:
+		// 레거시 프리이미지는 RLP 리스트이므로 첫 바이트가 0xc0 이상입니다.
+		var fields struct {
+			Nonce    uint64
+			GasPrice *big.Int
+			Gas      uint64
+			To       *common.Address `rlp:"nil"`
+			Value    *big.Int
+			Data     []byte
+			ChainID  *big.Int
+			R, S     uint
+		}
+		if err := rlp.DecodeBytes(preimage, &fields); err != nil {
+			return nil, nil, fmt.Errorf("failed to decode unsigned transaction: %v", err)
+		}
+		txChainID = fields.ChainID
+		tx = types.NewTx(&types.LegacyTx{
+			Nonce:    fields.Nonce,
+			GasPrice: fields.GasPrice,
+			Gas:      fields.Gas,
+			To:       fields.To,
+			Value:    fields.Value,
+			Data:     fields.Data,
+		})
+	}
+	if txChainID == nil || txChainID.Int64() != *chainID {
+		return nil, nil, fmt.Errorf("transaction chain ID %v does not match %s", txChainID, network)
+	}
+	return tx, extra, nil
+}
+
+func DecodeEthereumTxExtra(extra interface{}) (*EthereumTxExtra, error) {
+	raw, err := json.Marshal(extra)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode extra: %v", err)
+	}
+	var decoded EthereumTxExtra
+	if err := json.Unmarshal(raw, &decoded); err != nil {
+		return nil, fmt.Errorf("failed to decode extra: %v", err)
+	}
+	return &decoded, nil
+}
+
+// CreateEthereumSigningPayload 는 파티에게 전달할 서명 프리이미지를 돌려줍니다.
+// 파티는 이 값에 Keccak256 을 적용해 서명하므로 결과는 트랜잭션 유형의 서명 해시와 같습니다.
+func CreateEthereumSigningPayload(unsignedTx *transaction.UnsignedTransaction, network Network, point curves.Point) ([]byte, error) {
+	tx, extra, err := decodeEthereumTx(unsignedTx, network)
+	if err != nil {
+		return nil, err
+	}
+
+	// 서명할 키가 트랜잭션을 보내는 주소인지 확인합니다.
+	if extra.From != "" {
+		address, err := DeriveEthereumAddress(point, network, 0)
+		if err != nil {
+			return nil, err
+		}
+		if common.HexToAddress(extra.From) != common.HexToAddress(address) {
+			return nil, fmt.Errorf("transaction is not from %s", address)
+		}
+	}
+	return ethereumSigningPreimage(tx, big.NewInt(*network.ChainID()))
+}
+
+// AssembleSignedEthereumTransaction 은 서명을 결합한 트랜잭션의 바이너리 인코딩(0x hex)을 만듭니다.
+// 타입 트랜잭션은 유형 바이트로 시작하는 EIP-2718 인코딩입니다.
+func AssembleSignedEthereumTransaction(unsignedTx *transaction.UnsignedTransaction, network Network, point curves.Point, signature *transaction.Signature) (string, error) {
+	tx, _, err := decodeEthereumTx(unsignedTx, network)
+	if err != nil {
+		return "", err
+	}
+	if len(signature.R) > 32 || len(signature.S) > 32 || signature.V > 1 {
+		return "", fmt.Errorf("invalid secp256k1 signature")
+	}
+	sig := make([]byte, crypto.SignatureLength)
+	copy(sig[32-len(signature.R):32], signature.R)
+	copy(sig[64-len(signature.S):64], signature.S)
+	sig[64] = byte(signature.V)
+
+	signer := types.LatestSignerForChainID(big.NewInt(*network.ChainID()))
+	signedTx, err := tx.WithSignature(signer, sig)
+	if err != nil {
+		return "", fmt.Errorf("failed to attach signature: %v", err)
+	}
+
+	// 복구한 보낸 주소가 키의 주소와 같아야 합니다.
+	sender, err := types.Sender(signer, signedTx)
+	if err != nil {
+		return "", fmt.Errorf("failed to recover sender: %v", err)
+	}
+	address, err := DeriveEthereumAddress(point, network, 0)
+	if err != nil {
+		return "", err
+	}
+	if sender != common.HexToAddress(address) {
+		return "", fmt.Errorf("signature does not match %s", address)
+	}
+
+	encoded, err := signedTx.MarshalBinary()
+	if err != nil {
+		return "", fmt.Errorf("failed to encode signed transaction: %v", err)
+	}
+	return hexutil.Encode(encoded), nil
+}
+
+// EthereumPendingNonce 는 노드의 pending 논스 (mempool 의 트랜잭션 포함) 를 조회합니다.
+func EthereumPendingNonce(network Network, address string) (uint64, error) {
+	client, err := dialEthereum(network)
+	if err != nil {
+		return 0, err
+	}
+	defer client.Close()
+	return client.PendingNonceAt(context.Background(), common.HexToAddress(address))
+}
+
+// EthereumTxNonce 는 미서명 트랜잭션을 보내는 주소와 논스를 돌려줍니다. 논스는 서명할 프리이미지에서 읽습니다.
+func EthereumTxNonce(unsignedTx *transaction.UnsignedTransaction, network Network) (string, uint64, error) {
+	tx, extra, err := decodeEthereumTx(unsignedTx, network)
+	if err != nil {
+		return "", 0, err
+	}
+	if !IsValidEthereumAddress(extra.From) {
+		return "", 0, fmt.Errorf("unsigned transaction has no sender")
+	}
+	return common.HexToAddress(extra.From).Hex(), tx.Nonce(), nil
+}
+
+func getNonce(client ethereumBackend, address string, providedNonce *uint64) (uint64, error) {
 	if providedNonce != nil {
 		return *providedNonce, nil
 	}
 	return client.PendingNonceAt(context.Background(), common.HexToAddress(address))
 }
 
-func getGasPrice(client *ethclient.Client, providedGasPrice *string) (*big.Int, error) {
+func getGasPrice(client ethereumBackend, providedGasPrice *string) (*big.Int, error) {
 	if providedGasPrice != nil {
 		gasPrice, ok := new(big.Int).SetString(*providedGasPrice, 10)
 		if !ok {
@@ -164,14 +662,114 @@ func getGasPrice(client *ethclient.Client, providedGasPrice *string) (*big.Int,
 	return client.SuggestGasPrice(context.Background())
 }
 
-func getGasLimit(client *ethclient.Client, to string, data []byte, providedGasLimit *uint64) (uint64, error) {
+const (
+	// feeHistoryBlocks 는 기본 수수료를 정할 때 참고하는 최근 블록 수입니다.
+	feeHistoryBlocks = 10
+	// feeHistoryRewardPercentile 은 블록별 팁 중 사용할 백분위입니다.
+	feeHistoryRewardPercentile = 50
+)
+
+// getDynamicFees 는 EIP-1559 트랜잭션의 maxPriorityFeePerGas (팁) 와 maxFeePerGas 를 정합니다.
+// 주어지지 않은 값은 eth_feeHistory 로 정합니다.
+func getDynamicFees(client ethereumBackend, providedTip *string, providedFeeCap *string) (*big.Int, *big.Int, error) {
+	var tip, feeCap *big.Int
+	if providedTip != nil {
+		var ok bool
+		if tip, ok = new(big.Int).SetString(*providedTip, 10); !ok {
+			return nil, nil, fmt.Errorf("invalid max priority fee per gas: %s", *providedTip)
+		}
+	}
+	if providedFeeCap != nil {
+		var ok bool
+		if feeCap, ok = new(big.Int).SetString(*providedFeeCap, 10); !ok {
+			return nil, nil, fmt.Errorf("invalid max fee per gas: %s", *providedFeeCap)
+		}
+	}
+
+	if tip == nil || feeCap == nil {
+		history, err := client.FeeHistory(context.Background(), feeHistoryBlocks, nil, []float64{feeHistoryRewardPercentile})
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to get fee history: %v", err)
+		}
+		// BaseFee 의 마지막 값은 다음 블록의 기본 수수료입니다.
+		if len(history.BaseFee) == 0 || history.BaseFee[len(history.BaseFee)-1] == nil {
+			return nil, nil, fmt.Errorf("network does not support EIP-1559 transactions")
+		}
+		baseFee := history.BaseFee[len(history.BaseFee)-1]
+
+		if tip == nil {
+			if tip = medianReward(history.Reward); tip == nil {
+				if tip, err = client.SuggestGasTipCap(context.Background()); err != nil {
+					return nil, nil, fmt.Errorf("failed to suggest gas tip cap: %v", err)
+				}
+			}
+			if feeCap != nil && tip.Cmp(feeCap) > 0 {
+				tip = new(big.Int).Set(feeCap)
+			}
+		}
+		if feeCap == nil {
+			feeCap = new(big.Int).Add(new(big.Int).Mul(baseFee, big.NewInt(2)), tip)
+		}
+	}
+
+	if tip.Sign() < 0 || feeCap.Sign() < 0 {
+		return nil, nil, fmt.Errorf("fees must not be negative")
+	}
+	if tip.Cmp(feeCap) > 0 {
+		return nil, nil, fmt.Errorf("max priority fee per gas %s exceeds max fee per gas %s", tip, feeCap)
+	}
+	return tip, feeCap, nil
+}
+
+// medianReward 는 블록별 팁의 중간값입니다. 기록이 없으면 nil 입니다.
+func medianReward(rewards [][]*big.Int) *big.Int {
+	var values []*big.Int
+	for _, blockRewards := range rewards {
+		if len(blockRewards) > 0 && blockRewards[0] != nil {
+			values = append(values, blockRewards[0])
+		}
+	}
+	if len(values) == 0 {
+		return nil
+	}
+	sort.Slice(values, func(i, j int) bool { return values[i].Cmp(values[j]) < 0 })
+	return new(big.Int).Set(values[len(values)/2])
+}
+
+// getAccessList 는 요청의 접근 목록을 돌려줍니다. createAccessList 이면 eth_createAccessList 로 만듭니다.
+func getAccessList(client ethereumBackend, ethReq EthereumTxRequest, from common.Address, to common.Address, value *big.Int, data []byte) (types.AccessList, error) {
+	if !ethReq.CreateAccessList {
+		return ethReq.AccessList, nil
+	}
+	accessList, _, vmErr, err := client.CreateAccessList(context.Background(), ethereum.CallMsg{
+		From:  from,
+		To:    &to,
+		Value: value,
+		Data:  data,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create access list: %v", err)
+	}
+	if vmErr != "" {
+		return nil, fmt.Errorf("failed to create access list: %s", vmErr)
+	}
+	if accessList == nil {
+		return types.AccessList{}, nil
+	}
+	return *accessList, nil
+}
+
+// getGasLimit 은 보내는 주소로 가스를 추정합니다. 토큰 전송처럼 잔액을 확인하는 호출은 From 이 없으면 실패합니다.
+func getGasLimit(client ethereumBackend, from common.Address, to common.Address, value *big.Int, data []byte, accessList types.AccessList, providedGasLimit *uint64) (uint64, error) {
 	if providedGasLimit != nil {
 		return *providedGasLimit, nil
 	}
-	toAddress := common.HexToAddress(to)
 	gasLimit, err := client.EstimateGas(context.Background(), ethereum.CallMsg{
-		To:   &toAddress,
-		Data: data,
+		From:       from,
+		To:         &to,
+		Value:      value,
+		Data:       data,
+		AccessList: accessList,
 	})
 	if err != nil {
 		return 0, fmt.Errorf("failed to estimate gas limit: %v", err)
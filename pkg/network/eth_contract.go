@@ -0,0 +1,350 @@
+package network
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// EthereumContractCall 은 ABI 로 해석한 컨트랙트 호출입니다. Args 는 입력 이름별 값이며 이름이 없는 입력은 arg{순서} 입니다.
+// 정수는 10진수 문자열, 주소와 바이트는 0x hex 로 나타냅니다.
+type EthereumContractCall struct {
+	ABIName   string                 `json:"abiName,omitempty"`
+	Method    string                 `json:"method"`
+	Signature string                 `json:"signature"`
+	Args      map[string]interface{} `json:"args"`
+}
+
+// isContractCall 은 요청이 ABI 로 인코딩할 컨트랙트 호출인지 확인합니다.
+func (r EthereumTxRequest) isContractCall() bool {
+	return r.Method != ""
+}
+
+// ParseContractABI 는 JSON ABI 를 해석합니다. ABI 를 JSON 문자열로 감싼 값도 받습니다.
+func ParseContractABI(definition []byte) (abi.ABI, error) {
+	var wrapped string
+	if err := json.Unmarshal(definition, &wrapped); err == nil {
+		definition = []byte(wrapped)
+	}
+	parsed, err := abi.JSON(strings.NewReader(string(definition)))
+	if err != nil {
+		return abi.ABI{}, fmt.Errorf("invalid abi: %v", err)
+	}
+	if len(parsed.Methods) == 0 {
+		return abi.ABI{}, fmt.Errorf("invalid abi: no methods")
+	}
+	return parsed, nil
+}
+
+// buildContractCall 은 요청의 ABI, 메서드, 인자로 컨트랙트 호출 calldata 를 만듭니다.
+func buildContractCall(ethReq EthereumTxRequest) (*big.Int, []byte, *EthereumContractCall, error) {
+	if ethReq.isTokenTransfer() {
+		return nil, nil, nil, fmt.Errorf("method cannot be used with token transfers")
+	}
+	if ethReq.Data != "" {
+		return nil, nil, nil, fmt.Errorf("data is not allowed for contract calls")
+	}
+	if len(ethReq.ABI) == 0 {
+		return nil, nil, nil, fmt.Errorf("abi or abiName is required for contract calls")
+	}
+	contractABI, err := ParseContractABI(ethReq.ABI)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	method, err := findABIMethod(contractABI, ethReq.Method)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	value := new(big.Int)
+	if ethReq.Amount != "" {
+		var ok bool
+		if value, ok = new(big.Int).SetString(ethReq.Amount, 10); !ok || value.Sign() < 0 {
+			return nil, nil, nil, fmt.Errorf("invalid value: %s", ethReq.Amount)
+		}
+	}
+	if value.Sign() > 0 && !method.IsPayable() {
+		return nil, nil, nil, fmt.Errorf("method %s is not payable", method.Sig)
+	}
+
+	if len(ethReq.Args) != len(method.Inputs) {
+		return nil, nil, nil, fmt.Errorf("method %s takes %d arguments, got %d", method.Sig, len(method.Inputs), len(ethReq.Args))
+	}
+	values := make([]interface{}, len(method.Inputs))
+	for i, input := range method.Inputs {
+		v, err := abiValue(input.Type, ethReq.Args[i])
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("invalid argument %s: %v", argumentName(input, i), err)
+		}
+		values[i] = v.Interface()
+	}
+	packed, err := method.Inputs.Pack(values...)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to encode %s: %v", method.Sig, err)
+	}
+	data := append(append([]byte{}, method.ID...), packed...)
+
+	call, err := DecodeEthereumContractCall(contractABI, data)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	call.ABIName = ethReq.ABIName
+	return value, data, call, nil
+}
+
+// findABIMethod 는 이름 (예: "stake") 또는 시그니처 (예: "transfer(address,uint256)") 로 메서드를 찾습니다.
+// 오버로드된 메서드는 시그니처로 지정해야 합니다.
+func findABIMethod(contractABI abi.ABI, name string) (abi.Method, error) {
+	if strings.Contains(name, "(") {
+		signature := strings.ReplaceAll(name, " ", "")
+		for _, method := range contractABI.Methods {
+			if method.Sig == signature {
+				return method, nil
+			}
+		}
+		return abi.Method{}, fmt.Errorf("method %s not found in abi", name)
+	}
+
+	var overloads []string
+	for _, method := range contractABI.Methods {
+		if method.RawName == name {
+			overloads = append(overloads, method.Sig)
+		}
+	}
+	switch len(overloads) {
+	case 0:
+		return abi.Method{}, fmt.Errorf("method %s not found in abi", name)
+	case 1:
+		return findABIMethod(contractABI, overloads[0])
+	default:
+		sort.Strings(overloads)
+		return abi.Method{}, fmt.Errorf("method %s is overloaded, use one of: %s", name, strings.Join(overloads, ", "))
+	}
+}
+
+// DecodeEthereumContractCall 은 calldata 를 ABI 의 메서드 호출로 해석합니다.
+// 등록한 ABI 로 트랜잭션이 어떤 메서드를 어떤 인자로 호출하는지 확인할 때 사용합니다.
+func DecodeEthereumContractCall(contractABI abi.ABI, data []byte) (*EthereumContractCall, error) {
+	if len(data) < 4 {
+		return nil, fmt.Errorf("calldata is too short")
+	}
+	method, err := contractABI.MethodById(data[:4])
+	if err != nil {
+		return nil, err
+	}
+	values, err := method.Inputs.Unpack(data[4:])
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode %s: %v", method.Sig, err)
+	}
+	args := make(map[string]interface{}, len(values))
+	for i, input := range method.Inputs {
+		args[argumentName(input, i)] = abiJSONValue(reflect.ValueOf(values[i]))
+	}
+	return &EthereumContractCall{Method: method.RawName, Signature: method.Sig, Args: args}, nil
+}
+
+func argumentName(argument abi.Argument, index int) string {
+	if argument.Name == "" {
+		return fmt.Sprintf("arg%d", index)
+	}
+	return argument.Name
+}
+
+// abiValue 는 JSON 인자를 ABI 타입의 Go 값으로 바꿉니다.
+// 정수는 JSON 숫자 또는 10진수/0x hex 문자열, 주소와 바이트는 hex 문자열, 배열은 JSON 배열,
+// 튜플은 구성 요소 이름을 키로 하는 객체 또는 순서대로 나열한 배열입니다.
+func abiValue(t abi.Type, raw json.RawMessage) (reflect.Value, error) {
+	switch t.T {
+	case abi.IntTy, abi.UintTy:
+		n, err := abiInteger(t, raw)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		v := reflect.New(t.GetType()).Elem()
+		switch v.Kind() {
+		case reflect.Ptr:
+			v.Set(reflect.ValueOf(n))
+		case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			v.SetUint(n.Uint64())
+		default:
+			v.SetInt(n.Int64())
+		}
+		return v, nil
+	case abi.BoolTy:
+		var b bool
+		if err := json.Unmarshal(raw, &b); err != nil {
+			return reflect.Value{}, fmt.Errorf("expected bool")
+		}
+		return reflect.ValueOf(b), nil
+	case abi.StringTy:
+		var s string
+		if err := json.Unmarshal(raw, &s); err != nil {
+			return reflect.Value{}, fmt.Errorf("expected string")
+		}
+		return reflect.ValueOf(s), nil
+	case abi.AddressTy:
+		var s string
+		if err := json.Unmarshal(raw, &s); err != nil || !IsValidEthereumAddress(s) {
+			return reflect.Value{}, fmt.Errorf("expected address")
+		}
+		return reflect.ValueOf(common.HexToAddress(s)), nil
+	case abi.BytesTy:
+		b, err := abiBytes(raw)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		return reflect.ValueOf(b), nil
+	case abi.FixedBytesTy, abi.FunctionTy:
+		b, err := abiBytes(raw)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		v := reflect.New(t.GetType()).Elem()
+		if len(b) != v.Len() {
+			return reflect.Value{}, fmt.Errorf("expected %d bytes, got %d", v.Len(), len(b))
+		}
+		reflect.Copy(v, reflect.ValueOf(b))
+		return v, nil
+	case abi.SliceTy, abi.ArrayTy:
+		var items []json.RawMessage
+		if err := json.Unmarshal(raw, &items); err != nil {
+			return reflect.Value{}, fmt.Errorf("expected array")
+		}
+		var v reflect.Value
+		if t.T == abi.ArrayTy {
+			if len(items) != t.Size {
+				return reflect.Value{}, fmt.Errorf("expected %d items, got %d", t.Size, len(items))
+			}
+			v = reflect.New(t.GetType()).Elem()
+		} else {
+			v = reflect.MakeSlice(t.GetType(), len(items), len(items))
+		}
+		for i, item := range items {
+			elem, err := abiValue(*t.Elem, item)
+			if err != nil {
+				return reflect.Value{}, fmt.Errorf("[%d]: %v", i, err)
+			}
+			v.Index(i).Set(elem)
+		}
+		return v, nil
+	case abi.TupleTy:
+		items := make([]json.RawMessage, len(t.TupleElems))
+		var fields map[string]json.RawMessage
+		if err := json.Unmarshal(raw, &fields); err == nil {
+			for i, name := range t.TupleRawNames {
+				item, ok := fields[name]
+				if !ok {
+					return reflect.Value{}, fmt.Errorf("missing tuple field %s", name)
+				}
+				items[i] = item
+			}
+		} else {
+			var list []json.RawMessage
+			if err := json.Unmarshal(raw, &list); err != nil || len(list) != len(t.TupleElems) {
+				return reflect.Value{}, fmt.Errorf("expected tuple with %d fields", len(t.TupleElems))
+			}
+			copy(items, list)
+		}
+		v := reflect.New(t.GetType()).Elem()
+		for i, item := range items {
+			field, err := abiValue(*t.TupleElems[i], item)
+			if err != nil {
+				return reflect.Value{}, fmt.Errorf("%s: %v", t.TupleRawNames[i], err)
+			}
+			v.Field(i).Set(field)
+		}
+		return v, nil
+	default:
+		return reflect.Value{}, fmt.Errorf("unsupported abi type %s", t.String())
+	}
+}
+
+// abiInteger 는 JSON 숫자 또는 문자열 정수를 읽고 타입의 범위를 확인합니다.
+func abiInteger(t abi.Type, raw json.RawMessage) (*big.Int, error) {
+	var s string
+	if err := json.Unmarshal(raw, &s); err != nil {
+		var number json.Number
+		if err := json.Unmarshal(raw, &number); err != nil {
+			return nil, fmt.Errorf("expected integer")
+		}
+		s = number.String()
+	}
+	n, ok := new(big.Int).SetString(s, 0)
+	if !ok {
+		return nil, fmt.Errorf("expected integer, got %s", s)
+	}
+	min, max := new(big.Int), new(big.Int).Lsh(big.NewInt(1), uint(t.Size))
+	if t.T == abi.IntTy {
+		max.Rsh(max, 1)
+		min.Neg(max)
+	}
+	if n.Cmp(min) < 0 || n.Cmp(max) >= 0 {
+		return nil, fmt.Errorf("%s out of range for %s", n, t.String())
+	}
+	return n, nil
+}
+
+func abiBytes(raw json.RawMessage) ([]byte, error) {
+	var s string
+	if err := json.Unmarshal(raw, &s); err != nil {
+		return nil, fmt.Errorf("expected hex string")
+	}
+	if !strings.HasPrefix(s, "0x") {
+		s = "0x" + s
+	}
+	b, err := hexutil.Decode(s)
+	if err != nil {
+		return nil, fmt.Errorf("expected hex string: %v", err)
+	}
+	return b, nil
+}
+
+// abiJSONValue 는 ABI 로 디코딩한 값을 JSON 으로 나타낼 값으로 바꿉니다.
+func abiJSONValue(v reflect.Value) interface{} {
+	switch value := v.Interface().(type) {
+	case *big.Int:
+		return value.String()
+	case common.Address:
+		return value.Hex()
+	case []byte:
+		return hexutil.Encode(value)
+	}
+	switch v.Kind() {
+	case reflect.Array:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			b := make([]byte, v.Len())
+			reflect.Copy(reflect.ValueOf(b), v)
+			return hexutil.Encode(b)
+		}
+		fallthrough
+	case reflect.Slice:
+		items := make([]interface{}, v.Len())
+		for i := range items {
+			items[i] = abiJSONValue(v.Index(i))
+		}
+		return items
+	case reflect.Struct:
+		fields := make(map[string]interface{}, v.NumField())
+		for i := 0; i < v.NumField(); i++ {
+			name := v.Type().Field(i).Tag.Get("json")
+			if name == "" {
+				name = v.Type().Field(i).Name
+			}
+			fields[name] = abiJSONValue(v.Field(i))
+		}
+		return fields
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return new(big.Int).SetUint64(v.Uint()).String()
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return new(big.Int).SetInt64(v.Int()).String()
+	default:
+		return v.Interface()
+	}
+}
@@ -0,0 +1,146 @@
+package network
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const stakingABI = `[
+	{"type":"function","name":"stake","inputs":[{"name":"amount","type":"uint256"},{"name":"validator","type":"address"}],"outputs":[],"stateMutability":"nonpayable"},
+	{"type":"function","name":"deposit","inputs":[],"outputs":[],"stateMutability":"payable"},
+	{"type":"function","name":"deposit","inputs":[{"name":"receiver","type":"address"}],"outputs":[],"stateMutability":"payable"},
+	{"type":"function","name":"configure","inputs":[
+		{"name":"config","type":"tuple","components":[{"name":"fee","type":"uint16"},{"name":"paused","type":"bool"},{"name":"label","type":"string"}]},
+		{"name":"ids","type":"uint64[]"},
+		{"name":"salt","type":"bytes32"},
+		{"name":"payload","type":"bytes"},
+		{"name":"delta","type":"int8"}
+	],"outputs":[],"stateMutability":"nonpayable"}
+]`
+
+func contractArgs(t *testing.T, args ...interface{}) []json.RawMessage {
+	raw := make([]json.RawMessage, len(args))
+	for i, arg := range args {
+		b, err := json.Marshal(arg)
+		require.NoError(t, err)
+		raw[i] = b
+	}
+	return raw
+}
+
+func TestBuildContractCall(t *testing.T) {
+	from := "0x8ba1f109551bD432803012645Ac136ddd64DBA72"
+	contract := common.HexToAddress("0x00000000219ab540356cBB839Cbe05303d7705Fa")
+	validator := common.HexToAddress("0x000000000000000000000000000000000000dEaD")
+	parsed, err := ParseContractABI([]byte(stakingABI))
+	require.NoError(t, err)
+
+	t.Run("stake", func(t *testing.T) {
+		backend := newFakeEthereumBackend()
+		unsignedTx, err := buildEthereumTransaction(backend, EthereumTxRequest{
+			From: from, To: contract.Hex(), ABI: json.RawMessage(stakingABI), ABIName: "staking",
+			Method: "stake", Args: contractArgs(t, "1000000000000000000", validator.Hex()),
+		}, Ethereum_Sepolia)
+		require.NoError(t, err)
+		tx, extra, err := decodeEthereumTx(unsignedTx, Ethereum_Sepolia)
+		require.NoError(t, err)
+
+		expected, err := parsed.Pack("stake", big.NewInt(1e18), validator)
+		require.NoError(t, err)
+		assert.Equal(t, expected, tx.Data())
+		assert.Equal(t, contract, *tx.To())
+		assert.Zero(t, tx.Value().Sign())
+		assert.Equal(t, common.HexToAddress(from), backend.estimated.From)
+
+		require.NotNil(t, extra.Call)
+		assert.Equal(t, "staking", extra.Call.ABIName)
+		assert.Equal(t, "stake(uint256,address)", extra.Call.Signature)
+		assert.Equal(t, map[string]interface{}{"amount": "1000000000000000000", "validator": validator.Hex()}, extra.Call.Args)
+		assert.Equal(t, "call stake(uint256,address) on "+contract.Hex(), extra.Summary)
+	})
+
+	t.Run("payable overload", func(t *testing.T) {
+		unsignedTx, err := buildEthereumTransaction(newFakeEthereumBackend(), EthereumTxRequest{
+			From: from, To: contract.Hex(), Amount: "5000", ABI: json.RawMessage(stakingABI),
+			Method: "deposit(address)", Args: contractArgs(t, validator.Hex()),
+		}, Ethereum_Sepolia)
+		require.NoError(t, err)
+		tx, extra, err := decodeEthereumTx(unsignedTx, Ethereum_Sepolia)
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(5000), tx.Value())
+		assert.Equal(t, "deposit", extra.Call.Method)
+		assert.Equal(t, "deposit(address)", extra.Call.Signature)
+	})
+
+	t.Run("tuple, arrays and bytes", func(t *testing.T) {
+		salt := "0x" + "11" + "00000000000000000000000000000000000000000000000000000000000000"
+		unsignedTx, err := buildEthereumTransaction(newFakeEthereumBackend(), EthereumTxRequest{
+			From: from, To: contract.Hex(), ABI: json.RawMessage(stakingABI), Method: "configure",
+			Args: contractArgs(t,
+				map[string]interface{}{"fee": 30, "paused": true, "label": "main"},
+				[]interface{}{1, "2", "0x3"},
+				salt,
+				"abcd",
+				-5,
+			),
+		}, Ethereum_Sepolia)
+		require.NoError(t, err)
+		tx, extra, err := decodeEthereumTx(unsignedTx, Ethereum_Sepolia)
+		require.NoError(t, err)
+
+		call, err := DecodeEthereumContractCall(parsed, tx.Data())
+		require.NoError(t, err)
+		assert.Equal(t, extra.Call.Args, call.Args)
+		assert.Equal(t, map[string]interface{}{
+			"config":  map[string]interface{}{"fee": "30", "paused": true, "label": "main"},
+			"ids":     []interface{}{"1", "2", "3"},
+			"salt":    salt,
+			"payload": "0xabcd",
+			"delta":   "-5",
+		}, call.Args)
+	})
+
+	errorTests := map[string]EthereumTxRequest{
+		"unknown method":       {Method: "withdraw"},
+		"overloaded method":    {Method: "deposit"},
+		"argument count":       {Method: "stake", Args: contractArgs(t, "1")},
+		"invalid address":      {Method: "stake", Args: contractArgs(t, "1", "0x1234")},
+		"negative uint":        {Method: "stake", Args: contractArgs(t, "-1", validator.Hex())},
+		"int out of range":     {Method: "configure", Args: contractArgs(t, []interface{}{1, true, ""}, []int{}, common.Hash{}.Hex(), "0x", 128)},
+		"short fixed bytes":    {Method: "configure", Args: contractArgs(t, []interface{}{1, true, ""}, []int{}, "0x11", "0x", 1)},
+		"value to nonpayable":  {Method: "stake", Amount: "1", Args: contractArgs(t, "1", validator.Hex())},
+		"data with method":     {Method: "deposit()", Data: "abcd"},
+		"token with method":    {Method: "deposit()", Token: validator.Hex(), Amount: "1"},
+		"missing abi":          {Method: "deposit()", ABI: json.RawMessage{}},
+		"abi without function": {Method: "deposit()", ABI: json.RawMessage(`[]`)},
+	}
+	for name, req := range errorTests {
+		t.Run(name, func(t *testing.T) {
+			req.From, req.To = from, contract.Hex()
+			if req.ABI == nil {
+				req.ABI = json.RawMessage(stakingABI)
+			}
+			_, err := buildEthereumTransaction(newFakeEthereumBackend(), req, Ethereum_Sepolia)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestParseContractABI(t *testing.T) {
+	// ABI 를 JSON 문자열로 감싸 보내도 됩니다.
+	wrapped, err := json.Marshal(stakingABI)
+	require.NoError(t, err)
+	parsed, err := ParseContractABI(wrapped)
+	require.NoError(t, err)
+	assert.Contains(t, parsed.Methods, "stake")
+
+	_, err = ParseContractABI([]byte(`{"not": "an abi"}`))
+	assert.Error(t, err)
+	_, err = DecodeEthereumContractCall(parsed, []byte{0x01, 0x02})
+	assert.Error(t, err)
+}
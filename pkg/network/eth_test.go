@@ -0,0 +1,291 @@
+package network
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/base64"
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"tecdsa/pkg/transaction"
+
+	"github.com/coinbase/kryptology/pkg/core/curves"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeEthereumBackend 는 고정된 값을 돌려주는 RPC 입니다.
+type fakeEthereumBackend struct {
+	nonce      uint64
+	gasPrice   *big.Int
+	tipCap     *big.Int
+	feeHistory *ethereum.FeeHistory
+	gas        uint64
+	accessList types.AccessList
+	// estimated 는 EstimateGas 가 받은 호출입니다.
+	estimated ethereum.CallMsg
+	// contractCalls 는 CallContract 가 4바이트 함수 선택자로 돌려줄 결과입니다.
+	contractCalls map[string][]byte
+}
+
+func (b *fakeEthereumBackend) PendingNonceAt(context.Context, common.Address) (uint64, error) {
+	return b.nonce, nil
+}
+
+func (b *fakeEthereumBackend) SuggestGasPrice(context.Context) (*big.Int, error) {
+	return b.gasPrice, nil
+}
+
+func (b *fakeEthereumBackend) SuggestGasTipCap(context.Context) (*big.Int, error) {
+	return b.tipCap, nil
+}
+
+func (b *fakeEthereumBackend) FeeHistory(context.Context, uint64, *big.Int, []float64) (*ethereum.FeeHistory, error) {
+	return b.feeHistory, nil
+}
+
+func (b *fakeEthereumBackend) EstimateGas(_ context.Context, msg ethereum.CallMsg) (uint64, error) {
+	b.estimated = msg
+	return b.gas, nil
+}
+
+func (b *fakeEthereumBackend) CallContract(_ context.Context, msg ethereum.CallMsg, _ *big.Int) ([]byte, error) {
+	if len(msg.Data) < 4 {
+		return nil, nil
+	}
+	return b.contractCalls[hex.EncodeToString(msg.Data[:4])], nil
+}
+
+func (b *fakeEthereumBackend) CreateAccessList(context.Context, ethereum.CallMsg) (*types.AccessList, uint64, string, error) {
+	return &b.accessList, b.gas, "", nil
+}
+
+func gwei(n int64) *big.Int {
+	return new(big.Int).Mul(big.NewInt(n), big.NewInt(1e9))
+}
+
+func newFakeEthereumBackend() *fakeEthereumBackend {
+	return &fakeEthereumBackend{
+		nonce:    7,
+		gasPrice: gwei(20),
+		tipCap:   gwei(1),
+		feeHistory: &ethereum.FeeHistory{
+			Reward:  [][]*big.Int{{gwei(3)}, {gwei(1)}, {gwei(2)}},
+			BaseFee: []*big.Int{gwei(10), gwei(11), gwei(12), gwei(15)},
+		},
+		gas: 21000,
+		accessList: types.AccessList{{
+			Address:     common.HexToAddress("0x6B175474E89094C44Da98b954EedeAC495271d0F"),
+			StorageKeys: []common.Hash{common.HexToHash("0x01"), common.HexToHash("0x02")},
+		}},
+	}
+}
+
+// newEthereumKey 는 테스트용 키와 그 kryptology 공개키, 주소를 만듭니다.
+func newEthereumKey(t *testing.T) (*ecdsa.PrivateKey, curves.Point, string) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	point, err := curves.K256().Point.FromAffineUncompressed(crypto.FromECDSAPub(&key.PublicKey))
+	require.NoError(t, err)
+	return key, point, crypto.PubkeyToAddress(key.PublicKey).Hex()
+}
+
+// signEthereumPayload 는 파티처럼 keccak256(payload) 에 서명합니다.
+func signEthereumPayload(t *testing.T, key *ecdsa.PrivateKey, payload []byte) *transaction.Signature {
+	sig, err := crypto.Sign(crypto.Keccak256(payload), key)
+	require.NoError(t, err)
+	return &transaction.Signature{R: sig[:32], S: sig[32:64], V: uint64(sig[64])}
+}
+
+func TestBuildEthereumTransaction(t *testing.T) {
+	from := "0x8ba1f109551bD432803012645Ac136ddd64DBA72"
+	to := "0x000000000000000000000000000000000000dEaD"
+	chainID := big.NewInt(*Ethereum_Sepolia.ChainID())
+	legacy := uint8(types.LegacyTxType)
+	accessListType := uint8(types.AccessListTxType)
+	accessList := newFakeEthereumBackend().accessList
+
+	tests := []struct {
+		name       string
+		req        EthereumTxRequest
+		txType     uint8
+		tip        *big.Int
+		feeCap     *big.Int
+		accessList types.AccessList
+		wantErr    bool
+	}{
+		{
+			name:   "fee history defaults",
+			req:    EthereumTxRequest{From: from, To: to, Amount: "1000"},
+			txType: types.DynamicFeeTxType,
+			// 팁은 3, 1, 2 의 중간값, 최대 수수료는 다음 블록 기본 수수료 15 의 두 배에 팁을 더한 값입니다.
+			tip:    gwei(2),
+			feeCap: gwei(32),
+		},
+		{
+			name:   "provided fees",
+			req:    EthereumTxRequest{From: from, To: to, Amount: "1000", MaxFeePerGas: strPtr("50000000000"), MaxPriorityFeePerGas: strPtr("1500000000")},
+			txType: types.DynamicFeeTxType,
+			tip:    big.NewInt(1500000000),
+			feeCap: gwei(50),
+		},
+		{
+			name:   "fee cap below history tip",
+			req:    EthereumTxRequest{From: from, To: to, Amount: "1000", MaxFeePerGas: strPtr("1000000000")},
+			txType: types.DynamicFeeTxType,
+			tip:    gwei(1),
+			feeCap: gwei(1),
+		},
+		{
+			name:   "legacy gas price",
+			req:    EthereumTxRequest{From: from, To: to, Amount: "1000", GasPrice: strPtr("20000000000")},
+			txType: types.LegacyTxType,
+		},
+		{
+			name:   "legacy suggested gas price",
+			req:    EthereumTxRequest{From: from, To: to, Amount: "1000", Type: &legacy},
+			txType: types.LegacyTxType,
+		},
+		{
+			name:       "access list with gas price",
+			req:        EthereumTxRequest{From: from, To: to, Amount: "1000", GasPrice: strPtr("20000000000"), AccessList: accessList},
+			txType:     types.AccessListTxType,
+			accessList: accessList,
+		},
+		{
+			name:       "generated access list",
+			req:        EthereumTxRequest{From: from, To: to, Amount: "1000", Type: &accessListType, CreateAccessList: true},
+			txType:     types.AccessListTxType,
+			accessList: accessList,
+		},
+		{
+			name:       "dynamic fee with generated access list",
+			req:        EthereumTxRequest{From: from, To: to, Amount: "1000", CreateAccessList: true},
+			txType:     types.DynamicFeeTxType,
+			tip:        gwei(2),
+			feeCap:     gwei(32),
+			accessList: accessList,
+		},
+		{
+			name:    "legacy with access list",
+			req:     EthereumTxRequest{From: from, To: to, Amount: "1000", Type: &legacy, AccessList: accessList},
+			wantErr: true,
+		},
+		{
+			name:    "access list and generation",
+			req:     EthereumTxRequest{From: from, To: to, Amount: "1000", AccessList: accessList, CreateAccessList: true},
+			wantErr: true,
+		},
+		{
+			name:    "tip above fee cap",
+			req:     EthereumTxRequest{From: from, To: to, Amount: "1000", MaxFeePerGas: strPtr("1"), MaxPriorityFeePerGas: strPtr("2")},
+			wantErr: true,
+		},
+		{
+			name:    "legacy with dynamic fees",
+			req:     EthereumTxRequest{From: from, To: to, Amount: "1000", Type: &legacy, MaxFeePerGas: strPtr("1")},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			backend := newFakeEthereumBackend()
+			unsignedTx, err := buildEthereumTransaction(backend, tt.req, Ethereum_Sepolia)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			tx, extra, err := decodeEthereumTx(unsignedTx, Ethereum_Sepolia)
+			require.NoError(t, err)
+			assert.Equal(t, tt.txType, tx.Type())
+			assert.Equal(t, tt.txType, extra.Type)
+			assert.Equal(t, uint64(7), tx.Nonce())
+			// 추정한 가스에 20% 여유를 둡니다.
+			assert.Equal(t, uint64(25200), tx.Gas())
+			if tt.tip != nil {
+				assert.Equal(t, tt.tip, tx.GasTipCap())
+				assert.Equal(t, tt.feeCap, tx.GasFeeCap())
+				assert.Equal(t, tt.feeCap.String(), extra.MaxFeePerGas)
+			} else {
+				assert.Equal(t, gwei(20), tx.GasPrice())
+			}
+			if tt.accessList != nil {
+				assert.Equal(t, tt.accessList, tx.AccessList())
+				assert.Equal(t, tt.accessList, extra.AccessList)
+				// 가스는 접근 목록을 포함해 추정합니다.
+				assert.Equal(t, tt.accessList, backend.estimated.AccessList)
+			} else {
+				assert.Empty(t, tx.AccessList())
+				assert.Empty(t, extra.AccessList)
+			}
+
+			// 프리이미지의 keccak256 은 트랜잭션 유형의 서명 해시와 같아야 합니다.
+			preimage, err := base64.StdEncoding.DecodeString(unsignedTx.UnSignedTxEncodedBase64)
+			require.NoError(t, err)
+			signingHash := types.LatestSignerForChainID(chainID).Hash(tx)
+			assert.Equal(t, signingHash, crypto.Keccak256Hash(preimage))
+			assert.Equal(t, signingHash.Hex(), extra.SigningHash)
+		})
+	}
+}
+
+func TestAssembleSignedEthereumTransaction(t *testing.T) {
+	key, point, address := newEthereumKey(t)
+	legacy := uint8(types.LegacyTxType)
+
+	for name, req := range map[string]EthereumTxRequest{
+		"dynamic fee": {From: address, To: "0x000000000000000000000000000000000000dEaD", Amount: "1000", Data: "abcd"},
+		"legacy":      {From: address, To: "0x000000000000000000000000000000000000dEaD", Amount: "1000", Type: &legacy},
+		"access list": {From: address, To: "0x000000000000000000000000000000000000dEaD", Amount: "1000", GasPrice: strPtr("1"), CreateAccessList: true},
+	} {
+		t.Run(name, func(t *testing.T) {
+			unsignedTx, err := buildEthereumTransaction(newFakeEthereumBackend(), req, Ethereum)
+			require.NoError(t, err)
+
+			payload, err := CreateEthereumSigningPayload(unsignedTx, Ethereum, point)
+			require.NoError(t, err)
+			signedTxHex, err := AssembleSignedEthereumTransaction(unsignedTx, Ethereum, point, signEthereumPayload(t, key, payload))
+			require.NoError(t, err)
+
+			raw, err := hexutil.Decode(signedTxHex)
+			require.NoError(t, err)
+			signedTx := new(types.Transaction)
+			require.NoError(t, signedTx.UnmarshalBinary(raw))
+			sender, err := types.Sender(types.LatestSignerForChainID(signedTx.ChainId()), signedTx)
+			require.NoError(t, err)
+			assert.Equal(t, address, sender.Hex())
+			assert.Equal(t, big.NewInt(1000), signedTx.Value())
+		})
+	}
+
+	unsignedTx, err := buildEthereumTransaction(newFakeEthereumBackend(), EthereumTxRequest{
+		From: address, To: "0x000000000000000000000000000000000000dEaD", Amount: "1000",
+	}, Ethereum)
+	require.NoError(t, err)
+
+	// 다른 키로는 서명할 수 없습니다.
+	otherKey, otherPoint, _ := newEthereumKey(t)
+	_, err = CreateEthereumSigningPayload(unsignedTx, Ethereum, otherPoint)
+	assert.Error(t, err)
+	payload, err := CreateEthereumSigningPayload(unsignedTx, Ethereum, point)
+	require.NoError(t, err)
+	_, err = AssembleSignedEthereumTransaction(unsignedTx, Ethereum, point, signEthereumPayload(t, otherKey, payload))
+	assert.Error(t, err)
+
+	// 다른 체인의 트랜잭션은 거부합니다.
+	_, err = CreateEthereumSigningPayload(unsignedTx, Ethereum_Sepolia, point)
+	assert.Error(t, err)
+}
+
+func strPtr(s string) *string {
+	return &s
+}
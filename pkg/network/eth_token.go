@@ -0,0 +1,216 @@
+package network
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	TokenStandardERC20  = "erc20"
+	TokenStandardERC721 = "erc721"
+)
+
+// tokenABI 는 토큰 전송에 필요한 ERC-20, ERC-721 함수입니다.
+const tokenABI = `[
+	{"type":"function","name":"decimals","inputs":[],"outputs":[{"type":"uint8"}],"stateMutability":"view"},
+	{"type":"function","name":"symbol","inputs":[],"outputs":[{"type":"string"}],"stateMutability":"view"},
+	{"type":"function","name":"transfer","inputs":[{"name":"to","type":"address"},{"name":"amount","type":"uint256"}],"outputs":[{"type":"bool"}],"stateMutability":"nonpayable"},
+	{"type":"function","name":"ownerOf","inputs":[{"name":"tokenId","type":"uint256"}],"outputs":[{"type":"address"}],"stateMutability":"view"},
+	{"type":"function","name":"safeTransferFrom","inputs":[{"name":"from","type":"address"},{"name":"to","type":"address"},{"name":"tokenId","type":"uint256"}],"outputs":[],"stateMutability":"nonpayable"}
+]`
+
+var parsedTokenABI = mustParseABI(tokenABI)
+
+func mustParseABI(definition string) abi.ABI {
+	parsed, err := abi.JSON(strings.NewReader(definition))
+	if err != nil {
+		panic(err)
+	}
+	return parsed
+}
+
+// EthereumTokenTransfer 는 토큰 전송 트랜잭션의 Extra 에 담는 전송 내용입니다.
+type EthereumTokenTransfer struct {
+	Standard string `json:"standard"`
+	Contract string `json:"contract"`
+	Symbol   string `json:"symbol,omitempty"`
+	Decimals *uint8 `json:"decimals,omitempty"`
+	To       string `json:"to"`
+	// Amount 는 ERC-20 전송량 (소수점 단위), RawAmount 는 최소 단위 전송량입니다.
+	Amount    string `json:"amount,omitempty"`
+	RawAmount string `json:"rawAmount,omitempty"`
+	TokenID   string `json:"tokenId,omitempty"`
+}
+
+// isTokenTransfer 는 요청이 ERC-20 또는 ERC-721 전송인지 확인합니다.
+func (r EthereumTxRequest) isTokenTransfer() bool {
+	return r.Token != "" || r.Collection != ""
+}
+
+// buildTokenTransfer 는 토큰 전송 요청을 컨트랙트 호출 (받는 컨트랙트, calldata) 로 바꿉니다.
+func buildTokenTransfer(client ethereumBackend, ethReq EthereumTxRequest) (common.Address, []byte, *EthereumTokenTransfer, error) {
+	if ethReq.Token != "" && ethReq.Collection != "" {
+		return common.Address{}, nil, nil, fmt.Errorf("token and collection cannot be used together")
+	}
+	if ethReq.Data != "" {
+		return common.Address{}, nil, nil, fmt.Errorf("data is not allowed for token transfers")
+	}
+	if ethReq.Token != "" {
+		return buildERC20Transfer(client, ethReq)
+	}
+	return buildERC721Transfer(client, ethReq)
+}
+
+func buildERC20Transfer(client ethereumBackend, ethReq EthereumTxRequest) (common.Address, []byte, *EthereumTokenTransfer, error) {
+	if !IsValidEthereumAddress(ethReq.Token) {
+		return common.Address{}, nil, nil, fmt.Errorf("invalid 'token' address: %s", ethReq.Token)
+	}
+	token := common.HexToAddress(ethReq.Token)
+	from := common.HexToAddress(ethReq.From)
+
+	var decimals uint8
+	if err := callTokenContract(client, from, token, &decimals, "decimals"); err != nil {
+		return common.Address{}, nil, nil, fmt.Errorf("failed to read decimals of %s: %v", token.Hex(), err)
+	}
+	amount, err := parseTokenAmount(ethReq.Amount, decimals)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+
+	to := common.HexToAddress(ethReq.To)
+	data, err := parsedTokenABI.Pack("transfer", to, amount)
+	if err != nil {
+		return common.Address{}, nil, nil, fmt.Errorf("failed to encode transfer: %v", err)
+	}
+	return token, data, &EthereumTokenTransfer{
+		Standard:  TokenStandardERC20,
+		Contract:  token.Hex(),
+		Symbol:    readTokenSymbol(client, from, token),
+		Decimals:  &decimals,
+		To:        to.Hex(),
+		Amount:    formatTokenAmount(amount, decimals),
+		RawAmount: amount.String(),
+	}, nil
+}
+
+func buildERC721Transfer(client ethereumBackend, ethReq EthereumTxRequest) (common.Address, []byte, *EthereumTokenTransfer, error) {
+	if !IsValidEthereumAddress(ethReq.Collection) {
+		return common.Address{}, nil, nil, fmt.Errorf("invalid 'collection' address: %s", ethReq.Collection)
+	}
+	if ethReq.Amount != "" {
+		return common.Address{}, nil, nil, fmt.Errorf("amount is not allowed for ERC-721 transfers")
+	}
+	tokenID, ok := new(big.Int).SetString(ethReq.TokenID, 10)
+	if !ok || tokenID.Sign() < 0 {
+		return common.Address{}, nil, nil, fmt.Errorf("invalid 'tokenId': %s", ethReq.TokenID)
+	}
+	collection := common.HexToAddress(ethReq.Collection)
+	from := common.HexToAddress(ethReq.From)
+
+	// 보내는 주소가 토큰을 가지고 있어야 합니다.
+	var owner common.Address
+	if err := callTokenContract(client, from, collection, &owner, "ownerOf", tokenID); err != nil {
+		return common.Address{}, nil, nil, fmt.Errorf("failed to read owner of token %s: %v", tokenID, err)
+	}
+	if owner != from {
+		return common.Address{}, nil, nil, fmt.Errorf("token %s is owned by %s, not %s", tokenID, owner.Hex(), from.Hex())
+	}
+
+	to := common.HexToAddress(ethReq.To)
+	data, err := parsedTokenABI.Pack("safeTransferFrom", from, to, tokenID)
+	if err != nil {
+		return common.Address{}, nil, nil, fmt.Errorf("failed to encode safeTransferFrom: %v", err)
+	}
+	return collection, data, &EthereumTokenTransfer{
+		Standard: TokenStandardERC721,
+		Contract: collection.Hex(),
+		Symbol:   readTokenSymbol(client, from, collection),
+		To:       to.Hex(),
+		TokenID:  tokenID.String(),
+	}, nil
+}
+
+// callTokenContract 는 컨트랙트의 view 함수를 호출하고 첫 번째 반환값을 out 에 넣습니다.
+func callTokenContract(client ethereumBackend, from common.Address, contract common.Address, out interface{}, method string, args ...interface{}) error {
+	input, err := parsedTokenABI.Pack(method, args...)
+	if err != nil {
+		return err
+	}
+	output, err := client.CallContract(context.Background(), ethereum.CallMsg{From: from, To: &contract, Data: input}, nil)
+	if err != nil {
+		return err
+	}
+	if len(output) == 0 {
+		return fmt.Errorf("%s returned no data", method)
+	}
+	values, err := parsedTokenABI.Unpack(method, output)
+	if err != nil {
+		return err
+	}
+	return parsedTokenABI.Methods[method].Outputs.Copy(out, values)
+}
+
+// readTokenSymbol 은 토큰의 심볼을 읽습니다. symbol 은 선택 함수이므로 읽지 못하면 빈 문자열입니다.
+// 초기 토큰 일부 (MKR 등) 는 string 대신 bytes32 를 돌려줍니다.
+func readTokenSymbol(client ethereumBackend, from common.Address, contract common.Address) string {
+	var symbol string
+	if err := callTokenContract(client, from, contract, &symbol, "symbol"); err == nil {
+		return symbol
+	}
+	output, err := client.CallContract(context.Background(), ethereum.CallMsg{From: from, To: &contract, Data: parsedTokenABI.Methods["symbol"].ID}, nil)
+	if err != nil || len(output) != 32 {
+		return ""
+	}
+	return string(bytes.TrimRight(output, "\x00"))
+}
+
+// parseTokenAmount 는 소수점 단위 전송량 (예: "1.5") 을 decimals 에 맞는 최소 단위로 바꿉니다.
+func parseTokenAmount(amount string, decimals uint8) (*big.Int, error) {
+	whole, fraction, _ := strings.Cut(amount, ".")
+	if whole == "" && fraction == "" {
+		return nil, fmt.Errorf("invalid amount: %s", amount)
+	}
+	if len(fraction) > int(decimals) {
+		return nil, fmt.Errorf("amount %s has more than %d decimals", amount, decimals)
+	}
+	raw, ok := new(big.Int).SetString(whole+fraction+strings.Repeat("0", int(decimals)-len(fraction)), 10)
+	if !ok || raw.Sign() < 0 || strings.ContainsAny(amount, "+-") {
+		return nil, fmt.Errorf("invalid amount: %s", amount)
+	}
+	return raw, nil
+}
+
+// formatTokenAmount 는 최소 단위 전송량을 소수점 단위로 표시합니다 (끝의 0 은 생략).
+func formatTokenAmount(raw *big.Int, decimals uint8) string {
+	digits := raw.String()
+	if decimals == 0 {
+		return digits
+	}
+	if len(digits) <= int(decimals) {
+		digits = strings.Repeat("0", int(decimals)-len(digits)+1) + digits
+	}
+	whole, fraction := digits[:len(digits)-int(decimals)], strings.TrimRight(digits[len(digits)-int(decimals):], "0")
+	if fraction == "" {
+		return whole
+	}
+	return whole + "." + fraction
+}
+
+// tokenTransferSummary 는 토큰 전송 내용을 사람이 읽을 수 있는 한 줄로 만듭니다.
+func tokenTransferSummary(token *EthereumTokenTransfer) string {
+	symbol := token.Symbol
+	if symbol == "" {
+		symbol = token.Contract
+	}
+	if token.Standard == TokenStandardERC721 {
+		return fmt.Sprintf("transfer %s #%s to %s", symbol, token.TokenID, token.To)
+	}
+	return fmt.Sprintf("transfer %s %s to %s", token.Amount, symbol, token.To)
+}
@@ -0,0 +1,148 @@
+package network
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// withTokenContract 는 fake 백엔드가 method 호출에 values 를 ABI 인코딩해 돌려주게 합니다.
+func withTokenContract(t *testing.T, backend *fakeEthereumBackend, method string, values ...interface{}) {
+	output, err := parsedTokenABI.Methods[method].Outputs.Pack(values...)
+	require.NoError(t, err)
+	if backend.contractCalls == nil {
+		backend.contractCalls = map[string][]byte{}
+	}
+	backend.contractCalls[hex.EncodeToString(parsedTokenABI.Methods[method].ID)] = output
+}
+
+func TestBuildTokenTransfer(t *testing.T) {
+	from := common.HexToAddress("0x8ba1f109551bD432803012645Ac136ddd64DBA72")
+	to := common.HexToAddress("0x000000000000000000000000000000000000dEaD")
+	token := common.HexToAddress("0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48")
+	collection := common.HexToAddress("0xBC4CA0EdA7647A8aB7C2061c2E118A18a936f13D")
+
+	t.Run("erc20", func(t *testing.T) {
+		backend := newFakeEthereumBackend()
+		withTokenContract(t, backend, "decimals", uint8(6))
+		withTokenContract(t, backend, "symbol", "USDC")
+
+		unsignedTx, err := buildEthereumTransaction(backend, EthereumTxRequest{
+			From: from.Hex(), To: to.Hex(), Token: token.Hex(), Amount: "1.5",
+		}, Ethereum_Sepolia)
+		require.NoError(t, err)
+		tx, extra, err := decodeEthereumTx(unsignedTx, Ethereum_Sepolia)
+		require.NoError(t, err)
+
+		// 토큰 컨트랙트로 보내는 값 0 의 transfer(to, 1500000) 호출입니다.
+		assert.Equal(t, token, *tx.To())
+		assert.Zero(t, tx.Value().Sign())
+		expected, err := parsedTokenABI.Pack("transfer", to, big.NewInt(1500000))
+		require.NoError(t, err)
+		assert.Equal(t, expected, tx.Data())
+
+		// 가스는 보내는 주소로 추정합니다.
+		assert.Equal(t, from, backend.estimated.From)
+		assert.Equal(t, token, *backend.estimated.To)
+
+		require.NotNil(t, extra.Token)
+		assert.Equal(t, TokenStandardERC20, extra.Token.Standard)
+		assert.Equal(t, "1500000", extra.Token.RawAmount)
+		assert.Equal(t, uint8(6), *extra.Token.Decimals)
+		assert.Equal(t, "transfer 1.5 USDC to "+to.Hex(), extra.Summary)
+	})
+
+	t.Run("erc20 bytes32 symbol", func(t *testing.T) {
+		backend := newFakeEthereumBackend()
+		withTokenContract(t, backend, "decimals", uint8(18))
+		symbol := make([]byte, 32)
+		copy(symbol, "MKR")
+		backend.contractCalls[hex.EncodeToString(parsedTokenABI.Methods["symbol"].ID)] = symbol
+
+		unsignedTx, err := buildEthereumTransaction(backend, EthereumTxRequest{
+			From: from.Hex(), To: to.Hex(), Token: token.Hex(), Amount: "2",
+		}, Ethereum_Sepolia)
+		require.NoError(t, err)
+		extra, err := DecodeEthereumTxExtra(unsignedTx.Extra)
+		require.NoError(t, err)
+		assert.Equal(t, "MKR", extra.Token.Symbol)
+		assert.Equal(t, "2000000000000000000", extra.Token.RawAmount)
+	})
+
+	t.Run("erc721", func(t *testing.T) {
+		backend := newFakeEthereumBackend()
+		withTokenContract(t, backend, "ownerOf", from)
+		withTokenContract(t, backend, "symbol", "BAYC")
+
+		unsignedTx, err := buildEthereumTransaction(backend, EthereumTxRequest{
+			From: from.Hex(), To: to.Hex(), Collection: collection.Hex(), TokenID: "42",
+		}, Ethereum_Sepolia)
+		require.NoError(t, err)
+		tx, extra, err := decodeEthereumTx(unsignedTx, Ethereum_Sepolia)
+		require.NoError(t, err)
+
+		assert.Equal(t, collection, *tx.To())
+		assert.Zero(t, tx.Value().Sign())
+		expected, err := parsedTokenABI.Pack("safeTransferFrom", from, to, big.NewInt(42))
+		require.NoError(t, err)
+		assert.Equal(t, expected, tx.Data())
+		assert.Equal(t, from, backend.estimated.From)
+		assert.Equal(t, "transfer BAYC #42 to "+to.Hex(), extra.Summary)
+	})
+
+	errorTests := map[string]EthereumTxRequest{
+		"too many decimals":          {From: from.Hex(), To: to.Hex(), Token: token.Hex(), Amount: "1.0000001"},
+		"negative amount":            {From: from.Hex(), To: to.Hex(), Token: token.Hex(), Amount: "-1"},
+		"token and collection":       {From: from.Hex(), To: to.Hex(), Token: token.Hex(), Collection: collection.Hex(), Amount: "1"},
+		"data with token":            {From: from.Hex(), To: to.Hex(), Token: token.Hex(), Amount: "1", Data: "abcd"},
+		"amount with collection":     {From: from.Hex(), To: to.Hex(), Collection: collection.Hex(), TokenID: "42", Amount: "1"},
+		"invalid token id":           {From: from.Hex(), To: to.Hex(), Collection: collection.Hex(), TokenID: "0x2a"},
+		"not owner":                  {From: to.Hex(), To: from.Hex(), Collection: collection.Hex(), TokenID: "42"},
+		"token without decimals":     {From: from.Hex(), To: to.Hex(), Token: to.Hex(), Amount: "1"},
+		"invalid collection address": {From: from.Hex(), To: to.Hex(), Collection: "0x1234", TokenID: "42"},
+	}
+	for name, req := range errorTests {
+		t.Run(name, func(t *testing.T) {
+			backend := newFakeEthereumBackend()
+			withTokenContract(t, backend, "decimals", uint8(6))
+			withTokenContract(t, backend, "ownerOf", from)
+			// 컨트랙트가 아닌 주소는 빈 결과를 돌려줍니다.
+			if req.Token == to.Hex() {
+				backend.contractCalls = nil
+			}
+			_, err := buildEthereumTransaction(backend, req, Ethereum_Sepolia)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestTokenAmount(t *testing.T) {
+	tests := []struct {
+		amount   string
+		decimals uint8
+		raw      string
+		display  string
+	}{
+		{"1.5", 6, "1500000", "1.5"},
+		{"1", 18, "1000000000000000000", "1"},
+		{"0.000001", 6, "1", "0.000001"},
+		{".25", 2, "25", "0.25"},
+		{"100", 0, "100", "100"},
+		{"1.10", 6, "1100000", "1.1"},
+	}
+	for _, tt := range tests {
+		raw, err := parseTokenAmount(tt.amount, tt.decimals)
+		require.NoError(t, err, tt.amount)
+		assert.Equal(t, tt.raw, raw.String())
+		assert.Equal(t, tt.display, formatTokenAmount(raw, tt.decimals))
+	}
+
+	for _, amount := range []string{"", ".", "1.2.3", "abc", "+1", "1.5"} {
+		_, err := parseTokenAmount(amount, 0)
+		assert.Error(t, err, amount)
+	}
+}
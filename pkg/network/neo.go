@@ -0,0 +1,55 @@
+package network
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/sha256"
+	"fmt"
+	"math/big"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/btcsuite/btcutil/base58"
+	"github.com/coinbase/kryptology/pkg/core/curves"
+)
+
+// neoAddressVersion 은 NEO N3 주소의 버전 바이트입니다 (주소가 'N' 으로 시작).
+const neoAddressVersion = 0x35
+
+// neoCheckSigSyscall 은 System.Crypto.CheckSig 인터롭 해시입니다.
+var neoCheckSigSyscall = []byte{0x56, 0xe7, 0xb3, 0x27}
+
+// DeriveNeoAddress 는 단일 서명 검증 스크립트(PUSHDATA1 pubkey, SYSCALL CheckSig)의 스크립트 해시로 NEO N3 주소를 만듭니다.
+func DeriveNeoAddress(point curves.Point, _ Network, _ int) (string, error) {
+	if point.CurveName() != curves.P256Name {
+		return "", fmt.Errorf("neo addresses require a p256 key, got %s", point.CurveName())
+	}
+	publicKey := point.ToAffineCompressed()
+
+	script := make([]byte, 0, 2+len(publicKey)+1+len(neoCheckSigSyscall))
+	script = append(script, 0x0c, byte(len(publicKey)))
+	script = append(script, publicKey...)
+	script = append(script, 0x41)
+	script = append(script, neoCheckSigSyscall...)
+
+	return base58.CheckEncode(btcutil.Hash160(script), neoAddressVersion), nil
+}
+
+// VerifyNeoSignature 는 r || s (64바이트) 서명을 SHA-256 다이제스트로 검증합니다.
+// NEO 의 tx_origin 은 네트워크 매직(4바이트 LE) || SHA-256(미서명 트랜잭션) 입니다.
+func VerifyNeoSignature(point curves.Point, txOrigin []byte, signature []byte) bool {
+	if point.CurveName() != curves.P256Name || len(signature) != 64 {
+		return false
+	}
+	uncompressed := point.ToAffineUncompressed()
+	publicKey := &ecdsa.PublicKey{
+		Curve: elliptic.P256(),
+		X:     new(big.Int).SetBytes(uncompressed[1:33]),
+		Y:     new(big.Int).SetBytes(uncompressed[33:]),
+	}
+	digest := sha256.Sum256(txOrigin)
+	return ecdsa.Verify(publicKey, digest[:], new(big.Int).SetBytes(signature[:32]), new(big.Int).SetBytes(signature[32:]))
+}
+
+func IsNeoNetwork(n Network) bool {
+	return n.Family() == FamilyNeo
+}
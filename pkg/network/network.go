@@ -1,72 +1,44 @@
 package network
 
+import "github.com/btcsuite/btcd/chaincfg"
+
+// Network 는 네트워크 ID 입니다. 기본 네트워크 외의 네트워크는 설정 파일 (LoadNetworks) 로 추가합니다.
 type Network int
 
 const (
-	Bitcoin Network = iota
+	Bitcoin Network = iota + 1
 	BitcoinTestNet
 	BitcoinRegTest
 	Ethereum
 	Ethereum_Sepolia
 	Avalanche_C_CHAIN
 	Avalanche_C_CHAIN_Fuji
+	Solana
+	Solana_Devnet
+	Neo
+	Neo_Testnet
 )
 
 type NetworkMetadataInfo struct {
 	ID      int32
 	Name    string
+	Family  ChainFamily
 	ChainID *int64
-	RpcURL  string
-}
-
-var NetworkMetadata = map[Network]NetworkMetadataInfo{
-	Bitcoin: {
-		ID:      1,
-		Name:    "Bitcoin",
-		ChainID: nil,
-		RpcURL:  "",
-	},
-	BitcoinTestNet: {
-		ID:      2,
-		Name:    "Bitcoin Testnet",
-		ChainID: nil,
-		RpcURL:  "",
-	},
-	BitcoinRegTest: {
-		ID:      3,
-		Name:    "Bitcoin RegTest",
-		ChainID: nil,
-		RpcURL:  "",
-	},
-	Ethereum: {
-		ID:      4,
-		Name:    "Ethereum",
-		ChainID: intPtr(1),
-		RpcURL:  "https://eth.llamarpc.com",
-	},
-	Ethereum_Sepolia: {
-		ID:      5,
-		Name:    "Ethereum Sepolia",
-		ChainID: intPtr(11155111),
-		RpcURL:  "https://gateway.tenderly.co/public/sepolia",
-	},
-	Avalanche_C_CHAIN: {
-		ID:      6,
-		Name:    "Avalanche C-Chain",
-		ChainID: intPtr(43114),
-		RpcURL:  "https://avalanche-c-chain-rpc.publicnode.com	",
-	},
-	Avalanche_C_CHAIN_Fuji: {
-		ID:      7,
-		Name:    "Ethereum Sepolia",
-		ChainID: intPtr(43113),
-		RpcURL:  "https://ava-testnet.public.blastapi.io/ext/bc/C/rpc",
-	},
-}
-var Networks = []Network{
-	Bitcoin, BitcoinTestNet, BitcoinRegTest, Ethereum, Ethereum_Sepolia,
+	RpcURLs []string
+	// ExplorerURL 은 네트워크의 블록 익스플로러 주소입니다.
+	ExplorerURL string
+	Features    []string
+	// Curve 는 네트워크가 사용하는 서명 곡선입니다. 체인 계열로 정해집니다.
+	Curve Curve
+
+	bitcoinParams  *chaincfg.Params
+	bitcoinBackend string
 }
 
+// NetworkMetadata 와 Networks 는 등록된 네트워크입니다. 기본 네트워크는 networks.json 에 정의되어 있습니다.
+var NetworkMetadata = map[Network]NetworkMetadataInfo{}
+var Networks []Network
+
 func (n Network) String() string {
 	return NetworkMetadata[n].Name
 }
@@ -78,8 +50,61 @@ func (n Network) ID() int32 {
 	return NetworkMetadata[n].ID
 }
 
+// RPC 는 네트워크의 첫 번째 RPC 주소입니다.
 func (n Network) RPC() string {
-	return NetworkMetadata[n].RpcURL
+	if urls := n.RPCs(); len(urls) > 0 {
+		return urls[0]
+	}
+	return ""
+}
+
+// RPCs 는 차례로 시도할 네트워크의 RPC 주소입니다.
+func (n Network) RPCs() []string {
+	return NetworkMetadata[n].RpcURLs
+}
+
+func (n Network) Family() ChainFamily {
+	return NetworkMetadata[n].Family
+}
+
+func (n Network) ExplorerURL() string {
+	return NetworkMetadata[n].ExplorerURL
+}
+
+// HasFeature 는 네트워크가 기능 (FeatureEIP1559 등) 을 지원하는지 확인합니다.
+func (n Network) HasFeature(feature string) bool {
+	return containsString(NetworkMetadata[n].Features, feature)
+}
+
+func (n Network) Curve() Curve {
+	return NetworkMetadata[n].Curve
+}
+
+func IsBitcoinNetwork(n Network) bool {
+	return n.Family() == FamilyBitcoin
+}
+
+func IsEthereumNetwork(n Network) bool {
+	return n.Family() == FamilyEVM
+}
+
+func IsSolanaNetwork(n Network) bool {
+	return n.Family() == FamilySolana
+}
+
+// IsValidAddressType 은 네트워크에서 선택할 수 있는 주소 유형인지 확인합니다.
+// 주소 유형은 비트코인 네트워크에만 있으며, 그 외 네트워크는 0 만 허용합니다.
+func IsValidAddressType(n Network, addrType int) bool {
+	if IsBitcoinNetwork(n) {
+		switch addrType {
+		case P2SHP2WPKH, P2WPKH:
+			return n.HasFeature(FeatureSegwit)
+		case P2TR:
+			return n.HasFeature(FeatureTaproot)
+		}
+		return IsValidBitcoinAddressType(addrType)
+	}
+	return addrType == 0
 }
 
 func GetNetworkByChainID(chainID int64) (Network, bool) {
@@ -91,6 +116,8 @@ func GetNetworkByChainID(chainID int64) (Network, bool) {
 	return 0, false
 }
 
-func intPtr(i int64) *int64 {
-	return &i
+// GetNetworkByID 는 등록된 네트워크를 ID 로 찾습니다.
+func GetNetworkByID(id int32) (Network, bool) {
+	_, ok := NetworkMetadata[Network(id)]
+	return Network(id), ok
 }
@@ -0,0 +1,327 @@
+package network
+
+import (
+	_ "embed"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/wire"
+)
+
+// ChainFamily 는 네트워크의 체인 계열입니다. 주소 파생, 트랜잭션 생성, 서명 방식은 계열로 정해지므로
+// 같은 계열의 네트워크는 설정 파일에 항목을 추가하는 것만으로 사용할 수 있습니다.
+type ChainFamily string
+
+const (
+	FamilyBitcoin ChainFamily = "bitcoin"
+	FamilyEVM     ChainFamily = "evm"
+	FamilySolana  ChainFamily = "solana"
+	FamilyNeo     ChainFamily = "neo"
+)
+
+// 네트워크가 지원하는 기능입니다.
+const (
+	// FeatureEIP1559 가 없는 EVM 네트워크는 가스 가격을 주지 않아도 레거시 트랜잭션을 만듭니다.
+	FeatureEIP1559 = "eip1559"
+	// FeatureSegwit, FeatureTaproot 은 비트코인 계열 네트워크에서 P2SH-P2WPKH, P2WPKH 와 P2TR 주소를 허용합니다.
+	FeatureSegwit  = "segwit"
+	FeatureTaproot = "taproot"
+)
+
+var familyFeatures = map[ChainFamily][]string{
+	FamilyBitcoin: {FeatureSegwit, FeatureTaproot},
+	FamilyEVM:     {FeatureEIP1559},
+}
+
+// bitcoinBaseParams 는 비트코인 계열 네트워크가 주소 외의 체인 파라미터를 가져올 네트워크입니다.
+var bitcoinBaseParams = map[string]*chaincfg.Params{
+	"mainnet":  &chaincfg.MainNetParams,
+	"testnet3": &chaincfg.TestNet3Params,
+	"regtest":  &chaincfg.RegressionNetParams,
+	"signet":   &chaincfg.SigNetParams,
+}
+
+//go:embed networks.json
+var defaultNetworksConfig []byte
+
+// NetworksConfig 는 네트워크 설정 파일입니다.
+type NetworksConfig struct {
+	Networks []NetworkConfig `json:"networks"`
+}
+
+// NetworkConfig 는 설정 파일의 네트워크 항목입니다.
+type NetworkConfig struct {
+	ID     int32       `json:"id"`
+	Name   string      `json:"name"`
+	Family ChainFamily `json:"family"`
+	// ChainID 는 EVM 네트워크의 EIP-155 체인 ID 입니다.
+	ChainID *int64 `json:"chainId,omitempty"`
+	// RPCURLs 는 차례로 시도할 노드 RPC 주소입니다. 비트코인 계열은 bitcoin.backend 에 맞는 주소입니다.
+	RPCURLs     []string              `json:"rpcUrls,omitempty"`
+	ExplorerURL string                `json:"explorerUrl,omitempty"`
+	Features    []string              `json:"features,omitempty"`
+	Bitcoin     *BitcoinNetworkConfig `json:"bitcoin,omitempty"`
+}
+
+// BitcoinNetworkConfig 는 비트코인 계열 네트워크의 주소 파라미터입니다.
+// Base 외의 값을 주면 Base 의 파라미터에서 해당 값만 바꾸며, 이때 Net 은 다른 네트워크와 겹치지 않아야 합니다.
+type BitcoinNetworkConfig struct {
+	// Base 는 mainnet, testnet3, regtest, signet 중 하나입니다.
+	Base string `json:"base"`
+	// Backend 는 UTXO, 수수료, 브로드캐스트에 사용할 백엔드 (esplora, bitcoind, electrum) 입니다. 기본값은 esplora 입니다.
+	Backend          string  `json:"backend,omitempty"`
+	Net              uint32  `json:"net,omitempty"`
+	Bech32HRP        string  `json:"bech32Hrp,omitempty"`
+	PubKeyHashAddrID *uint8  `json:"pubKeyHashAddrId,omitempty"`
+	ScriptHashAddrID *uint8  `json:"scriptHashAddrId,omitempty"`
+	PrivateKeyID     *uint8  `json:"privateKeyId,omitempty"`
+	HDPublicKeyID    string  `json:"hdPublicKeyId,omitempty"`
+	HDPrivateKeyID   string  `json:"hdPrivateKeyId,omitempty"`
+	HDCoinType       *uint32 `json:"hdCoinType,omitempty"`
+}
+
+// registeredBitcoinNets 는 chaincfg 에 등록한 비트코인 계열 파라미터입니다. 같은 설정을 다시 읽어도 한 번만 등록합니다.
+var registeredBitcoinNets = map[wire.BitcoinNet]*chaincfg.Params{}
+
+func init() {
+	var cfg NetworksConfig
+	if err := json.Unmarshal(defaultNetworksConfig, &cfg); err != nil {
+		panic(fmt.Sprintf("invalid default network config: %v", err))
+	}
+	if err := RegisterNetworks(cfg.Networks); err != nil {
+		panic(fmt.Sprintf("invalid default network config: %v", err))
+	}
+}
+
+// LoadNetworks 는 설정 파일의 네트워크를 등록합니다. 기본 네트워크와 ID 가 같으면 설정 파일의 항목으로 바꿉니다.
+// 서버가 요청을 처리하기 전에 호출해야 합니다.
+func LoadNetworks(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read network config: %v", err)
+	}
+	var cfg NetworksConfig
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return fmt.Errorf("failed to parse network config %s: %v", path, err)
+	}
+	return RegisterNetworks(cfg.Networks)
+}
+
+// RegisterNetworks 는 네트워크를 검증해 등록합니다. 하나라도 잘못되면 아무것도 등록하지 않습니다.
+func RegisterNetworks(configs []NetworkConfig) error {
+	metadata := make(map[Network]NetworkMetadataInfo, len(NetworkMetadata)+len(configs))
+	for net, info := range NetworkMetadata {
+		metadata[net] = info
+	}
+
+	seen := make(map[int32]bool, len(configs))
+	for _, cfg := range configs {
+		if seen[cfg.ID] {
+			return fmt.Errorf("duplicate network ID: %d", cfg.ID)
+		}
+		seen[cfg.ID] = true
+
+		info, err := newNetworkMetadata(cfg)
+		if err != nil {
+			return fmt.Errorf("network %d (%s): %v", cfg.ID, cfg.Name, err)
+		}
+		metadata[Network(cfg.ID)] = info
+	}
+
+	// 체인 ID 로 네트워크를 찾으므로 EVM 네트워크의 체인 ID 는 겹치지 않아야 합니다.
+	chainIDs := make(map[int64]int32)
+	for _, info := range metadata {
+		if info.ChainID == nil {
+			continue
+		}
+		if other, ok := chainIDs[*info.ChainID]; ok {
+			return fmt.Errorf("networks %d and %d have the same chain ID %d", other, info.ID, *info.ChainID)
+		}
+		chainIDs[*info.ChainID] = info.ID
+	}
+
+	for _, info := range metadata {
+		if err := registerBitcoinParams(info.bitcoinParams); err != nil {
+			return fmt.Errorf("network %d (%s): %v", info.ID, info.Name, err)
+		}
+	}
+
+	networks := make([]Network, 0, len(metadata))
+	for net := range metadata {
+		networks = append(networks, net)
+	}
+	sort.Slice(networks, func(i, j int) bool { return networks[i] < networks[j] })
+
+	NetworkMetadata = metadata
+	Networks = networks
+	return nil
+}
+
+func newNetworkMetadata(cfg NetworkConfig) (NetworkMetadataInfo, error) {
+	if cfg.ID <= 0 {
+		return NetworkMetadataInfo{}, fmt.Errorf("id must be positive")
+	}
+	if cfg.Name == "" {
+		return NetworkMetadataInfo{}, fmt.Errorf("name is required")
+	}
+
+	info := NetworkMetadataInfo{
+		ID:          cfg.ID,
+		Name:        cfg.Name,
+		Family:      cfg.Family,
+		RpcURLs:     cfg.RPCURLs,
+		ExplorerURL: cfg.ExplorerURL,
+		Features:    cfg.Features,
+	}
+	switch cfg.Family {
+	case FamilyBitcoin, FamilyEVM:
+		info.Curve = Secp256k1
+	case FamilySolana:
+		info.Curve = Ed25519
+	case FamilyNeo:
+		info.Curve = P256
+	default:
+		return NetworkMetadataInfo{}, fmt.Errorf("unsupported chain family: %q", cfg.Family)
+	}
+
+	if cfg.Family == FamilyEVM {
+		if cfg.ChainID == nil || *cfg.ChainID <= 0 {
+			return NetworkMetadataInfo{}, fmt.Errorf("chainId is required for EVM networks")
+		}
+		info.ChainID = cfg.ChainID
+	} else if cfg.ChainID != nil {
+		return NetworkMetadataInfo{}, fmt.Errorf("chainId is only allowed for EVM networks")
+	}
+
+	for _, feature := range cfg.Features {
+		if !containsString(familyFeatures[cfg.Family], feature) {
+			return NetworkMetadataInfo{}, fmt.Errorf("unsupported feature for %s networks: %q", cfg.Family, feature)
+		}
+	}
+
+	if cfg.Family == FamilyBitcoin {
+		params, err := newBitcoinParams(cfg.Name, cfg.Bitcoin)
+		if err != nil {
+			return NetworkMetadataInfo{}, err
+		}
+		info.bitcoinParams = params
+		switch cfg.Bitcoin.Backend {
+		case "", BitcoinBackendEsplora, BitcoinBackendBitcoind, BitcoinBackendElectrum:
+			info.bitcoinBackend = cfg.Bitcoin.Backend
+		default:
+			return NetworkMetadataInfo{}, fmt.Errorf("unsupported bitcoin backend: %q", cfg.Bitcoin.Backend)
+		}
+	} else if cfg.Bitcoin != nil {
+		return NetworkMetadataInfo{}, fmt.Errorf("bitcoin parameters are only allowed for bitcoin networks")
+	}
+	return info, nil
+}
+
+// newBitcoinParams 는 Base 의 chaincfg 파라미터에 설정한 주소 파라미터를 덮어씁니다.
+func newBitcoinParams(name string, cfg *BitcoinNetworkConfig) (*chaincfg.Params, error) {
+	if cfg == nil {
+		return nil, fmt.Errorf("bitcoin parameters are required for bitcoin networks")
+	}
+	base, ok := bitcoinBaseParams[cfg.Base]
+	if !ok {
+		return nil, fmt.Errorf("unsupported bitcoin base network: %q", cfg.Base)
+	}
+	customized := cfg.Bech32HRP != "" || cfg.PubKeyHashAddrID != nil || cfg.ScriptHashAddrID != nil || cfg.PrivateKeyID != nil ||
+		cfg.HDPublicKeyID != "" || cfg.HDPrivateKeyID != "" || cfg.HDCoinType != nil
+	if !customized {
+		if cfg.Net != 0 && wire.BitcoinNet(cfg.Net) != base.Net {
+			return nil, fmt.Errorf("net is only allowed with custom address parameters")
+		}
+		return base, nil
+	}
+	if cfg.Net == 0 {
+		return nil, fmt.Errorf("net is required for custom address parameters")
+	}
+
+	params := *base
+	params.Name = name
+	params.Net = wire.BitcoinNet(cfg.Net)
+	if cfg.Bech32HRP != "" {
+		params.Bech32HRPSegwit = cfg.Bech32HRP
+	}
+	if cfg.PubKeyHashAddrID != nil {
+		params.PubKeyHashAddrID = *cfg.PubKeyHashAddrID
+	}
+	if cfg.ScriptHashAddrID != nil {
+		params.ScriptHashAddrID = *cfg.ScriptHashAddrID
+	}
+	if cfg.PrivateKeyID != nil {
+		params.PrivateKeyID = *cfg.PrivateKeyID
+	}
+	if cfg.HDCoinType != nil {
+		params.HDCoinType = *cfg.HDCoinType
+	}
+	if err := parseHDKeyID(cfg.HDPublicKeyID, &params.HDPublicKeyID); err != nil {
+		return nil, fmt.Errorf("invalid hdPublicKeyId: %v", err)
+	}
+	if err := parseHDKeyID(cfg.HDPrivateKeyID, &params.HDPrivateKeyID); err != nil {
+		return nil, fmt.Errorf("invalid hdPrivateKeyId: %v", err)
+	}
+	if params.PubKeyHashAddrID == params.ScriptHashAddrID {
+		return nil, fmt.Errorf("pubKeyHashAddrId and scriptHashAddrId must differ")
+	}
+	return &params, nil
+}
+
+func parseHDKeyID(value string, id *[4]byte) error {
+	if value == "" {
+		return nil
+	}
+	b, err := hex.DecodeString(value)
+	if err != nil {
+		return err
+	}
+	if len(b) != len(id) {
+		return fmt.Errorf("expected %d bytes, got %d", len(id), len(b))
+	}
+	copy(id[:], b)
+	return nil
+}
+
+// registerBitcoinParams 는 사용자 정의 파라미터를 chaincfg 에 등록합니다.
+// btcutil 은 등록된 bech32 접두어만 세그윗 주소로 해석합니다.
+func registerBitcoinParams(params *chaincfg.Params) error {
+	if params == nil {
+		return nil
+	}
+	for _, base := range bitcoinBaseParams {
+		if params == base {
+			return nil
+		}
+	}
+	if registered, ok := registeredBitcoinNets[params.Net]; ok {
+		if !sameBitcoinAddressParams(registered, params) {
+			return fmt.Errorf("net %d is already registered with different parameters", params.Net)
+		}
+		return nil
+	}
+	if err := chaincfg.Register(params); err != nil {
+		return fmt.Errorf("failed to register bitcoin parameters: %v", err)
+	}
+	registeredBitcoinNets[params.Net] = params
+	return nil
+}
+
+func sameBitcoinAddressParams(a, b *chaincfg.Params) bool {
+	return a.Bech32HRPSegwit == b.Bech32HRPSegwit && a.PubKeyHashAddrID == b.PubKeyHashAddrID &&
+		a.ScriptHashAddrID == b.ScriptHashAddrID && a.PrivateKeyID == b.PrivateKeyID &&
+		a.HDPublicKeyID == b.HDPublicKeyID && a.HDPrivateKeyID == b.HDPrivateKeyID && a.HDCoinType == b.HDCoinType
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
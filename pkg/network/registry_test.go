@@ -0,0 +1,182 @@
+package network
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// restoreNetworks 는 테스트가 등록한 네트워크를 테스트가 끝나면 되돌립니다.
+func restoreNetworks(t *testing.T) {
+	metadata, networks := NetworkMetadata, Networks
+	t.Cleanup(func() {
+		NetworkMetadata, Networks = metadata, networks
+	})
+}
+
+func uint8Ptr(v uint8) *uint8 { return &v }
+
+func int64Ptr(v int64) *int64 { return &v }
+
+func TestDefaultNetworks(t *testing.T) {
+	require.Len(t, Networks, 11)
+	for i, net := range Networks {
+		assert.Equal(t, int32(i+1), net.ID())
+		for _, url := range net.RPCs() {
+			assert.Equal(t, strings.TrimSpace(url), url)
+		}
+	}
+
+	assert.Equal(t, "Avalanche Fuji C-Chain", Avalanche_C_CHAIN_Fuji.String())
+	assert.True(t, IsEthereumNetwork(Avalanche_C_CHAIN))
+	assert.Equal(t, int64(43113), *Avalanche_C_CHAIN_Fuji.ChainID())
+	assert.True(t, IsBitcoinNetwork(BitcoinRegTest))
+	assert.Equal(t, Ed25519, Solana_Devnet.Curve())
+	assert.Equal(t, P256, Neo.Curve())
+	assert.True(t, Ethereum.HasFeature(FeatureEIP1559))
+
+	net, ok := GetNetworkByChainID(11155111)
+	require.True(t, ok)
+	assert.Equal(t, Ethereum_Sepolia, net)
+	_, ok = GetNetworkByID(99)
+	assert.False(t, ok)
+}
+
+func TestRegisterNetworks(t *testing.T) {
+	restoreNetworks(t)
+
+	require.NoError(t, RegisterNetworks([]NetworkConfig{
+		{ID: 100, Name: "Base", Family: FamilyEVM, ChainID: int64Ptr(8453), RPCURLs: []string{"https://mainnet.base.org"}, Features: []string{FeatureEIP1559}},
+		{ID: 101, Name: "BNB Smart Chain", Family: FamilyEVM, ChainID: int64Ptr(56), RPCURLs: []string{"https://bsc-dataseed.bnbchain.org"}},
+		{ID: 102, Name: "Litecoin", Family: FamilyBitcoin, Features: []string{FeatureSegwit}, Bitcoin: &BitcoinNetworkConfig{
+			Base: "mainnet", Net: 0xdbb6c0fb, Bech32HRP: "ltc",
+			PubKeyHashAddrID: uint8Ptr(0x30), ScriptHashAddrID: uint8Ptr(0x32), PrivateKeyID: uint8Ptr(0xb0),
+		}},
+	}))
+	// 같은 설정을 다시 등록해도 됩니다.
+	require.NoError(t, RegisterNetworks([]NetworkConfig{
+		{ID: 102, Name: "Litecoin", Family: FamilyBitcoin, Features: []string{FeatureSegwit}, Bitcoin: &BitcoinNetworkConfig{
+			Base: "mainnet", Net: 0xdbb6c0fb, Bech32HRP: "ltc",
+			PubKeyHashAddrID: uint8Ptr(0x30), ScriptHashAddrID: uint8Ptr(0x32), PrivateKeyID: uint8Ptr(0xb0),
+		}},
+	}))
+	assert.Len(t, Networks, 14)
+
+	t.Run("evm", func(t *testing.T) {
+		base, ok := GetNetworkByChainID(8453)
+		require.True(t, ok)
+		assert.Equal(t, Network(100), base)
+		assert.True(t, IsEthereumNetwork(base))
+		assert.Equal(t, Secp256k1, base.Curve())
+
+		_, point, address := newEthereumKey(t)
+		derived, err := DeriveEthereumAddress(point, base, 0)
+		require.NoError(t, err)
+		assert.Equal(t, address, derived)
+
+		// EIP-1559 를 지원하지 않는 체인은 기본으로 레거시 트랜잭션을 만듭니다.
+		bsc := Network(101)
+		unsignedTx, err := buildEthereumTransaction(newFakeEthereumBackend(), EthereumTxRequest{From: address, To: address, Amount: "1"}, bsc)
+		require.NoError(t, err)
+		tx, _, err := decodeEthereumTx(unsignedTx, bsc)
+		require.NoError(t, err)
+		assert.Equal(t, uint8(types.LegacyTxType), tx.Type())
+
+		dynamicFee := uint8(types.DynamicFeeTxType)
+		_, err = buildEthereumTransaction(newFakeEthereumBackend(), EthereumTxRequest{From: address, To: address, Amount: "1", Type: &dynamicFee}, bsc)
+		assert.Error(t, err)
+	})
+
+	t.Run("bitcoin family", func(t *testing.T) {
+		litecoin := Network(102)
+		assert.True(t, IsBitcoinNetwork(litecoin))
+		_, point, _ := newEthereumKey(t)
+
+		segwit, err := DeriveBitcoinAddress(point, litecoin, P2WPKH)
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(segwit, "ltc1q"), segwit)
+		legacy, err := DeriveBitcoinAddress(point, litecoin, P2PKH)
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(legacy, "L"), legacy)
+
+		for _, address := range []string{segwit, legacy} {
+			assert.True(t, IsValidBitcoinAddress(address, litecoin))
+			assert.False(t, IsValidBitcoinAddress(address, Bitcoin))
+		}
+		bitcoinAddress, err := DeriveBitcoinAddress(point, Bitcoin, P2WPKH)
+		require.NoError(t, err)
+		assert.False(t, IsValidBitcoinAddress(bitcoinAddress, litecoin))
+
+		assert.True(t, IsValidAddressType(litecoin, P2WPKH))
+		assert.False(t, IsValidAddressType(litecoin, P2TR))
+	})
+}
+
+func TestRegisterNetworksErrors(t *testing.T) {
+	restoreNetworks(t)
+
+	tests := map[string][]NetworkConfig{
+		"missing name":        {{ID: 100, Family: FamilyEVM, ChainID: int64Ptr(8453)}},
+		"invalid id":          {{ID: 0, Name: "Base", Family: FamilyEVM, ChainID: int64Ptr(8453)}},
+		"unknown family":      {{ID: 100, Name: "Cosmos", Family: "cosmos"}},
+		"evm without chainId": {{ID: 100, Name: "Base", Family: FamilyEVM}},
+		"chainId on solana":   {{ID: 100, Name: "Solana Testnet", Family: FamilySolana, ChainID: int64Ptr(1)}},
+		"duplicate chainId":   {{ID: 100, Name: "Mainnet Fork", Family: FamilyEVM, ChainID: int64Ptr(1)}},
+		"duplicate id": {
+			{ID: 100, Name: "Base", Family: FamilyEVM, ChainID: int64Ptr(8453)},
+			{ID: 100, Name: "Base Sepolia", Family: FamilyEVM, ChainID: int64Ptr(84532)},
+		},
+		"unknown feature":        {{ID: 100, Name: "Base", Family: FamilyEVM, ChainID: int64Ptr(8453), Features: []string{FeatureTaproot}}},
+		"missing bitcoin params": {{ID: 100, Name: "Litecoin", Family: FamilyBitcoin}},
+		"unknown bitcoin base":   {{ID: 100, Name: "Litecoin", Family: FamilyBitcoin, Bitcoin: &BitcoinNetworkConfig{Base: "litecoin"}}},
+		"custom params without net": {{ID: 100, Name: "Litecoin", Family: FamilyBitcoin, Bitcoin: &BitcoinNetworkConfig{
+			Base: "mainnet", Bech32HRP: "ltc",
+		}}},
+		"invalid hd key id": {{ID: 100, Name: "Litecoin", Family: FamilyBitcoin, Bitcoin: &BitcoinNetworkConfig{
+			Base: "mainnet", Net: 0xdbb6c0fb, HDPublicKeyID: "0488",
+		}}},
+		"net used by bitcoin": {{ID: 100, Name: "Litecoin", Family: FamilyBitcoin, Bitcoin: &BitcoinNetworkConfig{
+			Base: "mainnet", Net: 0xd9b4bef9, Bech32HRP: "ltc",
+		}}},
+	}
+	for name, configs := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Error(t, RegisterNetworks(configs))
+			// 잘못된 설정은 아무것도 등록하지 않습니다.
+			assert.Len(t, Networks, 11)
+			_, ok := GetNetworkByID(100)
+			assert.False(t, ok)
+		})
+	}
+}
+
+func TestLoadNetworks(t *testing.T) {
+	restoreNetworks(t)
+
+	path := filepath.Join(t.TempDir(), "networks.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{
+		"networks": [
+			{"id": 4, "name": "Ethereum", "family": "evm", "chainId": 1, "rpcUrls": ["https://rpc-1.example", "https://rpc-2.example"], "features": ["eip1559"]},
+			{"id": 100, "name": "Base Sepolia", "family": "evm", "chainId": 84532, "explorerUrl": "https://sepolia.basescan.org", "features": ["eip1559"]}
+		]
+	}`), 0o600))
+	require.NoError(t, LoadNetworks(path))
+
+	// 같은 ID 의 기본 네트워크는 설정 파일의 항목으로 바뀝니다.
+	assert.Equal(t, []string{"https://rpc-1.example", "https://rpc-2.example"}, Ethereum.RPCs())
+	assert.Equal(t, "https://rpc-1.example", Ethereum.RPC())
+	baseSepolia, ok := GetNetworkByID(100)
+	require.True(t, ok)
+	assert.Equal(t, "Base Sepolia", baseSepolia.String())
+	assert.Equal(t, "https://sepolia.basescan.org", baseSepolia.ExplorerURL())
+	assert.Equal(t, baseSepolia, Networks[len(Networks)-1])
+
+	require.NoError(t, os.WriteFile(path, []byte(`{"networks": [`), 0o600))
+	assert.Error(t, LoadNetworks(path))
+	assert.Error(t, LoadNetworks(filepath.Join(t.TempDir(), "missing.json")))
+}
@@ -0,0 +1,273 @@
+package network
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"tecdsa/pkg/transaction"
+
+	"github.com/btcsuite/btcutil/base58"
+	"github.com/coinbase/kryptology/pkg/core/curves"
+	"github.com/pkg/errors"
+)
+
+// solanaSystemProgramID 는 System Program 의 주소(11111111111111111111111111111111)입니다.
+var solanaSystemProgramID = [32]byte{}
+
+// solanaSystemTransfer 는 System Program 의 Transfer 명령 번호입니다.
+const solanaSystemTransfer = 2
+
+type SolanaTxRequest struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+	/*
+		Amount
+		lamports (1SOL = 1000000000)
+	*/
+	Amount string `json:"amount"`
+	// RecentBlockhash 를 생략하면 네트워크 RPC 의 getLatestBlockhash 로 채웁니다.
+	RecentBlockhash string `json:"recent_blockhash,omitempty"`
+}
+
+// SolanaTxExtra 는 미서명 트랜잭션의 Extra 로 전달되는 전송 정보입니다.
+type SolanaTxExtra struct {
+	From                 string `json:"from"`
+	To                   string `json:"to"`
+	Amount               uint64 `json:"amount"`
+	RecentBlockhash      string `json:"recent_blockhash"`
+	LastValidBlockHeight uint64 `json:"last_valid_block_height,omitempty"`
+}
+
+// DeriveSolanaAddress 는 Ed25519 공개키(32바이트)를 base58 로 인코딩한 주소를 만듭니다.
+func DeriveSolanaAddress(point curves.Point, _ Network, _ int) (string, error) {
+	if point.CurveName() != curves.ED25519Name {
+		return "", fmt.Errorf("solana addresses require an ed25519 key, got %s", point.CurveName())
+	}
+	return base58.Encode(point.ToAffineCompressed()), nil
+}
+
+func VerifySolanaSignature(point curves.Point, message []byte, signature []byte) bool {
+	if point.CurveName() != curves.ED25519Name || len(signature) != ed25519.SignatureSize {
+		return false
+	}
+	return ed25519.Verify(point.ToAffineCompressed(), message, signature)
+}
+
+func IsValidSolanaAddress(address string) bool {
+	return len(base58.Decode(address)) == ed25519.PublicKeySize
+}
+
+// CreateUnsignedSolanaTransaction 은 SOL 전송(System Program Transfer) 메시지를 만듭니다.
+// Ed25519 는 메시지 자체에 서명하므로 UnSignedTxEncodedBase64 는 직렬화된 메시지입니다.
+func CreateUnsignedSolanaTransaction(req interface{}, network Network) (*transaction.UnsignedTransaction, error) {
+	solReq, ok := req.(SolanaTxRequest)
+	if !ok {
+		return nil, fmt.Errorf("invalid request type for Solana transaction")
+	}
+	if !IsValidSolanaAddress(solReq.From) {
+		return nil, fmt.Errorf("invalid 'from' address: %s", solReq.From)
+	}
+	if !IsValidSolanaAddress(solReq.To) {
+		return nil, fmt.Errorf("invalid 'to' address: %s", solReq.To)
+	}
+	if solReq.From == solReq.To {
+		return nil, fmt.Errorf("'from' and 'to' must be different addresses")
+	}
+	amount, err := strconv.ParseUint(solReq.Amount, 10, 64)
+	if err != nil || amount == 0 {
+		return nil, fmt.Errorf("invalid amount: %s", solReq.Amount)
+	}
+
+	extra := SolanaTxExtra{
+		From:            solReq.From,
+		To:              solReq.To,
+		Amount:          amount,
+		RecentBlockhash: solReq.RecentBlockhash,
+	}
+	if extra.RecentBlockhash == "" {
+		extra.RecentBlockhash, extra.LastValidBlockHeight, err = getLatestSolanaBlockhash(network)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	message, err := solanaTransferMessage(extra.From, extra.To, extra.RecentBlockhash, amount)
+	if err != nil {
+		return nil, err
+	}
+
+	return &transaction.UnsignedTransaction{
+		NetworkID:               network.ID(),
+		UnSignedTxEncodedBase64: base64.StdEncoding.EncodeToString(message),
+		Extra:                   extra,
+	}, nil
+}
+
+// CreateSolanaSigningPayload 는 서명할 메시지를 돌려줍니다. 수수료 지불자(첫 번째 서명 계정)가 키와 같아야 합니다.
+func CreateSolanaSigningPayload(unsignedTx *transaction.UnsignedTransaction, _ Network, point curves.Point) ([]byte, error) {
+	message, err := base64.StdEncoding.DecodeString(unsignedTx.UnSignedTxEncodedBase64)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode unsigned transaction: %v", err)
+	}
+	feePayer, err := solanaFeePayer(message)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(feePayer, point.ToAffineCompressed()) {
+		return nil, fmt.Errorf("fee payer %s does not match the signing key", base58.Encode(feePayer))
+	}
+	return message, nil
+}
+
+// AssembleSignedSolanaTransaction 은 서명 1개와 메시지를 결합한 트랜잭션(base64)을 만듭니다.
+// sendTransaction 의 encoding: base64 로 바로 브로드캐스트할 수 있습니다.
+func AssembleSignedSolanaTransaction(unsignedTx *transaction.UnsignedTransaction, network Network, point curves.Point, signature *transaction.Signature) (string, error) {
+	message, err := CreateSolanaSigningPayload(unsignedTx, network, point)
+	if err != nil {
+		return "", err
+	}
+	if len(signature.R) != 32 || len(signature.S) != 32 {
+		return "", fmt.Errorf("invalid ed25519 signature")
+	}
+	sig := append(append([]byte{}, signature.R...), signature.S...)
+	if !VerifySolanaSignature(point, message, sig) {
+		return "", fmt.Errorf("signature does not verify against the message")
+	}
+
+	var buf bytes.Buffer
+	buf.Write(encodeSolanaShortVec(1))
+	buf.Write(sig)
+	buf.Write(message)
+	return base64.StdEncoding.EncodeToString(buf.Bytes()), nil
+}
+
+// solanaTransferMessage 는 레거시 메시지 형식의 System Transfer 메시지를 직렬화합니다.
+//
+//	header(3) | account keys | recent blockhash(32) | instructions
+func solanaTransferMessage(from, to, recentBlockhash string, lamports uint64) ([]byte, error) {
+	fromKey := base58.Decode(from)
+	toKey := base58.Decode(to)
+	blockhash := base58.Decode(recentBlockhash)
+	if len(blockhash) != 32 {
+		return nil, fmt.Errorf("invalid recent blockhash: %s", recentBlockhash)
+	}
+
+	var buf bytes.Buffer
+	// 서명 계정 1개(from), 읽기 전용 서명 계정 0개, 읽기 전용 비서명 계정 1개(System Program)
+	buf.Write([]byte{1, 0, 1})
+	buf.Write(encodeSolanaShortVec(3))
+	buf.Write(fromKey)
+	buf.Write(toKey)
+	buf.Write(solanaSystemProgramID[:])
+	buf.Write(blockhash)
+
+	data := make([]byte, 12)
+	binary.LittleEndian.PutUint32(data[:4], solanaSystemTransfer)
+	binary.LittleEndian.PutUint64(data[4:], lamports)
+
+	buf.Write(encodeSolanaShortVec(1))
+	buf.WriteByte(2) // program id index: System Program
+	buf.Write(encodeSolanaShortVec(2))
+	buf.Write([]byte{0, 1})
+	buf.Write(encodeSolanaShortVec(len(data)))
+	buf.Write(data)
+	return buf.Bytes(), nil
+}
+
+func solanaFeePayer(message []byte) ([]byte, error) {
+	if len(message) < 3 || message[0] == 0 {
+		return nil, fmt.Errorf("invalid solana message header")
+	}
+	count, n, err := decodeSolanaShortVec(message[3:])
+	if err != nil {
+		return nil, err
+	}
+	start := 3 + n
+	if count == 0 || len(message) < start+32 {
+		return nil, fmt.Errorf("solana message has no account keys")
+	}
+	return message[start : start+32], nil
+}
+
+// encodeSolanaShortVec 은 Solana 의 compact-u16 길이 인코딩입니다.
+func encodeSolanaShortVec(n int) []byte {
+	var out []byte
+	for {
+		b := byte(n & 0x7f)
+		n >>= 7
+		if n == 0 {
+			return append(out, b)
+		}
+		out = append(out, b|0x80)
+	}
+}
+
+func decodeSolanaShortVec(data []byte) (int, int, error) {
+	value := 0
+	for i := 0; i < 3 && i < len(data); i++ {
+		value |= int(data[i]&0x7f) << (7 * uint(i))
+		if data[i]&0x80 == 0 {
+			return value, i + 1, nil
+		}
+	}
+	return 0, 0, fmt.Errorf("invalid compact-u16 length")
+}
+
+func getLatestSolanaBlockhash(network Network) (string, uint64, error) {
+	body, err := json.Marshal(map[string]interface{}{
+		"jsonrpc": "2.0",
+		"id":      1,
+		"method":  "getLatestBlockhash",
+		"params":  []interface{}{map[string]string{"commitment": "finalized"}},
+	})
+	if err != nil {
+		return "", 0, errors.Wrap(err, "failed to encode rpc request")
+	}
+
+	urls := network.RPCs()
+	if len(urls) == 0 {
+		return "", 0, fmt.Errorf("no RPC endpoint is configured for network: %s", network)
+	}
+	// 실패한 RPC 주소는 건너뛰고 다음 주소를 시도합니다.
+	var lastErr error
+	for _, url := range urls {
+		blockhash, height, err := requestSolanaBlockhash(url, body)
+		if err == nil {
+			return blockhash, height, nil
+		}
+		lastErr = err
+	}
+	return "", 0, lastErr
+}
+
+func requestSolanaBlockhash(url string, body []byte) (string, uint64, error) {
+	resp, err := http.Post(url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return "", 0, errors.Wrap(err, "failed to connect to the Solana RPC")
+	}
+	defer resp.Body.Close()
+
+	var result struct {
+		Result struct {
+			Value struct {
+				Blockhash            string `json:"blockhash"`
+				LastValidBlockHeight uint64 `json:"lastValidBlockHeight"`
+			} `json:"value"`
+		} `json:"result"`
+		Error *struct {
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return "", 0, errors.Wrap(err, "failed to decode rpc response")
+	}
+	if result.Error != nil {
+		return "", 0, fmt.Errorf("getLatestBlockhash failed: %s", result.Error.Message)
+	}
+	return result.Result.Value.Blockhash, result.Result.Value.LastValidBlockHeight, nil
+}
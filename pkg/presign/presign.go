@@ -0,0 +1,351 @@
+// Package presign 은 DKLs 2자간 ECDSA 서명을 메시지와 무관한 사전 계산(presign)과
+// 메시지를 받은 뒤의 온라인 단계로 나눈 것입니다.
+//
+// kryptology dkls/v1/sign 에서 메시지는 Alice 의 Round3 마지막(sigA)과 Bob 의 Round4 마지막(sigB)에만 쓰이므로,
+// 논스 R, 곱셈(OT extension) 결과, EtaPhi 는 미리 계산해 둘 수 있습니다.
+//
+//	Alice Round1: 세션 시드
+//	Bob   Round2: 세션 시드, DB = kB*G, 곱셈 1라운드
+//	Alice Round3: 곱셈 2라운드, R 증명, EtaPhi -> AlicePresignature 저장
+//	Bob   Round4: 곱셈 마무리, R 검증, theta 계산 -> BobPresignature 저장
+//
+// 온라인 단계는 Alice 가 EtaSig 를 계산해 Bob 에게 보내고 Bob 이 서명을 완성하는 한 번의 왕복입니다.
+// 같은 presignature 로 두 메시지에 서명하면 논스가 재사용되어 키가 노출되므로 반드시 한 번만 사용해야 합니다.
+package presign
+
+import (
+	"crypto/ecdsa"
+	"crypto/rand"
+	"fmt"
+	"hash"
+	"math/big"
+
+	"tecdsa/pkg/schnorr"
+
+	"github.com/coinbase/kryptology/pkg/core/curves"
+	"github.com/coinbase/kryptology/pkg/ot/base/simplest"
+	"github.com/coinbase/kryptology/pkg/ot/extension/kos"
+	"github.com/coinbase/kryptology/pkg/tecdsa/dkls/v1/dkg"
+	zkp "github.com/coinbase/kryptology/pkg/zkp/schnorr"
+	"github.com/gtank/merlin"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/sha3"
+)
+
+const multiplicationCount = 2
+
+const transcriptLabel = "tecdsa_DKLs_Presign"
+
+type Round2Output struct {
+	Seed [simplest.DigestSize]byte
+	// DB 는 Bob 의 논스 점 kB*G 입니다.
+	DB             curves.Point
+	MultiplyRound1 [multiplicationCount]*kos.Round1Output
+}
+
+type Round3Output struct {
+	MultiplyRound2 [multiplicationCount]*schnorr.MultiplyRound2Output
+	// RSchnorrProof 는 R = kA*DB 에 대한 증명입니다.
+	RSchnorrProof *zkp.Proof
+	RPrime        curves.Point
+	EtaPhi        curves.Scalar
+}
+
+// AlicePresignature 는 온라인 서명에 필요한 Alice 의 상태입니다.
+type AlicePresignature struct {
+	R          curves.Point
+	T0         curves.Scalar
+	T1         curves.Scalar
+	Gamma2Hash curves.Scalar
+}
+
+// BobPresignature 는 온라인 서명에 필요한 Bob 의 상태입니다.
+type BobPresignature struct {
+	R          curves.Point
+	Theta      curves.Scalar
+	T1         curves.Scalar
+	Gamma2Hash curves.Scalar
+}
+
+// OnlineOutput 은 온라인 단계에서 Alice 가 Bob 에게 보내는 값입니다.
+type OnlineOutput struct {
+	EtaSig curves.Scalar
+}
+
+type Alice struct {
+	curve          *curves.Curve
+	seedOtResults  *simplest.ReceiverOutput
+	secretKeyShare curves.Scalar
+	publicKey      curves.Point
+	transcript     *merlin.Transcript
+}
+
+type Bob struct {
+	curve             *curves.Curve
+	seedOtResults     *simplest.SenderOutput
+	secretKeyShare    curves.Scalar
+	publicKey         curves.Point
+	transcript        *merlin.Transcript
+	multiplyReceivers [multiplicationCount]*schnorr.MultiplyReceiver
+	kB                curves.Scalar
+	dB                curves.Point
+}
+
+func NewAlice(curve *curves.Curve, output *dkg.AliceOutput) *Alice {
+	return &Alice{
+		curve:          curve,
+		seedOtResults:  output.SeedOtResult,
+		secretKeyShare: output.SecretKeyShare,
+		publicKey:      output.PublicKey,
+		transcript:     merlin.NewTranscript(transcriptLabel),
+	}
+}
+
+func NewBob(curve *curves.Curve, output *dkg.BobOutput) *Bob {
+	return &Bob{
+		curve:          curve,
+		seedOtResults:  output.SeedOtResult,
+		secretKeyShare: output.SecretKeyShare,
+		publicKey:      output.PublicKey,
+		transcript:     merlin.NewTranscript(transcriptLabel),
+	}
+}
+
+// Round1GenerateRandomSeed 는 Alice 의 세션 시드를 만듭니다.
+func (alice *Alice) Round1GenerateRandomSeed() ([simplest.DigestSize]byte, error) {
+	seed := [simplest.DigestSize]byte{}
+	if _, err := rand.Read(seed[:]); err != nil {
+		return seed, errors.Wrap(err, "failed to generate session seed")
+	}
+	alice.transcript.AppendMessage([]byte("session_id_alice"), seed[:])
+	return seed, nil
+}
+
+// Round2Initialize 는 Bob 의 논스 kB 를 정하고 두 곱셈 (1/kB, skB/kB) 을 시작합니다.
+func (bob *Bob) Round2Initialize(aliceSeed [simplest.DigestSize]byte) (*Round2Output, error) {
+	output := &Round2Output{}
+	if _, err := rand.Read(output.Seed[:]); err != nil {
+		return nil, errors.Wrap(err, "failed to generate session seed")
+	}
+	bob.transcript.AppendMessage([]byte("session_id_alice"), aliceSeed[:])
+	bob.transcript.AppendMessage([]byte("session_id_bob"), output.Seed[:])
+
+	var err error
+	for i := range bob.multiplyReceivers {
+		bob.multiplyReceivers[i], err = schnorr.NewMultiplyReceiver(bob.seedOtResults, bob.curve, multiplySessionID(bob.transcript, i))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	bob.kB = bob.curve.Scalar.Random(rand.Reader)
+	bob.dB = bob.curve.ScalarBaseMult(bob.kB)
+	output.DB = bob.dB
+	kBInv := bob.curve.Scalar.One().Div(bob.kB)
+
+	if output.MultiplyRound1[0], err = bob.multiplyReceivers[0].Round1Initialize(kBInv); err != nil {
+		return nil, err
+	}
+	if output.MultiplyRound1[1], err = bob.multiplyReceivers[1].Round1Initialize(bob.secretKeyShare.Mul(kBInv)); err != nil {
+		return nil, err
+	}
+	return output, nil
+}
+
+// Round3Presign 은 논스 R 과 곱셈 결과를 만들고, 메시지 없이 계산할 수 있는 Alice 의 값을 돌려줍니다.
+func (alice *Alice) Round3Presign(input *Round2Output) (*Round3Output, *AlicePresignature, error) {
+	alice.transcript.AppendMessage([]byte("session_id_bob"), input.Seed[:])
+
+	senders := [multiplicationCount]*schnorr.MultiplySender{}
+	var err error
+	for i := range senders {
+		senders[i], err = schnorr.NewMultiplySender(alice.seedOtResults, alice.curve, multiplySessionID(alice.transcript, i))
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	output := &Round3Output{}
+	kPrimeA := alice.curve.Scalar.Random(rand.Reader)
+	output.RPrime = input.DB.Mul(kPrimeA)
+	hashRPrime, err := hashPoint(alice.curve, output.RPrime)
+	if err != nil {
+		return nil, nil, err
+	}
+	kA := hashRPrime.Add(kPrimeA)
+
+	prover := zkp.NewProver(alice.curve, input.DB, proofSessionID(alice.transcript))
+	output.RSchnorrProof, err = prover.Prove(kA)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to prove R = kA * DB")
+	}
+	r := output.RSchnorrProof.Statement
+	phi := alice.curve.Scalar.Random(rand.Reader)
+	kAInv := alice.curve.Scalar.One().Div(kA)
+
+	if output.MultiplyRound2[0], err = senders[0].Round2Multiply(phi.Add(kAInv), input.MultiplyRound1[0]); err != nil {
+		return nil, nil, err
+	}
+	if output.MultiplyRound2[1], err = senders[1].Round2Multiply(alice.secretKeyShare.Mul(kAInv), input.MultiplyRound1[1]); err != nil {
+		return nil, nil, err
+	}
+	t0 := senders[0].OutputAdditiveShare()
+	t1 := senders[1].OutputAdditiveShare()
+
+	gamma1 := alice.curve.ScalarBaseMult(kA.Mul(phi).Add(alice.curve.Scalar.One())).Add(r.Mul(t0.Neg()))
+	hashGamma1, err := hashPoint(alice.curve, gamma1)
+	if err != nil {
+		return nil, nil, err
+	}
+	output.EtaPhi = hashGamma1.Add(phi)
+
+	gamma2 := alice.publicKey.Mul(t0).Add(alice.curve.ScalarBaseMult(t1.Neg()))
+	hashGamma2, err := hashPoint(alice.curve, gamma2)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return output, &AlicePresignature{R: r, T0: t0, T1: t1, Gamma2Hash: hashGamma2}, nil
+}
+
+// Round4Presign 은 곱셈을 마무리하고 Alice 의 R 증명을 검증해 Bob 의 presignature 를 만듭니다.
+func (bob *Bob) Round4Presign(input *Round3Output) (*BobPresignature, error) {
+	for i := range bob.multiplyReceivers {
+		if err := bob.multiplyReceivers[i].Round3Multiply(input.MultiplyRound2[i]); err != nil {
+			return nil, err
+		}
+	}
+	t0 := bob.multiplyReceivers[0].OutputAdditiveShare()
+	t1 := bob.multiplyReceivers[1].OutputAdditiveShare()
+
+	rPrimeHashed, err := hashPoint(bob.curve, input.RPrime)
+	if err != nil {
+		return nil, err
+	}
+	r := bob.dB.Mul(rPrimeHashed).Add(input.RPrime)
+	// Alice 가 보낸 statement 대신 직접 계산한 R 로 검증합니다.
+	input.RSchnorrProof.Statement = r
+	if err := zkp.Verify(input.RSchnorrProof, bob.curve, bob.dB, proofSessionID(bob.transcript)); err != nil {
+		return nil, errors.Wrap(err, "failed to verify alice's proof for R")
+	}
+
+	hashGamma1, err := hashPoint(bob.curve, r.Mul(t0))
+	if err != nil {
+		return nil, err
+	}
+	phi := input.EtaPhi.Sub(hashGamma1)
+	theta := t0.Sub(phi.Div(bob.kB))
+
+	gamma2 := bob.curve.ScalarBaseMult(t1).Add(bob.publicKey.Mul(theta.Neg()))
+	hashGamma2, err := hashPoint(bob.curve, gamma2)
+	if err != nil {
+		return nil, err
+	}
+
+	return &BobPresignature{R: r, Theta: theta, T1: t1, Gamma2Hash: hashGamma2}, nil
+}
+
+// Sign 은 메시지 다이제스트로 Alice 의 EtaSig 를 계산합니다.
+func (p *AlicePresignature) Sign(curve *curves.Curve, h hash.Hash, message []byte) (*OnlineOutput, error) {
+	digest, err := digestScalar(curve, h, message)
+	if err != nil {
+		return nil, err
+	}
+	rX, _, err := rCoordinates(curve, p.R)
+	if err != nil {
+		return nil, err
+	}
+	sigA := digest.Mul(p.T0).Add(rX.Mul(p.T1))
+	return &OnlineOutput{EtaSig: p.Gamma2Hash.Add(sigA)}, nil
+}
+
+// Finalize 는 Alice 의 EtaSig 로 서명을 완성하고 공개키로 검증합니다.
+func (p *BobPresignature) Finalize(curve *curves.Curve, h hash.Hash, publicKey curves.Point, message []byte, input *OnlineOutput) (*curves.EcdsaSignature, error) {
+	if _, err := h.Write(message); err != nil {
+		return nil, errors.Wrap(err, "failed to hash message")
+	}
+	digestBytes := h.Sum(nil)
+	digest, err := curve.Scalar.SetBytes(digestBytes)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to set digest scalar")
+	}
+	rX, rY, err := rCoordinates(curve, p.R)
+	if err != nil {
+		return nil, err
+	}
+
+	sigB := digest.Mul(p.Theta).Add(rX.Mul(p.T1))
+	s := sigB.Add(input.EtaSig.Sub(p.Gamma2Hash))
+
+	signature := &curves.EcdsaSignature{
+		R: rX.BigInt(),
+		S: s.BigInt(),
+		V: int(rY),
+	}
+	if signature.S.Bit(255) == 1 {
+		signature.S = s.Neg().BigInt()
+		signature.V ^= 1
+	}
+
+	uncompressed := publicKey.ToAffineUncompressed()
+	if len(uncompressed) != 65 {
+		return nil, fmt.Errorf("the uncompressed public key must have exactly 65 bytes")
+	}
+	ellipticCurve, err := curve.ToEllipticCurve()
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid curve")
+	}
+	ecdsaPublicKey := &ecdsa.PublicKey{
+		Curve: ellipticCurve,
+		X:     new(big.Int).SetBytes(uncompressed[1:33]),
+		Y:     new(big.Int).SetBytes(uncompressed[33:]),
+	}
+	if !ecdsa.Verify(ecdsaPublicKey, digestBytes, signature.R, signature.S) {
+		return nil, fmt.Errorf("final signature failed to verify")
+	}
+	return signature, nil
+}
+
+func multiplySessionID(transcript *merlin.Transcript, index int) [simplest.DigestSize]byte {
+	id := [simplest.DigestSize]byte{}
+	copy(id[:], transcript.ExtractBytes([]byte(fmt.Sprintf("multiply receiver id %d", index)), simplest.DigestSize))
+	return id
+}
+
+func proofSessionID(transcript *merlin.Transcript) []byte {
+	return transcript.ExtractBytes([]byte("schnorr proof for R"), simplest.DigestSize)
+}
+
+func hashPoint(curve *curves.Curve, point curves.Point) (curves.Scalar, error) {
+	hashed := sha3.Sum256(point.ToAffineCompressed())
+	scalar, err := curve.Scalar.SetBytes(hashed[:])
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to set scalar from point hash")
+	}
+	return scalar, nil
+}
+
+func digestScalar(curve *curves.Curve, h hash.Hash, message []byte) (curves.Scalar, error) {
+	if _, err := h.Write(message); err != nil {
+		return nil, errors.Wrap(err, "failed to hash message")
+	}
+	digest, err := curve.Scalar.SetBytes(h.Sum(nil))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to set digest scalar")
+	}
+	return digest, nil
+}
+
+// rCoordinates 는 R 의 x 좌표(mod q)와 y 좌표의 홀짝을 돌려줍니다.
+func rCoordinates(curve *curves.Curve, r curves.Point) (curves.Scalar, byte, error) {
+	compressed := r.ToAffineCompressed()
+	if len(compressed) != 33 {
+		return nil, 0, fmt.Errorf("the compressed form must be exactly 33 bytes")
+	}
+	rX, err := curve.Scalar.SetBytes(compressed[1:])
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "failed to set rX scalar")
+	}
+	// 0 을 더해 x 좌표를 q 로 나눈 나머지로 만듭니다.
+	return rX.Add(curve.Scalar.Zero()), compressed[0] & 0x1, nil
+}
@@ -0,0 +1,96 @@
+package presign
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	btcecdsa "github.com/btcsuite/btcd/btcec/v2/ecdsa"
+	"github.com/coinbase/kryptology/pkg/core/curves"
+	"github.com/coinbase/kryptology/pkg/tecdsa/dkls/v1/dkg"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func runDkg(t *testing.T, curve *curves.Curve) (*dkg.AliceOutput, *dkg.BobOutput) {
+	alice := dkg.NewAlice(curve)
+	bob := dkg.NewBob(curve)
+	seed, err := bob.Round1GenerateRandomSeed()
+	require.NoError(t, err)
+	round2, err := alice.Round2CommitToProof(seed)
+	require.NoError(t, err)
+	proof3, err := bob.Round3SchnorrProve(round2)
+	require.NoError(t, err)
+	proof4, err := alice.Round4VerifyAndReveal(proof3)
+	require.NoError(t, err)
+	proof5, err := bob.Round5DecommitmentAndStartOt(proof4)
+	require.NoError(t, err)
+	choices, err := alice.Round6DkgRound2Ot(proof5)
+	require.NoError(t, err)
+	challenge, err := bob.Round7DkgRound3Ot(choices)
+	require.NoError(t, err)
+	responses, err := alice.Round8DkgRound4Ot(challenge)
+	require.NoError(t, err)
+	openings, err := bob.Round9DkgRound5Ot(responses)
+	require.NoError(t, err)
+	require.NoError(t, alice.Round10DkgRound6Ot(openings))
+	return alice.Output(), bob.Output()
+}
+
+// runPresign 은 네 라운드를 실행해 Alice, Bob 의 presignature 를 만듭니다.
+func runPresign(t *testing.T, curve *curves.Curve, aliceOutput *dkg.AliceOutput, bobOutput *dkg.BobOutput) (*AlicePresignature, *BobPresignature) {
+	alice := NewAlice(curve, aliceOutput)
+	bob := NewBob(curve, bobOutput)
+	seed, err := alice.Round1GenerateRandomSeed()
+	require.NoError(t, err)
+	round2, err := bob.Round2Initialize(seed)
+	require.NoError(t, err)
+	round3, alicePresignature, err := alice.Round3Presign(round2)
+	require.NoError(t, err)
+	bobPresignature, err := bob.Round4Presign(round3)
+	require.NoError(t, err)
+	return alicePresignature, bobPresignature
+}
+
+func TestPresignSignatureVerifies(t *testing.T) {
+	curve := curves.K256()
+	aliceOutput, bobOutput := runDkg(t, curve)
+	publicKey, err := btcec.ParsePubKey(aliceOutput.PublicKey.ToAffineCompressed())
+	require.NoError(t, err)
+
+	for _, message := range [][]byte{[]byte("first message"), []byte("second message")} {
+		alicePresignature, bobPresignature := runPresign(t, curve, aliceOutput, bobOutput)
+		assert.True(t, alicePresignature.R.Equal(bobPresignature.R))
+
+		online, err := alicePresignature.Sign(curve, sha256.New(), message)
+		require.NoError(t, err)
+		signature, err := bobPresignature.Finalize(curve, sha256.New(), bobOutput.PublicKey, message, online)
+		require.NoError(t, err)
+
+		// 게이트웨이와 무관한 btcec 구현으로 DKG 공개키에 대해 검증합니다.
+		var r, s btcec.ModNScalar
+		require.False(t, r.SetByteSlice(signature.R.FillBytes(make([]byte, 32))))
+		require.False(t, s.SetByteSlice(signature.S.FillBytes(make([]byte, 32))))
+		digest := sha256.Sum256(message)
+		assert.True(t, btcecdsa.NewSignature(&r, &s).Verify(digest[:], publicKey))
+		otherDigest := sha256.Sum256(append(message, '!'))
+		assert.False(t, btcecdsa.NewSignature(&r, &s).Verify(otherDigest[:], publicKey))
+	}
+}
+
+func TestPresignFinalizeRejectsTamperedOnlineOutput(t *testing.T) {
+	curve := curves.K256()
+	aliceOutput, bobOutput := runDkg(t, curve)
+	alicePresignature, bobPresignature := runPresign(t, curve, aliceOutput, bobOutput)
+
+	message := []byte("message")
+	online, err := alicePresignature.Sign(curve, sha256.New(), message)
+	require.NoError(t, err)
+	tampered := &OnlineOutput{EtaSig: online.EtaSig.Add(curve.Scalar.One())}
+	_, err = bobPresignature.Finalize(curve, sha256.New(), bobOutput.PublicKey, message, tampered)
+	assert.ErrorContains(t, err, "final signature failed to verify")
+
+	// Alice 와 다른 메시지로 완성하면 검증에 실패합니다.
+	_, err = bobPresignature.Finalize(curve, sha256.New(), bobOutput.PublicKey, []byte("other"), online)
+	assert.ErrorContains(t, err, "final signature failed to verify")
+}
@@ -50,4 +50,38 @@ const (
 	ErrMsgInvalidSignRequest           = "서명 요청이 유효하지 않습니다"
 	ErrMsgFailedStartSigning           = "서명 프로세스 시작에 실패했습니다"
 	ErrMsgFailedDuringSigning          = "서명 프로세스 중 실패했습니다"
+	ErrMsgFailedStoreKey               = "키 정보를 저장하는데 실패했습니다"
+	ErrMsgInvalidKeyID                 = "유효하지 않은 키 ID입니다"
+	ErrMsgKeyNotFound                  = "키를 찾을 수 없습니다"
+	ErrMsgKeyNotOwned                  = "요청한 클라이언트의 키가 아닙니다"
+	ErrMsgNotBitcoinKey                = "비트코인 네트워크의 키가 아닙니다"
+	ErrMsgFailedExportXpub             = "확장 공개키를 내보내는데 실패했습니다"
+	ErrMsgInvalidAddressType           = "지원하지 않는 주소 유형입니다"
+	ErrMsgFailedCreateSigningPayload   = "서명할 트랜잭션 해시를 만드는데 실패했습니다"
+	ErrMsgFailedAssembleTransaction    = "서명된 트랜잭션을 만드는데 실패했습니다"
+	ErrMsgFailedRetrieveKeyAddresses   = "키의 주소 목록을 가져오는데 실패했습니다"
+	ErrMsgFailedAddKeyAddress          = "키에 주소를 추가하는데 실패했습니다"
+	ErrMsgKeyAddressMismatch           = "파티 간 파생된 주소가 일치하지 않습니다"
+	ErrMsgNotTaprootAddress            = "Taproot(P2TR) 주소가 아닙니다"
+	ErrMsgUseTaprootSign               = "Taproot(P2TR) 주소는 /sign_taproot 으로 서명해야 합니다"
+	ErrMsgUseEd25519KeyGen             = "Ed25519 네트워크의 키는 /key_gen_ed25519 로 생성해야 합니다"
+	ErrMsgUseEd25519Sign               = "Ed25519 키는 /sign_ed25519 로 서명해야 합니다"
+	ErrMsgNotEd25519Network            = "Ed25519 곡선을 사용하는 네트워크가 아닙니다"
+	ErrMsgNotEd25519Key                = "Ed25519 키가 아닙니다"
+	ErrMsgUnsupportedCurve             = "네트워크가 지원하지 않는 곡선입니다"
+	ErrMsgSignedRequestRequired        = "클라이언트 키로 서명한 요청이어야 합니다"
+	ErrMsgInvalidContractABI           = "유효하지 않은 컨트랙트 ABI 입니다"
+	ErrMsgContractABINotFound          = "등록되지 않은 컨트랙트 ABI 입니다"
+	ErrMsgDuplicateContractABI         = "이미 등록된 컨트랙트 ABI 이름입니다"
+	ErrMsgContractABIMismatch          = "ABI 가 등록된 컨트랙트가 아닙니다"
+	ErrMsgFailedStoreContractABI       = "컨트랙트 ABI 를 저장하는데 실패했습니다"
+	ErrMsgFailedRetrieveContractABIs   = "컨트랙트 ABI 목록을 가져오는데 실패했습니다"
+	ErrMsgInvalidAddress               = "유효하지 않은 주소입니다"
+	ErrMsgFailedReserveNonce           = "논스를 할당하는데 실패했습니다"
+	ErrMsgFailedRetrieveNonces         = "논스 상태를 가져오는데 실패했습니다"
+	ErrMsgFailedReleaseNonce           = "논스를 해제하는데 실패했습니다"
+	ErrMsgNonceNotReserved             = "할당되지 않았거나 이미 해제된 논스입니다"
+	ErrMsgInvalidPSBT                  = "유효하지 않은 PSBT 입니다"
+	ErrMsgNoOwnedPSBTInputs            = "게이트웨이 키가 서명할 수 있는 PSBT 입력이 없습니다"
+	ErrMsgFailedFinalizePSBT           = "PSBT 를 완성하는데 실패했습니다"
 )
@@ -0,0 +1,165 @@
+package schnorr
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"math/big"
+
+	"github.com/coinbase/kryptology/pkg/core/curves"
+)
+
+// BIP340 / BIP341 에서 사용하는 태그 해시 이름입니다.
+const (
+	tagChallenge = "BIP0340/challenge"
+	tagTapTweak  = "TapTweak"
+)
+
+// secp256k1 의 군 위수
+var curveOrder, _ = new(big.Int).SetString("fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364141", 16)
+
+// TaggedHash 는 BIP340 의 tagged hash: sha256(sha256(tag) || sha256(tag) || msg...) 입니다.
+func TaggedHash(tag string, msgs ...[]byte) [32]byte {
+	tagHash := sha256.Sum256([]byte(tag))
+	h := sha256.New()
+	h.Write(tagHash[:])
+	h.Write(tagHash[:])
+	for _, msg := range msgs {
+		h.Write(msg)
+	}
+	var out [32]byte
+	copy(out[:], h.Sum(nil))
+	return out
+}
+
+// XOnly 는 점의 32바이트 x 좌표입니다.
+func XOnly(point curves.Point) []byte {
+	return point.ToAffineCompressed()[1:]
+}
+
+// HasEvenY 는 점의 y 좌표가 짝수인지 확인합니다.
+func HasEvenY(point curves.Point) bool {
+	return point.ToAffineCompressed()[0] == 0x02
+}
+
+// LiftX 는 x 좌표에서 y 가 짝수인 점을 복원합니다.
+func LiftX(curve *curves.Curve, x []byte) (curves.Point, error) {
+	if len(x) != 32 {
+		return nil, fmt.Errorf("invalid x-only public key length: %d", len(x))
+	}
+	return curve.Point.FromAffineCompressed(append([]byte{0x02}, x...))
+}
+
+// scalarFromHash 는 32바이트 해시를 군 위수로 나눈 나머지를 스칼라로 만듭니다.
+func scalarFromHash(curve *curves.Curve, h []byte) (curves.Scalar, error) {
+	return curve.Scalar.SetBigInt(new(big.Int).Mod(new(big.Int).SetBytes(h), curveOrder))
+}
+
+func negateIf(scalar curves.Scalar, negate bool) curves.Scalar {
+	if negate {
+		return scalar.Neg()
+	}
+	return scalar
+}
+
+// TaprootTweak 는 스크립트 트리가 없는 키 경로 지출의 BIP341 트윅입니다.
+// internalKey 의 y 가 홀수이면 부호를 바꾼 점(짝수 y)을 내부 키로 사용합니다.
+type TaprootTweak struct {
+	// InternalKeyNegated 는 내부 키의 y 가 홀수여서 비밀키 부호를 바꿔야 하는지 여부입니다.
+	InternalKeyNegated bool
+	// Tweak 은 t = H_TapTweak(xonly(P)) 입니다.
+	Tweak curves.Scalar
+	// OutputKey 는 Q = P' + tG 입니다.
+	OutputKey curves.Point
+	// OutputKeyNegated 는 Q 의 y 가 홀수여서 최종 비밀키 부호를 바꿔야 하는지 여부입니다.
+	OutputKeyNegated bool
+}
+
+// ComputeTaprootTweak 은 내부 키에서 P2TR 출력 키와 트윅을 계산합니다.
+func ComputeTaprootTweak(curve *curves.Curve, internalKey curves.Point) (*TaprootTweak, error) {
+	negated := !HasEvenY(internalKey)
+	evenKey := internalKey
+	if negated {
+		evenKey = internalKey.Neg()
+	}
+
+	tweakHash := TaggedHash(tagTapTweak, XOnly(evenKey))
+	if new(big.Int).SetBytes(tweakHash[:]).Cmp(curveOrder) >= 0 {
+		return nil, fmt.Errorf("taproot tweak exceeds curve order")
+	}
+	tweak, err := scalarFromHash(curve, tweakHash[:])
+	if err != nil {
+		return nil, err
+	}
+
+	outputKey := evenKey.Add(curve.ScalarBaseMult(tweak))
+	if outputKey.IsIdentity() {
+		return nil, fmt.Errorf("taproot output key is infinity")
+	}
+
+	return &TaprootTweak{
+		InternalKeyNegated: negated,
+		Tweak:              tweak,
+		OutputKey:          outputKey,
+		OutputKeyNegated:   !HasEvenY(outputKey),
+	}, nil
+}
+
+// untweakedKey 는 트윅 없이 DKG 공개키로 직접 서명할 때의 TaprootTweak 입니다.
+// 트윅이 0 이므로 출력 키는 y 가 짝수인 내부 키이고, 부호는 내부 키에서만 바뀝니다.
+func untweakedKey(curve *curves.Curve, internalKey curves.Point) *TaprootTweak {
+	negated := !HasEvenY(internalKey)
+	evenKey := internalKey
+	if negated {
+		evenKey = internalKey.Neg()
+	}
+	return &TaprootTweak{
+		InternalKeyNegated: negated,
+		Tweak:              curve.Scalar.Zero(),
+		OutputKey:          evenKey,
+	}
+}
+
+// TaprootOutputKey 는 내부 키의 P2TR 출력 키(x-only 32바이트)를 돌려줍니다.
+func TaprootOutputKey(curve *curves.Curve, internalKey curves.Point) ([]byte, error) {
+	tweak, err := ComputeTaprootTweak(curve, internalKey)
+	if err != nil {
+		return nil, err
+	}
+	return XOnly(tweak.OutputKey), nil
+}
+
+// challenge 는 e = H_challenge(R.x || P.x || m) mod n 입니다.
+func challenge(curve *curves.Curve, rx, px, message []byte) (curves.Scalar, error) {
+	h := TaggedHash(tagChallenge, rx, px, message)
+	return scalarFromHash(curve, h[:])
+}
+
+// Verify 는 BIP340 서명(64바이트)을 x-only 공개키로 검증합니다.
+func Verify(curve *curves.Curve, publicKey []byte, message []byte, signature []byte) bool {
+	if len(signature) != 64 {
+		return false
+	}
+	p, err := LiftX(curve, publicKey)
+	if err != nil {
+		return false
+	}
+	rx := signature[:32]
+	if new(big.Int).SetBytes(signature[32:]).Cmp(curveOrder) >= 0 {
+		return false
+	}
+	s, err := curve.Scalar.SetBytes(signature[32:])
+	if err != nil {
+		return false
+	}
+	e, err := challenge(curve, rx, publicKey, message)
+	if err != nil {
+		return false
+	}
+
+	// R = sG - eP
+	r := curve.ScalarBaseMult(s).Sub(p.Mul(e))
+	if r.IsIdentity() || !HasEvenY(r) {
+		return false
+	}
+	return string(XOnly(r)) == string(rx)
+}
@@ -0,0 +1,257 @@
+//
+// Copyright Coinbase, Inc. All Rights Reserved.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package schnorr
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"fmt"
+	"math/big"
+
+	"github.com/coinbase/kryptology/pkg/core/curves"
+	"github.com/coinbase/kryptology/pkg/ot/base/simplest"
+	"github.com/coinbase/kryptology/pkg/ot/extension/kos"
+	"github.com/gtank/merlin"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/sha3"
+)
+
+// kryptology dkls/v1/sign 의 곱셈 프로토콜(DKLs18 Protocol 5)을 옮겨온 것입니다.
+// 원본은 결과 덧셈 쉐어를 외부에 공개하지 않아, DKG 의 곱셈 쉐어(a*b)를
+// 덧셈 쉐어(tA + tB)로 바꾸는 데 쓸 수 있도록 OutputAdditiveShare 를 노출합니다.
+// DKG 에서 만든 시드 OT 결과를 그대로 사용하므로 세션마다 고유한 세션 ID 가 필요합니다.
+
+// MultiplySender 는 시드 OT 의 receiver 쪽 결과를 가진 파티(Alice)입니다.
+type MultiplySender struct {
+	cOtSender           *kos.Sender
+	outputAdditiveShare curves.Scalar
+	gadget              [kos.L]curves.Scalar
+	curve               *curves.Curve
+	transcript          *merlin.Transcript
+	uniqueSessionId     [simplest.DigestSize]byte
+}
+
+// MultiplyReceiver 는 시드 OT 의 sender 쪽 결과를 가진 파티(Bob)입니다.
+type MultiplyReceiver struct {
+	cOtReceiver         *kos.Receiver
+	outputAdditiveShare curves.Scalar
+	omega               [kos.COtBlockSizeBytes]byte
+	gadget              [kos.L]curves.Scalar
+	curve               *curves.Curve
+	transcript          *merlin.Transcript
+	uniqueSessionId     [simplest.DigestSize]byte
+}
+
+// MultiplyRound2Output 은 곱셈 프로토콜의 두 번째 라운드 결과입니다.
+type MultiplyRound2Output struct {
+	COTRound2Output *kos.Round2Output
+	R               [kos.L]curves.Scalar
+	U               curves.Scalar
+}
+
+func generateGadgetVector(curve *curves.Curve) ([kos.L]curves.Scalar, error) {
+	var err error
+	gadget := [kos.L]curves.Scalar{}
+	for i := 0; i < kos.Kappa; i++ {
+		gadget[i], err = curve.Scalar.SetBigInt(new(big.Int).Lsh(big.NewInt(1), uint(i)))
+		if err != nil {
+			return gadget, errors.Wrap(err, "creating gadget scalar from big int")
+		}
+	}
+	shake := sha3.NewCShake256(nil, []byte("Coinbase DKLs gadget vector"))
+	for i := kos.Kappa; i < kos.L; i++ {
+		bytes := [simplest.DigestSize]byte{}
+		if _, err = shake.Read(bytes[:]); err != nil {
+			return gadget, err
+		}
+		gadget[i], err = curve.Scalar.SetBytes(bytes[:])
+		if err != nil {
+			return gadget, errors.Wrap(err, "creating gadget scalar from bytes")
+		}
+	}
+	return gadget, nil
+}
+
+func NewMultiplySender(seedOtResults *simplest.ReceiverOutput, curve *curves.Curve, uniqueSessionId [simplest.DigestSize]byte) (*MultiplySender, error) {
+	gadget, err := generateGadgetVector(curve)
+	if err != nil {
+		return nil, errors.Wrap(err, "error generating gadget vector in new multiply sender")
+	}
+	transcript := merlin.NewTranscript("Coinbase_DKLs_Multiply")
+	transcript.AppendMessage([]byte("session_id"), uniqueSessionId[:])
+	return &MultiplySender{
+		cOtSender:       kos.NewCOtSender(seedOtResults, curve),
+		curve:           curve,
+		transcript:      transcript,
+		uniqueSessionId: uniqueSessionId,
+		gadget:          gadget,
+	}, nil
+}
+
+func NewMultiplyReceiver(seedOtResults *simplest.SenderOutput, curve *curves.Curve, uniqueSessionId [simplest.DigestSize]byte) (*MultiplyReceiver, error) {
+	gadget, err := generateGadgetVector(curve)
+	if err != nil {
+		return nil, errors.Wrap(err, "error generating gadget vector in new multiply receiver")
+	}
+	transcript := merlin.NewTranscript("Coinbase_DKLs_Multiply")
+	transcript.AppendMessage([]byte("session_id"), uniqueSessionId[:])
+	return &MultiplyReceiver{
+		cOtReceiver:     kos.NewCOtReceiver(seedOtResults, curve),
+		curve:           curve,
+		transcript:      transcript,
+		uniqueSessionId: uniqueSessionId,
+		gadget:          gadget,
+	}, nil
+}
+
+// OutputAdditiveShare 는 곱셈이 끝난 뒤 sender 의 덧셈 쉐어입니다.
+func (sender *MultiplySender) OutputAdditiveShare() curves.Scalar {
+	return sender.outputAdditiveShare
+}
+
+// OutputAdditiveShare 는 곱셈이 끝난 뒤 receiver 의 덧셈 쉐어입니다.
+func (receiver *MultiplyReceiver) OutputAdditiveShare() curves.Scalar {
+	return receiver.outputAdditiveShare
+}
+
+// encode 는 선택적 실패 공격으로 beta 의 비트가 새지 않도록 무작위 가젯 성분을 섞어 인코딩합니다.
+func (receiver *MultiplyReceiver) encode(beta curves.Scalar) ([kos.COtBlockSizeBytes]byte, error) {
+	encoding := [kos.COtBlockSizeBytes]byte{}
+	bytesOfBetaMinusDotProduct := beta.Bytes()
+	if _, err := rand.Read(encoding[kos.KappaBytes:]); err != nil {
+		return encoding, errors.Wrap(err, "sampling `gamma` random bytes in multiply receiver encode")
+	}
+	for j := kos.Kappa; j < kos.L; j++ {
+		jthBitOfGamma := simplest.ExtractBitFromByteVector(encoding[:], j)
+		option0, err := receiver.curve.Scalar.SetBytes(bytesOfBetaMinusDotProduct[:])
+		if err != nil {
+			return encoding, errors.Wrap(err, "setting masking bits scalar from bytes")
+		}
+		option0Bytes := option0.Bytes()
+		option1 := option0.Sub(receiver.gadget[j])
+		option1Bytes := option1.Bytes()
+		bytesOfBetaMinusDotProduct = option0Bytes
+		subtle.ConstantTimeCopy(int(jthBitOfGamma), bytesOfBetaMinusDotProduct[:], option1Bytes)
+	}
+	copy(encoding[0:kos.KappaBytes], reverseScalarBytes(bytesOfBetaMinusDotProduct[:]))
+	return encoding, nil
+}
+
+// Round1Initialize 는 receiver 가 beta 를 인코딩해 cOT 확장을 시작합니다.
+func (receiver *MultiplyReceiver) Round1Initialize(beta curves.Scalar) (*kos.Round1Output, error) {
+	var err error
+	if receiver.omega, err = receiver.encode(beta); err != nil {
+		return nil, errors.Wrap(err, "encoding input beta in receiver round 1 initialize")
+	}
+	cOtRound1Output, err := receiver.cOtReceiver.Round1Initialize(receiver.uniqueSessionId, receiver.omega)
+	if err != nil {
+		return nil, errors.Wrap(err, "error in cOT round 1 initialize within multiply round 1 initialize")
+	}
+	for i := 0; i < kos.Kappa; i++ {
+		label := []byte(fmt.Sprintf("row %d of U", i))
+		receiver.transcript.AppendMessage(label, cOtRound1Output.U[i][:])
+	}
+	receiver.transcript.AppendMessage([]byte("wPrime"), cOtRound1Output.WPrime[:])
+	receiver.transcript.AppendMessage([]byte("vPrime"), cOtRound1Output.VPrime[:])
+	return cOtRound1Output, nil
+}
+
+// Round2Multiply 는 sender 가 alpha 로 cOT 에 응답하고 자신의 덧셈 쉐어를 계산합니다.
+func (sender *MultiplySender) Round2Multiply(alpha curves.Scalar, round1Output *kos.Round1Output) (*MultiplyRound2Output, error) {
+	var err error
+	alphaHat := sender.curve.Scalar.Random(rand.Reader)
+	input := [kos.L][2]curves.Scalar{}
+	for j := 0; j < kos.L; j++ {
+		input[j][0] = alpha
+		input[j][1] = alphaHat
+	}
+	round2Output := &MultiplyRound2Output{}
+	round2Output.COTRound2Output, err = sender.cOtSender.Round2Transfer(sender.uniqueSessionId, input, round1Output)
+	if err != nil {
+		return nil, errors.Wrap(err, "error in cOT within round 2 multiply")
+	}
+	for i := 0; i < kos.Kappa; i++ {
+		label := []byte(fmt.Sprintf("row %d of U", i))
+		sender.transcript.AppendMessage(label, round1Output.U[i][:])
+	}
+	sender.transcript.AppendMessage([]byte("wPrime"), round1Output.WPrime[:])
+	sender.transcript.AppendMessage([]byte("vPrime"), round1Output.VPrime[:])
+	chiWidth := 2
+	for i := 0; i < kos.Kappa; i++ {
+		for k := 0; k < chiWidth; k++ {
+			label := []byte(fmt.Sprintf("row %d of Tau", i))
+			sender.transcript.AppendMessage(label, round2Output.COTRound2Output.Tau[i][k].Bytes())
+		}
+	}
+	chi := make([]curves.Scalar, chiWidth)
+	for k := 0; k < chiWidth; k++ {
+		label := []byte(fmt.Sprintf("draw challenge chi %d", k))
+		randomBytes := sender.transcript.ExtractBytes(label, kos.KappaBytes)
+		chi[k], err = sender.curve.Scalar.SetBytes(randomBytes)
+		if err != nil {
+			return nil, errors.Wrap(err, "setting chi scalar from bytes")
+		}
+	}
+	sender.outputAdditiveShare = sender.curve.Scalar.Zero()
+	for j := 0; j < kos.L; j++ {
+		round2Output.R[j] = sender.curve.Scalar.Zero()
+		for k := 0; k < chiWidth; k++ {
+			round2Output.R[j] = round2Output.R[j].Add(chi[k].Mul(sender.cOtSender.OutputAdditiveShares[j][k]))
+		}
+		sender.outputAdditiveShare = sender.outputAdditiveShare.Add(sender.gadget[j].Mul(sender.cOtSender.OutputAdditiveShares[j][0]))
+	}
+	round2Output.U = chi[0].Mul(alpha).Add(chi[1].Mul(alphaHat))
+	return round2Output, nil
+}
+
+// Round3Multiply 는 receiver 가 cOT 를 마무리하고 sender 의 값을 검증한 뒤 자신의 덧셈 쉐어를 계산합니다.
+func (receiver *MultiplyReceiver) Round3Multiply(round2Output *MultiplyRound2Output) error {
+	chiWidth := 2
+	for i := 0; i < kos.Kappa; i++ {
+		for k := 0; k < chiWidth; k++ {
+			label := []byte(fmt.Sprintf("row %d of Tau", i))
+			receiver.transcript.AppendMessage(label, round2Output.COTRound2Output.Tau[i][k].Bytes())
+		}
+	}
+	if err := receiver.cOtReceiver.Round3Transfer(round2Output.COTRound2Output); err != nil {
+		return errors.Wrap(err, "error within cOT round 3 transfer within round 3 multiply")
+	}
+	var err error
+	chi := make([]curves.Scalar, chiWidth)
+	for k := 0; k < chiWidth; k++ {
+		label := []byte(fmt.Sprintf("draw challenge chi %d", k))
+		randomBytes := receiver.transcript.ExtractBytes(label, kos.KappaBytes)
+		chi[k], err = receiver.curve.Scalar.SetBytes(randomBytes)
+		if err != nil {
+			return errors.Wrap(err, "setting chi scalar from bytes")
+		}
+	}
+
+	receiver.outputAdditiveShare = receiver.curve.Scalar.Zero()
+	for j := 0; j < kos.L; j++ {
+		leftHandSideOfCheck := round2Output.R[j]
+		for k := 0; k < chiWidth; k++ {
+			leftHandSideOfCheck = leftHandSideOfCheck.Add(chi[k].Mul(receiver.cOtReceiver.OutputAdditiveShares[j][k]))
+		}
+		rightHandSideOfCheck := [simplest.DigestSize]byte{}
+		jthBitOfOmega := simplest.ExtractBitFromByteVector(receiver.omega[:], j)
+		subtle.ConstantTimeCopy(int(jthBitOfOmega), rightHandSideOfCheck[:], round2Output.U.Bytes())
+		if subtle.ConstantTimeCompare(rightHandSideOfCheck[:], leftHandSideOfCheck.Bytes()) != 1 {
+			return fmt.Errorf("alice's values R and U failed to check in round 3 multiply")
+		}
+		receiver.outputAdditiveShare = receiver.outputAdditiveShare.Add(receiver.gadget[j].Mul(receiver.cOtReceiver.OutputAdditiveShares[j][0]))
+	}
+	return nil
+}
+
+func reverseScalarBytes(inBytes []byte) []byte {
+	outBytes := make([]byte, len(inBytes))
+	for i, j := 0, len(inBytes)-1; j >= 0; i, j = i+1, j-1 {
+		outBytes[i] = inBytes[j]
+	}
+	return outBytes
+}
@@ -0,0 +1,268 @@
+// Package schnorr 는 DKLs DKG 쉐어로 BIP340 Schnorr(Taproot 키 경로) 서명을 만드는 2자간 프로토콜입니다.
+//
+// DKLs DKG 의 쉐어는 곱셈 쉐어(P = a*b*G)이므로, 서명할 때마다 DKG 의 시드 OT 로
+// 곱셈 프로토콜을 실행해 덧셈 쉐어(tA + tB = a*b)로 바꾼 뒤 서명합니다.
+// 논스는 Alice 가 먼저 커밋하고, Bob 이 공개한 뒤 Alice 가 여는 순서로 교환합니다.
+//
+//	Alice Round1: 세션 시드, 논스 커밋
+//	Bob   Round2: 세션 시드, 곱셈 1라운드, 논스 Rb
+//	Alice Round3: 곱셈 2라운드, 논스 Ra, Xa = tA*G, 부분 서명 sA
+//	Bob   Round4: 곱셈 마무리, 검증, 최종 서명 (R.x || s)
+package schnorr
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"fmt"
+
+	"github.com/coinbase/kryptology/pkg/core/curves"
+	"github.com/coinbase/kryptology/pkg/ot/base/simplest"
+	"github.com/coinbase/kryptology/pkg/ot/extension/kos"
+	"github.com/coinbase/kryptology/pkg/tecdsa/dkls/v1/dkg"
+	"github.com/pkg/errors"
+)
+
+const nonceCommitmentLabel = "tecdsa/schnorr/nonce-commitment"
+
+type Round1Output struct {
+	Seed            [simplest.DigestSize]byte
+	NonceCommitment [32]byte
+}
+
+type Round2Output struct {
+	Seed           [simplest.DigestSize]byte
+	MultiplyRound1 *kos.Round1Output
+	// Nonce 는 Bob 의 논스 점 Rb 입니다 (압축 형식).
+	Nonce []byte
+}
+
+type Round3Output struct {
+	MultiplyRound2 *MultiplyRound2Output
+	// Nonce 는 Alice 의 논스 점 Ra 입니다 (압축 형식).
+	Nonce []byte
+	// AdditiveShareCommitment 는 Alice 의 덧셈 쉐어 공개값 Xa = tA*G 입니다 (압축 형식).
+	AdditiveShareCommitment []byte
+	// PartialSignature 는 Alice 의 부분 서명 sA 입니다.
+	PartialSignature []byte
+}
+
+type Alice struct {
+	curve      *curves.Curve
+	output     *dkg.AliceOutput
+	message    []byte
+	tweak      *TaprootTweak
+	seed       [simplest.DigestSize]byte
+	nonce      curves.Scalar
+	noncePoint curves.Point
+}
+
+type Bob struct {
+	curve      *curves.Curve
+	output     *dkg.BobOutput
+	message    []byte
+	tweak      *TaprootTweak
+	aliceRound *Round1Output
+	receiver   *MultiplyReceiver
+	nonce      curves.Scalar
+	noncePoint curves.Point
+}
+
+// NewAlice 는 32바이트 메시지(BIP341 서명 해시)에 서명할 Alice 를 만듭니다.
+func NewAlice(curve *curves.Curve, output *dkg.AliceOutput, message []byte) (*Alice, error) {
+	if len(message) != 32 {
+		return nil, fmt.Errorf("message must be 32 bytes, got %d", len(message))
+	}
+	tweak, err := ComputeTaprootTweak(curve, output.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	return &Alice{curve: curve, output: output, message: message, tweak: tweak}, nil
+}
+
+// NewBob 는 32바이트 메시지(BIP341 서명 해시)에 서명할 Bob 을 만듭니다.
+func NewBob(curve *curves.Curve, output *dkg.BobOutput, message []byte) (*Bob, error) {
+	if len(message) != 32 {
+		return nil, fmt.Errorf("message must be 32 bytes, got %d", len(message))
+	}
+	tweak, err := ComputeTaprootTweak(curve, output.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	return &Bob{curve: curve, output: output, message: message, tweak: tweak}, nil
+}
+
+// NewAliceWithoutTweak 는 BIP341 트윅 없이 DKG 공개키(x-only)로 검증되는 BIP340 서명을 만들 Alice 를 만듭니다.
+func NewAliceWithoutTweak(curve *curves.Curve, output *dkg.AliceOutput, message []byte) (*Alice, error) {
+	if len(message) != 32 {
+		return nil, fmt.Errorf("message must be 32 bytes, got %d", len(message))
+	}
+	return &Alice{curve: curve, output: output, message: message, tweak: untweakedKey(curve, output.PublicKey)}, nil
+}
+
+// NewBobWithoutTweak 는 BIP341 트윅 없이 DKG 공개키(x-only)로 검증되는 BIP340 서명을 만들 Bob 을 만듭니다.
+func NewBobWithoutTweak(curve *curves.Curve, output *dkg.BobOutput, message []byte) (*Bob, error) {
+	if len(message) != 32 {
+		return nil, fmt.Errorf("message must be 32 bytes, got %d", len(message))
+	}
+	return &Bob{curve: curve, output: output, message: message, tweak: untweakedKey(curve, output.PublicKey)}, nil
+}
+
+// Round1Commit 은 Alice 의 세션 시드와 논스 커밋을 만듭니다.
+func (alice *Alice) Round1Commit() (*Round1Output, error) {
+	if _, err := rand.Read(alice.seed[:]); err != nil {
+		return nil, errors.Wrap(err, "failed to generate session seed")
+	}
+	alice.nonce = alice.curve.Scalar.Random(rand.Reader)
+	alice.noncePoint = alice.curve.ScalarBaseMult(alice.nonce)
+
+	return &Round1Output{
+		Seed:            alice.seed,
+		NonceCommitment: nonceCommitment(alice.seed, alice.noncePoint),
+	}, nil
+}
+
+// Round2Respond 는 Bob 의 세션 시드, 곱셈 1라운드, 논스를 만듭니다.
+func (bob *Bob) Round2Respond(input *Round1Output) (*Round2Output, error) {
+	bob.aliceRound = input
+
+	output := &Round2Output{}
+	if _, err := rand.Read(output.Seed[:]); err != nil {
+		return nil, errors.Wrap(err, "failed to generate session seed")
+	}
+
+	var err error
+	bob.receiver, err = NewMultiplyReceiver(bob.output.SeedOtResult, bob.curve, sessionID(input.Seed, output.Seed))
+	if err != nil {
+		return nil, err
+	}
+	output.MultiplyRound1, err = bob.receiver.Round1Initialize(bob.output.SecretKeyShare)
+	if err != nil {
+		return nil, err
+	}
+
+	bob.nonce = bob.curve.Scalar.Random(rand.Reader)
+	bob.noncePoint = bob.curve.ScalarBaseMult(bob.nonce)
+	output.Nonce = bob.noncePoint.ToAffineCompressed()
+	return output, nil
+}
+
+// Round3Sign 은 곱셈을 진행해 Alice 의 덧셈 쉐어를 얻고 부분 서명을 만듭니다.
+func (alice *Alice) Round3Sign(input *Round2Output) (*Round3Output, error) {
+	bobNonce, err := alice.curve.Point.FromAffineCompressed(input.Nonce)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid nonce from bob")
+	}
+
+	sender, err := NewMultiplySender(alice.output.SeedOtResult, alice.curve, sessionID(alice.seed, input.Seed))
+	if err != nil {
+		return nil, err
+	}
+	multiplyRound2, err := sender.Round2Multiply(alice.output.SecretKeyShare, input.MultiplyRound1)
+	if err != nil {
+		return nil, err
+	}
+	additiveShare := sender.OutputAdditiveShare()
+
+	r := alice.noncePoint.Add(bobNonce)
+	if r.IsIdentity() {
+		return nil, fmt.Errorf("aggregated nonce is infinity")
+	}
+	e, err := challenge(alice.curve, XOnly(r), XOnly(alice.tweak.OutputKey), alice.message)
+	if err != nil {
+		return nil, err
+	}
+
+	// Alice 는 트윅을 더하는 쪽입니다: d = gQ * (gP * (tA + tB) + t)
+	secret := negateIf(negateIf(additiveShare, alice.tweak.InternalKeyNegated).Add(alice.tweak.Tweak), alice.tweak.OutputKeyNegated)
+	k := negateIf(alice.nonce, !HasEvenY(r))
+	partial := k.Add(e.Mul(secret))
+
+	return &Round3Output{
+		MultiplyRound2:          multiplyRound2,
+		Nonce:                   alice.noncePoint.ToAffineCompressed(),
+		AdditiveShareCommitment: alice.curve.ScalarBaseMult(additiveShare).ToAffineCompressed(),
+		PartialSignature:        partial.Bytes(),
+	}, nil
+}
+
+// Round4Sign 은 Alice 의 값을 검증하고 64바이트 BIP340 서명을 완성합니다.
+func (bob *Bob) Round4Sign(input *Round3Output) ([]byte, error) {
+	aliceNonce, err := bob.curve.Point.FromAffineCompressed(input.Nonce)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid nonce from alice")
+	}
+	commitment := nonceCommitment(bob.aliceRound.Seed, aliceNonce)
+	if subtle.ConstantTimeCompare(commitment[:], bob.aliceRound.NonceCommitment[:]) != 1 {
+		return nil, fmt.Errorf("alice's nonce does not match her commitment")
+	}
+
+	if err := bob.receiver.Round3Multiply(input.MultiplyRound2); err != nil {
+		return nil, err
+	}
+	additiveShare := bob.receiver.OutputAdditiveShare()
+
+	// 덧셈 쉐어의 합이 DKG 공개키와 같아야 합니다.
+	aliceShareCommitment, err := bob.curve.Point.FromAffineCompressed(input.AdditiveShareCommitment)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid additive share commitment from alice")
+	}
+	if !aliceShareCommitment.Add(bob.curve.ScalarBaseMult(additiveShare)).Equal(bob.output.PublicKey) {
+		return nil, fmt.Errorf("additive shares do not match the public key")
+	}
+
+	r := aliceNonce.Add(bob.noncePoint)
+	if r.IsIdentity() {
+		return nil, fmt.Errorf("aggregated nonce is infinity")
+	}
+	rNegated := !HasEvenY(r)
+	e, err := challenge(bob.curve, XOnly(r), XOnly(bob.tweak.OutputKey), bob.message)
+	if err != nil {
+		return nil, err
+	}
+
+	// Alice 의 부분 서명 검증: sA*G == ±Ra + e * gQ * (gP * Xa + tG)
+	alicePartial, err := bob.curve.Scalar.SetBytes(input.PartialSignature)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid partial signature from alice")
+	}
+	aliceKey := aliceShareCommitment
+	if bob.tweak.InternalKeyNegated {
+		aliceKey = aliceKey.Neg()
+	}
+	aliceKey = aliceKey.Add(bob.curve.ScalarBaseMult(bob.tweak.Tweak))
+	if bob.tweak.OutputKeyNegated {
+		aliceKey = aliceKey.Neg()
+	}
+	aliceR := aliceNonce
+	if rNegated {
+		aliceR = aliceR.Neg()
+	}
+	if !bob.curve.ScalarBaseMult(alicePartial).Equal(aliceR.Add(aliceKey.Mul(e))) {
+		return nil, fmt.Errorf("alice's partial signature is invalid")
+	}
+
+	secret := negateIf(negateIf(additiveShare, bob.tweak.InternalKeyNegated), bob.tweak.OutputKeyNegated)
+	k := negateIf(bob.nonce, rNegated)
+	s := alicePartial.Add(k.Add(e.Mul(secret)))
+
+	signature := append(XOnly(r), s.Bytes()...)
+	if !Verify(bob.curve, XOnly(bob.tweak.OutputKey), bob.message, signature) {
+		return nil, fmt.Errorf("failed to verify schnorr signature")
+	}
+	return signature, nil
+}
+
+func sessionID(aliceSeed, bobSeed [simplest.DigestSize]byte) [simplest.DigestSize]byte {
+	return sha256.Sum256(append(aliceSeed[:], bobSeed[:]...))
+}
+
+func nonceCommitment(seed [simplest.DigestSize]byte, nonce curves.Point) [32]byte {
+	h := sha256.New()
+	h.Write([]byte(nonceCommitmentLabel))
+	h.Write(seed[:])
+	h.Write(nonce.ToAffineCompressed())
+	var out [32]byte
+	copy(out[:], h.Sum(nil))
+	return out
+}
@@ -0,0 +1,138 @@
+package schnorr
+
+import (
+	"crypto/rand"
+	"testing"
+
+	btcschnorr "github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/coinbase/kryptology/pkg/core/curves"
+	"github.com/coinbase/kryptology/pkg/tecdsa/dkls/v1/dkg"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func runDkg(t *testing.T, curve *curves.Curve) (*dkg.AliceOutput, *dkg.BobOutput) {
+	alice := dkg.NewAlice(curve)
+	bob := dkg.NewBob(curve)
+	seed, err := bob.Round1GenerateRandomSeed()
+	require.NoError(t, err)
+	round2, err := alice.Round2CommitToProof(seed)
+	require.NoError(t, err)
+	proof3, err := bob.Round3SchnorrProve(round2)
+	require.NoError(t, err)
+	proof4, err := alice.Round4VerifyAndReveal(proof3)
+	require.NoError(t, err)
+	proof5, err := bob.Round5DecommitmentAndStartOt(proof4)
+	require.NoError(t, err)
+	choices, err := alice.Round6DkgRound2Ot(proof5)
+	require.NoError(t, err)
+	challenge, err := bob.Round7DkgRound3Ot(choices)
+	require.NoError(t, err)
+	responses, err := alice.Round8DkgRound4Ot(challenge)
+	require.NoError(t, err)
+	openings, err := bob.Round9DkgRound5Ot(responses)
+	require.NoError(t, err)
+	require.NoError(t, alice.Round10DkgRound6Ot(openings))
+	return alice.Output(), bob.Output()
+}
+
+// runSign 은 Alice, Bob 의 네 라운드를 실행해 BIP340 서명을 만듭니다.
+func runSign(t *testing.T, alice *Alice, bob *Bob) []byte {
+	round1, err := alice.Round1Commit()
+	require.NoError(t, err)
+	round2, err := bob.Round2Respond(round1)
+	require.NoError(t, err)
+	round3, err := alice.Round3Sign(round2)
+	require.NoError(t, err)
+	signature, err := bob.Round4Sign(round3)
+	require.NoError(t, err)
+	return signature
+}
+
+// verifyWithBtcec 은 btcec 의 BIP340 구현으로 서명을 검증합니다.
+func verifyWithBtcec(t *testing.T, publicKey, message, signature []byte) bool {
+	pubKey, err := btcschnorr.ParsePubKey(publicKey)
+	require.NoError(t, err)
+	sig, err := btcschnorr.ParseSignature(signature)
+	require.NoError(t, err)
+	return sig.Verify(message, pubKey)
+}
+
+func TestTwoPartySign(t *testing.T) {
+	curve := curves.K256()
+
+	// 내부 키의 y 가 짝수인 경우와 홀수인 경우를 모두 확인합니다.
+	seen := map[bool]bool{}
+	for i := 0; i < 32 && len(seen) < 2; i++ {
+		aliceOutput, bobOutput := runDkg(t, curve)
+		evenY := HasEvenY(aliceOutput.PublicKey)
+		if seen[evenY] {
+			continue
+		}
+		seen[evenY] = true
+
+		message := make([]byte, 32)
+		_, err := rand.Read(message)
+		require.NoError(t, err)
+		internalKey := XOnly(aliceOutput.PublicKey)
+		outputKey, err := TaprootOutputKey(curve, aliceOutput.PublicKey)
+		require.NoError(t, err)
+
+		// BIP341 트윅을 적용한 키 경로 서명은 출력 키로만 검증됩니다.
+		alice, err := NewAlice(curve, aliceOutput, message)
+		require.NoError(t, err)
+		bob, err := NewBob(curve, bobOutput, message)
+		require.NoError(t, err)
+		signature := runSign(t, alice, bob)
+		assert.True(t, verifyWithBtcec(t, outputKey, message, signature), "even y: %v", evenY)
+		assert.False(t, verifyWithBtcec(t, internalKey, message, signature), "even y: %v", evenY)
+
+		// 트윅이 없는 서명은 DKG 공개키로만 검증됩니다.
+		alice, err = NewAliceWithoutTweak(curve, aliceOutput, message)
+		require.NoError(t, err)
+		bob, err = NewBobWithoutTweak(curve, bobOutput, message)
+		require.NoError(t, err)
+		signature = runSign(t, alice, bob)
+		assert.True(t, verifyWithBtcec(t, internalKey, message, signature), "even y: %v", evenY)
+		assert.False(t, verifyWithBtcec(t, outputKey, message, signature), "even y: %v", evenY)
+		assert.True(t, Verify(curve, internalKey, message, signature))
+	}
+	assert.Len(t, seen, 2)
+}
+
+func TestTwoPartySignRejectsTampering(t *testing.T) {
+	curve := curves.K256()
+	aliceOutput, bobOutput := runDkg(t, curve)
+	message := make([]byte, 32)
+
+	_, err := NewAlice(curve, aliceOutput, message[:31])
+	assert.ErrorContains(t, err, "message must be 32 bytes")
+
+	newSession := func() (*Alice, *Bob, *Round3Output) {
+		alice, err := NewAlice(curve, aliceOutput, message)
+		require.NoError(t, err)
+		bob, err := NewBob(curve, bobOutput, message)
+		require.NoError(t, err)
+		round1, err := alice.Round1Commit()
+		require.NoError(t, err)
+		round2, err := bob.Round2Respond(round1)
+		require.NoError(t, err)
+		round3, err := alice.Round3Sign(round2)
+		require.NoError(t, err)
+		return alice, bob, round3
+	}
+
+	// 커밋과 다른 논스는 거부합니다.
+	_, bob, round3 := newSession()
+	round3.Nonce = curve.ScalarBaseMult(curve.Scalar.Random(rand.Reader)).ToAffineCompressed()
+	_, err = bob.Round4Sign(round3)
+	assert.ErrorContains(t, err, "does not match her commitment")
+
+	// 잘못된 부분 서명은 거부합니다.
+	_, bob, round3 = newSession()
+	partial, err := curve.Scalar.SetBytes(round3.PartialSignature)
+	require.NoError(t, err)
+	round3.PartialSignature = partial.Add(curve.Scalar.One()).Bytes()
+	_, err = bob.Round4Sign(round3)
+	assert.ErrorContains(t, err, "partial signature is invalid")
+}
@@ -1,80 +1,117 @@
 package service
 
 import (
+	"crypto/sha256"
 	"fmt"
+	"hash"
 	"tecdsa/pkg/network"
 	"tecdsa/pkg/transaction"
 
 	"github.com/coinbase/kryptology/pkg/core/curves"
+	"golang.org/x/crypto/sha3"
 )
 
-type AddressDerivationFunc func(curves.Point, network.Network) (string, error)
+type AddressDerivationFunc func(curves.Point, network.Network, int) (string, error)
 type SignatureVerifierFunc func(curves.Point, []byte, []byte) bool
 type CreateUnsignedTxFunc func(interface{}, network.Network) (*transaction.UnsignedTransaction, error)
+type MessageHashFunc func() hash.Hash
+type SigningPayloadFunc func(*transaction.UnsignedTransaction, network.Network, curves.Point) ([]byte, error)
+type AssembleSignedTxFunc func(*transaction.UnsignedTransaction, network.Network, curves.Point, *transaction.Signature) (string, error)
+type SigningPayloadsFunc func(*transaction.UnsignedTransaction, network.Network, curves.Point) ([][]byte, error)
+type AssembleSignedTxInputsFunc func(*transaction.UnsignedTransaction, network.Network, curves.Point, []*transaction.Signature) (string, error)
 
 type NetworkHandler struct {
 	AddressDerivation         AddressDerivationFunc
 	SignatureVerifier         SignatureVerifierFunc
 	CreateUnsignedTransaction CreateUnsignedTxFunc
+	// MessageHash 는 파티가 tx_origin 을 서명할 때 사용할 다이제스트입니다.
+	MessageHash MessageHashFunc
+	// SigningPayload 는 미서명 트랜잭션에서 파티에게 보낼 tx_origin 을 만듭니다.
+	SigningPayload SigningPayloadFunc
+	// AssembleSignedTransaction 은 서명을 결합해 브로드캐스트 가능한 트랜잭션(hex)을 만듭니다.
+	AssembleSignedTransaction AssembleSignedTxFunc
+	// SigningPayloads, AssembleSignedTransactionInputs 는 입력마다 서명이 필요한 트랜잭션 (비트코인) 을 처리합니다.
+	// 없으면 SigningPayload, AssembleSignedTransaction 으로 서명 하나를 처리합니다.
+	SigningPayloads                 SigningPayloadsFunc
+	AssembleSignedTransactionInputs AssembleSignedTxInputsFunc
 }
 
+// NetworkService 는 체인 계열별 핸들러로 네트워크의 주소 파생, 트랜잭션 생성, 서명을 처리합니다.
+// 설정 파일로 추가한 네트워크도 같은 계열의 핸들러를 사용합니다.
 type NetworkService struct {
-	networkHandlerMap map[network.Network]NetworkHandler
+	familyHandlerMap map[network.ChainFamily]NetworkHandler
 }
 
 func NewNetworkService() *NetworkService {
 	return &NetworkService{
-		networkHandlerMap: map[network.Network]NetworkHandler{
-			network.Bitcoin: {
-				AddressDerivation:         network.DeriveBitcoinAddress,
-				CreateUnsignedTransaction: network.CreateUnsignedBitcoinTransaction,
+		familyHandlerMap: map[network.ChainFamily]NetworkHandler{
+			network.FamilyBitcoin: {
+				AddressDerivation:               network.DeriveBitcoinAddress,
+				CreateUnsignedTransaction:       network.CreateUnsignedBitcoinTransaction,
+				MessageHash:                     network.NewBitcoinMessageHash,
+				SigningPayload:                  network.CreateBitcoinSigningPayload,
+				AssembleSignedTransaction:       network.AssembleSignedBitcoinTransaction,
+				SigningPayloads:                 network.CreateBitcoinSigningPayloads,
+				AssembleSignedTransactionInputs: network.AssembleSignedBitcoinTransactionInputs,
 			},
-			network.BitcoinTestNet: {
-				AddressDerivation:         network.DeriveBitcoinAddress,
-				CreateUnsignedTransaction: network.CreateUnsignedBitcoinTransaction,
-			},
-			network.BitcoinRegTest: {
-				AddressDerivation:         network.DeriveBitcoinAddress,
-				CreateUnsignedTransaction: network.CreateUnsignedBitcoinTransaction,
-			},
-			network.Ethereum: {
+			network.FamilyEVM: {
 				AddressDerivation:         network.DeriveEthereumAddress,
 				SignatureVerifier:         network.VerifyEtherumSignature,
 				CreateUnsignedTransaction: network.CreateUnsignedEthereumTransaction,
+				MessageHash:               sha3.NewLegacyKeccak256,
+				SigningPayload:            network.CreateEthereumSigningPayload,
+				AssembleSignedTransaction: network.AssembleSignedEthereumTransaction,
 			},
-			network.Ethereum_Sepolia: {
-				AddressDerivation:         network.DeriveEthereumAddress,
-				SignatureVerifier:         network.VerifyEtherumSignature,
-				CreateUnsignedTransaction: network.CreateUnsignedEthereumTransaction,
+			// Ed25519 는 메시지 자체에 서명하므로 MessageHash 가 없습니다.
+			network.FamilySolana: {
+				AddressDerivation:         network.DeriveSolanaAddress,
+				SignatureVerifier:         network.VerifySolanaSignature,
+				CreateUnsignedTransaction: network.CreateUnsignedSolanaTransaction,
+				SigningPayload:            network.CreateSolanaSigningPayload,
+				AssembleSignedTransaction: network.AssembleSignedSolanaTransaction,
+			},
+			// NEO 는 트랜잭션 생성 없이 tx_origin 서명만 지원합니다.
+			network.FamilyNeo: {
+				AddressDerivation: network.DeriveNeoAddress,
+				SignatureVerifier: network.VerifyNeoSignature,
+				MessageHash:       sha256.New,
 			},
 		},
 	}
 }
 
+func (s *NetworkService) handler(net network.Network) (NetworkHandler, bool) {
+	handler, exists := s.familyHandlerMap[net.Family()]
+	return handler, exists
+}
+
 func (s *NetworkService) GetNetworkByID(id int32) (network.Network, error) {
-	for net, metadata := range network.NetworkMetadata {
-		if metadata.ID == id {
-			return net, nil
-		}
+	net, ok := network.GetNetworkByID(id)
+	if !ok {
+		return 0, fmt.Errorf("unsupported network ID: %d", id)
 	}
-	return 0, fmt.Errorf("unsupported network ID: %d", id)
+	return net, nil
 }
 
 func (s *NetworkService) GetAllNetworks() []network.Network {
 	return network.Networks
 }
 
-func (s *NetworkService) DeriveAddress(point curves.Point, network network.Network) (string, error) {
-	handler, exists := s.networkHandlerMap[network]
+func (s *NetworkService) DeriveAddress(point curves.Point, network network.Network, addressType int) (string, error) {
+	handler, exists := s.handler(network)
 
 	if !exists {
 		return "", fmt.Errorf("unsupported network: %s", network)
 	}
-	return handler.AddressDerivation(point, network)
+	// 키의 곡선과 네트워크의 곡선이 다르면 주소를 만들 수 없습니다 (예: Ed25519 키의 이더리움 주소).
+	if point.CurveName() != network.Curve().KryptologyCurve().Name {
+		return "", fmt.Errorf("%s keys cannot derive %s addresses", point.CurveName(), network)
+	}
+	return handler.AddressDerivation(point, network, addressType)
 }
 
 func (s *NetworkService) VerifySignature(point curves.Point, network network.Network, txOrigin []byte, signature []byte) (bool, error) {
-	handler, exists := s.networkHandlerMap[network]
+	handler, exists := s.handler(network)
 	if !exists {
 		return false, fmt.Errorf("unsupported network: %s", network)
 	}
@@ -82,9 +119,60 @@ func (s *NetworkService) VerifySignature(point curves.Point, network network.Net
 }
 
 func (s *NetworkService) CreateUnsignedTransaction(network network.Network, txRequest interface{}) (*transaction.UnsignedTransaction, error) {
-	handler, exists := s.networkHandlerMap[network]
-	if !exists {
+	handler, exists := s.handler(network)
+	if !exists || handler.CreateUnsignedTransaction == nil {
 		return nil, fmt.Errorf("unsupported network: %s", network)
 	}
 	return handler.CreateUnsignedTransaction(txRequest, network)
 }
+
+// NewMessageHash 는 서명 세션마다 새 해시 인스턴스를 돌려줍니다.
+// 네트워크 정보가 없는 이전 쉐어는 기존과 같이 Keccak256 을 사용합니다.
+func (s *NetworkService) NewMessageHash(network network.Network) hash.Hash {
+	handler, exists := s.handler(network)
+	if !exists || handler.MessageHash == nil {
+		return sha3.NewLegacyKeccak256()
+	}
+	return handler.MessageHash()
+}
+
+func (s *NetworkService) CreateSigningPayload(network network.Network, unsignedTx *transaction.UnsignedTransaction, point curves.Point) ([]byte, error) {
+	handler, exists := s.handler(network)
+	if !exists || handler.SigningPayload == nil {
+		return nil, fmt.Errorf("signing unsigned transactions is not supported for network: %s", network)
+	}
+	return handler.SigningPayload(unsignedTx, network, point)
+}
+
+func (s *NetworkService) AssembleSignedTransaction(network network.Network, unsignedTx *transaction.UnsignedTransaction, point curves.Point, signature *transaction.Signature) (string, error) {
+	handler, exists := s.handler(network)
+	if !exists || handler.AssembleSignedTransaction == nil {
+		return "", fmt.Errorf("assembling signed transactions is not supported for network: %s", network)
+	}
+	return handler.AssembleSignedTransaction(unsignedTx, network, point, signature)
+}
+
+// CreateSigningPayloads 는 미서명 트랜잭션에서 서명할 tx_origin 을 서명 순서대로 만듭니다. 비트코인은 입력마다 하나씩입니다.
+func (s *NetworkService) CreateSigningPayloads(network network.Network, unsignedTx *transaction.UnsignedTransaction, point curves.Point) ([][]byte, error) {
+	handler, exists := s.handler(network)
+	if exists && handler.SigningPayloads != nil {
+		return handler.SigningPayloads(unsignedTx, network, point)
+	}
+	payload, err := s.CreateSigningPayload(network, unsignedTx, point)
+	if err != nil {
+		return nil, err
+	}
+	return [][]byte{payload}, nil
+}
+
+// AssembleSignedTransactionInputs 는 CreateSigningPayloads 의 순서대로 받은 서명을 결합합니다.
+func (s *NetworkService) AssembleSignedTransactionInputs(network network.Network, unsignedTx *transaction.UnsignedTransaction, point curves.Point, signatures []*transaction.Signature) (string, error) {
+	handler, exists := s.handler(network)
+	if exists && handler.AssembleSignedTransactionInputs != nil {
+		return handler.AssembleSignedTransactionInputs(unsignedTx, network, point, signatures)
+	}
+	if len(signatures) != 1 {
+		return "", fmt.Errorf("expected 1 signature, got %d", len(signatures))
+	}
+	return s.AssembleSignedTransaction(network, unsignedTx, point, signatures[0])
+}
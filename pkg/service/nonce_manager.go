@@ -0,0 +1,221 @@
+package service
+
+import (
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	"tecdsa/pkg/database/models"
+	"tecdsa/pkg/database/repository"
+	"tecdsa/pkg/network"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ChainNonceFunc 는 노드에서 계정의 pending 논스를 조회합니다.
+type ChainNonceFunc func(network.Network, string) (uint64, error)
+
+// maxNonceReserveAttempts 는 다른 게이트웨이와 같은 논스를 동시에 할당해 실패했을 때 다시 시도하는 횟수입니다.
+const maxNonceReserveAttempts = 5
+
+// nonceReserveBackoff 는 재시도 사이의 기본 대기 시간입니다. 시도할 때마다 늘어나며, 같은 논스를 두고 경쟁한 게이트웨이들이
+// 다시 동시에 시도하지 않도록 최대 같은 길이의 무작위 시간을 더합니다.
+var nonceReserveBackoff = 10 * time.Millisecond
+
+// defaultNonceReservationTTL 은 할당한 논스를 서명하지 않았을 때 다른 트랜잭션에 다시 할당하기까지의 기본 시간입니다.
+const defaultNonceReservationTTL = 10 * time.Minute
+
+var nonceStatusNames = map[int32]string{
+	models.NonceReserved: "reserved",
+	models.NonceSigned:   "signed",
+	models.NonceReleased: "released",
+}
+
+// nonceStatusExpired 는 만료 시간이 지나도록 서명하지 않아 다시 할당할 논스의 상태 이름입니다.
+const nonceStatusExpired = "expired"
+
+// NonceState 는 계정의 체인 논스와 게이트웨이가 할당한 논스입니다.
+type NonceState struct {
+	Network      int32              `json:"network"`
+	Address      string             `json:"address"`
+	ChainNonce   uint64             `json:"chain_nonce"`
+	NextNonce    uint64             `json:"next_nonce"`
+	Reservations []NonceReservation `json:"reservations"`
+}
+
+type NonceReservation struct {
+	Nonce  uint64 `json:"nonce"`
+	Status string `json:"status"`
+}
+
+// NonceManager 는 EVM 계정 (네트워크, 주소) 별로 트랜잭션 논스를 할당합니다.
+// 같은 주소의 트랜잭션을 동시에 만들어도 서로 다른 논스를 받으며, 할당은 데이터베이스에서 원자적으로 이루어지므로
+// 여러 게이트웨이가 같은 데이터베이스를 사용해도 됩니다.
+// 미서명 트랜잭션을 만들고 서명하지 않으면 그 논스가 계정의 다음 트랜잭션을 막으므로, reservationTTL 이 지나도록
+// 서명하지 않은 논스는 다시 할당합니다.
+type NonceManager struct {
+	repo           repository.NonceRepository
+	chainNonce     ChainNonceFunc
+	reservationTTL time.Duration
+	mutex          sync.Mutex
+	accounts       map[string]*sync.Mutex
+}
+
+// NewNonceManager 는 논스 관리자를 만듭니다. reservationTTL 이 0 이면 기본값 (10분) 을 사용합니다.
+func NewNonceManager(repo repository.NonceRepository, chainNonce ChainNonceFunc, reservationTTL time.Duration) *NonceManager {
+	if reservationTTL <= 0 {
+		reservationTTL = defaultNonceReservationTTL
+	}
+	return &NonceManager{
+		repo:           repo,
+		chainNonce:     chainNonce,
+		reservationTTL: reservationTTL,
+		accounts:       make(map[string]*sync.Mutex),
+	}
+}
+
+// Reserve 는 계정의 다음 논스를 할당합니다. 해제되었거나 할당이 만료된 논스가 있으면 가장 작은 것을 먼저 다시 할당합니다.
+func (m *NonceManager) Reserve(net network.Network, address string) (uint64, error) {
+	address, err := nonceAccount(net, address)
+	if err != nil {
+		return 0, err
+	}
+	unlock := m.lock(net, address)
+	defer unlock()
+
+	chainNonce, err := m.chainNonce(net, address)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get pending nonce: %v", err)
+	}
+	for attempt := 1; ; attempt++ {
+		nonce, err := m.repo.Reserve(net.ID(), address, chainNonce, time.Now().Add(-m.reservationTTL))
+		if err == nil {
+			return nonce, nil
+		}
+		if attempt == maxNonceReserveAttempts {
+			return 0, err
+		}
+		time.Sleep(nonceReserveDelay(attempt))
+	}
+}
+
+// nonceReserveDelay 는 attempt 번째 시도가 실패한 뒤 기다릴 시간입니다.
+func nonceReserveDelay(attempt int) time.Duration {
+	return time.Duration(attempt)*nonceReserveBackoff + time.Duration(rand.Int63n(int64(nonceReserveBackoff)))
+}
+
+// MarkSigned 는 할당한 논스의 트랜잭션이 서명되었음을 기록합니다.
+func (m *NonceManager) MarkSigned(net network.Network, address string, nonce uint64) error {
+	address, err := nonceAccount(net, address)
+	if err != nil {
+		return err
+	}
+	_, err = m.repo.UpdateStatus(net.ID(), address, nonce, []int32{models.NonceReserved}, models.NonceSigned)
+	return err
+}
+
+// Release 는 서명이나 브로드캐스트에 실패한 트랜잭션의 논스를 해제해 다음 트랜잭션이 다시 사용하게 합니다.
+// 게이트웨이가 할당하지 않았거나 이미 해제한 논스면 false 를 돌려줍니다.
+func (m *NonceManager) Release(net network.Network, address string, nonce uint64) (bool, error) {
+	address, err := nonceAccount(net, address)
+	if err != nil {
+		return false, err
+	}
+	return m.repo.UpdateStatus(net.ID(), address, nonce, []int32{models.NonceReserved, models.NonceSigned}, models.NonceReleased)
+}
+
+// Resync 는 계정에 할당한 논스를 모두 지우고 체인의 pending 논스부터 다시 할당하게 합니다.
+// 게이트웨이 밖에서 트랜잭션을 보냈거나, 할당한 논스의 트랜잭션이 버려졌을 때 사용합니다.
+func (m *NonceManager) Resync(net network.Network, address string) (*NonceState, error) {
+	address, err := nonceAccount(net, address)
+	if err != nil {
+		return nil, err
+	}
+	unlock := m.lock(net, address)
+	defer unlock()
+
+	if err := m.repo.DeleteByAccount(net.ID(), address); err != nil {
+		return nil, err
+	}
+	return m.state(net, address)
+}
+
+// State 는 계정의 체인 논스, 다음에 할당할 논스, 할당한 논스 목록을 돌려줍니다.
+func (m *NonceManager) State(net network.Network, address string) (*NonceState, error) {
+	address, err := nonceAccount(net, address)
+	if err != nil {
+		return nil, err
+	}
+	return m.state(net, address)
+}
+
+func (m *NonceManager) state(net network.Network, address string) (*NonceState, error) {
+	chainNonce, err := m.chainNonce(net, address)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pending nonce: %v", err)
+	}
+	records, err := m.repo.FindByAccount(net.ID(), address)
+	if err != nil {
+		return nil, err
+	}
+
+	state := &NonceState{
+		Network:      net.ID(),
+		Address:      address,
+		ChainNonce:   chainNonce,
+		NextNonce:    chainNonce,
+		Reservations: []NonceReservation{},
+	}
+	var released *uint64
+	expiredBefore := time.Now().Add(-m.reservationTTL)
+	for _, record := range records {
+		// 체인 논스보다 작은 논스는 이미 사용되었으며 다음 할당 때 지웁니다.
+		if record.Nonce < chainNonce {
+			continue
+		}
+		status := nonceStatusNames[record.Status]
+		expired := record.Status == models.NonceReserved && record.ReservedAt.Before(expiredBefore)
+		if expired {
+			status = nonceStatusExpired
+		}
+		state.Reservations = append(state.Reservations, NonceReservation{Nonce: record.Nonce, Status: status})
+		if (record.Status == models.NonceReleased || expired) && released == nil {
+			nonce := record.Nonce
+			released = &nonce
+		}
+		if record.Nonce+1 > state.NextNonce {
+			state.NextNonce = record.Nonce + 1
+		}
+	}
+	if released != nil {
+		state.NextNonce = *released
+	}
+	return state, nil
+}
+
+// lock 은 같은 게이트웨이 안에서 계정의 논스 할당을 차례로 처리합니다.
+func (m *NonceManager) lock(net network.Network, address string) func() {
+	key := fmt.Sprintf("%d:%s", net.ID(), address)
+	m.mutex.Lock()
+	accountMutex, ok := m.accounts[key]
+	if !ok {
+		accountMutex = &sync.Mutex{}
+		m.accounts[key] = accountMutex
+	}
+	m.mutex.Unlock()
+
+	accountMutex.Lock()
+	return accountMutex.Unlock
+}
+
+// nonceAccount 는 EVM 네트워크의 주소를 체크섬 형식으로 바꿉니다.
+func nonceAccount(net network.Network, address string) (string, error) {
+	if !network.IsEthereumNetwork(net) {
+		return "", fmt.Errorf("nonces are only managed for EVM networks")
+	}
+	if !network.IsValidEthereumAddress(address) {
+		return "", fmt.Errorf("invalid address: %s", address)
+	}
+	return common.HexToAddress(address).Hex(), nil
+}
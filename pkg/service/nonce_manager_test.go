@@ -0,0 +1,174 @@
+package service
+
+import (
+	"errors"
+	"path/filepath"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"tecdsa/pkg/database"
+	"tecdsa/pkg/database/repository"
+	"tecdsa/pkg/network"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestNonceManager(t *testing.T, chainNonce *uint64, reservationTTL time.Duration) *NonceManager {
+	dialector, err := database.NewDialector(database.Config{Driver: database.DriverSQLite, Name: filepath.Join(t.TempDir(), "nonce.db")})
+	require.NoError(t, err)
+	db, err := database.Open(dialector)
+	require.NoError(t, err)
+	t.Cleanup(func() { database.CloseDB(db) })
+
+	return NewNonceManager(repository.NewNonceRepository(db), func(network.Network, string) (uint64, error) {
+		return *chainNonce, nil
+	}, reservationTTL)
+}
+
+func TestNonceManager(t *testing.T) {
+	chainNonce := uint64(10)
+	manager := newTestNonceManager(t, &chainNonce, 0)
+	address := "0x8ba1f109551bd432803012645ac136ddd64dba72"
+
+	// 같은 주소에 동시에 할당해도 논스가 겹치지 않습니다.
+	const count = 8
+	nonces := make([]uint64, count)
+	var wg sync.WaitGroup
+	for i := 0; i < count; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			nonce, err := manager.Reserve(network.Ethereum_Sepolia, address)
+			assert.NoError(t, err)
+			nonces[i] = nonce
+		}(i)
+	}
+	wg.Wait()
+	sort.Slice(nonces, func(i, j int) bool { return nonces[i] < nonces[j] })
+	for i, nonce := range nonces {
+		assert.Equal(t, uint64(10+i), nonce)
+	}
+
+	// 다른 네트워크의 같은 주소는 따로 할당합니다.
+	nonce, err := manager.Reserve(network.Ethereum, address)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(10), nonce)
+
+	// 서명에 실패해 해제한 논스를 먼저 다시 할당합니다.
+	require.NoError(t, manager.MarkSigned(network.Ethereum_Sepolia, address, 10))
+	released, err := manager.Release(network.Ethereum_Sepolia, address, 12)
+	require.NoError(t, err)
+	assert.True(t, released)
+	released, err = manager.Release(network.Ethereum_Sepolia, address, 12)
+	require.NoError(t, err)
+	assert.False(t, released)
+
+	state, err := manager.State(network.Ethereum_Sepolia, address)
+	require.NoError(t, err)
+	assert.Equal(t, "0x8ba1f109551bD432803012645Ac136ddd64DBA72", state.Address)
+	assert.Equal(t, uint64(12), state.NextNonce)
+	assert.Equal(t, NonceReservation{Nonce: 10, Status: "signed"}, state.Reservations[0])
+	assert.Equal(t, NonceReservation{Nonce: 12, Status: "released"}, state.Reservations[2])
+
+	nonce, err = manager.Reserve(network.Ethereum_Sepolia, address)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(12), nonce)
+
+	// 체인에서 사용된 논스는 목록에서 빠집니다.
+	chainNonce = 15
+	state, err = manager.State(network.Ethereum_Sepolia, address)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(18), state.NextNonce)
+	assert.Len(t, state.Reservations, 3)
+
+	// 다시 맞추면 체인의 pending 논스부터 할당합니다.
+	state, err = manager.Resync(network.Ethereum_Sepolia, address)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(15), state.NextNonce)
+	assert.Empty(t, state.Reservations)
+	nonce, err = manager.Reserve(network.Ethereum_Sepolia, address)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(15), nonce)
+
+	_, err = manager.Reserve(network.Bitcoin, address)
+	assert.Error(t, err)
+	_, err = manager.Reserve(network.Ethereum_Sepolia, "0x1234")
+	assert.Error(t, err)
+}
+
+func TestNonceManagerReservationTTL(t *testing.T) {
+	chainNonce := uint64(10)
+	manager := newTestNonceManager(t, &chainNonce, 50*time.Millisecond)
+	address := "0x8ba1f109551bd432803012645ac136ddd64dba72"
+
+	for _, expected := range []uint64{10, 11} {
+		nonce, err := manager.Reserve(network.Ethereum_Sepolia, address)
+		require.NoError(t, err)
+		assert.Equal(t, expected, nonce)
+	}
+	require.NoError(t, manager.MarkSigned(network.Ethereum_Sepolia, address, 10))
+
+	// 만료 시간이 지나도록 서명하지 않은 논스는 다음 트랜잭션에 다시 할당하며, 서명한 논스는 그대로 둡니다.
+	time.Sleep(100 * time.Millisecond)
+	state, err := manager.State(network.Ethereum_Sepolia, address)
+	require.NoError(t, err)
+	assert.Equal(t, []NonceReservation{{Nonce: 10, Status: "signed"}, {Nonce: 11, Status: "expired"}}, state.Reservations)
+	assert.Equal(t, uint64(11), state.NextNonce)
+
+	nonce, err := manager.Reserve(network.Ethereum_Sepolia, address)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(11), nonce)
+	nonce, err = manager.Reserve(network.Ethereum_Sepolia, address)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(12), nonce)
+
+	state, err = manager.State(network.Ethereum_Sepolia, address)
+	require.NoError(t, err)
+	assert.Equal(t, NonceReservation{Nonce: 11, Status: "reserved"}, state.Reservations[1])
+	assert.Equal(t, uint64(13), state.NextNonce)
+}
+
+// conflictNonceRepository 는 처음 conflicts 번의 할당을 다른 게이트웨이와 충돌한 것처럼 실패시킵니다.
+type conflictNonceRepository struct {
+	repository.NonceRepository
+	conflicts int
+	attempts  []time.Time
+}
+
+func (r *conflictNonceRepository) Reserve(network int32, address string, chainNonce uint64, _ time.Time) (uint64, error) {
+	r.attempts = append(r.attempts, time.Now())
+	if len(r.attempts) <= r.conflicts {
+		return 0, errors.New("UNIQUE constraint failed")
+	}
+	return chainNonce, nil
+}
+
+func TestNonceManagerReserveRetry(t *testing.T) {
+	address := "0x8ba1f109551bd432803012645ac136ddd64dba72"
+	chainNonce := func(network.Network, string) (uint64, error) { return 7, nil }
+
+	// 충돌하면 시도 사이에 기다린 뒤 다시 할당합니다.
+	repo := &conflictNonceRepository{conflicts: 2}
+	nonce, err := NewNonceManager(repo, chainNonce, 0).Reserve(network.Ethereum_Sepolia, address)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(7), nonce)
+	require.Len(t, repo.attempts, 3)
+	for i := 1; i < len(repo.attempts); i++ {
+		assert.GreaterOrEqual(t, repo.attempts[i].Sub(repo.attempts[i-1]), time.Duration(i)*nonceReserveBackoff)
+	}
+
+	// 계속 충돌하면 maxNonceReserveAttempts 번 시도한 뒤 실패합니다.
+	repo = &conflictNonceRepository{conflicts: maxNonceReserveAttempts}
+	_, err = NewNonceManager(repo, chainNonce, 0).Reserve(network.Ethereum_Sepolia, address)
+	assert.Error(t, err)
+	assert.Len(t, repo.attempts, maxNonceReserveAttempts)
+
+	for attempt := 1; attempt < maxNonceReserveAttempts; attempt++ {
+		delay := nonceReserveDelay(attempt)
+		assert.GreaterOrEqual(t, delay, time.Duration(attempt)*nonceReserveBackoff)
+		assert.Less(t, delay, time.Duration(attempt+1)*nonceReserveBackoff)
+	}
+}
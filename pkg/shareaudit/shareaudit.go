@@ -0,0 +1,334 @@
+// Package shareaudit 는 파티 (Alice, Bob) 데이터베이스에 저장된 쉐어를 검사하고 현재 형식으로 옮깁니다.
+// 모든 쉐어를 디코딩해 공개키가 저장된 주소와 맞는지 확인하고,
+// 이전 형식 (gob 또는 낮은 Envelope 버전) 의 쉐어는 현재 형식으로 다시 인코딩합니다.
+package shareaudit
+
+import (
+	"fmt"
+
+	"tecdsa/pkg/database/models"
+	deserializer "tecdsa/pkg/deserializers"
+	"tecdsa/pkg/network"
+	"tecdsa/pkg/service"
+	"tecdsa/pkg/sharestore"
+
+	"github.com/coinbase/kryptology/pkg/core/curves"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+const (
+	PartyAlice = "alice"
+	PartyBob   = "bob"
+)
+
+// 쉐어 검사 결과입니다.
+const (
+	StatusOK = "ok"
+	// StatusMigrated 는 현재 형식으로 다시 저장한 쉐어입니다.
+	StatusMigrated = "migrated"
+	// StatusNeedsMigration 은 dry-run 에서 다시 저장할 쉐어입니다.
+	StatusNeedsMigration = "needs_migration"
+	// StatusCorrupt 는 읽거나 디코딩할 수 없는 쉐어입니다.
+	StatusCorrupt = "corrupt"
+	// StatusAddressMismatch 는 공개키에서 파생한 주소가 저장된 주소와 다른 쉐어입니다.
+	StatusAddressMismatch = "address_mismatch"
+)
+
+const batchSize = 100
+
+// Options 는 검사할 파티와 실행 방식입니다.
+type Options struct {
+	Party string
+	// DryRun 이면 쉐어를 검사만 하고 다시 저장하지 않습니다.
+	DryRun bool
+}
+
+// Row 는 쉐어 하나의 검사 결과입니다.
+type Row struct {
+	ID            uint32   `json:"id"`
+	KeyID         uint32   `json:"key_id"`
+	Address       string   `json:"address"`
+	Network       int32    `json:"network"`
+	AddressType   int32    `json:"address_type"`
+	Curve         string   `json:"curve"`
+	FormatVersion uint32   `json:"format_version"`
+	Status        string   `json:"status"`
+	Errors        []string `json:"errors,omitempty"`
+}
+
+// Report 는 파티 데이터베이스 전체의 검사 결과입니다.
+type Report struct {
+	Party           string `json:"party"`
+	DryRun          bool   `json:"dry_run"`
+	FormatVersion   uint32 `json:"format_version"`
+	Total           int    `json:"total"`
+	OK              int    `json:"ok"`
+	Migrated        int    `json:"migrated"`
+	NeedsMigration  int    `json:"needs_migration"`
+	Corrupt         int    `json:"corrupt"`
+	AddressMismatch int    `json:"address_mismatch"`
+	Rows            []Row  `json:"rows"`
+}
+
+// Failed 는 손상되었거나 주소가 맞지 않는 쉐어가 있는지 확인합니다.
+func (r *Report) Failed() bool {
+	return r.Corrupt > 0 || r.AddressMismatch > 0
+}
+
+func (r *Report) add(row Row) {
+	r.Total++
+	switch row.Status {
+	case StatusOK:
+		r.OK++
+	case StatusMigrated:
+		r.Migrated++
+	case StatusNeedsMigration:
+		r.NeedsMigration++
+	case StatusCorrupt:
+		r.Corrupt++
+	case StatusAddressMismatch:
+		r.AddressMismatch++
+	}
+	r.Rows = append(r.Rows, row)
+}
+
+type Auditor struct {
+	db             *gorm.DB
+	store          sharestore.ShareStore
+	networkService *service.NetworkService
+	opts           Options
+}
+
+// NewAuditor 는 db 의 쉐어 메타데이터와 store 의 쉐어 바이트를 검사하는 Auditor 를 만듭니다.
+func NewAuditor(db *gorm.DB, store sharestore.ShareStore, networkService *service.NetworkService, opts Options) (*Auditor, error) {
+	if opts.Party != PartyAlice && opts.Party != PartyBob {
+		return nil, fmt.Errorf("unsupported party: %q (alice or bob)", opts.Party)
+	}
+	return &Auditor{db: db, store: store, networkService: networkService, opts: opts}, nil
+}
+
+// Run 은 모든 쉐어를 검사합니다. 개별 쉐어의 문제는 보고서에 기록하고,
+// 데이터베이스를 읽거나 쉐어를 다시 저장하지 못한 경우에만 에러를 돌려줍니다.
+func (a *Auditor) Run() (*Report, error) {
+	report := &Report{
+		Party:         a.opts.Party,
+		DryRun:        a.opts.DryRun,
+		FormatVersion: deserializer.FormatVersion,
+		Rows:          []Row{},
+	}
+
+	var records []*models.ParitalSecretShare
+	var runErr error
+	result := a.db.Order("id").FindInBatches(&records, batchSize, func(tx *gorm.DB, batch int) error {
+		for _, record := range records {
+			row, err := a.audit(record)
+			if err != nil {
+				runErr = err
+				return err
+			}
+			report.add(row)
+		}
+		return nil
+	})
+	if runErr != nil {
+		return report, runErr
+	}
+	if result.Error != nil {
+		return report, errors.Wrap(result.Error, "failed to retrieve secret shares from database")
+	}
+	return report, nil
+}
+
+func (a *Auditor) audit(record *models.ParitalSecretShare) (Row, error) {
+	curve := network.Curve(record.Curve)
+	row := Row{
+		ID:          record.ID,
+		KeyID:       record.KeyID,
+		Address:     record.Address,
+		Network:     record.Network,
+		AddressType: record.AddressType,
+		Curve:       curve.String(),
+		Status:      StatusOK,
+	}
+	corrupt := func(err error) (Row, error) {
+		row.Status = StatusCorrupt
+		row.Errors = append(row.Errors, err.Error())
+		return row, nil
+	}
+
+	payload, err := a.readShare(record)
+	if err != nil {
+		return corrupt(err)
+	}
+	if row.FormatVersion, err = deserializer.PayloadVersion(payload); err != nil {
+		return corrupt(err)
+	}
+	share, err := decodeShare(a.opts.Party, curve, payload)
+	if err != nil {
+		return corrupt(err)
+	}
+
+	if mismatches := a.verifyAddresses(record, share.publicKey); len(mismatches) > 0 {
+		row.Status = StatusAddressMismatch
+		row.Errors = mismatches
+		return row, nil
+	}
+
+	if row.FormatVersion == deserializer.FormatVersion {
+		return row, nil
+	}
+	if a.opts.DryRun {
+		row.Status = StatusNeedsMigration
+		return row, nil
+	}
+	if err := a.migrate(record, curve, share); err != nil {
+		return row, errors.Wrapf(err, "failed to migrate secret share %d", record.ID)
+	}
+	row.Status = StatusMigrated
+	row.FormatVersion = deserializer.FormatVersion
+	return row, nil
+}
+
+// readShare 는 쉐어 저장소에서 쉐어 바이트를 읽습니다. ShareRef 가 없으면 Share 컬럼을 사용합니다.
+func (a *Auditor) readShare(record *models.ParitalSecretShare) ([]byte, error) {
+	if record.ShareRef == "" {
+		if len(record.Share) == 0 {
+			return nil, errors.New("secret share is empty")
+		}
+		return record.Share, nil
+	}
+	share, err := a.store.Get(record.ShareRef)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to retrieve secret share")
+	}
+	return share, nil
+}
+
+// verifyAddresses 는 쉐어의 주소와 키에 등록된 모든 네트워크 주소를 공개키에서 다시 파생해 비교합니다.
+func (a *Auditor) verifyAddresses(record *models.ParitalSecretShare, publicKey curves.Point) []string {
+	var mismatches []string
+	check := func(networkID int32, addressType int32, address string) {
+		networkObj, err := a.networkService.GetNetworkByID(networkID)
+		if err != nil {
+			mismatches = append(mismatches, err.Error())
+			return
+		}
+		derived, err := a.networkService.DeriveAddress(publicKey, networkObj, int(addressType))
+		if err != nil {
+			mismatches = append(mismatches, fmt.Sprintf("failed to derive %s address: %v", networkObj, err))
+			return
+		}
+		if derived != address {
+			mismatches = append(mismatches, fmt.Sprintf("%s address %s does not match derived address %s", networkObj, address, derived))
+		}
+	}
+
+	check(record.Network, record.AddressType, record.Address)
+	if record.KeyID == 0 {
+		return mismatches
+	}
+	var keyAddresses []*models.KeyAddress
+	if err := a.db.Where("key_id = ?", record.KeyID).Find(&keyAddresses).Error; err != nil {
+		return append(mismatches, errors.Wrap(err, "failed to retrieve key addresses").Error())
+	}
+	for _, keyAddress := range keyAddresses {
+		// 키 생성 네트워크의 주소는 쉐어의 주소와 같습니다.
+		if keyAddress.Address == record.Address {
+			continue
+		}
+		check(keyAddress.Network, keyAddress.AddressType, keyAddress.Address)
+	}
+	return mismatches
+}
+
+// migrate 는 쉐어를 현재 형식으로 다시 인코딩해 원래 위치 (쉐어 저장소 또는 Share 컬럼) 에 덮어씁니다.
+// 다시 인코딩한 쉐어를 디코딩해 같은 공개키가 나오는지 확인한 뒤에 저장합니다.
+func (a *Auditor) migrate(record *models.ParitalSecretShare, curve network.Curve, share *decodedShare) error {
+	encoded, err := share.encode()
+	if err != nil {
+		return err
+	}
+	reencoded, err := decodeShare(a.opts.Party, curve, encoded)
+	if err != nil {
+		return errors.Wrap(err, "re-encoded share does not decode")
+	}
+	if !reencoded.publicKey.Equal(share.publicKey) {
+		return errors.New("re-encoded share has a different public key")
+	}
+
+	if record.ShareRef != "" {
+		return a.store.Put(record.ShareRef, encoded)
+	}
+	err = a.db.Model(&models.ParitalSecretShare{}).Where("id = ?", record.ID).Update("share", encoded).Error
+	if err != nil {
+		return errors.Wrap(err, "failed to update secret share")
+	}
+	return nil
+}
+
+// decodedShare 는 곡선과 파티에 맞게 디코딩한 쉐어입니다.
+type decodedShare struct {
+	publicKey curves.Point
+	// encode 는 쉐어를 현재 형식으로 인코딩합니다.
+	encode func() ([]byte, error)
+}
+
+func decodeShare(party string, curve network.Curve, payload []byte) (*decodedShare, error) {
+	var share *decodedShare
+	var secretKeyShare curves.Scalar
+	switch {
+	case curve == network.Ed25519:
+		output, err := deserializer.DecodeEddsaDkgResult(payload)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to decode secret share")
+		}
+		if output.SecretKeyShare == nil || output.PeerPublicKeyShare == nil || output.PublicKey == nil {
+			return nil, errors.New("secret share is incomplete")
+		}
+		// Ed25519 공개키는 두 공개 쉐어의 합입니다.
+		publicShare := output.SecretKeyShare.Point().Generator().Mul(output.SecretKeyShare)
+		if !publicShare.Add(output.PeerPublicKeyShare).Equal(output.PublicKey) {
+			return nil, errors.New("secret share does not match public key")
+		}
+		share = &decodedShare{
+			publicKey: output.PublicKey,
+			encode:    func() ([]byte, error) { return deserializer.EncodeEddsaDkgOutput(output) },
+		}
+		secretKeyShare = output.SecretKeyShare
+	case party == PartyAlice:
+		output, err := deserializer.DecodeAliceDkgResult(payload)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to decode secret share")
+		}
+		if output.SeedOtResult == nil {
+			return nil, errors.New("secret share has no seed OT result")
+		}
+		share = &decodedShare{
+			publicKey: output.PublicKey,
+			encode:    func() ([]byte, error) { return deserializer.EncodeAliceDkgOutput(output) },
+		}
+		secretKeyShare = output.SecretKeyShare
+	default:
+		output, err := deserializer.DecodeBobDkgResult(payload)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to decode secret share")
+		}
+		if output.SeedOtResult == nil {
+			return nil, errors.New("secret share has no seed OT result")
+		}
+		share = &decodedShare{
+			publicKey: output.PublicKey,
+			encode:    func() ([]byte, error) { return deserializer.EncodeBobDkgOutput(output) },
+		}
+		secretKeyShare = output.SecretKeyShare
+	}
+
+	if share.publicKey == nil || secretKeyShare == nil {
+		return nil, errors.New("secret share is incomplete")
+	}
+	if share.publicKey.CurveName() != curve.KryptologyCurve().Name {
+		return nil, fmt.Errorf("secret share is on %s, expected %s", share.publicKey.CurveName(), curve)
+	}
+	return share, nil
+}
@@ -0,0 +1,169 @@
+package shareaudit_test
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/gob"
+	"testing"
+
+	"tecdsa/pkg/database"
+	"tecdsa/pkg/database/models"
+	deserializer "tecdsa/pkg/deserializers"
+	"tecdsa/pkg/network"
+	"tecdsa/pkg/service"
+	"tecdsa/pkg/shareaudit"
+	"tecdsa/pkg/sharestore"
+
+	"github.com/coinbase/kryptology/pkg/core/curves"
+	"github.com/coinbase/kryptology/pkg/tecdsa/dkls/v1/dkg"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+var testKey = []byte("0123456789abcdef0123456789abcdef")
+
+func openDatabase(t *testing.T) *gorm.DB {
+	sqlDB, err := sql.Open("sqlite3", ":memory:")
+	require.NoError(t, err)
+	sqlDB.SetMaxOpenConns(1)
+	db, err := database.Open(sqlite.Dialector{Conn: sqlDB})
+	require.NoError(t, err)
+	t.Cleanup(func() { database.CloseDB(db) })
+	return db
+}
+
+func runDkg(t *testing.T) *dkg.AliceOutput {
+	alice := dkg.NewAlice(curves.K256())
+	bob := dkg.NewBob(curves.K256())
+	seed, err := bob.Round1GenerateRandomSeed()
+	require.NoError(t, err)
+	round2, err := alice.Round2CommitToProof(seed)
+	require.NoError(t, err)
+	proof3, err := bob.Round3SchnorrProve(round2)
+	require.NoError(t, err)
+	proof4, err := alice.Round4VerifyAndReveal(proof3)
+	require.NoError(t, err)
+	proof5, err := bob.Round5DecommitmentAndStartOt(proof4)
+	require.NoError(t, err)
+	choices, err := alice.Round6DkgRound2Ot(proof5)
+	require.NoError(t, err)
+	challenge, err := bob.Round7DkgRound3Ot(choices)
+	require.NoError(t, err)
+	responses, err := alice.Round8DkgRound4Ot(challenge)
+	require.NoError(t, err)
+	openings, err := bob.Round9DkgRound5Ot(responses)
+	require.NoError(t, err)
+	require.NoError(t, alice.Round10DkgRound6Ot(openings))
+	return alice.Output()
+}
+
+// encodeGob 은 Envelope 이전 버전과 같은 방식으로 쉐어를 gob 인코딩합니다.
+func encodeGob(t *testing.T, value interface{}) []byte {
+	gob.Register(&curves.ScalarK256{})
+	gob.Register(&curves.PointK256{})
+	buf := bytes.NewBuffer([]byte{})
+	require.NoError(t, gob.NewEncoder(buf).Encode(value))
+	return buf.Bytes()
+}
+
+func TestAuditor(t *testing.T) {
+	db := openDatabase(t)
+	store, err := sharestore.NewFileStore(t.TempDir(), testKey)
+	require.NoError(t, err)
+
+	output := runDkg(t)
+	ethAddress, err := network.DeriveEthereumAddress(output.PublicKey, network.Ethereum, 0)
+	require.NoError(t, err)
+	btcAddress, err := network.DeriveBitcoinAddress(output.PublicKey, network.Bitcoin, 0)
+	require.NoError(t, err)
+	other := runDkg(t)
+	otherAddress, err := network.DeriveEthereumAddress(other.PublicKey, network.Ethereum, 0)
+	require.NoError(t, err)
+
+	legacyShare := encodeGob(t, output)
+	currentShare, err := deserializer.EncodeAliceDkgOutput(other)
+	require.NoError(t, err)
+	require.NoError(t, store.Put("current", currentShare))
+
+	ethereumID := network.Ethereum.ID()
+	records := []*models.ParitalSecretShare{
+		// 1: 쉐어 저장소 도입 전 Share 컬럼의 gob 쉐어
+		{KeyID: 1, Address: ethAddress, Share: legacyShare, Network: ethereumID},
+		// 2: 쉐어 저장소의 현재 형식 쉐어
+		{KeyID: 2, Address: otherAddress, Share: []byte{}, ShareRef: "current", Network: ethereumID},
+		// 3: 디코딩할 수 없는 쉐어
+		{KeyID: 3, Address: "corrupt", Share: []byte("not a share"), Network: ethereumID},
+		// 4: 다른 키의 주소가 저장된 쉐어
+		{KeyID: 4, Address: "0x0000000000000000000000000000000000000000", Share: legacyShare, Network: ethereumID},
+		// 5: 쉐어 저장소에서 사라진 쉐어
+		{KeyID: 5, Address: "missing", Share: []byte{}, ShareRef: "missing", Network: ethereumID},
+	}
+	for _, record := range records {
+		require.NoError(t, db.Create(record).Error)
+	}
+	require.NoError(t, db.Create(&models.KeyAddress{KeyID: 1, Network: ethereumID, Address: ethAddress}).Error)
+	require.NoError(t, db.Create(&models.KeyAddress{KeyID: 1, Network: network.Bitcoin.ID(), Address: btcAddress}).Error)
+
+	run := func(dryRun bool) *shareaudit.Report {
+		auditor, err := shareaudit.NewAuditor(db, store, service.NewNetworkService(), shareaudit.Options{
+			Party:  shareaudit.PartyAlice,
+			DryRun: dryRun,
+		})
+		require.NoError(t, err)
+		report, err := auditor.Run()
+		require.NoError(t, err)
+		return report
+	}
+	statuses := func(report *shareaudit.Report) []string {
+		var statuses []string
+		for _, row := range report.Rows {
+			statuses = append(statuses, row.Status)
+		}
+		return statuses
+	}
+
+	// dry-run 은 쉐어를 바꾸지 않습니다.
+	report := run(true)
+	assert.Equal(t, []string{
+		shareaudit.StatusNeedsMigration,
+		shareaudit.StatusOK,
+		shareaudit.StatusCorrupt,
+		shareaudit.StatusAddressMismatch,
+		shareaudit.StatusCorrupt,
+	}, statuses(report))
+	assert.Equal(t, uint32(0), report.Rows[0].FormatVersion)
+	assert.True(t, report.Failed())
+	storedShare := func(id uint32) []byte {
+		var stored models.ParitalSecretShare
+		require.NoError(t, db.First(&stored, id).Error)
+		return stored.Share
+	}
+	assert.Equal(t, legacyShare, storedShare(records[0].ID))
+
+	report = run(false)
+	assert.Equal(t, shareaudit.StatusMigrated, report.Rows[0].Status)
+	assert.Equal(t, 1, report.Migrated)
+	assert.Equal(t, 2, report.Corrupt)
+	assert.Equal(t, 1, report.AddressMismatch)
+
+	// 주소가 맞지 않는 쉐어는 옮기지 않습니다.
+	assert.Equal(t, legacyShare, storedShare(records[3].ID))
+
+	assert.False(t, deserializer.IsLegacy(storedShare(records[0].ID)))
+	migrated, err := deserializer.DecodeAliceDkgResult(storedShare(records[0].ID))
+	require.NoError(t, err)
+	assert.True(t, migrated.PublicKey.Equal(output.PublicKey))
+	assert.Equal(t, shareaudit.StatusOK, run(false).Rows[0].Status)
+
+	// 키에 등록된 다른 네트워크의 주소도 확인합니다.
+	require.NoError(t, db.Model(&models.KeyAddress{}).Where("address = ?", btcAddress).Update("address", "tampered").Error)
+	report = run(true)
+	assert.Equal(t, shareaudit.StatusAddressMismatch, report.Rows[0].Status)
+}
+
+func TestNewAuditorRequiresParty(t *testing.T) {
+	_, err := shareaudit.NewAuditor(nil, nil, service.NewNetworkService(), shareaudit.Options{Party: "carol"})
+	assert.Error(t, err)
+}
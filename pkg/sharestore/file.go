@@ -0,0 +1,103 @@
+package sharestore
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+)
+
+type fileStore struct {
+	dir  string
+	aead cipher.AEAD
+}
+
+// NewFileStore 는 쉐어마다 AES-256-GCM 으로 봉인한 파일 하나를 dir 에 저장하는 저장소를 만듭니다.
+// 참조 ID 를 추가 인증 데이터로 사용하므로 파일을 다른 이름으로 바꾸면 열리지 않습니다.
+func NewFileStore(dir string, key []byte) (ShareStore, error) {
+	if dir == "" {
+		return nil, errors.New("share store path is required")
+	}
+	if len(key) != 32 {
+		return nil, errors.New("share store key must be 32 bytes")
+	}
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, errors.Wrap(err, "failed to create share store directory")
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create cipher")
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create cipher")
+	}
+	return &fileStore{dir: dir, aead: aead}, nil
+}
+
+func (s *fileStore) Put(ref string, share []byte) error {
+	nonce := make([]byte, s.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return errors.Wrap(err, "failed to generate nonce")
+	}
+	sealed := s.aead.Seal(nonce, nonce, share, []byte(ref))
+
+	// 임시 파일에 쓴 뒤 이름을 바꿔 중간에 실패해도 쉐어 파일이 깨지지 않게 합니다.
+	tmp, err := os.CreateTemp(s.dir, ".share-*")
+	if err != nil {
+		return errors.Wrap(err, "failed to create share file")
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(sealed); err != nil {
+		tmp.Close()
+		return errors.Wrap(err, "failed to write share file")
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return errors.Wrap(err, "failed to write share file")
+	}
+	if err := tmp.Close(); err != nil {
+		return errors.Wrap(err, "failed to write share file")
+	}
+	if err := os.Rename(tmp.Name(), s.path(ref)); err != nil {
+		return errors.Wrap(err, "failed to write share file")
+	}
+	return nil
+}
+
+func (s *fileStore) Get(ref string) ([]byte, error) {
+	sealed, err := os.ReadFile(s.path(ref))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, ErrNotFound
+		}
+		return nil, errors.Wrap(err, "failed to read share file")
+	}
+	nonceSize := s.aead.NonceSize()
+	if len(sealed) < nonceSize {
+		return nil, errors.New("share file is corrupted")
+	}
+	share, err := s.aead.Open(nil, sealed[:nonceSize], sealed[nonceSize:], []byte(ref))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open share file")
+	}
+	return share, nil
+}
+
+func (s *fileStore) Delete(ref string) error {
+	if err := os.Remove(s.path(ref)); err != nil && !os.IsNotExist(err) {
+		return errors.Wrap(err, "failed to delete share file")
+	}
+	return nil
+}
+
+// path 는 참조 ID 의 해시를 파일 이름으로 사용해 경로 문자가 섞여도 dir 밖으로 나가지 않게 합니다.
+func (s *fileStore) path(ref string) string {
+	name := sha256.Sum256([]byte(ref))
+	return filepath.Join(s.dir, hex.EncodeToString(name[:])+".share")
+}
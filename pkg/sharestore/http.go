@@ -0,0 +1,114 @@
+package sharestore
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const httpTimeout = 10 * time.Second
+
+type httpStore struct {
+	baseURL string
+	token   string
+	client  *http.Client
+}
+
+// httpSecret 은 HTTP KV 저장소가 주고받는 시크릿 본문입니다.
+type httpSecret struct {
+	Value string `json:"value"`
+}
+
+// NewHTTPStore 는 시크릿 매니저 형태의 HTTP KV 서버에 쉐어를 저장하는 저장소를 만듭니다.
+// 쉐어는 {baseURL}/secrets/{ref} 에 PUT, GET, DELETE 하며 본문은 {"value": "<base64>"} 입니다.
+// token 이 있으면 Authorization: Bearer 헤더로 보냅니다.
+func NewHTTPStore(baseURL, token string) ShareStore {
+	return &httpStore{
+		baseURL: strings.TrimRight(baseURL, "/"),
+		token:   token,
+		client:  &http.Client{Timeout: httpTimeout},
+	}
+}
+
+func (s *httpStore) Put(ref string, share []byte) error {
+	body, err := json.Marshal(httpSecret{Value: base64.StdEncoding.EncodeToString(share)})
+	if err != nil {
+		return errors.Wrap(err, "failed to encode secret share")
+	}
+	resp, err := s.do(http.MethodPut, ref, body)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusNoContent {
+		return unexpectedStatus(resp)
+	}
+	return nil
+}
+
+func (s *httpStore) Get(ref string) ([]byte, error) {
+	resp, err := s.do(http.MethodGet, ref, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, ErrNotFound
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, unexpectedStatus(resp)
+	}
+
+	var secret httpSecret
+	if err := json.NewDecoder(resp.Body).Decode(&secret); err != nil {
+		return nil, errors.Wrap(err, "failed to decode secret share")
+	}
+	share, err := base64.StdEncoding.DecodeString(secret.Value)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode secret share")
+	}
+	return share, nil
+}
+
+func (s *httpStore) Delete(ref string) error {
+	resp, err := s.do(http.MethodDelete, ref, nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusNotFound {
+		return unexpectedStatus(resp)
+	}
+	return nil
+}
+
+func (s *httpStore) do(method, ref string, body []byte) (*http.Response, error) {
+	req, err := http.NewRequest(method, s.baseURL+"/secrets/"+url.PathEscape(ref), bytes.NewReader(body))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create share store request")
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if s.token != "" {
+		req.Header.Set("Authorization", "Bearer "+s.token)
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to reach share store")
+	}
+	return resp, nil
+}
+
+func unexpectedStatus(resp *http.Response) error {
+	message, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+	return fmt.Errorf("share store returned %d: %s", resp.StatusCode, strings.TrimSpace(string(message)))
+}
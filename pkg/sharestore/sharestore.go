@@ -0,0 +1,72 @@
+// Package sharestore 는 파티의 비밀 쉐어 바이트를 보관하는 저장소입니다.
+// 쉐어의 메타데이터 (주소, 네트워크, 클라이언트) 는 SQL 리포지토리에 두고,
+// 쉐어 바이트만 SQL, 암호화 파일, HTTP KV (시크릿 매니저) 중 설정한 저장소에 저장합니다.
+// Alice 와 Bob 이 서로 다른 저장소를 사용하면 한 저장소가 유출되어도 키를 복원할 수 없습니다.
+package sharestore
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+const (
+	TypeSQL  = "sql"
+	TypeFile = "file"
+	TypeHTTP = "http"
+)
+
+// ErrNotFound 는 저장소에 해당 쉐어가 없을 때 돌려줍니다.
+var ErrNotFound = errors.New("secret share not found")
+
+// ShareStore 는 쉐어 바이트를 참조 ID 로 저장하고 조회합니다.
+type ShareStore interface {
+	Put(ref string, share []byte) error
+	Get(ref string) ([]byte, error)
+	Delete(ref string) error
+}
+
+// Config 는 쉐어 저장소 설정입니다. Type 이 비어 있으면 SQL 저장소를 사용합니다.
+type Config struct {
+	Type string
+	// Path 는 파일 저장소의 디렉터리입니다.
+	Path string
+	// Key 는 파일 저장소의 AES-256 키 (hex 64자) 입니다.
+	Key string
+	// URL, Token 은 HTTP KV 저장소의 주소와 Bearer 토큰입니다.
+	URL   string
+	Token string
+}
+
+// New 는 설정에 맞는 쉐어 저장소를 만듭니다. db 는 SQL 저장소에서 사용합니다.
+func New(cfg Config, db *gorm.DB) (ShareStore, error) {
+	switch cfg.Type {
+	case "", TypeSQL:
+		return NewSQLStore(db), nil
+	case TypeFile:
+		key, err := hex.DecodeString(cfg.Key)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid share store key")
+		}
+		return NewFileStore(cfg.Path, key)
+	case TypeHTTP:
+		if cfg.URL == "" {
+			return nil, errors.New("share store url is required")
+		}
+		return NewHTTPStore(cfg.URL, cfg.Token), nil
+	default:
+		return nil, fmt.Errorf("unsupported share store: %s", cfg.Type)
+	}
+}
+
+// NewRef 는 새 쉐어의 참조 ID 를 만듭니다.
+func NewRef() (string, error) {
+	ref := make([]byte, 16)
+	if _, err := rand.Read(ref); err != nil {
+		return "", errors.Wrap(err, "failed to generate share ref")
+	}
+	return hex.EncodeToString(ref), nil
+}
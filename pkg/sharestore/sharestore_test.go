@@ -0,0 +1,203 @@
+package sharestore_test
+
+import (
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"tecdsa/pkg/database"
+	"tecdsa/pkg/database/repository"
+	"tecdsa/pkg/sharestore"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+const testToken = "test-token"
+
+var testKey = []byte("0123456789abcdef0123456789abcdef")
+
+// kvServer 는 HTTP KV 저장소 테스트용 인메모리 시크릿 매니저입니다.
+type kvServer struct {
+	mu      sync.Mutex
+	secrets map[string]json.RawMessage
+}
+
+func newKVServer(t *testing.T) *httptest.Server {
+	kv := &kvServer{secrets: map[string]json.RawMessage{}}
+	server := httptest.NewServer(kv)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func (kv *kvServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("Authorization") != "Bearer "+testToken {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+	ref, ok := strings.CutPrefix(r.URL.Path, "/v1/secrets/")
+	if !ok || ref == "" {
+		http.NotFound(w, r)
+		return
+	}
+
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+	switch r.Method {
+	case http.MethodPut:
+		var body json.RawMessage
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		kv.secrets[ref] = body
+		w.WriteHeader(http.StatusNoContent)
+	case http.MethodGet:
+		body, ok := kv.secrets[ref]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(body)
+	case http.MethodDelete:
+		delete(kv.secrets, ref)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func openDatabase(t *testing.T) *gorm.DB {
+	sqlDB, err := sql.Open("sqlite3", ":memory:")
+	require.NoError(t, err)
+	sqlDB.SetMaxOpenConns(1)
+	db, err := database.Open(sqlite.Dialector{Conn: sqlDB})
+	require.NoError(t, err)
+	t.Cleanup(func() { database.CloseDB(db) })
+	return db
+}
+
+func TestShareStores(t *testing.T) {
+	stores := map[string]func(t *testing.T) sharestore.ShareStore{
+		"sql": func(t *testing.T) sharestore.ShareStore {
+			return sharestore.NewSQLStore(openDatabase(t))
+		},
+		"file": func(t *testing.T) sharestore.ShareStore {
+			store, err := sharestore.NewFileStore(t.TempDir(), testKey)
+			require.NoError(t, err)
+			return store
+		},
+		"http": func(t *testing.T) sharestore.ShareStore {
+			return sharestore.NewHTTPStore(newKVServer(t).URL+"/v1", testToken)
+		},
+	}
+
+	for name, newStore := range stores {
+		t.Run(name, func(t *testing.T) {
+			store := newStore(t)
+
+			_, err := store.Get("missing")
+			assert.ErrorIs(t, err, sharestore.ErrNotFound)
+
+			require.NoError(t, store.Put("ref-1", []byte("share-1")))
+			require.NoError(t, store.Put("ref-2", []byte{0x00, 0xff}))
+			share, err := store.Get("ref-1")
+			require.NoError(t, err)
+			assert.Equal(t, []byte("share-1"), share)
+
+			// 같은 참조 ID 에 다시 저장하면 덮어씁니다.
+			require.NoError(t, store.Put("ref-1", []byte("share-1b")))
+			share, err = store.Get("ref-1")
+			require.NoError(t, err)
+			assert.Equal(t, []byte("share-1b"), share)
+
+			require.NoError(t, store.Delete("ref-1"))
+			_, err = store.Get("ref-1")
+			assert.ErrorIs(t, err, sharestore.ErrNotFound)
+			require.NoError(t, store.Delete("ref-1"))
+
+			share, err = store.Get("ref-2")
+			require.NoError(t, err)
+			assert.Equal(t, []byte{0x00, 0xff}, share)
+		})
+	}
+}
+
+func TestFileStoreSealsShares(t *testing.T) {
+	dir := t.TempDir()
+	store, err := sharestore.NewFileStore(dir, testKey)
+	require.NoError(t, err)
+	require.NoError(t, store.Put("ref-1", []byte("plaintext-share")))
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.share"))
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	sealed, err := os.ReadFile(files[0])
+	require.NoError(t, err)
+	assert.NotContains(t, string(sealed), "plaintext-share")
+
+	// 다른 키로는 열 수 없습니다.
+	otherKey := []byte("fedcba9876543210fedcba9876543210")
+	other, err := sharestore.NewFileStore(dir, otherKey)
+	require.NoError(t, err)
+	_, err = other.Get("ref-1")
+	assert.Error(t, err)
+
+	// 변조된 파일은 열 수 없습니다.
+	sealed[len(sealed)-1] ^= 0x01
+	require.NoError(t, os.WriteFile(files[0], sealed, 0o600))
+	_, err = store.Get("ref-1")
+	assert.Error(t, err)
+
+	_, err = sharestore.NewFileStore(dir, []byte("short"))
+	assert.Error(t, err)
+}
+
+func TestHTTPStoreRequiresToken(t *testing.T) {
+	store := sharestore.NewHTTPStore(newKVServer(t).URL+"/v1", "wrong-token")
+	assert.Error(t, store.Put("ref-1", []byte("share")))
+}
+
+func TestRepositoryWithExternalStore(t *testing.T) {
+	db := openDatabase(t)
+	dir := t.TempDir()
+	store, err := sharestore.NewFileStore(dir, testKey)
+	require.NoError(t, err)
+	repo := repository.NewPartialSecretShareRepositoryWithStore(db, store)
+
+	require.NoError(t, repo.Create(1, "address-1", []byte("share-1"), 4, 0, 0, 0))
+
+	// 데이터베이스에는 쉐어 바이트가 남지 않습니다.
+	var stored struct {
+		Share    []byte
+		ShareRef string
+	}
+	require.NoError(t, db.Table("parital_secret_shares").Where("key_id = ?", 1).Take(&stored).Error)
+	assert.Empty(t, stored.Share)
+	assert.NotEmpty(t, stored.ShareRef)
+
+	record, err := repo.FindByAddress("address-1")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("share-1"), record.Share)
+
+	// 메타데이터 저장에 실패하면 저장소의 쉐어도 지웁니다.
+	require.Error(t, repo.Create(2, "address-1", []byte("share-2"), 4, 0, 0, 0))
+	files, err := filepath.Glob(filepath.Join(dir, "*.share"))
+	require.NoError(t, err)
+	assert.Len(t, files, 1)
+
+	// 쉐어 저장소 도입 전에 Share 컬럼에 저장한 쉐어도 읽을 수 있습니다.
+	require.NoError(t, db.Exec("INSERT INTO parital_secret_shares (key_id, address, share) VALUES (?, ?, ?)", 3, "legacy", []byte("legacy-share")).Error)
+	record, err = repo.FindByKeyID(3)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("legacy-share"), record.Share)
+}
@@ -0,0 +1,48 @@
+package sharestore
+
+import (
+	"tecdsa/pkg/database/models"
+
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type sqlStore struct {
+	db *gorm.DB
+}
+
+// NewSQLStore 는 쉐어를 share_secrets 테이블에 저장하는 저장소를 만듭니다.
+func NewSQLStore(db *gorm.DB) ShareStore {
+	return &sqlStore{db: db}
+}
+
+func (s *sqlStore) Put(ref string, share []byte) error {
+	record := models.ShareSecret{ShareRef: ref, Share: share}
+	err := s.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "share_ref"}},
+		DoUpdates: clause.AssignmentColumns([]string{"share", "updated_at"}),
+	}).Create(&record).Error
+	if err != nil {
+		return errors.Wrap(err, "failed to store secret share in database")
+	}
+	return nil
+}
+
+func (s *sqlStore) Get(ref string) ([]byte, error) {
+	var record models.ShareSecret
+	if err := s.db.Where("share_ref = ?", ref).First(&record).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrNotFound
+		}
+		return nil, errors.Wrap(err, "failed to retrieve secret share from database")
+	}
+	return record.Share, nil
+}
+
+func (s *sqlStore) Delete(ref string) error {
+	if err := s.db.Unscoped().Where("share_ref = ?", ref).Delete(&models.ShareSecret{}).Error; err != nil {
+		return errors.Wrap(err, "failed to delete secret share from database")
+	}
+	return nil
+}
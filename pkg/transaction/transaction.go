@@ -5,3 +5,10 @@ type UnsignedTransaction struct {
 	UnSignedTxEncodedBase64 string      `json:"unsigned_tx_encoded_base64"`
 	Extra           interface{} `json:"extra,omitempty"`
 }
+
+// Signature 는 파티가 만든 ECDSA 서명입니다.
+type Signature struct {
+	V uint64 `json:"v"`
+	R []byte `json:"r"`
+	S []byte `json:"s"`
+}
@@ -0,0 +1,1113 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        v5.27.1
+// source: eddsa/eddsa.proto
+
+package eddsa
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type EddsaKeygenMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Msg:
+	//
+	//	*EddsaKeygenMessage_EddsaKeyGenGatewayTo1Output
+	//	*EddsaKeygenMessage_EddsaKeyGenRound1To2Output
+	//	*EddsaKeygenMessage_EddsaKeyGenRound2To3Output
+	//	*EddsaKeygenMessage_EddsaKeyGenRound3To4Output
+	//	*EddsaKeygenMessage_EddsaKeyGenRound4ToGatewayOutput
+	Msg isEddsaKeygenMessage_Msg `protobuf_oneof:"msg"`
+}
+
+func (x *EddsaKeygenMessage) Reset() {
+	*x = EddsaKeygenMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eddsa_eddsa_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EddsaKeygenMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EddsaKeygenMessage) ProtoMessage() {}
+
+func (x *EddsaKeygenMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_eddsa_eddsa_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EddsaKeygenMessage.ProtoReflect.Descriptor instead.
+func (*EddsaKeygenMessage) Descriptor() ([]byte, []int) {
+	return file_eddsa_eddsa_proto_rawDescGZIP(), []int{0}
+}
+
+func (m *EddsaKeygenMessage) GetMsg() isEddsaKeygenMessage_Msg {
+	if m != nil {
+		return m.Msg
+	}
+	return nil
+}
+
+func (x *EddsaKeygenMessage) GetEddsaKeyGenGatewayTo1Output() *EddsaKeyGenGatewayTo1Output {
+	if x, ok := x.GetMsg().(*EddsaKeygenMessage_EddsaKeyGenGatewayTo1Output); ok {
+		return x.EddsaKeyGenGatewayTo1Output
+	}
+	return nil
+}
+
+func (x *EddsaKeygenMessage) GetEddsaKeyGenRound1To2Output() *EddsaKeyGenRound1To2Output {
+	if x, ok := x.GetMsg().(*EddsaKeygenMessage_EddsaKeyGenRound1To2Output); ok {
+		return x.EddsaKeyGenRound1To2Output
+	}
+	return nil
+}
+
+func (x *EddsaKeygenMessage) GetEddsaKeyGenRound2To3Output() *EddsaKeyGenRound2To3Output {
+	if x, ok := x.GetMsg().(*EddsaKeygenMessage_EddsaKeyGenRound2To3Output); ok {
+		return x.EddsaKeyGenRound2To3Output
+	}
+	return nil
+}
+
+func (x *EddsaKeygenMessage) GetEddsaKeyGenRound3To4Output() *EddsaKeyGenRound3To4Output {
+	if x, ok := x.GetMsg().(*EddsaKeygenMessage_EddsaKeyGenRound3To4Output); ok {
+		return x.EddsaKeyGenRound3To4Output
+	}
+	return nil
+}
+
+func (x *EddsaKeygenMessage) GetEddsaKeyGenRound4ToGatewayOutput() *EddsaKeyGenRound4ToGatewayOutput {
+	if x, ok := x.GetMsg().(*EddsaKeygenMessage_EddsaKeyGenRound4ToGatewayOutput); ok {
+		return x.EddsaKeyGenRound4ToGatewayOutput
+	}
+	return nil
+}
+
+type isEddsaKeygenMessage_Msg interface {
+	isEddsaKeygenMessage_Msg()
+}
+
+type EddsaKeygenMessage_EddsaKeyGenGatewayTo1Output struct {
+	EddsaKeyGenGatewayTo1Output *EddsaKeyGenGatewayTo1Output `protobuf:"bytes,1,opt,name=eddsaKeyGenGatewayTo1Output,proto3,oneof"`
+}
+
+type EddsaKeygenMessage_EddsaKeyGenRound1To2Output struct {
+	EddsaKeyGenRound1To2Output *EddsaKeyGenRound1To2Output `protobuf:"bytes,2,opt,name=eddsaKeyGenRound1To2Output,proto3,oneof"`
+}
+
+type EddsaKeygenMessage_EddsaKeyGenRound2To3Output struct {
+	EddsaKeyGenRound2To3Output *EddsaKeyGenRound2To3Output `protobuf:"bytes,3,opt,name=eddsaKeyGenRound2To3Output,proto3,oneof"`
+}
+
+type EddsaKeygenMessage_EddsaKeyGenRound3To4Output struct {
+	EddsaKeyGenRound3To4Output *EddsaKeyGenRound3To4Output `protobuf:"bytes,4,opt,name=eddsaKeyGenRound3To4Output,proto3,oneof"`
+}
+
+type EddsaKeygenMessage_EddsaKeyGenRound4ToGatewayOutput struct {
+	EddsaKeyGenRound4ToGatewayOutput *EddsaKeyGenRound4ToGatewayOutput `protobuf:"bytes,5,opt,name=eddsaKeyGenRound4ToGatewayOutput,proto3,oneof"`
+}
+
+func (*EddsaKeygenMessage_EddsaKeyGenGatewayTo1Output) isEddsaKeygenMessage_Msg() {}
+
+func (*EddsaKeygenMessage_EddsaKeyGenRound1To2Output) isEddsaKeygenMessage_Msg() {}
+
+func (*EddsaKeygenMessage_EddsaKeyGenRound2To3Output) isEddsaKeygenMessage_Msg() {}
+
+func (*EddsaKeygenMessage_EddsaKeyGenRound3To4Output) isEddsaKeygenMessage_Msg() {}
+
+func (*EddsaKeygenMessage_EddsaKeyGenRound4ToGatewayOutput) isEddsaKeygenMessage_Msg() {}
+
+// 요청 -> 라운드 1
+type EddsaKeyGenGatewayTo1Output struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *EddsaKeyGenGatewayTo1Output) Reset() {
+	*x = EddsaKeyGenGatewayTo1Output{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eddsa_eddsa_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EddsaKeyGenGatewayTo1Output) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EddsaKeyGenGatewayTo1Output) ProtoMessage() {}
+
+func (x *EddsaKeyGenGatewayTo1Output) ProtoReflect() protoreflect.Message {
+	mi := &file_eddsa_eddsa_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EddsaKeyGenGatewayTo1Output.ProtoReflect.Descriptor instead.
+func (*EddsaKeyGenGatewayTo1Output) Descriptor() ([]byte, []int) {
+	return file_eddsa_eddsa_proto_rawDescGZIP(), []int{1}
+}
+
+// 라운드 1 -> 라운드 2 (Alice 의 공개 쉐어 커밋)
+type EddsaKeyGenRound1To2Output struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Payload []byte `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+}
+
+func (x *EddsaKeyGenRound1To2Output) Reset() {
+	*x = EddsaKeyGenRound1To2Output{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eddsa_eddsa_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EddsaKeyGenRound1To2Output) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EddsaKeyGenRound1To2Output) ProtoMessage() {}
+
+func (x *EddsaKeyGenRound1To2Output) ProtoReflect() protoreflect.Message {
+	mi := &file_eddsa_eddsa_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EddsaKeyGenRound1To2Output.ProtoReflect.Descriptor instead.
+func (*EddsaKeyGenRound1To2Output) Descriptor() ([]byte, []int) {
+	return file_eddsa_eddsa_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *EddsaKeyGenRound1To2Output) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+// 라운드 2 -> 라운드 3 (Bob 의 공개 쉐어, 지식 증명)
+type EddsaKeyGenRound2To3Output struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Payload []byte `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+}
+
+func (x *EddsaKeyGenRound2To3Output) Reset() {
+	*x = EddsaKeyGenRound2To3Output{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eddsa_eddsa_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EddsaKeyGenRound2To3Output) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EddsaKeyGenRound2To3Output) ProtoMessage() {}
+
+func (x *EddsaKeyGenRound2To3Output) ProtoReflect() protoreflect.Message {
+	mi := &file_eddsa_eddsa_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EddsaKeyGenRound2To3Output.ProtoReflect.Descriptor instead.
+func (*EddsaKeyGenRound2To3Output) Descriptor() ([]byte, []int) {
+	return file_eddsa_eddsa_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *EddsaKeyGenRound2To3Output) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+// 라운드 3 -> 라운드 4 (Alice 의 공개 쉐어, 커밋 솔트, 지식 증명)
+type EddsaKeyGenRound3To4Output struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Payload []byte `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+}
+
+func (x *EddsaKeyGenRound3To4Output) Reset() {
+	*x = EddsaKeyGenRound3To4Output{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eddsa_eddsa_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EddsaKeyGenRound3To4Output) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EddsaKeyGenRound3To4Output) ProtoMessage() {}
+
+func (x *EddsaKeyGenRound3To4Output) ProtoReflect() protoreflect.Message {
+	mi := &file_eddsa_eddsa_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EddsaKeyGenRound3To4Output.ProtoReflect.Descriptor instead.
+func (*EddsaKeyGenRound3To4Output) Descriptor() ([]byte, []int) {
+	return file_eddsa_eddsa_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *EddsaKeyGenRound3To4Output) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+// 라운드 4 -> 게이트웨이
+type EddsaKeyGenRound4ToGatewayOutput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RequestId string `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Address   string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	PublicKey string `protobuf:"bytes,3,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+}
+
+func (x *EddsaKeyGenRound4ToGatewayOutput) Reset() {
+	*x = EddsaKeyGenRound4ToGatewayOutput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eddsa_eddsa_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EddsaKeyGenRound4ToGatewayOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EddsaKeyGenRound4ToGatewayOutput) ProtoMessage() {}
+
+func (x *EddsaKeyGenRound4ToGatewayOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_eddsa_eddsa_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EddsaKeyGenRound4ToGatewayOutput.ProtoReflect.Descriptor instead.
+func (*EddsaKeyGenRound4ToGatewayOutput) Descriptor() ([]byte, []int) {
+	return file_eddsa_eddsa_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *EddsaKeyGenRound4ToGatewayOutput) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *EddsaKeyGenRound4ToGatewayOutput) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *EddsaKeyGenRound4ToGatewayOutput) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+type EddsaSignMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Msg:
+	//
+	//	*EddsaSignMessage_EddsaSignGatewayTo1Output
+	//	*EddsaSignMessage_EddsaSignRound1To2Output
+	//	*EddsaSignMessage_EddsaSignRound2To3Output
+	//	*EddsaSignMessage_EddsaSignRound3To4Output
+	//	*EddsaSignMessage_EddsaSignRound4ToGatewayOutput
+	Msg isEddsaSignMessage_Msg `protobuf_oneof:"msg"`
+}
+
+func (x *EddsaSignMessage) Reset() {
+	*x = EddsaSignMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eddsa_eddsa_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EddsaSignMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EddsaSignMessage) ProtoMessage() {}
+
+func (x *EddsaSignMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_eddsa_eddsa_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EddsaSignMessage.ProtoReflect.Descriptor instead.
+func (*EddsaSignMessage) Descriptor() ([]byte, []int) {
+	return file_eddsa_eddsa_proto_rawDescGZIP(), []int{6}
+}
+
+func (m *EddsaSignMessage) GetMsg() isEddsaSignMessage_Msg {
+	if m != nil {
+		return m.Msg
+	}
+	return nil
+}
+
+func (x *EddsaSignMessage) GetEddsaSignGatewayTo1Output() *EddsaSignGatewayTo1Output {
+	if x, ok := x.GetMsg().(*EddsaSignMessage_EddsaSignGatewayTo1Output); ok {
+		return x.EddsaSignGatewayTo1Output
+	}
+	return nil
+}
+
+func (x *EddsaSignMessage) GetEddsaSignRound1To2Output() *EddsaSignRound1To2Output {
+	if x, ok := x.GetMsg().(*EddsaSignMessage_EddsaSignRound1To2Output); ok {
+		return x.EddsaSignRound1To2Output
+	}
+	return nil
+}
+
+func (x *EddsaSignMessage) GetEddsaSignRound2To3Output() *EddsaSignRound2To3Output {
+	if x, ok := x.GetMsg().(*EddsaSignMessage_EddsaSignRound2To3Output); ok {
+		return x.EddsaSignRound2To3Output
+	}
+	return nil
+}
+
+func (x *EddsaSignMessage) GetEddsaSignRound3To4Output() *EddsaSignRound3To4Output {
+	if x, ok := x.GetMsg().(*EddsaSignMessage_EddsaSignRound3To4Output); ok {
+		return x.EddsaSignRound3To4Output
+	}
+	return nil
+}
+
+func (x *EddsaSignMessage) GetEddsaSignRound4ToGatewayOutput() *EddsaSignRound4ToGatewayOutput {
+	if x, ok := x.GetMsg().(*EddsaSignMessage_EddsaSignRound4ToGatewayOutput); ok {
+		return x.EddsaSignRound4ToGatewayOutput
+	}
+	return nil
+}
+
+type isEddsaSignMessage_Msg interface {
+	isEddsaSignMessage_Msg()
+}
+
+type EddsaSignMessage_EddsaSignGatewayTo1Output struct {
+	EddsaSignGatewayTo1Output *EddsaSignGatewayTo1Output `protobuf:"bytes,1,opt,name=eddsaSignGatewayTo1Output,proto3,oneof"`
+}
+
+type EddsaSignMessage_EddsaSignRound1To2Output struct {
+	EddsaSignRound1To2Output *EddsaSignRound1To2Output `protobuf:"bytes,2,opt,name=eddsaSignRound1To2Output,proto3,oneof"`
+}
+
+type EddsaSignMessage_EddsaSignRound2To3Output struct {
+	EddsaSignRound2To3Output *EddsaSignRound2To3Output `protobuf:"bytes,3,opt,name=eddsaSignRound2To3Output,proto3,oneof"`
+}
+
+type EddsaSignMessage_EddsaSignRound3To4Output struct {
+	EddsaSignRound3To4Output *EddsaSignRound3To4Output `protobuf:"bytes,4,opt,name=eddsaSignRound3To4Output,proto3,oneof"`
+}
+
+type EddsaSignMessage_EddsaSignRound4ToGatewayOutput struct {
+	EddsaSignRound4ToGatewayOutput *EddsaSignRound4ToGatewayOutput `protobuf:"bytes,5,opt,name=eddsaSignRound4ToGatewayOutput,proto3,oneof"`
+}
+
+func (*EddsaSignMessage_EddsaSignGatewayTo1Output) isEddsaSignMessage_Msg() {}
+
+func (*EddsaSignMessage_EddsaSignRound1To2Output) isEddsaSignMessage_Msg() {}
+
+func (*EddsaSignMessage_EddsaSignRound2To3Output) isEddsaSignMessage_Msg() {}
+
+func (*EddsaSignMessage_EddsaSignRound3To4Output) isEddsaSignMessage_Msg() {}
+
+func (*EddsaSignMessage_EddsaSignRound4ToGatewayOutput) isEddsaSignMessage_Msg() {}
+
+// 요청 -> 라운드 1
+type EddsaSignGatewayTo1Output struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *EddsaSignGatewayTo1Output) Reset() {
+	*x = EddsaSignGatewayTo1Output{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eddsa_eddsa_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EddsaSignGatewayTo1Output) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EddsaSignGatewayTo1Output) ProtoMessage() {}
+
+func (x *EddsaSignGatewayTo1Output) ProtoReflect() protoreflect.Message {
+	mi := &file_eddsa_eddsa_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EddsaSignGatewayTo1Output.ProtoReflect.Descriptor instead.
+func (*EddsaSignGatewayTo1Output) Descriptor() ([]byte, []int) {
+	return file_eddsa_eddsa_proto_rawDescGZIP(), []int{7}
+}
+
+// 라운드 1 -> 라운드 2 (Alice 의 논스 커밋)
+type EddsaSignRound1To2Output struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Payload []byte `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+}
+
+func (x *EddsaSignRound1To2Output) Reset() {
+	*x = EddsaSignRound1To2Output{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eddsa_eddsa_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EddsaSignRound1To2Output) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EddsaSignRound1To2Output) ProtoMessage() {}
+
+func (x *EddsaSignRound1To2Output) ProtoReflect() protoreflect.Message {
+	mi := &file_eddsa_eddsa_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EddsaSignRound1To2Output.ProtoReflect.Descriptor instead.
+func (*EddsaSignRound1To2Output) Descriptor() ([]byte, []int) {
+	return file_eddsa_eddsa_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *EddsaSignRound1To2Output) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+// 라운드 2 -> 라운드 3 (Bob 의 논스)
+type EddsaSignRound2To3Output struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Payload []byte `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+}
+
+func (x *EddsaSignRound2To3Output) Reset() {
+	*x = EddsaSignRound2To3Output{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eddsa_eddsa_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EddsaSignRound2To3Output) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EddsaSignRound2To3Output) ProtoMessage() {}
+
+func (x *EddsaSignRound2To3Output) ProtoReflect() protoreflect.Message {
+	mi := &file_eddsa_eddsa_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EddsaSignRound2To3Output.ProtoReflect.Descriptor instead.
+func (*EddsaSignRound2To3Output) Descriptor() ([]byte, []int) {
+	return file_eddsa_eddsa_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *EddsaSignRound2To3Output) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+// 라운드 3 -> 라운드 4 (Alice 의 논스, 부분 서명)
+type EddsaSignRound3To4Output struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Payload []byte `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+}
+
+func (x *EddsaSignRound3To4Output) Reset() {
+	*x = EddsaSignRound3To4Output{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eddsa_eddsa_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EddsaSignRound3To4Output) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EddsaSignRound3To4Output) ProtoMessage() {}
+
+func (x *EddsaSignRound3To4Output) ProtoReflect() protoreflect.Message {
+	mi := &file_eddsa_eddsa_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EddsaSignRound3To4Output.ProtoReflect.Descriptor instead.
+func (*EddsaSignRound3To4Output) Descriptor() ([]byte, []int) {
+	return file_eddsa_eddsa_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *EddsaSignRound3To4Output) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+// 라운드 4 -> 게이트웨이
+type EddsaSignRound4ToGatewayOutput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RequestId string `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// Ed25519 서명 (R || s, 64바이트)
+	Signature []byte `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *EddsaSignRound4ToGatewayOutput) Reset() {
+	*x = EddsaSignRound4ToGatewayOutput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eddsa_eddsa_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EddsaSignRound4ToGatewayOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EddsaSignRound4ToGatewayOutput) ProtoMessage() {}
+
+func (x *EddsaSignRound4ToGatewayOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_eddsa_eddsa_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EddsaSignRound4ToGatewayOutput.ProtoReflect.Descriptor instead.
+func (*EddsaSignRound4ToGatewayOutput) Descriptor() ([]byte, []int) {
+	return file_eddsa_eddsa_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *EddsaSignRound4ToGatewayOutput) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *EddsaSignRound4ToGatewayOutput) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+var File_eddsa_eddsa_proto protoreflect.FileDescriptor
+
+var file_eddsa_eddsa_proto_rawDesc = []byte{
+	0x0a, 0x11, 0x65, 0x64, 0x64, 0x73, 0x61, 0x2f, 0x65, 0x64, 0x64, 0x73, 0x61, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x05, 0x65, 0x64, 0x64, 0x73, 0x61, 0x22, 0xa9, 0x04, 0x0a, 0x12, 0x45,
+	0x64, 0x64, 0x73, 0x61, 0x4b, 0x65, 0x79, 0x67, 0x65, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x66, 0x0a, 0x1b, 0x65, 0x64, 0x64, 0x73, 0x61, 0x4b, 0x65, 0x79, 0x47, 0x65, 0x6e,
+	0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x54, 0x6f, 0x31, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x65, 0x64, 0x64, 0x73, 0x61, 0x2e, 0x45,
+	0x64, 0x64, 0x73, 0x61, 0x4b, 0x65, 0x79, 0x47, 0x65, 0x6e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61,
+	0x79, 0x54, 0x6f, 0x31, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x48, 0x00, 0x52, 0x1b, 0x65, 0x64,
+	0x64, 0x73, 0x61, 0x4b, 0x65, 0x79, 0x47, 0x65, 0x6e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
+	0x54, 0x6f, 0x31, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x63, 0x0a, 0x1a, 0x65, 0x64, 0x64,
+	0x73, 0x61, 0x4b, 0x65, 0x79, 0x47, 0x65, 0x6e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x31, 0x54, 0x6f,
+	0x32, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e,
+	0x65, 0x64, 0x64, 0x73, 0x61, 0x2e, 0x45, 0x64, 0x64, 0x73, 0x61, 0x4b, 0x65, 0x79, 0x47, 0x65,
+	0x6e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x31, 0x54, 0x6f, 0x32, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x48, 0x00, 0x52, 0x1a, 0x65, 0x64, 0x64, 0x73, 0x61, 0x4b, 0x65, 0x79, 0x47, 0x65, 0x6e, 0x52,
+	0x6f, 0x75, 0x6e, 0x64, 0x31, 0x54, 0x6f, 0x32, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x63,
+	0x0a, 0x1a, 0x65, 0x64, 0x64, 0x73, 0x61, 0x4b, 0x65, 0x79, 0x47, 0x65, 0x6e, 0x52, 0x6f, 0x75,
+	0x6e, 0x64, 0x32, 0x54, 0x6f, 0x33, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x65, 0x64, 0x64, 0x73, 0x61, 0x2e, 0x45, 0x64, 0x64, 0x73, 0x61,
+	0x4b, 0x65, 0x79, 0x47, 0x65, 0x6e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x32, 0x54, 0x6f, 0x33, 0x4f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x48, 0x00, 0x52, 0x1a, 0x65, 0x64, 0x64, 0x73, 0x61, 0x4b, 0x65,
+	0x79, 0x47, 0x65, 0x6e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x32, 0x54, 0x6f, 0x33, 0x4f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x12, 0x63, 0x0a, 0x1a, 0x65, 0x64, 0x64, 0x73, 0x61, 0x4b, 0x65, 0x79, 0x47,
+	0x65, 0x6e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x33, 0x54, 0x6f, 0x34, 0x4f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x65, 0x64, 0x64, 0x73, 0x61, 0x2e,
+	0x45, 0x64, 0x64, 0x73, 0x61, 0x4b, 0x65, 0x79, 0x47, 0x65, 0x6e, 0x52, 0x6f, 0x75, 0x6e, 0x64,
+	0x33, 0x54, 0x6f, 0x34, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x48, 0x00, 0x52, 0x1a, 0x65, 0x64,
+	0x64, 0x73, 0x61, 0x4b, 0x65, 0x79, 0x47, 0x65, 0x6e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x33, 0x54,
+	0x6f, 0x34, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x75, 0x0a, 0x20, 0x65, 0x64, 0x64, 0x73,
+	0x61, 0x4b, 0x65, 0x79, 0x47, 0x65, 0x6e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x34, 0x54, 0x6f, 0x47,
+	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x27, 0x2e, 0x65, 0x64, 0x64, 0x73, 0x61, 0x2e, 0x45, 0x64, 0x64, 0x73, 0x61,
+	0x4b, 0x65, 0x79, 0x47, 0x65, 0x6e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x34, 0x54, 0x6f, 0x47, 0x61,
+	0x74, 0x65, 0x77, 0x61, 0x79, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x48, 0x00, 0x52, 0x20, 0x65,
+	0x64, 0x64, 0x73, 0x61, 0x4b, 0x65, 0x79, 0x47, 0x65, 0x6e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x34,
+	0x54, 0x6f, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x42,
+	0x05, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x22, 0x1d, 0x0a, 0x1b, 0x45, 0x64, 0x64, 0x73, 0x61, 0x4b,
+	0x65, 0x79, 0x47, 0x65, 0x6e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x54, 0x6f, 0x31, 0x4f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x36, 0x0a, 0x1a, 0x45, 0x64, 0x64, 0x73, 0x61, 0x4b, 0x65,
+	0x79, 0x47, 0x65, 0x6e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x31, 0x54, 0x6f, 0x32, 0x4f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x36, 0x0a,
+	0x1a, 0x45, 0x64, 0x64, 0x73, 0x61, 0x4b, 0x65, 0x79, 0x47, 0x65, 0x6e, 0x52, 0x6f, 0x75, 0x6e,
+	0x64, 0x32, 0x54, 0x6f, 0x33, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70,
+	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x36, 0x0a, 0x1a, 0x45, 0x64, 0x64, 0x73, 0x61, 0x4b, 0x65,
+	0x79, 0x47, 0x65, 0x6e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x33, 0x54, 0x6f, 0x34, 0x4f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x7a, 0x0a,
+	0x20, 0x45, 0x64, 0x64, 0x73, 0x61, 0x4b, 0x65, 0x79, 0x47, 0x65, 0x6e, 0x52, 0x6f, 0x75, 0x6e,
+	0x64, 0x34, 0x54, 0x6f, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x4f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x22, 0x89, 0x04, 0x0a, 0x10, 0x45, 0x64,
+	0x64, 0x73, 0x61, 0x53, 0x69, 0x67, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x60,
+	0x0a, 0x19, 0x65, 0x64, 0x64, 0x73, 0x61, 0x53, 0x69, 0x67, 0x6e, 0x47, 0x61, 0x74, 0x65, 0x77,
+	0x61, 0x79, 0x54, 0x6f, 0x31, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x20, 0x2e, 0x65, 0x64, 0x64, 0x73, 0x61, 0x2e, 0x45, 0x64, 0x64, 0x73, 0x61, 0x53,
+	0x69, 0x67, 0x6e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x54, 0x6f, 0x31, 0x4f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x48, 0x00, 0x52, 0x19, 0x65, 0x64, 0x64, 0x73, 0x61, 0x53, 0x69, 0x67, 0x6e,
+	0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x54, 0x6f, 0x31, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x12, 0x5d, 0x0a, 0x18, 0x65, 0x64, 0x64, 0x73, 0x61, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x6f, 0x75,
+	0x6e, 0x64, 0x31, 0x54, 0x6f, 0x32, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x65, 0x64, 0x64, 0x73, 0x61, 0x2e, 0x45, 0x64, 0x64, 0x73, 0x61,
+	0x53, 0x69, 0x67, 0x6e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x31, 0x54, 0x6f, 0x32, 0x4f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x48, 0x00, 0x52, 0x18, 0x65, 0x64, 0x64, 0x73, 0x61, 0x53, 0x69, 0x67, 0x6e,
+	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x31, 0x54, 0x6f, 0x32, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12,
+	0x5d, 0x0a, 0x18, 0x65, 0x64, 0x64, 0x73, 0x61, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x6f, 0x75, 0x6e,
+	0x64, 0x32, 0x54, 0x6f, 0x33, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x65, 0x64, 0x64, 0x73, 0x61, 0x2e, 0x45, 0x64, 0x64, 0x73, 0x61, 0x53,
+	0x69, 0x67, 0x6e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x32, 0x54, 0x6f, 0x33, 0x4f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x48, 0x00, 0x52, 0x18, 0x65, 0x64, 0x64, 0x73, 0x61, 0x53, 0x69, 0x67, 0x6e, 0x52,
+	0x6f, 0x75, 0x6e, 0x64, 0x32, 0x54, 0x6f, 0x33, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x5d,
+	0x0a, 0x18, 0x65, 0x64, 0x64, 0x73, 0x61, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x6f, 0x75, 0x6e, 0x64,
+	0x33, 0x54, 0x6f, 0x34, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1f, 0x2e, 0x65, 0x64, 0x64, 0x73, 0x61, 0x2e, 0x45, 0x64, 0x64, 0x73, 0x61, 0x53, 0x69,
+	0x67, 0x6e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x33, 0x54, 0x6f, 0x34, 0x4f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x48, 0x00, 0x52, 0x18, 0x65, 0x64, 0x64, 0x73, 0x61, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x6f,
+	0x75, 0x6e, 0x64, 0x33, 0x54, 0x6f, 0x34, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x6f, 0x0a,
+	0x1e, 0x65, 0x64, 0x64, 0x73, 0x61, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x34,
+	0x54, 0x6f, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x65, 0x64, 0x64, 0x73, 0x61, 0x2e, 0x45, 0x64,
+	0x64, 0x73, 0x61, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x34, 0x54, 0x6f, 0x47,
+	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x48, 0x00, 0x52, 0x1e,
+	0x65, 0x64, 0x64, 0x73, 0x61, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x34, 0x54,
+	0x6f, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x42, 0x05,
+	0x0a, 0x03, 0x6d, 0x73, 0x67, 0x22, 0x1b, 0x0a, 0x19, 0x45, 0x64, 0x64, 0x73, 0x61, 0x53, 0x69,
+	0x67, 0x6e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x54, 0x6f, 0x31, 0x4f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x22, 0x34, 0x0a, 0x18, 0x45, 0x64, 0x64, 0x73, 0x61, 0x53, 0x69, 0x67, 0x6e, 0x52,
+	0x6f, 0x75, 0x6e, 0x64, 0x31, 0x54, 0x6f, 0x32, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x34, 0x0a, 0x18, 0x45, 0x64, 0x64, 0x73,
+	0x61, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x32, 0x54, 0x6f, 0x33, 0x4f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x34,
+	0x0a, 0x18, 0x45, 0x64, 0x64, 0x73, 0x61, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x6f, 0x75, 0x6e, 0x64,
+	0x33, 0x54, 0x6f, 0x34, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79,
+	0x6c, 0x6f, 0x61, 0x64, 0x22, 0x5d, 0x0a, 0x1e, 0x45, 0x64, 0x64, 0x73, 0x61, 0x53, 0x69, 0x67,
+	0x6e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x34, 0x54, 0x6f, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
+	0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x32, 0x58, 0x0a, 0x12, 0x45, 0x64, 0x64, 0x73, 0x61, 0x4b, 0x65, 0x79, 0x67,
+	0x65, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x42, 0x0a, 0x06, 0x4b, 0x65, 0x79,
+	0x47, 0x65, 0x6e, 0x12, 0x19, 0x2e, 0x65, 0x64, 0x64, 0x73, 0x61, 0x2e, 0x45, 0x64, 0x64, 0x73,
+	0x61, 0x4b, 0x65, 0x79, 0x67, 0x65, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x19,
+	0x2e, 0x65, 0x64, 0x64, 0x73, 0x61, 0x2e, 0x45, 0x64, 0x64, 0x73, 0x61, 0x4b, 0x65, 0x79, 0x67,
+	0x65, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x28, 0x01, 0x30, 0x01, 0x32, 0x50, 0x0a,
+	0x10, 0x45, 0x64, 0x64, 0x73, 0x61, 0x53, 0x69, 0x67, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x3c, 0x0a, 0x04, 0x53, 0x69, 0x67, 0x6e, 0x12, 0x17, 0x2e, 0x65, 0x64, 0x64, 0x73,
+	0x61, 0x2e, 0x45, 0x64, 0x64, 0x73, 0x61, 0x53, 0x69, 0x67, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x1a, 0x17, 0x2e, 0x65, 0x64, 0x64, 0x73, 0x61, 0x2e, 0x45, 0x64, 0x64, 0x73, 0x61,
+	0x53, 0x69, 0x67, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x28, 0x01, 0x30, 0x01, 0x42,
+	0x14, 0x5a, 0x12, 0x74, 0x65, 0x63, 0x64, 0x73, 0x61, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
+	0x65, 0x64, 0x64, 0x73, 0x61, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_eddsa_eddsa_proto_rawDescOnce sync.Once
+	file_eddsa_eddsa_proto_rawDescData = file_eddsa_eddsa_proto_rawDesc
+)
+
+func file_eddsa_eddsa_proto_rawDescGZIP() []byte {
+	file_eddsa_eddsa_proto_rawDescOnce.Do(func() {
+		file_eddsa_eddsa_proto_rawDescData = protoimpl.X.CompressGZIP(file_eddsa_eddsa_proto_rawDescData)
+	})
+	return file_eddsa_eddsa_proto_rawDescData
+}
+
+var file_eddsa_eddsa_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_eddsa_eddsa_proto_goTypes = []any{
+	(*EddsaKeygenMessage)(nil),               // 0: eddsa.EddsaKeygenMessage
+	(*EddsaKeyGenGatewayTo1Output)(nil),      // 1: eddsa.EddsaKeyGenGatewayTo1Output
+	(*EddsaKeyGenRound1To2Output)(nil),       // 2: eddsa.EddsaKeyGenRound1To2Output
+	(*EddsaKeyGenRound2To3Output)(nil),       // 3: eddsa.EddsaKeyGenRound2To3Output
+	(*EddsaKeyGenRound3To4Output)(nil),       // 4: eddsa.EddsaKeyGenRound3To4Output
+	(*EddsaKeyGenRound4ToGatewayOutput)(nil), // 5: eddsa.EddsaKeyGenRound4ToGatewayOutput
+	(*EddsaSignMessage)(nil),                 // 6: eddsa.EddsaSignMessage
+	(*EddsaSignGatewayTo1Output)(nil),        // 7: eddsa.EddsaSignGatewayTo1Output
+	(*EddsaSignRound1To2Output)(nil),         // 8: eddsa.EddsaSignRound1To2Output
+	(*EddsaSignRound2To3Output)(nil),         // 9: eddsa.EddsaSignRound2To3Output
+	(*EddsaSignRound3To4Output)(nil),         // 10: eddsa.EddsaSignRound3To4Output
+	(*EddsaSignRound4ToGatewayOutput)(nil),   // 11: eddsa.EddsaSignRound4ToGatewayOutput
+}
+var file_eddsa_eddsa_proto_depIdxs = []int32{
+	1,  // 0: eddsa.EddsaKeygenMessage.eddsaKeyGenGatewayTo1Output:type_name -> eddsa.EddsaKeyGenGatewayTo1Output
+	2,  // 1: eddsa.EddsaKeygenMessage.eddsaKeyGenRound1To2Output:type_name -> eddsa.EddsaKeyGenRound1To2Output
+	3,  // 2: eddsa.EddsaKeygenMessage.eddsaKeyGenRound2To3Output:type_name -> eddsa.EddsaKeyGenRound2To3Output
+	4,  // 3: eddsa.EddsaKeygenMessage.eddsaKeyGenRound3To4Output:type_name -> eddsa.EddsaKeyGenRound3To4Output
+	5,  // 4: eddsa.EddsaKeygenMessage.eddsaKeyGenRound4ToGatewayOutput:type_name -> eddsa.EddsaKeyGenRound4ToGatewayOutput
+	7,  // 5: eddsa.EddsaSignMessage.eddsaSignGatewayTo1Output:type_name -> eddsa.EddsaSignGatewayTo1Output
+	8,  // 6: eddsa.EddsaSignMessage.eddsaSignRound1To2Output:type_name -> eddsa.EddsaSignRound1To2Output
+	9,  // 7: eddsa.EddsaSignMessage.eddsaSignRound2To3Output:type_name -> eddsa.EddsaSignRound2To3Output
+	10, // 8: eddsa.EddsaSignMessage.eddsaSignRound3To4Output:type_name -> eddsa.EddsaSignRound3To4Output
+	11, // 9: eddsa.EddsaSignMessage.eddsaSignRound4ToGatewayOutput:type_name -> eddsa.EddsaSignRound4ToGatewayOutput
+	0,  // 10: eddsa.EddsaKeygenService.KeyGen:input_type -> eddsa.EddsaKeygenMessage
+	6,  // 11: eddsa.EddsaSignService.Sign:input_type -> eddsa.EddsaSignMessage
+	0,  // 12: eddsa.EddsaKeygenService.KeyGen:output_type -> eddsa.EddsaKeygenMessage
+	6,  // 13: eddsa.EddsaSignService.Sign:output_type -> eddsa.EddsaSignMessage
+	12, // [12:14] is the sub-list for method output_type
+	10, // [10:12] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_eddsa_eddsa_proto_init() }
+func file_eddsa_eddsa_proto_init() {
+	if File_eddsa_eddsa_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_eddsa_eddsa_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*EddsaKeygenMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eddsa_eddsa_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*EddsaKeyGenGatewayTo1Output); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eddsa_eddsa_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*EddsaKeyGenRound1To2Output); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eddsa_eddsa_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*EddsaKeyGenRound2To3Output); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eddsa_eddsa_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*EddsaKeyGenRound3To4Output); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eddsa_eddsa_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*EddsaKeyGenRound4ToGatewayOutput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eddsa_eddsa_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*EddsaSignMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eddsa_eddsa_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*EddsaSignGatewayTo1Output); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eddsa_eddsa_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*EddsaSignRound1To2Output); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eddsa_eddsa_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*EddsaSignRound2To3Output); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eddsa_eddsa_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*EddsaSignRound3To4Output); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eddsa_eddsa_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*EddsaSignRound4ToGatewayOutput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_eddsa_eddsa_proto_msgTypes[0].OneofWrappers = []any{
+		(*EddsaKeygenMessage_EddsaKeyGenGatewayTo1Output)(nil),
+		(*EddsaKeygenMessage_EddsaKeyGenRound1To2Output)(nil),
+		(*EddsaKeygenMessage_EddsaKeyGenRound2To3Output)(nil),
+		(*EddsaKeygenMessage_EddsaKeyGenRound3To4Output)(nil),
+		(*EddsaKeygenMessage_EddsaKeyGenRound4ToGatewayOutput)(nil),
+	}
+	file_eddsa_eddsa_proto_msgTypes[6].OneofWrappers = []any{
+		(*EddsaSignMessage_EddsaSignGatewayTo1Output)(nil),
+		(*EddsaSignMessage_EddsaSignRound1To2Output)(nil),
+		(*EddsaSignMessage_EddsaSignRound2To3Output)(nil),
+		(*EddsaSignMessage_EddsaSignRound3To4Output)(nil),
+		(*EddsaSignMessage_EddsaSignRound4ToGatewayOutput)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_eddsa_eddsa_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   12,
+			NumExtensions: 0,
+			NumServices:   2,
+		},
+		GoTypes:           file_eddsa_eddsa_proto_goTypes,
+		DependencyIndexes: file_eddsa_eddsa_proto_depIdxs,
+		MessageInfos:      file_eddsa_eddsa_proto_msgTypes,
+	}.Build()
+	File_eddsa_eddsa_proto = out.File
+	file_eddsa_eddsa_proto_rawDesc = nil
+	file_eddsa_eddsa_proto_goTypes = nil
+	file_eddsa_eddsa_proto_depIdxs = nil
+}
@@ -0,0 +1,265 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.4.0
+// - protoc             v5.27.1
+// source: eddsa/eddsa.proto
+
+package eddsa
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.62.0 or later.
+const _ = grpc.SupportPackageIsVersion8
+
+const (
+	EddsaKeygenService_KeyGen_FullMethodName = "/eddsa.EddsaKeygenService/KeyGen"
+)
+
+// EddsaKeygenServiceClient is the client API for EddsaKeygenService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type EddsaKeygenServiceClient interface {
+	KeyGen(ctx context.Context, opts ...grpc.CallOption) (EddsaKeygenService_KeyGenClient, error)
+}
+
+type eddsaKeygenServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewEddsaKeygenServiceClient(cc grpc.ClientConnInterface) EddsaKeygenServiceClient {
+	return &eddsaKeygenServiceClient{cc}
+}
+
+func (c *eddsaKeygenServiceClient) KeyGen(ctx context.Context, opts ...grpc.CallOption) (EddsaKeygenService_KeyGenClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &EddsaKeygenService_ServiceDesc.Streams[0], EddsaKeygenService_KeyGen_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &eddsaKeygenServiceKeyGenClient{ClientStream: stream}
+	return x, nil
+}
+
+type EddsaKeygenService_KeyGenClient interface {
+	Send(*EddsaKeygenMessage) error
+	Recv() (*EddsaKeygenMessage, error)
+	grpc.ClientStream
+}
+
+type eddsaKeygenServiceKeyGenClient struct {
+	grpc.ClientStream
+}
+
+func (x *eddsaKeygenServiceKeyGenClient) Send(m *EddsaKeygenMessage) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *eddsaKeygenServiceKeyGenClient) Recv() (*EddsaKeygenMessage, error) {
+	m := new(EddsaKeygenMessage)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// EddsaKeygenServiceServer is the server API for EddsaKeygenService service.
+// All implementations must embed UnimplementedEddsaKeygenServiceServer
+// for forward compatibility
+type EddsaKeygenServiceServer interface {
+	KeyGen(EddsaKeygenService_KeyGenServer) error
+	mustEmbedUnimplementedEddsaKeygenServiceServer()
+}
+
+// UnimplementedEddsaKeygenServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedEddsaKeygenServiceServer struct {
+}
+
+func (UnimplementedEddsaKeygenServiceServer) KeyGen(EddsaKeygenService_KeyGenServer) error {
+	return status.Errorf(codes.Unimplemented, "method KeyGen not implemented")
+}
+func (UnimplementedEddsaKeygenServiceServer) mustEmbedUnimplementedEddsaKeygenServiceServer() {}
+
+// UnsafeEddsaKeygenServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to EddsaKeygenServiceServer will
+// result in compilation errors.
+type UnsafeEddsaKeygenServiceServer interface {
+	mustEmbedUnimplementedEddsaKeygenServiceServer()
+}
+
+func RegisterEddsaKeygenServiceServer(s grpc.ServiceRegistrar, srv EddsaKeygenServiceServer) {
+	s.RegisterService(&EddsaKeygenService_ServiceDesc, srv)
+}
+
+func _EddsaKeygenService_KeyGen_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(EddsaKeygenServiceServer).KeyGen(&eddsaKeygenServiceKeyGenServer{ServerStream: stream})
+}
+
+type EddsaKeygenService_KeyGenServer interface {
+	Send(*EddsaKeygenMessage) error
+	Recv() (*EddsaKeygenMessage, error)
+	grpc.ServerStream
+}
+
+type eddsaKeygenServiceKeyGenServer struct {
+	grpc.ServerStream
+}
+
+func (x *eddsaKeygenServiceKeyGenServer) Send(m *EddsaKeygenMessage) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *eddsaKeygenServiceKeyGenServer) Recv() (*EddsaKeygenMessage, error) {
+	m := new(EddsaKeygenMessage)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// EddsaKeygenService_ServiceDesc is the grpc.ServiceDesc for EddsaKeygenService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var EddsaKeygenService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "eddsa.EddsaKeygenService",
+	HandlerType: (*EddsaKeygenServiceServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "KeyGen",
+			Handler:       _EddsaKeygenService_KeyGen_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "eddsa/eddsa.proto",
+}
+
+const (
+	EddsaSignService_Sign_FullMethodName = "/eddsa.EddsaSignService/Sign"
+)
+
+// EddsaSignServiceClient is the client API for EddsaSignService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type EddsaSignServiceClient interface {
+	Sign(ctx context.Context, opts ...grpc.CallOption) (EddsaSignService_SignClient, error)
+}
+
+type eddsaSignServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewEddsaSignServiceClient(cc grpc.ClientConnInterface) EddsaSignServiceClient {
+	return &eddsaSignServiceClient{cc}
+}
+
+func (c *eddsaSignServiceClient) Sign(ctx context.Context, opts ...grpc.CallOption) (EddsaSignService_SignClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &EddsaSignService_ServiceDesc.Streams[0], EddsaSignService_Sign_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &eddsaSignServiceSignClient{ClientStream: stream}
+	return x, nil
+}
+
+type EddsaSignService_SignClient interface {
+	Send(*EddsaSignMessage) error
+	Recv() (*EddsaSignMessage, error)
+	grpc.ClientStream
+}
+
+type eddsaSignServiceSignClient struct {
+	grpc.ClientStream
+}
+
+func (x *eddsaSignServiceSignClient) Send(m *EddsaSignMessage) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *eddsaSignServiceSignClient) Recv() (*EddsaSignMessage, error) {
+	m := new(EddsaSignMessage)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// EddsaSignServiceServer is the server API for EddsaSignService service.
+// All implementations must embed UnimplementedEddsaSignServiceServer
+// for forward compatibility
+type EddsaSignServiceServer interface {
+	Sign(EddsaSignService_SignServer) error
+	mustEmbedUnimplementedEddsaSignServiceServer()
+}
+
+// UnimplementedEddsaSignServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedEddsaSignServiceServer struct {
+}
+
+func (UnimplementedEddsaSignServiceServer) Sign(EddsaSignService_SignServer) error {
+	return status.Errorf(codes.Unimplemented, "method Sign not implemented")
+}
+func (UnimplementedEddsaSignServiceServer) mustEmbedUnimplementedEddsaSignServiceServer() {}
+
+// UnsafeEddsaSignServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to EddsaSignServiceServer will
+// result in compilation errors.
+type UnsafeEddsaSignServiceServer interface {
+	mustEmbedUnimplementedEddsaSignServiceServer()
+}
+
+func RegisterEddsaSignServiceServer(s grpc.ServiceRegistrar, srv EddsaSignServiceServer) {
+	s.RegisterService(&EddsaSignService_ServiceDesc, srv)
+}
+
+func _EddsaSignService_Sign_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(EddsaSignServiceServer).Sign(&eddsaSignServiceSignServer{ServerStream: stream})
+}
+
+type EddsaSignService_SignServer interface {
+	Send(*EddsaSignMessage) error
+	Recv() (*EddsaSignMessage, error)
+	grpc.ServerStream
+}
+
+type eddsaSignServiceSignServer struct {
+	grpc.ServerStream
+}
+
+func (x *eddsaSignServiceSignServer) Send(m *EddsaSignMessage) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *eddsaSignServiceSignServer) Recv() (*EddsaSignMessage, error) {
+	m := new(EddsaSignMessage)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// EddsaSignService_ServiceDesc is the grpc.ServiceDesc for EddsaSignService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var EddsaSignService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "eddsa.EddsaSignService",
+	HandlerType: (*EddsaSignServiceServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Sign",
+			Handler:       _EddsaSignService_Sign_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "eddsa/eddsa.proto",
+}
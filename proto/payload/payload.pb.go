@@ -0,0 +1,2342 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        v5.27.1
+// source: payload/payload.proto
+
+package payload
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Curve 는 payload 의 점과 스칼라가 속한 곡선입니다. 곡선 원소가 없는 메시지는 CURVE_UNSPECIFIED 입니다.
+// 스칼라는 곡선의 표준 바이트 표현, 점은 압축 형식으로 저장합니다.
+type Curve int32
+
+const (
+	Curve_CURVE_UNSPECIFIED Curve = 0
+	Curve_CURVE_SECP256K1   Curve = 1
+	Curve_CURVE_P256        Curve = 2
+	Curve_CURVE_ED25519     Curve = 3
+)
+
+// Enum value maps for Curve.
+var (
+	Curve_name = map[int32]string{
+		0: "CURVE_UNSPECIFIED",
+		1: "CURVE_SECP256K1",
+		2: "CURVE_P256",
+		3: "CURVE_ED25519",
+	}
+	Curve_value = map[string]int32{
+		"CURVE_UNSPECIFIED": 0,
+		"CURVE_SECP256K1":   1,
+		"CURVE_P256":        2,
+		"CURVE_ED25519":     3,
+	}
+)
+
+func (x Curve) Enum() *Curve {
+	p := new(Curve)
+	*p = x
+	return p
+}
+
+func (x Curve) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Curve) Descriptor() protoreflect.EnumDescriptor {
+	return file_payload_payload_proto_enumTypes[0].Descriptor()
+}
+
+func (Curve) Type() protoreflect.EnumType {
+	return &file_payload_payload_proto_enumTypes[0]
+}
+
+func (x Curve) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Curve.Descriptor instead.
+func (Curve) EnumDescriptor() ([]byte, []int) {
+	return file_payload_payload_proto_rawDescGZIP(), []int{0}
+}
+
+// Protocol 은 payload 의 메시지 종류입니다. 주석은 payload 의 메시지 타입입니다.
+type Protocol int32
+
+const (
+	Protocol_PROTOCOL_UNSPECIFIED Protocol = 0
+	// DKLs ECDSA 키 생성
+	Protocol_DKLS_DKG_ROUND1  Protocol = 1  // Bytes
+	Protocol_DKLS_DKG_ROUND2  Protocol = 2  // DklsDkgRound2
+	Protocol_DKLS_DKG_ROUND3  Protocol = 3  // SchnorrProof
+	Protocol_DKLS_DKG_ROUND4  Protocol = 4  // SchnorrProof
+	Protocol_DKLS_DKG_ROUND5  Protocol = 5  // SchnorrProof
+	Protocol_DKLS_DKG_ROUND6  Protocol = 6  // BytesList (receiver masked choices)
+	Protocol_DKLS_DKG_ROUND7  Protocol = 7  // BytesList (OT challenges)
+	Protocol_DKLS_DKG_ROUND8  Protocol = 8  // BytesList (OT challenge responses)
+	Protocol_DKLS_DKG_ROUND9  Protocol = 9  // BytesPairList (challenge openings)
+	Protocol_DKLS_ALICE_SHARE Protocol = 10 // DklsAliceShare
+	Protocol_DKLS_BOB_SHARE   Protocol = 11 // DklsBobShare
+	// DKLs ECDSA 서명
+	Protocol_DKLS_SIGN_ROUND1 Protocol = 20 // Bytes
+	Protocol_DKLS_SIGN_ROUND2 Protocol = 21 // DklsSignRound2
+	Protocol_DKLS_SIGN_ROUND3 Protocol = 22 // DklsSignRound3
+	// DKLs ECDSA presignature
+	Protocol_PRESIGN_ROUND1     Protocol = 30 // Bytes
+	Protocol_PRESIGN_ROUND2     Protocol = 31 // DklsSignRound2
+	Protocol_PRESIGN_ROUND3     Protocol = 32 // DklsSignRound3 (eta_sig 없음)
+	Protocol_PRESIGN_ONLINE     Protocol = 33 // PresignOnline
+	Protocol_ALICE_PRESIGNATURE Protocol = 34 // AlicePresignature
+	Protocol_BOB_PRESIGNATURE   Protocol = 35 // BobPresignature
+	// 2-of-2 Ed25519
+	Protocol_EDDSA_DKG_ROUND1  Protocol = 40 // Bytes
+	Protocol_EDDSA_DKG_ROUND2  Protocol = 41 // EddsaDkgRound
+	Protocol_EDDSA_DKG_ROUND3  Protocol = 42 // EddsaDkgRound
+	Protocol_EDDSA_SHARE       Protocol = 43 // EddsaShare
+	Protocol_EDDSA_SIGN_ROUND1 Protocol = 44 // NonceCommitment
+	Protocol_EDDSA_SIGN_ROUND2 Protocol = 45 // PartialSignature
+	Protocol_EDDSA_SIGN_ROUND3 Protocol = 46 // PartialSignature
+	// 2-of-2 BIP-340 Schnorr (Taproot)
+	Protocol_TAPROOT_SIGN_ROUND1 Protocol = 50 // NonceCommitment
+	Protocol_TAPROOT_SIGN_ROUND2 Protocol = 51 // TaprootSignRound2
+	Protocol_TAPROOT_SIGN_ROUND3 Protocol = 52 // TaprootSignRound3
+)
+
+// Enum value maps for Protocol.
+var (
+	Protocol_name = map[int32]string{
+		0:  "PROTOCOL_UNSPECIFIED",
+		1:  "DKLS_DKG_ROUND1",
+		2:  "DKLS_DKG_ROUND2",
+		3:  "DKLS_DKG_ROUND3",
+		4:  "DKLS_DKG_ROUND4",
+		5:  "DKLS_DKG_ROUND5",
+		6:  "DKLS_DKG_ROUND6",
+		7:  "DKLS_DKG_ROUND7",
+		8:  "DKLS_DKG_ROUND8",
+		9:  "DKLS_DKG_ROUND9",
+		10: "DKLS_ALICE_SHARE",
+		11: "DKLS_BOB_SHARE",
+		20: "DKLS_SIGN_ROUND1",
+		21: "DKLS_SIGN_ROUND2",
+		22: "DKLS_SIGN_ROUND3",
+		30: "PRESIGN_ROUND1",
+		31: "PRESIGN_ROUND2",
+		32: "PRESIGN_ROUND3",
+		33: "PRESIGN_ONLINE",
+		34: "ALICE_PRESIGNATURE",
+		35: "BOB_PRESIGNATURE",
+		40: "EDDSA_DKG_ROUND1",
+		41: "EDDSA_DKG_ROUND2",
+		42: "EDDSA_DKG_ROUND3",
+		43: "EDDSA_SHARE",
+		44: "EDDSA_SIGN_ROUND1",
+		45: "EDDSA_SIGN_ROUND2",
+		46: "EDDSA_SIGN_ROUND3",
+		50: "TAPROOT_SIGN_ROUND1",
+		51: "TAPROOT_SIGN_ROUND2",
+		52: "TAPROOT_SIGN_ROUND3",
+	}
+	Protocol_value = map[string]int32{
+		"PROTOCOL_UNSPECIFIED": 0,
+		"DKLS_DKG_ROUND1":      1,
+		"DKLS_DKG_ROUND2":      2,
+		"DKLS_DKG_ROUND3":      3,
+		"DKLS_DKG_ROUND4":      4,
+		"DKLS_DKG_ROUND5":      5,
+		"DKLS_DKG_ROUND6":      6,
+		"DKLS_DKG_ROUND7":      7,
+		"DKLS_DKG_ROUND8":      8,
+		"DKLS_DKG_ROUND9":      9,
+		"DKLS_ALICE_SHARE":     10,
+		"DKLS_BOB_SHARE":       11,
+		"DKLS_SIGN_ROUND1":     20,
+		"DKLS_SIGN_ROUND2":     21,
+		"DKLS_SIGN_ROUND3":     22,
+		"PRESIGN_ROUND1":       30,
+		"PRESIGN_ROUND2":       31,
+		"PRESIGN_ROUND3":       32,
+		"PRESIGN_ONLINE":       33,
+		"ALICE_PRESIGNATURE":   34,
+		"BOB_PRESIGNATURE":     35,
+		"EDDSA_DKG_ROUND1":     40,
+		"EDDSA_DKG_ROUND2":     41,
+		"EDDSA_DKG_ROUND3":     42,
+		"EDDSA_SHARE":          43,
+		"EDDSA_SIGN_ROUND1":    44,
+		"EDDSA_SIGN_ROUND2":    45,
+		"EDDSA_SIGN_ROUND3":    46,
+		"TAPROOT_SIGN_ROUND1":  50,
+		"TAPROOT_SIGN_ROUND2":  51,
+		"TAPROOT_SIGN_ROUND3":  52,
+	}
+)
+
+func (x Protocol) Enum() *Protocol {
+	p := new(Protocol)
+	*p = x
+	return p
+}
+
+func (x Protocol) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Protocol) Descriptor() protoreflect.EnumDescriptor {
+	return file_payload_payload_proto_enumTypes[1].Descriptor()
+}
+
+func (Protocol) Type() protoreflect.EnumType {
+	return &file_payload_payload_proto_enumTypes[1]
+}
+
+func (x Protocol) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Protocol.Descriptor instead.
+func (Protocol) EnumDescriptor() ([]byte, []int) {
+	return file_payload_payload_proto_rawDescGZIP(), []int{1}
+}
+
+// Envelope 는 라운드 메시지와 저장된 쉐어, presignature 를 감싸는 버전 정보입니다.
+// 직렬화된 Envelope 앞에는 이전 gob 인코딩과 구분하기 위한 매직 바이트 00 54 45 43 ("\x00TEC") 가 붙습니다.
+// payload 는 protocol 에 적힌 메시지를 protobuf 로 직렬화한 값입니다.
+type Envelope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Version  uint32   `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Curve    Curve    `protobuf:"varint,2,opt,name=curve,proto3,enum=payload.Curve" json:"curve,omitempty"`
+	Protocol Protocol `protobuf:"varint,3,opt,name=protocol,proto3,enum=payload.Protocol" json:"protocol,omitempty"`
+	Payload  []byte   `protobuf:"bytes,4,opt,name=payload,proto3" json:"payload,omitempty"`
+}
+
+func (x *Envelope) Reset() {
+	*x = Envelope{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payload_payload_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Envelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Envelope) ProtoMessage() {}
+
+func (x *Envelope) ProtoReflect() protoreflect.Message {
+	mi := &file_payload_payload_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Envelope.ProtoReflect.Descriptor instead.
+func (*Envelope) Descriptor() ([]byte, []int) {
+	return file_payload_payload_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Envelope) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Envelope) GetCurve() Curve {
+	if x != nil {
+		return x.Curve
+	}
+	return Curve_CURVE_UNSPECIFIED
+}
+
+func (x *Envelope) GetProtocol() Protocol {
+	if x != nil {
+		return x.Protocol
+	}
+	return Protocol_PROTOCOL_UNSPECIFIED
+}
+
+func (x *Envelope) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+type Bytes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value []byte `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *Bytes) Reset() {
+	*x = Bytes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payload_payload_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Bytes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Bytes) ProtoMessage() {}
+
+func (x *Bytes) ProtoReflect() protoreflect.Message {
+	mi := &file_payload_payload_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Bytes.ProtoReflect.Descriptor instead.
+func (*Bytes) Descriptor() ([]byte, []int) {
+	return file_payload_payload_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Bytes) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type BytesList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Values [][]byte `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *BytesList) Reset() {
+	*x = BytesList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payload_payload_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BytesList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BytesList) ProtoMessage() {}
+
+func (x *BytesList) ProtoReflect() protoreflect.Message {
+	mi := &file_payload_payload_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BytesList.ProtoReflect.Descriptor instead.
+func (*BytesList) Descriptor() ([]byte, []int) {
+	return file_payload_payload_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *BytesList) GetValues() [][]byte {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type BytesPair struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	First  []byte `protobuf:"bytes,1,opt,name=first,proto3" json:"first,omitempty"`
+	Second []byte `protobuf:"bytes,2,opt,name=second,proto3" json:"second,omitempty"`
+}
+
+func (x *BytesPair) Reset() {
+	*x = BytesPair{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payload_payload_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BytesPair) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BytesPair) ProtoMessage() {}
+
+func (x *BytesPair) ProtoReflect() protoreflect.Message {
+	mi := &file_payload_payload_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BytesPair.ProtoReflect.Descriptor instead.
+func (*BytesPair) Descriptor() ([]byte, []int) {
+	return file_payload_payload_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *BytesPair) GetFirst() []byte {
+	if x != nil {
+		return x.First
+	}
+	return nil
+}
+
+func (x *BytesPair) GetSecond() []byte {
+	if x != nil {
+		return x.Second
+	}
+	return nil
+}
+
+type BytesPairList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pairs []*BytesPair `protobuf:"bytes,1,rep,name=pairs,proto3" json:"pairs,omitempty"`
+}
+
+func (x *BytesPairList) Reset() {
+	*x = BytesPairList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payload_payload_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BytesPairList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BytesPairList) ProtoMessage() {}
+
+func (x *BytesPairList) ProtoReflect() protoreflect.Message {
+	mi := &file_payload_payload_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BytesPairList.ProtoReflect.Descriptor instead.
+func (*BytesPairList) Descriptor() ([]byte, []int) {
+	return file_payload_payload_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *BytesPairList) GetPairs() []*BytesPair {
+	if x != nil {
+		return x.Pairs
+	}
+	return nil
+}
+
+// SchnorrProof 는 이산로그 지식 증명 (c, s) 와 증명 대상 점입니다.
+type SchnorrProof struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	C         []byte `protobuf:"bytes,1,opt,name=c,proto3" json:"c,omitempty"`
+	S         []byte `protobuf:"bytes,2,opt,name=s,proto3" json:"s,omitempty"`
+	Statement []byte `protobuf:"bytes,3,opt,name=statement,proto3" json:"statement,omitempty"`
+}
+
+func (x *SchnorrProof) Reset() {
+	*x = SchnorrProof{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payload_payload_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SchnorrProof) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchnorrProof) ProtoMessage() {}
+
+func (x *SchnorrProof) ProtoReflect() protoreflect.Message {
+	mi := &file_payload_payload_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchnorrProof.ProtoReflect.Descriptor instead.
+func (*SchnorrProof) Descriptor() ([]byte, []int) {
+	return file_payload_payload_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SchnorrProof) GetC() []byte {
+	if x != nil {
+		return x.C
+	}
+	return nil
+}
+
+func (x *SchnorrProof) GetS() []byte {
+	if x != nil {
+		return x.S
+	}
+	return nil
+}
+
+func (x *SchnorrProof) GetStatement() []byte {
+	if x != nil {
+		return x.Statement
+	}
+	return nil
+}
+
+type DklsDkgRound2 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Seed       []byte `protobuf:"bytes,1,opt,name=seed,proto3" json:"seed,omitempty"`
+	Commitment []byte `protobuf:"bytes,2,opt,name=commitment,proto3" json:"commitment,omitempty"`
+}
+
+func (x *DklsDkgRound2) Reset() {
+	*x = DklsDkgRound2{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payload_payload_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DklsDkgRound2) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DklsDkgRound2) ProtoMessage() {}
+
+func (x *DklsDkgRound2) ProtoReflect() protoreflect.Message {
+	mi := &file_payload_payload_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DklsDkgRound2.ProtoReflect.Descriptor instead.
+func (*DklsDkgRound2) Descriptor() ([]byte, []int) {
+	return file_payload_payload_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DklsDkgRound2) GetSeed() []byte {
+	if x != nil {
+		return x.Seed
+	}
+	return nil
+}
+
+func (x *DklsDkgRound2) GetCommitment() []byte {
+	if x != nil {
+		return x.Commitment
+	}
+	return nil
+}
+
+// SeedOtReceiverOutput 은 base OT 의 수신자 결과입니다 (Alice 의 쉐어에 포함).
+type SeedOtReceiverOutput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PackedRandomChoiceBits   []byte   `protobuf:"bytes,1,opt,name=packed_random_choice_bits,json=packedRandomChoiceBits,proto3" json:"packed_random_choice_bits,omitempty"`
+	RandomChoiceBits         []uint32 `protobuf:"varint,2,rep,packed,name=random_choice_bits,json=randomChoiceBits,proto3" json:"random_choice_bits,omitempty"`
+	OneTimePadDecryptionKeys [][]byte `protobuf:"bytes,3,rep,name=one_time_pad_decryption_keys,json=oneTimePadDecryptionKeys,proto3" json:"one_time_pad_decryption_keys,omitempty"`
+}
+
+func (x *SeedOtReceiverOutput) Reset() {
+	*x = SeedOtReceiverOutput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payload_payload_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SeedOtReceiverOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeedOtReceiverOutput) ProtoMessage() {}
+
+func (x *SeedOtReceiverOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_payload_payload_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeedOtReceiverOutput.ProtoReflect.Descriptor instead.
+func (*SeedOtReceiverOutput) Descriptor() ([]byte, []int) {
+	return file_payload_payload_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SeedOtReceiverOutput) GetPackedRandomChoiceBits() []byte {
+	if x != nil {
+		return x.PackedRandomChoiceBits
+	}
+	return nil
+}
+
+func (x *SeedOtReceiverOutput) GetRandomChoiceBits() []uint32 {
+	if x != nil {
+		return x.RandomChoiceBits
+	}
+	return nil
+}
+
+func (x *SeedOtReceiverOutput) GetOneTimePadDecryptionKeys() [][]byte {
+	if x != nil {
+		return x.OneTimePadDecryptionKeys
+	}
+	return nil
+}
+
+// SeedOtSenderOutput 은 base OT 의 송신자 결과입니다 (Bob 의 쉐어에 포함).
+type SeedOtSenderOutput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OneTimePadEncryptionKeys []*BytesPair `protobuf:"bytes,1,rep,name=one_time_pad_encryption_keys,json=oneTimePadEncryptionKeys,proto3" json:"one_time_pad_encryption_keys,omitempty"`
+}
+
+func (x *SeedOtSenderOutput) Reset() {
+	*x = SeedOtSenderOutput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payload_payload_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SeedOtSenderOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeedOtSenderOutput) ProtoMessage() {}
+
+func (x *SeedOtSenderOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_payload_payload_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeedOtSenderOutput.ProtoReflect.Descriptor instead.
+func (*SeedOtSenderOutput) Descriptor() ([]byte, []int) {
+	return file_payload_payload_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SeedOtSenderOutput) GetOneTimePadEncryptionKeys() []*BytesPair {
+	if x != nil {
+		return x.OneTimePadEncryptionKeys
+	}
+	return nil
+}
+
+type DklsAliceShare struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PublicKey      []byte                `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	SecretKeyShare []byte                `protobuf:"bytes,2,opt,name=secret_key_share,json=secretKeyShare,proto3" json:"secret_key_share,omitempty"`
+	SeedOtResult   *SeedOtReceiverOutput `protobuf:"bytes,3,opt,name=seed_ot_result,json=seedOtResult,proto3" json:"seed_ot_result,omitempty"`
+}
+
+func (x *DklsAliceShare) Reset() {
+	*x = DklsAliceShare{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payload_payload_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DklsAliceShare) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DklsAliceShare) ProtoMessage() {}
+
+func (x *DklsAliceShare) ProtoReflect() protoreflect.Message {
+	mi := &file_payload_payload_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DklsAliceShare.ProtoReflect.Descriptor instead.
+func (*DklsAliceShare) Descriptor() ([]byte, []int) {
+	return file_payload_payload_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DklsAliceShare) GetPublicKey() []byte {
+	if x != nil {
+		return x.PublicKey
+	}
+	return nil
+}
+
+func (x *DklsAliceShare) GetSecretKeyShare() []byte {
+	if x != nil {
+		return x.SecretKeyShare
+	}
+	return nil
+}
+
+func (x *DklsAliceShare) GetSeedOtResult() *SeedOtReceiverOutput {
+	if x != nil {
+		return x.SeedOtResult
+	}
+	return nil
+}
+
+type DklsBobShare struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PublicKey      []byte              `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	SecretKeyShare []byte              `protobuf:"bytes,2,opt,name=secret_key_share,json=secretKeyShare,proto3" json:"secret_key_share,omitempty"`
+	SeedOtResult   *SeedOtSenderOutput `protobuf:"bytes,3,opt,name=seed_ot_result,json=seedOtResult,proto3" json:"seed_ot_result,omitempty"`
+}
+
+func (x *DklsBobShare) Reset() {
+	*x = DklsBobShare{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payload_payload_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DklsBobShare) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DklsBobShare) ProtoMessage() {}
+
+func (x *DklsBobShare) ProtoReflect() protoreflect.Message {
+	mi := &file_payload_payload_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DklsBobShare.ProtoReflect.Descriptor instead.
+func (*DklsBobShare) Descriptor() ([]byte, []int) {
+	return file_payload_payload_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DklsBobShare) GetPublicKey() []byte {
+	if x != nil {
+		return x.PublicKey
+	}
+	return nil
+}
+
+func (x *DklsBobShare) GetSecretKeyShare() []byte {
+	if x != nil {
+		return x.SecretKeyShare
+	}
+	return nil
+}
+
+func (x *DklsBobShare) GetSeedOtResult() *SeedOtSenderOutput {
+	if x != nil {
+		return x.SeedOtResult
+	}
+	return nil
+}
+
+// KosRound1 은 OT extension 의 첫 번째 메시지입니다. u 는 행 단위 바이트 행렬입니다.
+type KosRound1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	U      [][]byte `protobuf:"bytes,1,rep,name=u,proto3" json:"u,omitempty"`
+	WPrime []byte   `protobuf:"bytes,2,opt,name=w_prime,json=wPrime,proto3" json:"w_prime,omitempty"`
+	VPrime []byte   `protobuf:"bytes,3,opt,name=v_prime,json=vPrime,proto3" json:"v_prime,omitempty"`
+}
+
+func (x *KosRound1) Reset() {
+	*x = KosRound1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payload_payload_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KosRound1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KosRound1) ProtoMessage() {}
+
+func (x *KosRound1) ProtoReflect() protoreflect.Message {
+	mi := &file_payload_payload_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KosRound1.ProtoReflect.Descriptor instead.
+func (*KosRound1) Descriptor() ([]byte, []int) {
+	return file_payload_payload_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *KosRound1) GetU() [][]byte {
+	if x != nil {
+		return x.U
+	}
+	return nil
+}
+
+func (x *KosRound1) GetWPrime() []byte {
+	if x != nil {
+		return x.WPrime
+	}
+	return nil
+}
+
+func (x *KosRound1) GetVPrime() []byte {
+	if x != nil {
+		return x.VPrime
+	}
+	return nil
+}
+
+// MultiplyRound2 는 곱셈 서브 프로토콜의 두 번째 메시지입니다.
+// tau 는 [L][OtWidth] 스칼라 행렬을 행 우선으로 펼친 값입니다.
+type MultiplyRound2 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tau [][]byte `protobuf:"bytes,1,rep,name=tau,proto3" json:"tau,omitempty"`
+	R   [][]byte `protobuf:"bytes,2,rep,name=r,proto3" json:"r,omitempty"`
+	U   []byte   `protobuf:"bytes,3,opt,name=u,proto3" json:"u,omitempty"`
+}
+
+func (x *MultiplyRound2) Reset() {
+	*x = MultiplyRound2{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payload_payload_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MultiplyRound2) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultiplyRound2) ProtoMessage() {}
+
+func (x *MultiplyRound2) ProtoReflect() protoreflect.Message {
+	mi := &file_payload_payload_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultiplyRound2.ProtoReflect.Descriptor instead.
+func (*MultiplyRound2) Descriptor() ([]byte, []int) {
+	return file_payload_payload_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *MultiplyRound2) GetTau() [][]byte {
+	if x != nil {
+		return x.Tau
+	}
+	return nil
+}
+
+func (x *MultiplyRound2) GetR() [][]byte {
+	if x != nil {
+		return x.R
+	}
+	return nil
+}
+
+func (x *MultiplyRound2) GetU() []byte {
+	if x != nil {
+		return x.U
+	}
+	return nil
+}
+
+type DklsSignRound2 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	KosRound1 []*KosRound1 `protobuf:"bytes,1,rep,name=kos_round1,json=kosRound1,proto3" json:"kos_round1,omitempty"`
+	Db        []byte       `protobuf:"bytes,2,opt,name=db,proto3" json:"db,omitempty"`
+	Seed      []byte       `protobuf:"bytes,3,opt,name=seed,proto3" json:"seed,omitempty"`
+}
+
+func (x *DklsSignRound2) Reset() {
+	*x = DklsSignRound2{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payload_payload_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DklsSignRound2) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DklsSignRound2) ProtoMessage() {}
+
+func (x *DklsSignRound2) ProtoReflect() protoreflect.Message {
+	mi := &file_payload_payload_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DklsSignRound2.ProtoReflect.Descriptor instead.
+func (*DklsSignRound2) Descriptor() ([]byte, []int) {
+	return file_payload_payload_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DklsSignRound2) GetKosRound1() []*KosRound1 {
+	if x != nil {
+		return x.KosRound1
+	}
+	return nil
+}
+
+func (x *DklsSignRound2) GetDb() []byte {
+	if x != nil {
+		return x.Db
+	}
+	return nil
+}
+
+func (x *DklsSignRound2) GetSeed() []byte {
+	if x != nil {
+		return x.Seed
+	}
+	return nil
+}
+
+type DklsSignRound3 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MultiplyRound2 []*MultiplyRound2 `protobuf:"bytes,1,rep,name=multiply_round2,json=multiplyRound2,proto3" json:"multiply_round2,omitempty"`
+	RSchnorrProof  *SchnorrProof     `protobuf:"bytes,2,opt,name=r_schnorr_proof,json=rSchnorrProof,proto3" json:"r_schnorr_proof,omitempty"`
+	RPrime         []byte            `protobuf:"bytes,3,opt,name=r_prime,json=rPrime,proto3" json:"r_prime,omitempty"`
+	EtaPhi         []byte            `protobuf:"bytes,4,opt,name=eta_phi,json=etaPhi,proto3" json:"eta_phi,omitempty"`
+	EtaSig         []byte            `protobuf:"bytes,5,opt,name=eta_sig,json=etaSig,proto3" json:"eta_sig,omitempty"`
+}
+
+func (x *DklsSignRound3) Reset() {
+	*x = DklsSignRound3{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payload_payload_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DklsSignRound3) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DklsSignRound3) ProtoMessage() {}
+
+func (x *DklsSignRound3) ProtoReflect() protoreflect.Message {
+	mi := &file_payload_payload_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DklsSignRound3.ProtoReflect.Descriptor instead.
+func (*DklsSignRound3) Descriptor() ([]byte, []int) {
+	return file_payload_payload_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DklsSignRound3) GetMultiplyRound2() []*MultiplyRound2 {
+	if x != nil {
+		return x.MultiplyRound2
+	}
+	return nil
+}
+
+func (x *DklsSignRound3) GetRSchnorrProof() *SchnorrProof {
+	if x != nil {
+		return x.RSchnorrProof
+	}
+	return nil
+}
+
+func (x *DklsSignRound3) GetRPrime() []byte {
+	if x != nil {
+		return x.RPrime
+	}
+	return nil
+}
+
+func (x *DklsSignRound3) GetEtaPhi() []byte {
+	if x != nil {
+		return x.EtaPhi
+	}
+	return nil
+}
+
+func (x *DklsSignRound3) GetEtaSig() []byte {
+	if x != nil {
+		return x.EtaSig
+	}
+	return nil
+}
+
+type PresignOnline struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EtaSig []byte `protobuf:"bytes,1,opt,name=eta_sig,json=etaSig,proto3" json:"eta_sig,omitempty"`
+}
+
+func (x *PresignOnline) Reset() {
+	*x = PresignOnline{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payload_payload_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PresignOnline) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PresignOnline) ProtoMessage() {}
+
+func (x *PresignOnline) ProtoReflect() protoreflect.Message {
+	mi := &file_payload_payload_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PresignOnline.ProtoReflect.Descriptor instead.
+func (*PresignOnline) Descriptor() ([]byte, []int) {
+	return file_payload_payload_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *PresignOnline) GetEtaSig() []byte {
+	if x != nil {
+		return x.EtaSig
+	}
+	return nil
+}
+
+type AlicePresignature struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	R          []byte `protobuf:"bytes,1,opt,name=r,proto3" json:"r,omitempty"`
+	T0         []byte `protobuf:"bytes,2,opt,name=t0,proto3" json:"t0,omitempty"`
+	T1         []byte `protobuf:"bytes,3,opt,name=t1,proto3" json:"t1,omitempty"`
+	Gamma2Hash []byte `protobuf:"bytes,4,opt,name=gamma2_hash,json=gamma2Hash,proto3" json:"gamma2_hash,omitempty"`
+}
+
+func (x *AlicePresignature) Reset() {
+	*x = AlicePresignature{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payload_payload_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AlicePresignature) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AlicePresignature) ProtoMessage() {}
+
+func (x *AlicePresignature) ProtoReflect() protoreflect.Message {
+	mi := &file_payload_payload_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AlicePresignature.ProtoReflect.Descriptor instead.
+func (*AlicePresignature) Descriptor() ([]byte, []int) {
+	return file_payload_payload_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *AlicePresignature) GetR() []byte {
+	if x != nil {
+		return x.R
+	}
+	return nil
+}
+
+func (x *AlicePresignature) GetT0() []byte {
+	if x != nil {
+		return x.T0
+	}
+	return nil
+}
+
+func (x *AlicePresignature) GetT1() []byte {
+	if x != nil {
+		return x.T1
+	}
+	return nil
+}
+
+func (x *AlicePresignature) GetGamma2Hash() []byte {
+	if x != nil {
+		return x.Gamma2Hash
+	}
+	return nil
+}
+
+type BobPresignature struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	R          []byte `protobuf:"bytes,1,opt,name=r,proto3" json:"r,omitempty"`
+	Theta      []byte `protobuf:"bytes,2,opt,name=theta,proto3" json:"theta,omitempty"`
+	T1         []byte `protobuf:"bytes,3,opt,name=t1,proto3" json:"t1,omitempty"`
+	Gamma2Hash []byte `protobuf:"bytes,4,opt,name=gamma2_hash,json=gamma2Hash,proto3" json:"gamma2_hash,omitempty"`
+}
+
+func (x *BobPresignature) Reset() {
+	*x = BobPresignature{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payload_payload_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BobPresignature) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BobPresignature) ProtoMessage() {}
+
+func (x *BobPresignature) ProtoReflect() protoreflect.Message {
+	mi := &file_payload_payload_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BobPresignature.ProtoReflect.Descriptor instead.
+func (*BobPresignature) Descriptor() ([]byte, []int) {
+	return file_payload_payload_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *BobPresignature) GetR() []byte {
+	if x != nil {
+		return x.R
+	}
+	return nil
+}
+
+func (x *BobPresignature) GetTheta() []byte {
+	if x != nil {
+		return x.Theta
+	}
+	return nil
+}
+
+func (x *BobPresignature) GetT1() []byte {
+	if x != nil {
+		return x.T1
+	}
+	return nil
+}
+
+func (x *BobPresignature) GetGamma2Hash() []byte {
+	if x != nil {
+		return x.Gamma2Hash
+	}
+	return nil
+}
+
+type EddsaProof struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Commitment []byte `protobuf:"bytes,1,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	Response   []byte `protobuf:"bytes,2,opt,name=response,proto3" json:"response,omitempty"`
+}
+
+func (x *EddsaProof) Reset() {
+	*x = EddsaProof{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payload_payload_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EddsaProof) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EddsaProof) ProtoMessage() {}
+
+func (x *EddsaProof) ProtoReflect() protoreflect.Message {
+	mi := &file_payload_payload_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EddsaProof.ProtoReflect.Descriptor instead.
+func (*EddsaProof) Descriptor() ([]byte, []int) {
+	return file_payload_payload_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *EddsaProof) GetCommitment() []byte {
+	if x != nil {
+		return x.Commitment
+	}
+	return nil
+}
+
+func (x *EddsaProof) GetResponse() []byte {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+// EddsaDkgRound 은 Ed25519 키 생성의 2, 3 라운드 메시지입니다. salt 는 3 라운드에만 있습니다.
+type EddsaDkgRound struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PublicKeyShare []byte      `protobuf:"bytes,1,opt,name=public_key_share,json=publicKeyShare,proto3" json:"public_key_share,omitempty"`
+	Proof          *EddsaProof `protobuf:"bytes,2,opt,name=proof,proto3" json:"proof,omitempty"`
+	Salt           []byte      `protobuf:"bytes,3,opt,name=salt,proto3" json:"salt,omitempty"`
+}
+
+func (x *EddsaDkgRound) Reset() {
+	*x = EddsaDkgRound{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payload_payload_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EddsaDkgRound) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EddsaDkgRound) ProtoMessage() {}
+
+func (x *EddsaDkgRound) ProtoReflect() protoreflect.Message {
+	mi := &file_payload_payload_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EddsaDkgRound.ProtoReflect.Descriptor instead.
+func (*EddsaDkgRound) Descriptor() ([]byte, []int) {
+	return file_payload_payload_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *EddsaDkgRound) GetPublicKeyShare() []byte {
+	if x != nil {
+		return x.PublicKeyShare
+	}
+	return nil
+}
+
+func (x *EddsaDkgRound) GetProof() *EddsaProof {
+	if x != nil {
+		return x.Proof
+	}
+	return nil
+}
+
+func (x *EddsaDkgRound) GetSalt() []byte {
+	if x != nil {
+		return x.Salt
+	}
+	return nil
+}
+
+type EddsaShare struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SecretKeyShare     []byte `protobuf:"bytes,1,opt,name=secret_key_share,json=secretKeyShare,proto3" json:"secret_key_share,omitempty"`
+	PeerPublicKeyShare []byte `protobuf:"bytes,2,opt,name=peer_public_key_share,json=peerPublicKeyShare,proto3" json:"peer_public_key_share,omitempty"`
+	PublicKey          []byte `protobuf:"bytes,3,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+}
+
+func (x *EddsaShare) Reset() {
+	*x = EddsaShare{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payload_payload_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EddsaShare) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EddsaShare) ProtoMessage() {}
+
+func (x *EddsaShare) ProtoReflect() protoreflect.Message {
+	mi := &file_payload_payload_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EddsaShare.ProtoReflect.Descriptor instead.
+func (*EddsaShare) Descriptor() ([]byte, []int) {
+	return file_payload_payload_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *EddsaShare) GetSecretKeyShare() []byte {
+	if x != nil {
+		return x.SecretKeyShare
+	}
+	return nil
+}
+
+func (x *EddsaShare) GetPeerPublicKeyShare() []byte {
+	if x != nil {
+		return x.PeerPublicKeyShare
+	}
+	return nil
+}
+
+func (x *EddsaShare) GetPublicKey() []byte {
+	if x != nil {
+		return x.PublicKey
+	}
+	return nil
+}
+
+type NonceCommitment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Seed            []byte `protobuf:"bytes,1,opt,name=seed,proto3" json:"seed,omitempty"`
+	NonceCommitment []byte `protobuf:"bytes,2,opt,name=nonce_commitment,json=nonceCommitment,proto3" json:"nonce_commitment,omitempty"`
+}
+
+func (x *NonceCommitment) Reset() {
+	*x = NonceCommitment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payload_payload_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NonceCommitment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NonceCommitment) ProtoMessage() {}
+
+func (x *NonceCommitment) ProtoReflect() protoreflect.Message {
+	mi := &file_payload_payload_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NonceCommitment.ProtoReflect.Descriptor instead.
+func (*NonceCommitment) Descriptor() ([]byte, []int) {
+	return file_payload_payload_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *NonceCommitment) GetSeed() []byte {
+	if x != nil {
+		return x.Seed
+	}
+	return nil
+}
+
+func (x *NonceCommitment) GetNonceCommitment() []byte {
+	if x != nil {
+		return x.NonceCommitment
+	}
+	return nil
+}
+
+type PartialSignature struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Nonce            []byte `protobuf:"bytes,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	PartialSignature []byte `protobuf:"bytes,2,opt,name=partial_signature,json=partialSignature,proto3" json:"partial_signature,omitempty"`
+}
+
+func (x *PartialSignature) Reset() {
+	*x = PartialSignature{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payload_payload_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PartialSignature) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartialSignature) ProtoMessage() {}
+
+func (x *PartialSignature) ProtoReflect() protoreflect.Message {
+	mi := &file_payload_payload_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PartialSignature.ProtoReflect.Descriptor instead.
+func (*PartialSignature) Descriptor() ([]byte, []int) {
+	return file_payload_payload_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *PartialSignature) GetNonce() []byte {
+	if x != nil {
+		return x.Nonce
+	}
+	return nil
+}
+
+func (x *PartialSignature) GetPartialSignature() []byte {
+	if x != nil {
+		return x.PartialSignature
+	}
+	return nil
+}
+
+type TaprootSignRound2 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Seed           []byte     `protobuf:"bytes,1,opt,name=seed,proto3" json:"seed,omitempty"`
+	MultiplyRound1 *KosRound1 `protobuf:"bytes,2,opt,name=multiply_round1,json=multiplyRound1,proto3" json:"multiply_round1,omitempty"`
+	Nonce          []byte     `protobuf:"bytes,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
+}
+
+func (x *TaprootSignRound2) Reset() {
+	*x = TaprootSignRound2{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payload_payload_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TaprootSignRound2) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaprootSignRound2) ProtoMessage() {}
+
+func (x *TaprootSignRound2) ProtoReflect() protoreflect.Message {
+	mi := &file_payload_payload_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaprootSignRound2.ProtoReflect.Descriptor instead.
+func (*TaprootSignRound2) Descriptor() ([]byte, []int) {
+	return file_payload_payload_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *TaprootSignRound2) GetSeed() []byte {
+	if x != nil {
+		return x.Seed
+	}
+	return nil
+}
+
+func (x *TaprootSignRound2) GetMultiplyRound1() *KosRound1 {
+	if x != nil {
+		return x.MultiplyRound1
+	}
+	return nil
+}
+
+func (x *TaprootSignRound2) GetNonce() []byte {
+	if x != nil {
+		return x.Nonce
+	}
+	return nil
+}
+
+type TaprootSignRound3 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MultiplyRound2          *MultiplyRound2 `protobuf:"bytes,1,opt,name=multiply_round2,json=multiplyRound2,proto3" json:"multiply_round2,omitempty"`
+	Nonce                   []byte          `protobuf:"bytes,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	AdditiveShareCommitment []byte          `protobuf:"bytes,3,opt,name=additive_share_commitment,json=additiveShareCommitment,proto3" json:"additive_share_commitment,omitempty"`
+	PartialSignature        []byte          `protobuf:"bytes,4,opt,name=partial_signature,json=partialSignature,proto3" json:"partial_signature,omitempty"`
+}
+
+func (x *TaprootSignRound3) Reset() {
+	*x = TaprootSignRound3{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payload_payload_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TaprootSignRound3) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaprootSignRound3) ProtoMessage() {}
+
+func (x *TaprootSignRound3) ProtoReflect() protoreflect.Message {
+	mi := &file_payload_payload_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaprootSignRound3.ProtoReflect.Descriptor instead.
+func (*TaprootSignRound3) Descriptor() ([]byte, []int) {
+	return file_payload_payload_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *TaprootSignRound3) GetMultiplyRound2() *MultiplyRound2 {
+	if x != nil {
+		return x.MultiplyRound2
+	}
+	return nil
+}
+
+func (x *TaprootSignRound3) GetNonce() []byte {
+	if x != nil {
+		return x.Nonce
+	}
+	return nil
+}
+
+func (x *TaprootSignRound3) GetAdditiveShareCommitment() []byte {
+	if x != nil {
+		return x.AdditiveShareCommitment
+	}
+	return nil
+}
+
+func (x *TaprootSignRound3) GetPartialSignature() []byte {
+	if x != nil {
+		return x.PartialSignature
+	}
+	return nil
+}
+
+var File_payload_payload_proto protoreflect.FileDescriptor
+
+var file_payload_payload_proto_rawDesc = []byte{
+	0x0a, 0x15, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
+	0x22, 0x93, 0x01, 0x0a, 0x08, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x05, 0x63, 0x75, 0x72, 0x76, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
+	0x2e, 0x43, 0x75, 0x72, 0x76, 0x65, 0x52, 0x05, 0x63, 0x75, 0x72, 0x76, 0x65, 0x12, 0x2d, 0x0a,
+	0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x11, 0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x18, 0x0a, 0x07,
+	0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70,
+	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x1d, 0x0a, 0x05, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x23, 0x0a, 0x09, 0x42, 0x79, 0x74, 0x65, 0x73, 0x4c, 0x69,
+	0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0c, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x39, 0x0a, 0x09, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x50, 0x61, 0x69, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x72, 0x73, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x66, 0x69, 0x72, 0x73, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x73,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x22, 0x39, 0x0a, 0x0d, 0x42, 0x79, 0x74, 0x65, 0x73, 0x50, 0x61,
+	0x69, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x05, 0x70, 0x61, 0x69, 0x72, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e,
+	0x42, 0x79, 0x74, 0x65, 0x73, 0x50, 0x61, 0x69, 0x72, 0x52, 0x05, 0x70, 0x61, 0x69, 0x72, 0x73,
+	0x22, 0x48, 0x0a, 0x0c, 0x53, 0x63, 0x68, 0x6e, 0x6f, 0x72, 0x72, 0x50, 0x72, 0x6f, 0x6f, 0x66,
+	0x12, 0x0c, 0x0a, 0x01, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x01, 0x63, 0x12, 0x0c,
+	0x0a, 0x01, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x01, 0x73, 0x12, 0x1c, 0x0a, 0x09,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x43, 0x0a, 0x0d, 0x44, 0x6b,
+	0x6c, 0x73, 0x44, 0x6b, 0x67, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x32, 0x12, 0x12, 0x0a, 0x04, 0x73,
+	0x65, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x73, 0x65, 0x65, 0x64, 0x12,
+	0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x22,
+	0xbf, 0x01, 0x0a, 0x14, 0x53, 0x65, 0x65, 0x64, 0x4f, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76,
+	0x65, 0x72, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x39, 0x0a, 0x19, 0x70, 0x61, 0x63, 0x6b,
+	0x65, 0x64, 0x5f, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x5f, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65,
+	0x5f, 0x62, 0x69, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x16, 0x70, 0x61, 0x63,
+	0x6b, 0x65, 0x64, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x42,
+	0x69, 0x74, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x5f, 0x63, 0x68,
+	0x6f, 0x69, 0x63, 0x65, 0x5f, 0x62, 0x69, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0d, 0x52,
+	0x10, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x42, 0x69, 0x74,
+	0x73, 0x12, 0x3e, 0x0a, 0x1c, 0x6f, 0x6e, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x70, 0x61,
+	0x64, 0x5f, 0x64, 0x65, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6b, 0x65, 0x79,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x18, 0x6f, 0x6e, 0x65, 0x54, 0x69, 0x6d, 0x65,
+	0x50, 0x61, 0x64, 0x44, 0x65, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79,
+	0x73, 0x22, 0x68, 0x0a, 0x12, 0x53, 0x65, 0x65, 0x64, 0x4f, 0x74, 0x53, 0x65, 0x6e, 0x64, 0x65,
+	0x72, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x52, 0x0a, 0x1c, 0x6f, 0x6e, 0x65, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x5f, 0x70, 0x61, 0x64, 0x5f, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x50, 0x61, 0x69,
+	0x72, 0x52, 0x18, 0x6f, 0x6e, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x50, 0x61, 0x64, 0x45, 0x6e, 0x63,
+	0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x73, 0x22, 0x9e, 0x01, 0x0a, 0x0e,
+	0x44, 0x6b, 0x6c, 0x73, 0x41, 0x6c, 0x69, 0x63, 0x65, 0x53, 0x68, 0x61, 0x72, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x28, 0x0a,
+	0x10, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x73, 0x68, 0x61, 0x72,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x4b,
+	0x65, 0x79, 0x53, 0x68, 0x61, 0x72, 0x65, 0x12, 0x43, 0x0a, 0x0e, 0x73, 0x65, 0x65, 0x64, 0x5f,
+	0x6f, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1d, 0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x53, 0x65, 0x65, 0x64, 0x4f, 0x74,
+	0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x0c,
+	0x73, 0x65, 0x65, 0x64, 0x4f, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x9a, 0x01, 0x0a,
+	0x0c, 0x44, 0x6b, 0x6c, 0x73, 0x42, 0x6f, 0x62, 0x53, 0x68, 0x61, 0x72, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x28, 0x0a, 0x10,
+	0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x4b, 0x65,
+	0x79, 0x53, 0x68, 0x61, 0x72, 0x65, 0x12, 0x41, 0x0a, 0x0e, 0x73, 0x65, 0x65, 0x64, 0x5f, 0x6f,
+	0x74, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x53, 0x65, 0x65, 0x64, 0x4f, 0x74, 0x53,
+	0x65, 0x6e, 0x64, 0x65, 0x72, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x0c, 0x73, 0x65, 0x65,
+	0x64, 0x4f, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x4b, 0x0a, 0x09, 0x4b, 0x6f, 0x73,
+	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x31, 0x12, 0x0c, 0x0a, 0x01, 0x75, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0c, 0x52, 0x01, 0x75, 0x12, 0x17, 0x0a, 0x07, 0x77, 0x5f, 0x70, 0x72, 0x69, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x77, 0x50, 0x72, 0x69, 0x6d, 0x65, 0x12, 0x17, 0x0a,
+	0x07, 0x76, 0x5f, 0x70, 0x72, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06,
+	0x76, 0x50, 0x72, 0x69, 0x6d, 0x65, 0x22, 0x3e, 0x0a, 0x0e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70,
+	0x6c, 0x79, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x32, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x75, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x03, 0x74, 0x61, 0x75, 0x12, 0x0c, 0x0a, 0x01, 0x72, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x01, 0x72, 0x12, 0x0c, 0x0a, 0x01, 0x75, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x01, 0x75, 0x22, 0x67, 0x0a, 0x0e, 0x44, 0x6b, 0x6c, 0x73, 0x53, 0x69,
+	0x67, 0x6e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x32, 0x12, 0x31, 0x0a, 0x0a, 0x6b, 0x6f, 0x73, 0x5f,
+	0x72, 0x6f, 0x75, 0x6e, 0x64, 0x31, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70,
+	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x4b, 0x6f, 0x73, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x31,
+	0x52, 0x09, 0x6b, 0x6f, 0x73, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x31, 0x12, 0x0e, 0x0a, 0x02, 0x64,
+	0x62, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x64, 0x62, 0x12, 0x12, 0x0a, 0x04, 0x73,
+	0x65, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x73, 0x65, 0x65, 0x64, 0x22,
+	0xdc, 0x01, 0x0a, 0x0e, 0x44, 0x6b, 0x6c, 0x73, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x6f, 0x75, 0x6e,
+	0x64, 0x33, 0x12, 0x40, 0x0a, 0x0f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x79, 0x5f, 0x72,
+	0x6f, 0x75, 0x6e, 0x64, 0x32, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x79, 0x52, 0x6f,
+	0x75, 0x6e, 0x64, 0x32, 0x52, 0x0e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x79, 0x52, 0x6f,
+	0x75, 0x6e, 0x64, 0x32, 0x12, 0x3d, 0x0a, 0x0f, 0x72, 0x5f, 0x73, 0x63, 0x68, 0x6e, 0x6f, 0x72,
+	0x72, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x53, 0x63, 0x68, 0x6e, 0x6f, 0x72, 0x72, 0x50,
+	0x72, 0x6f, 0x6f, 0x66, 0x52, 0x0d, 0x72, 0x53, 0x63, 0x68, 0x6e, 0x6f, 0x72, 0x72, 0x50, 0x72,
+	0x6f, 0x6f, 0x66, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x5f, 0x70, 0x72, 0x69, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x72, 0x50, 0x72, 0x69, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07,
+	0x65, 0x74, 0x61, 0x5f, 0x70, 0x68, 0x69, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x65,
+	0x74, 0x61, 0x50, 0x68, 0x69, 0x12, 0x17, 0x0a, 0x07, 0x65, 0x74, 0x61, 0x5f, 0x73, 0x69, 0x67,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x65, 0x74, 0x61, 0x53, 0x69, 0x67, 0x22, 0x28,
+	0x0a, 0x0d, 0x50, 0x72, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x4f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x12,
+	0x17, 0x0a, 0x07, 0x65, 0x74, 0x61, 0x5f, 0x73, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x06, 0x65, 0x74, 0x61, 0x53, 0x69, 0x67, 0x22, 0x62, 0x0a, 0x11, 0x41, 0x6c, 0x69, 0x63,
+	0x65, 0x50, 0x72, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x0c, 0x0a,
+	0x01, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x01, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x74,
+	0x30, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x74, 0x30, 0x12, 0x0e, 0x0a, 0x02, 0x74,
+	0x31, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x74, 0x31, 0x12, 0x1f, 0x0a, 0x0b, 0x67,
+	0x61, 0x6d, 0x6d, 0x61, 0x32, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x0a, 0x67, 0x61, 0x6d, 0x6d, 0x61, 0x32, 0x48, 0x61, 0x73, 0x68, 0x22, 0x66, 0x0a, 0x0f,
+	0x42, 0x6f, 0x62, 0x50, 0x72, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12,
+	0x0c, 0x0a, 0x01, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x01, 0x72, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x68, 0x65, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x74, 0x68,
+	0x65, 0x74, 0x61, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x31, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x02, 0x74, 0x31, 0x12, 0x1f, 0x0a, 0x0b, 0x67, 0x61, 0x6d, 0x6d, 0x61, 0x32, 0x5f, 0x68, 0x61,
+	0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x67, 0x61, 0x6d, 0x6d, 0x61, 0x32,
+	0x48, 0x61, 0x73, 0x68, 0x22, 0x48, 0x0a, 0x0a, 0x45, 0x64, 0x64, 0x73, 0x61, 0x50, 0x72, 0x6f,
+	0x6f, 0x66, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x78,
+	0x0a, 0x0d, 0x45, 0x64, 0x64, 0x73, 0x61, 0x44, 0x6b, 0x67, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12,
+	0x28, 0x0a, 0x10, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x73, 0x68,
+	0x61, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x70, 0x75, 0x62, 0x6c, 0x69,
+	0x63, 0x4b, 0x65, 0x79, 0x53, 0x68, 0x61, 0x72, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x70, 0x72, 0x6f,
+	0x6f, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f,
+	0x61, 0x64, 0x2e, 0x45, 0x64, 0x64, 0x73, 0x61, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x05, 0x70,
+	0x72, 0x6f, 0x6f, 0x66, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x61, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x04, 0x73, 0x61, 0x6c, 0x74, 0x22, 0x88, 0x01, 0x0a, 0x0a, 0x45, 0x64, 0x64,
+	0x73, 0x61, 0x53, 0x68, 0x61, 0x72, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x0e, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x4b, 0x65, 0x79, 0x53, 0x68, 0x61, 0x72,
+	0x65, 0x12, 0x31, 0x0a, 0x15, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x12, 0x70, 0x65, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x53,
+	0x68, 0x61, 0x72, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b,
+	0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x4b, 0x65, 0x79, 0x22, 0x50, 0x0a, 0x0f, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x43, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x65, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x73, 0x65, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x6e, 0x6f,
+	0x6e, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x55, 0x0a, 0x10, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c,
+	0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e,
+	0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12,
+	0x2b, 0x0a, 0x11, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10, 0x70, 0x61, 0x72, 0x74,
+	0x69, 0x61, 0x6c, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x7a, 0x0a, 0x11,
+	0x54, 0x61, 0x70, 0x72, 0x6f, 0x6f, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x6f, 0x75, 0x6e, 0x64,
+	0x32, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x04, 0x73, 0x65, 0x65, 0x64, 0x12, 0x3b, 0x0a, 0x0f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c,
+	0x79, 0x5f, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x31, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x4b, 0x6f, 0x73, 0x52, 0x6f, 0x75, 0x6e,
+	0x64, 0x31, 0x52, 0x0e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x79, 0x52, 0x6f, 0x75, 0x6e,
+	0x64, 0x31, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x22, 0xd4, 0x01, 0x0a, 0x11, 0x54, 0x61, 0x70,
+	0x72, 0x6f, 0x6f, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x33, 0x12, 0x40,
+	0x0a, 0x0f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x79, 0x5f, 0x72, 0x6f, 0x75, 0x6e, 0x64,
+	0x32, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x79, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x32,
+	0x52, 0x0e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x79, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x32,
+	0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x3a, 0x0a, 0x19, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69,
+	0x76, 0x65, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d,
+	0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x17, 0x61, 0x64, 0x64, 0x69, 0x74,
+	0x69, 0x76, 0x65, 0x53, 0x68, 0x61, 0x72, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x73, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10, 0x70,
+	0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x2a,
+	0x56, 0x0a, 0x05, 0x43, 0x75, 0x72, 0x76, 0x65, 0x12, 0x15, 0x0a, 0x11, 0x43, 0x55, 0x52, 0x56,
+	0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x13, 0x0a, 0x0f, 0x43, 0x55, 0x52, 0x56, 0x45, 0x5f, 0x53, 0x45, 0x43, 0x50, 0x32, 0x35, 0x36,
+	0x4b, 0x31, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x43, 0x55, 0x52, 0x56, 0x45, 0x5f, 0x50, 0x32,
+	0x35, 0x36, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d, 0x43, 0x55, 0x52, 0x56, 0x45, 0x5f, 0x45, 0x44,
+	0x32, 0x35, 0x35, 0x31, 0x39, 0x10, 0x03, 0x2a, 0xae, 0x05, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x18, 0x0a, 0x14, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x13,
+	0x0a, 0x0f, 0x44, 0x4b, 0x4c, 0x53, 0x5f, 0x44, 0x4b, 0x47, 0x5f, 0x52, 0x4f, 0x55, 0x4e, 0x44,
+	0x31, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x44, 0x4b, 0x4c, 0x53, 0x5f, 0x44, 0x4b, 0x47, 0x5f,
+	0x52, 0x4f, 0x55, 0x4e, 0x44, 0x32, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x44, 0x4b, 0x4c, 0x53,
+	0x5f, 0x44, 0x4b, 0x47, 0x5f, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x33, 0x10, 0x03, 0x12, 0x13, 0x0a,
+	0x0f, 0x44, 0x4b, 0x4c, 0x53, 0x5f, 0x44, 0x4b, 0x47, 0x5f, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x34,
+	0x10, 0x04, 0x12, 0x13, 0x0a, 0x0f, 0x44, 0x4b, 0x4c, 0x53, 0x5f, 0x44, 0x4b, 0x47, 0x5f, 0x52,
+	0x4f, 0x55, 0x4e, 0x44, 0x35, 0x10, 0x05, 0x12, 0x13, 0x0a, 0x0f, 0x44, 0x4b, 0x4c, 0x53, 0x5f,
+	0x44, 0x4b, 0x47, 0x5f, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x36, 0x10, 0x06, 0x12, 0x13, 0x0a, 0x0f,
+	0x44, 0x4b, 0x4c, 0x53, 0x5f, 0x44, 0x4b, 0x47, 0x5f, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x37, 0x10,
+	0x07, 0x12, 0x13, 0x0a, 0x0f, 0x44, 0x4b, 0x4c, 0x53, 0x5f, 0x44, 0x4b, 0x47, 0x5f, 0x52, 0x4f,
+	0x55, 0x4e, 0x44, 0x38, 0x10, 0x08, 0x12, 0x13, 0x0a, 0x0f, 0x44, 0x4b, 0x4c, 0x53, 0x5f, 0x44,
+	0x4b, 0x47, 0x5f, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x39, 0x10, 0x09, 0x12, 0x14, 0x0a, 0x10, 0x44,
+	0x4b, 0x4c, 0x53, 0x5f, 0x41, 0x4c, 0x49, 0x43, 0x45, 0x5f, 0x53, 0x48, 0x41, 0x52, 0x45, 0x10,
+	0x0a, 0x12, 0x12, 0x0a, 0x0e, 0x44, 0x4b, 0x4c, 0x53, 0x5f, 0x42, 0x4f, 0x42, 0x5f, 0x53, 0x48,
+	0x41, 0x52, 0x45, 0x10, 0x0b, 0x12, 0x14, 0x0a, 0x10, 0x44, 0x4b, 0x4c, 0x53, 0x5f, 0x53, 0x49,
+	0x47, 0x4e, 0x5f, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x31, 0x10, 0x14, 0x12, 0x14, 0x0a, 0x10, 0x44,
+	0x4b, 0x4c, 0x53, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x5f, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x32, 0x10,
+	0x15, 0x12, 0x14, 0x0a, 0x10, 0x44, 0x4b, 0x4c, 0x53, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x5f, 0x52,
+	0x4f, 0x55, 0x4e, 0x44, 0x33, 0x10, 0x16, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x52, 0x45, 0x53, 0x49,
+	0x47, 0x4e, 0x5f, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x31, 0x10, 0x1e, 0x12, 0x12, 0x0a, 0x0e, 0x50,
+	0x52, 0x45, 0x53, 0x49, 0x47, 0x4e, 0x5f, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x32, 0x10, 0x1f, 0x12,
+	0x12, 0x0a, 0x0e, 0x50, 0x52, 0x45, 0x53, 0x49, 0x47, 0x4e, 0x5f, 0x52, 0x4f, 0x55, 0x4e, 0x44,
+	0x33, 0x10, 0x20, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x52, 0x45, 0x53, 0x49, 0x47, 0x4e, 0x5f, 0x4f,
+	0x4e, 0x4c, 0x49, 0x4e, 0x45, 0x10, 0x21, 0x12, 0x16, 0x0a, 0x12, 0x41, 0x4c, 0x49, 0x43, 0x45,
+	0x5f, 0x50, 0x52, 0x45, 0x53, 0x49, 0x47, 0x4e, 0x41, 0x54, 0x55, 0x52, 0x45, 0x10, 0x22, 0x12,
+	0x14, 0x0a, 0x10, 0x42, 0x4f, 0x42, 0x5f, 0x50, 0x52, 0x45, 0x53, 0x49, 0x47, 0x4e, 0x41, 0x54,
+	0x55, 0x52, 0x45, 0x10, 0x23, 0x12, 0x14, 0x0a, 0x10, 0x45, 0x44, 0x44, 0x53, 0x41, 0x5f, 0x44,
+	0x4b, 0x47, 0x5f, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x31, 0x10, 0x28, 0x12, 0x14, 0x0a, 0x10, 0x45,
+	0x44, 0x44, 0x53, 0x41, 0x5f, 0x44, 0x4b, 0x47, 0x5f, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x32, 0x10,
+	0x29, 0x12, 0x14, 0x0a, 0x10, 0x45, 0x44, 0x44, 0x53, 0x41, 0x5f, 0x44, 0x4b, 0x47, 0x5f, 0x52,
+	0x4f, 0x55, 0x4e, 0x44, 0x33, 0x10, 0x2a, 0x12, 0x0f, 0x0a, 0x0b, 0x45, 0x44, 0x44, 0x53, 0x41,
+	0x5f, 0x53, 0x48, 0x41, 0x52, 0x45, 0x10, 0x2b, 0x12, 0x15, 0x0a, 0x11, 0x45, 0x44, 0x44, 0x53,
+	0x41, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x5f, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x31, 0x10, 0x2c, 0x12,
+	0x15, 0x0a, 0x11, 0x45, 0x44, 0x44, 0x53, 0x41, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x5f, 0x52, 0x4f,
+	0x55, 0x4e, 0x44, 0x32, 0x10, 0x2d, 0x12, 0x15, 0x0a, 0x11, 0x45, 0x44, 0x44, 0x53, 0x41, 0x5f,
+	0x53, 0x49, 0x47, 0x4e, 0x5f, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x33, 0x10, 0x2e, 0x12, 0x17, 0x0a,
+	0x13, 0x54, 0x41, 0x50, 0x52, 0x4f, 0x4f, 0x54, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x5f, 0x52, 0x4f,
+	0x55, 0x4e, 0x44, 0x31, 0x10, 0x32, 0x12, 0x17, 0x0a, 0x13, 0x54, 0x41, 0x50, 0x52, 0x4f, 0x4f,
+	0x54, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x5f, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x32, 0x10, 0x33, 0x12,
+	0x17, 0x0a, 0x13, 0x54, 0x41, 0x50, 0x52, 0x4f, 0x4f, 0x54, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x5f,
+	0x52, 0x4f, 0x55, 0x4e, 0x44, 0x33, 0x10, 0x34, 0x42, 0x16, 0x5a, 0x14, 0x74, 0x65, 0x63, 0x64,
+	0x73, 0x61, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_payload_payload_proto_rawDescOnce sync.Once
+	file_payload_payload_proto_rawDescData = file_payload_payload_proto_rawDesc
+)
+
+func file_payload_payload_proto_rawDescGZIP() []byte {
+	file_payload_payload_proto_rawDescOnce.Do(func() {
+		file_payload_payload_proto_rawDescData = protoimpl.X.CompressGZIP(file_payload_payload_proto_rawDescData)
+	})
+	return file_payload_payload_proto_rawDescData
+}
+
+var file_payload_payload_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_payload_payload_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
+var file_payload_payload_proto_goTypes = []any{
+	(Curve)(0),                   // 0: payload.Curve
+	(Protocol)(0),                // 1: payload.Protocol
+	(*Envelope)(nil),             // 2: payload.Envelope
+	(*Bytes)(nil),                // 3: payload.Bytes
+	(*BytesList)(nil),            // 4: payload.BytesList
+	(*BytesPair)(nil),            // 5: payload.BytesPair
+	(*BytesPairList)(nil),        // 6: payload.BytesPairList
+	(*SchnorrProof)(nil),         // 7: payload.SchnorrProof
+	(*DklsDkgRound2)(nil),        // 8: payload.DklsDkgRound2
+	(*SeedOtReceiverOutput)(nil), // 9: payload.SeedOtReceiverOutput
+	(*SeedOtSenderOutput)(nil),   // 10: payload.SeedOtSenderOutput
+	(*DklsAliceShare)(nil),       // 11: payload.DklsAliceShare
+	(*DklsBobShare)(nil),         // 12: payload.DklsBobShare
+	(*KosRound1)(nil),            // 13: payload.KosRound1
+	(*MultiplyRound2)(nil),       // 14: payload.MultiplyRound2
+	(*DklsSignRound2)(nil),       // 15: payload.DklsSignRound2
+	(*DklsSignRound3)(nil),       // 16: payload.DklsSignRound3
+	(*PresignOnline)(nil),        // 17: payload.PresignOnline
+	(*AlicePresignature)(nil),    // 18: payload.AlicePresignature
+	(*BobPresignature)(nil),      // 19: payload.BobPresignature
+	(*EddsaProof)(nil),           // 20: payload.EddsaProof
+	(*EddsaDkgRound)(nil),        // 21: payload.EddsaDkgRound
+	(*EddsaShare)(nil),           // 22: payload.EddsaShare
+	(*NonceCommitment)(nil),      // 23: payload.NonceCommitment
+	(*PartialSignature)(nil),     // 24: payload.PartialSignature
+	(*TaprootSignRound2)(nil),    // 25: payload.TaprootSignRound2
+	(*TaprootSignRound3)(nil),    // 26: payload.TaprootSignRound3
+}
+var file_payload_payload_proto_depIdxs = []int32{
+	0,  // 0: payload.Envelope.curve:type_name -> payload.Curve
+	1,  // 1: payload.Envelope.protocol:type_name -> payload.Protocol
+	5,  // 2: payload.BytesPairList.pairs:type_name -> payload.BytesPair
+	5,  // 3: payload.SeedOtSenderOutput.one_time_pad_encryption_keys:type_name -> payload.BytesPair
+	9,  // 4: payload.DklsAliceShare.seed_ot_result:type_name -> payload.SeedOtReceiverOutput
+	10, // 5: payload.DklsBobShare.seed_ot_result:type_name -> payload.SeedOtSenderOutput
+	13, // 6: payload.DklsSignRound2.kos_round1:type_name -> payload.KosRound1
+	14, // 7: payload.DklsSignRound3.multiply_round2:type_name -> payload.MultiplyRound2
+	7,  // 8: payload.DklsSignRound3.r_schnorr_proof:type_name -> payload.SchnorrProof
+	20, // 9: payload.EddsaDkgRound.proof:type_name -> payload.EddsaProof
+	13, // 10: payload.TaprootSignRound2.multiply_round1:type_name -> payload.KosRound1
+	14, // 11: payload.TaprootSignRound3.multiply_round2:type_name -> payload.MultiplyRound2
+	12, // [12:12] is the sub-list for method output_type
+	12, // [12:12] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
+}
+
+func init() { file_payload_payload_proto_init() }
+func file_payload_payload_proto_init() {
+	if File_payload_payload_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_payload_payload_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*Envelope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payload_payload_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*Bytes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payload_payload_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*BytesList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payload_payload_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*BytesPair); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payload_payload_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*BytesPairList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payload_payload_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*SchnorrProof); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payload_payload_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*DklsDkgRound2); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payload_payload_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*SeedOtReceiverOutput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payload_payload_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*SeedOtSenderOutput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payload_payload_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*DklsAliceShare); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payload_payload_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*DklsBobShare); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payload_payload_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*KosRound1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payload_payload_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*MultiplyRound2); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payload_payload_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*DklsSignRound2); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payload_payload_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*DklsSignRound3); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payload_payload_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*PresignOnline); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payload_payload_proto_msgTypes[16].Exporter = func(v any, i int) any {
+			switch v := v.(*AlicePresignature); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payload_payload_proto_msgTypes[17].Exporter = func(v any, i int) any {
+			switch v := v.(*BobPresignature); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payload_payload_proto_msgTypes[18].Exporter = func(v any, i int) any {
+			switch v := v.(*EddsaProof); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payload_payload_proto_msgTypes[19].Exporter = func(v any, i int) any {
+			switch v := v.(*EddsaDkgRound); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payload_payload_proto_msgTypes[20].Exporter = func(v any, i int) any {
+			switch v := v.(*EddsaShare); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payload_payload_proto_msgTypes[21].Exporter = func(v any, i int) any {
+			switch v := v.(*NonceCommitment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payload_payload_proto_msgTypes[22].Exporter = func(v any, i int) any {
+			switch v := v.(*PartialSignature); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payload_payload_proto_msgTypes[23].Exporter = func(v any, i int) any {
+			switch v := v.(*TaprootSignRound2); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payload_payload_proto_msgTypes[24].Exporter = func(v any, i int) any {
+			switch v := v.(*TaprootSignRound3); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_payload_payload_proto_rawDesc,
+			NumEnums:      2,
+			NumMessages:   25,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_payload_payload_proto_goTypes,
+		DependencyIndexes: file_payload_payload_proto_depIdxs,
+		EnumInfos:         file_payload_payload_proto_enumTypes,
+		MessageInfos:      file_payload_payload_proto_msgTypes,
+	}.Build()
+	File_payload_payload_proto = out.File
+	file_payload_payload_proto_rawDesc = nil
+	file_payload_payload_proto_goTypes = nil
+	file_payload_payload_proto_depIdxs = nil
+}
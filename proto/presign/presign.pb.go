@@ -0,0 +1,770 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        v5.27.1
+// source: presign/presign.proto
+
+package presign
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type PresignMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Msg:
+	//
+	//	*PresignMessage_PresignGatewayTo1Output
+	//	*PresignMessage_PresignRound1To2Output
+	//	*PresignMessage_PresignRound2To3Output
+	//	*PresignMessage_PresignRound3To4Output
+	//	*PresignMessage_PresignRound4ToGatewayOutput
+	Msg isPresignMessage_Msg `protobuf_oneof:"msg"`
+}
+
+func (x *PresignMessage) Reset() {
+	*x = PresignMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_presign_presign_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PresignMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PresignMessage) ProtoMessage() {}
+
+func (x *PresignMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_presign_presign_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PresignMessage.ProtoReflect.Descriptor instead.
+func (*PresignMessage) Descriptor() ([]byte, []int) {
+	return file_presign_presign_proto_rawDescGZIP(), []int{0}
+}
+
+func (m *PresignMessage) GetMsg() isPresignMessage_Msg {
+	if m != nil {
+		return m.Msg
+	}
+	return nil
+}
+
+func (x *PresignMessage) GetPresignGatewayTo1Output() *PresignGatewayTo1Output {
+	if x, ok := x.GetMsg().(*PresignMessage_PresignGatewayTo1Output); ok {
+		return x.PresignGatewayTo1Output
+	}
+	return nil
+}
+
+func (x *PresignMessage) GetPresignRound1To2Output() *PresignRound1To2Output {
+	if x, ok := x.GetMsg().(*PresignMessage_PresignRound1To2Output); ok {
+		return x.PresignRound1To2Output
+	}
+	return nil
+}
+
+func (x *PresignMessage) GetPresignRound2To3Output() *PresignRound2To3Output {
+	if x, ok := x.GetMsg().(*PresignMessage_PresignRound2To3Output); ok {
+		return x.PresignRound2To3Output
+	}
+	return nil
+}
+
+func (x *PresignMessage) GetPresignRound3To4Output() *PresignRound3To4Output {
+	if x, ok := x.GetMsg().(*PresignMessage_PresignRound3To4Output); ok {
+		return x.PresignRound3To4Output
+	}
+	return nil
+}
+
+func (x *PresignMessage) GetPresignRound4ToGatewayOutput() *PresignRound4ToGatewayOutput {
+	if x, ok := x.GetMsg().(*PresignMessage_PresignRound4ToGatewayOutput); ok {
+		return x.PresignRound4ToGatewayOutput
+	}
+	return nil
+}
+
+type isPresignMessage_Msg interface {
+	isPresignMessage_Msg()
+}
+
+type PresignMessage_PresignGatewayTo1Output struct {
+	PresignGatewayTo1Output *PresignGatewayTo1Output `protobuf:"bytes,1,opt,name=presignGatewayTo1Output,proto3,oneof"`
+}
+
+type PresignMessage_PresignRound1To2Output struct {
+	PresignRound1To2Output *PresignRound1To2Output `protobuf:"bytes,2,opt,name=presignRound1To2Output,proto3,oneof"`
+}
+
+type PresignMessage_PresignRound2To3Output struct {
+	PresignRound2To3Output *PresignRound2To3Output `protobuf:"bytes,3,opt,name=presignRound2To3Output,proto3,oneof"`
+}
+
+type PresignMessage_PresignRound3To4Output struct {
+	PresignRound3To4Output *PresignRound3To4Output `protobuf:"bytes,4,opt,name=presignRound3To4Output,proto3,oneof"`
+}
+
+type PresignMessage_PresignRound4ToGatewayOutput struct {
+	PresignRound4ToGatewayOutput *PresignRound4ToGatewayOutput `protobuf:"bytes,5,opt,name=presignRound4ToGatewayOutput,proto3,oneof"`
+}
+
+func (*PresignMessage_PresignGatewayTo1Output) isPresignMessage_Msg() {}
+
+func (*PresignMessage_PresignRound1To2Output) isPresignMessage_Msg() {}
+
+func (*PresignMessage_PresignRound2To3Output) isPresignMessage_Msg() {}
+
+func (*PresignMessage_PresignRound3To4Output) isPresignMessage_Msg() {}
+
+func (*PresignMessage_PresignRound4ToGatewayOutput) isPresignMessage_Msg() {}
+
+// 요청 -> 라운드 1
+type PresignGatewayTo1Output struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *PresignGatewayTo1Output) Reset() {
+	*x = PresignGatewayTo1Output{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_presign_presign_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PresignGatewayTo1Output) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PresignGatewayTo1Output) ProtoMessage() {}
+
+func (x *PresignGatewayTo1Output) ProtoReflect() protoreflect.Message {
+	mi := &file_presign_presign_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PresignGatewayTo1Output.ProtoReflect.Descriptor instead.
+func (*PresignGatewayTo1Output) Descriptor() ([]byte, []int) {
+	return file_presign_presign_proto_rawDescGZIP(), []int{1}
+}
+
+// 라운드 1 -> 라운드 2
+type PresignRound1To2Output struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Payload []byte `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+}
+
+func (x *PresignRound1To2Output) Reset() {
+	*x = PresignRound1To2Output{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_presign_presign_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PresignRound1To2Output) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PresignRound1To2Output) ProtoMessage() {}
+
+func (x *PresignRound1To2Output) ProtoReflect() protoreflect.Message {
+	mi := &file_presign_presign_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PresignRound1To2Output.ProtoReflect.Descriptor instead.
+func (*PresignRound1To2Output) Descriptor() ([]byte, []int) {
+	return file_presign_presign_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PresignRound1To2Output) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+// 라운드 2 -> 라운드 3
+type PresignRound2To3Output struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Payload []byte `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+}
+
+func (x *PresignRound2To3Output) Reset() {
+	*x = PresignRound2To3Output{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_presign_presign_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PresignRound2To3Output) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PresignRound2To3Output) ProtoMessage() {}
+
+func (x *PresignRound2To3Output) ProtoReflect() protoreflect.Message {
+	mi := &file_presign_presign_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PresignRound2To3Output.ProtoReflect.Descriptor instead.
+func (*PresignRound2To3Output) Descriptor() ([]byte, []int) {
+	return file_presign_presign_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PresignRound2To3Output) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+// 라운드 3 -> 라운드 4
+type PresignRound3To4Output struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Payload []byte `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+}
+
+func (x *PresignRound3To4Output) Reset() {
+	*x = PresignRound3To4Output{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_presign_presign_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PresignRound3To4Output) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PresignRound3To4Output) ProtoMessage() {}
+
+func (x *PresignRound3To4Output) ProtoReflect() protoreflect.Message {
+	mi := &file_presign_presign_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PresignRound3To4Output.ProtoReflect.Descriptor instead.
+func (*PresignRound3To4Output) Descriptor() ([]byte, []int) {
+	return file_presign_presign_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PresignRound3To4Output) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+// 라운드 4 -> 게이트웨이
+type PresignRound4ToGatewayOutput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PresignId string `protobuf:"bytes,1,opt,name=presign_id,json=presignId,proto3" json:"presign_id,omitempty"`
+}
+
+func (x *PresignRound4ToGatewayOutput) Reset() {
+	*x = PresignRound4ToGatewayOutput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_presign_presign_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PresignRound4ToGatewayOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PresignRound4ToGatewayOutput) ProtoMessage() {}
+
+func (x *PresignRound4ToGatewayOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_presign_presign_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PresignRound4ToGatewayOutput.ProtoReflect.Descriptor instead.
+func (*PresignRound4ToGatewayOutput) Descriptor() ([]byte, []int) {
+	return file_presign_presign_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PresignRound4ToGatewayOutput) GetPresignId() string {
+	if x != nil {
+		return x.PresignId
+	}
+	return ""
+}
+
+// 게이트웨이 -> 파티 : presignature 로 서명 (Alice 먼저, Alice 의 payload 를 Bob 에게)
+type SignWithPresignRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PresignId string `protobuf:"bytes,1,opt,name=presign_id,json=presignId,proto3" json:"presign_id,omitempty"`
+	Address   string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	TxOrigin  []byte `protobuf:"bytes,3,opt,name=tx_origin,json=txOrigin,proto3" json:"tx_origin,omitempty"`
+	Payload   []byte `protobuf:"bytes,4,opt,name=payload,proto3" json:"payload,omitempty"`
+}
+
+func (x *SignWithPresignRequest) Reset() {
+	*x = SignWithPresignRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_presign_presign_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SignWithPresignRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignWithPresignRequest) ProtoMessage() {}
+
+func (x *SignWithPresignRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_presign_presign_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignWithPresignRequest.ProtoReflect.Descriptor instead.
+func (*SignWithPresignRequest) Descriptor() ([]byte, []int) {
+	return file_presign_presign_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SignWithPresignRequest) GetPresignId() string {
+	if x != nil {
+		return x.PresignId
+	}
+	return ""
+}
+
+func (x *SignWithPresignRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *SignWithPresignRequest) GetTxOrigin() []byte {
+	if x != nil {
+		return x.TxOrigin
+	}
+	return nil
+}
+
+func (x *SignWithPresignRequest) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+// 파티 -> 게이트웨이 : Alice 는 payload, Bob 은 완성된 서명
+type SignWithPresignResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Payload []byte `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	V       uint64 `protobuf:"varint,2,opt,name=v,proto3" json:"v,omitempty"`
+	R       []byte `protobuf:"bytes,3,opt,name=r,proto3" json:"r,omitempty"`
+	S       []byte `protobuf:"bytes,4,opt,name=s,proto3" json:"s,omitempty"`
+}
+
+func (x *SignWithPresignResponse) Reset() {
+	*x = SignWithPresignResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_presign_presign_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SignWithPresignResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignWithPresignResponse) ProtoMessage() {}
+
+func (x *SignWithPresignResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_presign_presign_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignWithPresignResponse.ProtoReflect.Descriptor instead.
+func (*SignWithPresignResponse) Descriptor() ([]byte, []int) {
+	return file_presign_presign_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SignWithPresignResponse) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *SignWithPresignResponse) GetV() uint64 {
+	if x != nil {
+		return x.V
+	}
+	return 0
+}
+
+func (x *SignWithPresignResponse) GetR() []byte {
+	if x != nil {
+		return x.R
+	}
+	return nil
+}
+
+func (x *SignWithPresignResponse) GetS() []byte {
+	if x != nil {
+		return x.S
+	}
+	return nil
+}
+
+var File_presign_presign_proto protoreflect.FileDescriptor
+
+var file_presign_presign_proto_rawDesc = []byte{
+	0x0a, 0x15, 0x70, 0x72, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x2f, 0x70, 0x72, 0x65, 0x73, 0x69, 0x67,
+	0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x70, 0x72, 0x65, 0x73, 0x69, 0x67, 0x6e,
+	0x22, 0xf3, 0x03, 0x0a, 0x0e, 0x50, 0x72, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x5c, 0x0a, 0x17, 0x70, 0x72, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x47, 0x61,
+	0x74, 0x65, 0x77, 0x61, 0x79, 0x54, 0x6f, 0x31, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x72, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x2e, 0x50,
+	0x72, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x54, 0x6f, 0x31,
+	0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x48, 0x00, 0x52, 0x17, 0x70, 0x72, 0x65, 0x73, 0x69, 0x67,
+	0x6e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x54, 0x6f, 0x31, 0x4f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x12, 0x59, 0x0a, 0x16, 0x70, 0x72, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x52, 0x6f, 0x75, 0x6e,
+	0x64, 0x31, 0x54, 0x6f, 0x32, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x72, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x2e, 0x50, 0x72, 0x65, 0x73,
+	0x69, 0x67, 0x6e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x31, 0x54, 0x6f, 0x32, 0x4f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x48, 0x00, 0x52, 0x16, 0x70, 0x72, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x52, 0x6f, 0x75,
+	0x6e, 0x64, 0x31, 0x54, 0x6f, 0x32, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x59, 0x0a, 0x16,
+	0x70, 0x72, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x32, 0x54, 0x6f, 0x33,
+	0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70,
+	0x72, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x2e, 0x50, 0x72, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x52, 0x6f,
+	0x75, 0x6e, 0x64, 0x32, 0x54, 0x6f, 0x33, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x48, 0x00, 0x52,
+	0x16, 0x70, 0x72, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x32, 0x54, 0x6f,
+	0x33, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x59, 0x0a, 0x16, 0x70, 0x72, 0x65, 0x73, 0x69,
+	0x67, 0x6e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x33, 0x54, 0x6f, 0x34, 0x4f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x72, 0x65, 0x73, 0x69, 0x67,
+	0x6e, 0x2e, 0x50, 0x72, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x33, 0x54,
+	0x6f, 0x34, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x48, 0x00, 0x52, 0x16, 0x70, 0x72, 0x65, 0x73,
+	0x69, 0x67, 0x6e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x33, 0x54, 0x6f, 0x34, 0x4f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x12, 0x6b, 0x0a, 0x1c, 0x70, 0x72, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x52, 0x6f, 0x75,
+	0x6e, 0x64, 0x34, 0x54, 0x6f, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x4f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x70, 0x72, 0x65, 0x73, 0x69,
+	0x67, 0x6e, 0x2e, 0x50, 0x72, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x34,
+	0x54, 0x6f, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x48,
+	0x00, 0x52, 0x1c, 0x70, 0x72, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x34,
+	0x54, 0x6f, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x42,
+	0x05, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x22, 0x19, 0x0a, 0x17, 0x50, 0x72, 0x65, 0x73, 0x69, 0x67,
+	0x6e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x54, 0x6f, 0x31, 0x4f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x22, 0x32, 0x0a, 0x16, 0x50, 0x72, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x52, 0x6f, 0x75, 0x6e,
+	0x64, 0x31, 0x54, 0x6f, 0x32, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70,
+	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x32, 0x0a, 0x16, 0x50, 0x72, 0x65, 0x73, 0x69, 0x67, 0x6e,
+	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x32, 0x54, 0x6f, 0x33, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x32, 0x0a, 0x16, 0x50, 0x72, 0x65,
+	0x73, 0x69, 0x67, 0x6e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x33, 0x54, 0x6f, 0x34, 0x4f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x3d, 0x0a,
+	0x1c, 0x50, 0x72, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x34, 0x54, 0x6f,
+	0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x70, 0x72, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x70, 0x72, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x49, 0x64, 0x22, 0x88, 0x01, 0x0a,
+	0x16, 0x53, 0x69, 0x67, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x50, 0x72, 0x65, 0x73, 0x69, 0x67, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x65, 0x73, 0x69,
+	0x67, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x65,
+	0x73, 0x69, 0x67, 0x6e, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x1b, 0x0a, 0x09, 0x74, 0x78, 0x5f, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x08, 0x74, 0x78, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x18, 0x0a,
+	0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07,
+	0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x5d, 0x0a, 0x17, 0x53, 0x69, 0x67, 0x6e, 0x57,
+	0x69, 0x74, 0x68, 0x50, 0x72, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x0c, 0x0a, 0x01,
+	0x76, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x01, 0x76, 0x12, 0x0c, 0x0a, 0x01, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x01, 0x72, 0x12, 0x0c, 0x0a, 0x01, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x01, 0x73, 0x32, 0xa7, 0x01, 0x0a, 0x0e, 0x50, 0x72, 0x65, 0x73, 0x69,
+	0x67, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3f, 0x0a, 0x07, 0x50, 0x72, 0x65,
+	0x73, 0x69, 0x67, 0x6e, 0x12, 0x17, 0x2e, 0x70, 0x72, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x2e, 0x50,
+	0x72, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x17, 0x2e,
+	0x70, 0x72, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x2e, 0x50, 0x72, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x28, 0x01, 0x30, 0x01, 0x12, 0x54, 0x0a, 0x0f, 0x53, 0x69,
+	0x67, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x50, 0x72, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x12, 0x1f, 0x2e,
+	0x70, 0x72, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x57, 0x69, 0x74, 0x68,
+	0x50, 0x72, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20,
+	0x2e, 0x70, 0x72, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x57, 0x69, 0x74,
+	0x68, 0x50, 0x72, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x42, 0x16, 0x5a, 0x14, 0x74, 0x65, 0x63, 0x64, 0x73, 0x61, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2f, 0x70, 0x72, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_presign_presign_proto_rawDescOnce sync.Once
+	file_presign_presign_proto_rawDescData = file_presign_presign_proto_rawDesc
+)
+
+func file_presign_presign_proto_rawDescGZIP() []byte {
+	file_presign_presign_proto_rawDescOnce.Do(func() {
+		file_presign_presign_proto_rawDescData = protoimpl.X.CompressGZIP(file_presign_presign_proto_rawDescData)
+	})
+	return file_presign_presign_proto_rawDescData
+}
+
+var file_presign_presign_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_presign_presign_proto_goTypes = []any{
+	(*PresignMessage)(nil),               // 0: presign.PresignMessage
+	(*PresignGatewayTo1Output)(nil),      // 1: presign.PresignGatewayTo1Output
+	(*PresignRound1To2Output)(nil),       // 2: presign.PresignRound1To2Output
+	(*PresignRound2To3Output)(nil),       // 3: presign.PresignRound2To3Output
+	(*PresignRound3To4Output)(nil),       // 4: presign.PresignRound3To4Output
+	(*PresignRound4ToGatewayOutput)(nil), // 5: presign.PresignRound4ToGatewayOutput
+	(*SignWithPresignRequest)(nil),       // 6: presign.SignWithPresignRequest
+	(*SignWithPresignResponse)(nil),      // 7: presign.SignWithPresignResponse
+}
+var file_presign_presign_proto_depIdxs = []int32{
+	1, // 0: presign.PresignMessage.presignGatewayTo1Output:type_name -> presign.PresignGatewayTo1Output
+	2, // 1: presign.PresignMessage.presignRound1To2Output:type_name -> presign.PresignRound1To2Output
+	3, // 2: presign.PresignMessage.presignRound2To3Output:type_name -> presign.PresignRound2To3Output
+	4, // 3: presign.PresignMessage.presignRound3To4Output:type_name -> presign.PresignRound3To4Output
+	5, // 4: presign.PresignMessage.presignRound4ToGatewayOutput:type_name -> presign.PresignRound4ToGatewayOutput
+	0, // 5: presign.PresignService.Presign:input_type -> presign.PresignMessage
+	6, // 6: presign.PresignService.SignWithPresign:input_type -> presign.SignWithPresignRequest
+	0, // 7: presign.PresignService.Presign:output_type -> presign.PresignMessage
+	7, // 8: presign.PresignService.SignWithPresign:output_type -> presign.SignWithPresignResponse
+	7, // [7:9] is the sub-list for method output_type
+	5, // [5:7] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_presign_presign_proto_init() }
+func file_presign_presign_proto_init() {
+	if File_presign_presign_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_presign_presign_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*PresignMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_presign_presign_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*PresignGatewayTo1Output); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_presign_presign_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*PresignRound1To2Output); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_presign_presign_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*PresignRound2To3Output); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_presign_presign_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*PresignRound3To4Output); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_presign_presign_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*PresignRound4ToGatewayOutput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_presign_presign_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*SignWithPresignRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_presign_presign_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*SignWithPresignResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_presign_presign_proto_msgTypes[0].OneofWrappers = []any{
+		(*PresignMessage_PresignGatewayTo1Output)(nil),
+		(*PresignMessage_PresignRound1To2Output)(nil),
+		(*PresignMessage_PresignRound2To3Output)(nil),
+		(*PresignMessage_PresignRound3To4Output)(nil),
+		(*PresignMessage_PresignRound4ToGatewayOutput)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_presign_presign_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_presign_presign_proto_goTypes,
+		DependencyIndexes: file_presign_presign_proto_depIdxs,
+		MessageInfos:      file_presign_presign_proto_msgTypes,
+	}.Build()
+	File_presign_presign_proto = out.File
+	file_presign_presign_proto_rawDesc = nil
+	file_presign_presign_proto_goTypes = nil
+	file_presign_presign_proto_depIdxs = nil
+}
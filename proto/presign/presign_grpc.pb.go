@@ -0,0 +1,185 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.4.0
+// - protoc             v5.27.1
+// source: presign/presign.proto
+
+package presign
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.62.0 or later.
+const _ = grpc.SupportPackageIsVersion8
+
+const (
+	PresignService_Presign_FullMethodName         = "/presign.PresignService/Presign"
+	PresignService_SignWithPresign_FullMethodName = "/presign.PresignService/SignWithPresign"
+)
+
+// PresignServiceClient is the client API for PresignService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PresignServiceClient interface {
+	// 메시지와 무관한 서명 재료를 미리 계산합니다.
+	Presign(ctx context.Context, opts ...grpc.CallOption) (PresignService_PresignClient, error)
+	// presignature 를 한 번 사용해 서명합니다.
+	SignWithPresign(ctx context.Context, in *SignWithPresignRequest, opts ...grpc.CallOption) (*SignWithPresignResponse, error)
+}
+
+type presignServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPresignServiceClient(cc grpc.ClientConnInterface) PresignServiceClient {
+	return &presignServiceClient{cc}
+}
+
+func (c *presignServiceClient) Presign(ctx context.Context, opts ...grpc.CallOption) (PresignService_PresignClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &PresignService_ServiceDesc.Streams[0], PresignService_Presign_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &presignServicePresignClient{ClientStream: stream}
+	return x, nil
+}
+
+type PresignService_PresignClient interface {
+	Send(*PresignMessage) error
+	Recv() (*PresignMessage, error)
+	grpc.ClientStream
+}
+
+type presignServicePresignClient struct {
+	grpc.ClientStream
+}
+
+func (x *presignServicePresignClient) Send(m *PresignMessage) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *presignServicePresignClient) Recv() (*PresignMessage, error) {
+	m := new(PresignMessage)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *presignServiceClient) SignWithPresign(ctx context.Context, in *SignWithPresignRequest, opts ...grpc.CallOption) (*SignWithPresignResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SignWithPresignResponse)
+	err := c.cc.Invoke(ctx, PresignService_SignWithPresign_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PresignServiceServer is the server API for PresignService service.
+// All implementations must embed UnimplementedPresignServiceServer
+// for forward compatibility
+type PresignServiceServer interface {
+	// 메시지와 무관한 서명 재료를 미리 계산합니다.
+	Presign(PresignService_PresignServer) error
+	// presignature 를 한 번 사용해 서명합니다.
+	SignWithPresign(context.Context, *SignWithPresignRequest) (*SignWithPresignResponse, error)
+	mustEmbedUnimplementedPresignServiceServer()
+}
+
+// UnimplementedPresignServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedPresignServiceServer struct {
+}
+
+func (UnimplementedPresignServiceServer) Presign(PresignService_PresignServer) error {
+	return status.Errorf(codes.Unimplemented, "method Presign not implemented")
+}
+func (UnimplementedPresignServiceServer) SignWithPresign(context.Context, *SignWithPresignRequest) (*SignWithPresignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignWithPresign not implemented")
+}
+func (UnimplementedPresignServiceServer) mustEmbedUnimplementedPresignServiceServer() {}
+
+// UnsafePresignServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PresignServiceServer will
+// result in compilation errors.
+type UnsafePresignServiceServer interface {
+	mustEmbedUnimplementedPresignServiceServer()
+}
+
+func RegisterPresignServiceServer(s grpc.ServiceRegistrar, srv PresignServiceServer) {
+	s.RegisterService(&PresignService_ServiceDesc, srv)
+}
+
+func _PresignService_Presign_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(PresignServiceServer).Presign(&presignServicePresignServer{ServerStream: stream})
+}
+
+type PresignService_PresignServer interface {
+	Send(*PresignMessage) error
+	Recv() (*PresignMessage, error)
+	grpc.ServerStream
+}
+
+type presignServicePresignServer struct {
+	grpc.ServerStream
+}
+
+func (x *presignServicePresignServer) Send(m *PresignMessage) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *presignServicePresignServer) Recv() (*PresignMessage, error) {
+	m := new(PresignMessage)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _PresignService_SignWithPresign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignWithPresignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PresignServiceServer).SignWithPresign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PresignService_SignWithPresign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PresignServiceServer).SignWithPresign(ctx, req.(*SignWithPresignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PresignService_ServiceDesc is the grpc.ServiceDesc for PresignService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PresignService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "presign.PresignService",
+	HandlerType: (*PresignServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SignWithPresign",
+			Handler:    _PresignService_SignWithPresign_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Presign",
+			Handler:       _PresignService_Presign_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "presign/presign.proto",
+}
@@ -0,0 +1,595 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        v5.27.1
+// source: taproot/taproot.proto
+
+package taproot
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type TaprootSignMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Msg:
+	//
+	//	*TaprootSignMessage_TaprootSignGatewayTo1Output
+	//	*TaprootSignMessage_TaprootSignRound1To2Output
+	//	*TaprootSignMessage_TaprootSignRound2To3Output
+	//	*TaprootSignMessage_TaprootSignRound3To4Output
+	//	*TaprootSignMessage_TaprootSignRound4ToGatewayOutput
+	Msg isTaprootSignMessage_Msg `protobuf_oneof:"msg"`
+}
+
+func (x *TaprootSignMessage) Reset() {
+	*x = TaprootSignMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taproot_taproot_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TaprootSignMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaprootSignMessage) ProtoMessage() {}
+
+func (x *TaprootSignMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_taproot_taproot_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaprootSignMessage.ProtoReflect.Descriptor instead.
+func (*TaprootSignMessage) Descriptor() ([]byte, []int) {
+	return file_taproot_taproot_proto_rawDescGZIP(), []int{0}
+}
+
+func (m *TaprootSignMessage) GetMsg() isTaprootSignMessage_Msg {
+	if m != nil {
+		return m.Msg
+	}
+	return nil
+}
+
+func (x *TaprootSignMessage) GetTaprootSignGatewayTo1Output() *TaprootSignGatewayTo1Output {
+	if x, ok := x.GetMsg().(*TaprootSignMessage_TaprootSignGatewayTo1Output); ok {
+		return x.TaprootSignGatewayTo1Output
+	}
+	return nil
+}
+
+func (x *TaprootSignMessage) GetTaprootSignRound1To2Output() *TaprootSignRound1To2Output {
+	if x, ok := x.GetMsg().(*TaprootSignMessage_TaprootSignRound1To2Output); ok {
+		return x.TaprootSignRound1To2Output
+	}
+	return nil
+}
+
+func (x *TaprootSignMessage) GetTaprootSignRound2To3Output() *TaprootSignRound2To3Output {
+	if x, ok := x.GetMsg().(*TaprootSignMessage_TaprootSignRound2To3Output); ok {
+		return x.TaprootSignRound2To3Output
+	}
+	return nil
+}
+
+func (x *TaprootSignMessage) GetTaprootSignRound3To4Output() *TaprootSignRound3To4Output {
+	if x, ok := x.GetMsg().(*TaprootSignMessage_TaprootSignRound3To4Output); ok {
+		return x.TaprootSignRound3To4Output
+	}
+	return nil
+}
+
+func (x *TaprootSignMessage) GetTaprootSignRound4ToGatewayOutput() *TaprootSignRound4ToGatewayOutput {
+	if x, ok := x.GetMsg().(*TaprootSignMessage_TaprootSignRound4ToGatewayOutput); ok {
+		return x.TaprootSignRound4ToGatewayOutput
+	}
+	return nil
+}
+
+type isTaprootSignMessage_Msg interface {
+	isTaprootSignMessage_Msg()
+}
+
+type TaprootSignMessage_TaprootSignGatewayTo1Output struct {
+	TaprootSignGatewayTo1Output *TaprootSignGatewayTo1Output `protobuf:"bytes,1,opt,name=taprootSignGatewayTo1Output,proto3,oneof"`
+}
+
+type TaprootSignMessage_TaprootSignRound1To2Output struct {
+	TaprootSignRound1To2Output *TaprootSignRound1To2Output `protobuf:"bytes,2,opt,name=taprootSignRound1To2Output,proto3,oneof"`
+}
+
+type TaprootSignMessage_TaprootSignRound2To3Output struct {
+	TaprootSignRound2To3Output *TaprootSignRound2To3Output `protobuf:"bytes,3,opt,name=taprootSignRound2To3Output,proto3,oneof"`
+}
+
+type TaprootSignMessage_TaprootSignRound3To4Output struct {
+	TaprootSignRound3To4Output *TaprootSignRound3To4Output `protobuf:"bytes,4,opt,name=taprootSignRound3To4Output,proto3,oneof"`
+}
+
+type TaprootSignMessage_TaprootSignRound4ToGatewayOutput struct {
+	TaprootSignRound4ToGatewayOutput *TaprootSignRound4ToGatewayOutput `protobuf:"bytes,5,opt,name=taprootSignRound4ToGatewayOutput,proto3,oneof"`
+}
+
+func (*TaprootSignMessage_TaprootSignGatewayTo1Output) isTaprootSignMessage_Msg() {}
+
+func (*TaprootSignMessage_TaprootSignRound1To2Output) isTaprootSignMessage_Msg() {}
+
+func (*TaprootSignMessage_TaprootSignRound2To3Output) isTaprootSignMessage_Msg() {}
+
+func (*TaprootSignMessage_TaprootSignRound3To4Output) isTaprootSignMessage_Msg() {}
+
+func (*TaprootSignMessage_TaprootSignRound4ToGatewayOutput) isTaprootSignMessage_Msg() {}
+
+// 요청 -> 라운드 1
+type TaprootSignGatewayTo1Output struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *TaprootSignGatewayTo1Output) Reset() {
+	*x = TaprootSignGatewayTo1Output{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taproot_taproot_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TaprootSignGatewayTo1Output) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaprootSignGatewayTo1Output) ProtoMessage() {}
+
+func (x *TaprootSignGatewayTo1Output) ProtoReflect() protoreflect.Message {
+	mi := &file_taproot_taproot_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaprootSignGatewayTo1Output.ProtoReflect.Descriptor instead.
+func (*TaprootSignGatewayTo1Output) Descriptor() ([]byte, []int) {
+	return file_taproot_taproot_proto_rawDescGZIP(), []int{1}
+}
+
+// 라운드 1 -> 라운드 2 (Alice 의 세션 시드, 논스 커밋)
+type TaprootSignRound1To2Output struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Payload []byte `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+}
+
+func (x *TaprootSignRound1To2Output) Reset() {
+	*x = TaprootSignRound1To2Output{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taproot_taproot_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TaprootSignRound1To2Output) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaprootSignRound1To2Output) ProtoMessage() {}
+
+func (x *TaprootSignRound1To2Output) ProtoReflect() protoreflect.Message {
+	mi := &file_taproot_taproot_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaprootSignRound1To2Output.ProtoReflect.Descriptor instead.
+func (*TaprootSignRound1To2Output) Descriptor() ([]byte, []int) {
+	return file_taproot_taproot_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TaprootSignRound1To2Output) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+// 라운드 2 -> 라운드 3 (Bob 의 세션 시드, 곱셈 1라운드, 논스)
+type TaprootSignRound2To3Output struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Payload []byte `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+}
+
+func (x *TaprootSignRound2To3Output) Reset() {
+	*x = TaprootSignRound2To3Output{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taproot_taproot_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TaprootSignRound2To3Output) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaprootSignRound2To3Output) ProtoMessage() {}
+
+func (x *TaprootSignRound2To3Output) ProtoReflect() protoreflect.Message {
+	mi := &file_taproot_taproot_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaprootSignRound2To3Output.ProtoReflect.Descriptor instead.
+func (*TaprootSignRound2To3Output) Descriptor() ([]byte, []int) {
+	return file_taproot_taproot_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TaprootSignRound2To3Output) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+// 라운드 3 -> 라운드 4 (Alice 의 곱셈 2라운드, 논스, 부분 서명)
+type TaprootSignRound3To4Output struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Payload []byte `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+}
+
+func (x *TaprootSignRound3To4Output) Reset() {
+	*x = TaprootSignRound3To4Output{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taproot_taproot_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TaprootSignRound3To4Output) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaprootSignRound3To4Output) ProtoMessage() {}
+
+func (x *TaprootSignRound3To4Output) ProtoReflect() protoreflect.Message {
+	mi := &file_taproot_taproot_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaprootSignRound3To4Output.ProtoReflect.Descriptor instead.
+func (*TaprootSignRound3To4Output) Descriptor() ([]byte, []int) {
+	return file_taproot_taproot_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *TaprootSignRound3To4Output) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+// 라운드 4 -> 게이트웨이
+type TaprootSignRound4ToGatewayOutput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RequestId string `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// BIP340 서명 (R.x || s, 64바이트)
+	Signature []byte `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *TaprootSignRound4ToGatewayOutput) Reset() {
+	*x = TaprootSignRound4ToGatewayOutput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taproot_taproot_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TaprootSignRound4ToGatewayOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaprootSignRound4ToGatewayOutput) ProtoMessage() {}
+
+func (x *TaprootSignRound4ToGatewayOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_taproot_taproot_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaprootSignRound4ToGatewayOutput.ProtoReflect.Descriptor instead.
+func (*TaprootSignRound4ToGatewayOutput) Descriptor() ([]byte, []int) {
+	return file_taproot_taproot_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *TaprootSignRound4ToGatewayOutput) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *TaprootSignRound4ToGatewayOutput) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+var File_taproot_taproot_proto protoreflect.FileDescriptor
+
+var file_taproot_taproot_proto_rawDesc = []byte{
+	0x0a, 0x15, 0x74, 0x61, 0x70, 0x72, 0x6f, 0x6f, 0x74, 0x2f, 0x74, 0x61, 0x70, 0x72, 0x6f, 0x6f,
+	0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x74, 0x61, 0x70, 0x72, 0x6f, 0x6f, 0x74,
+	0x22, 0xb3, 0x04, 0x0a, 0x12, 0x54, 0x61, 0x70, 0x72, 0x6f, 0x6f, 0x74, 0x53, 0x69, 0x67, 0x6e,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x68, 0x0a, 0x1b, 0x74, 0x61, 0x70, 0x72, 0x6f,
+	0x6f, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x54, 0x6f, 0x31,
+	0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x74,
+	0x61, 0x70, 0x72, 0x6f, 0x6f, 0x74, 0x2e, 0x54, 0x61, 0x70, 0x72, 0x6f, 0x6f, 0x74, 0x53, 0x69,
+	0x67, 0x6e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x54, 0x6f, 0x31, 0x4f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x48, 0x00, 0x52, 0x1b, 0x74, 0x61, 0x70, 0x72, 0x6f, 0x6f, 0x74, 0x53, 0x69, 0x67,
+	0x6e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x54, 0x6f, 0x31, 0x4f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x12, 0x65, 0x0a, 0x1a, 0x74, 0x61, 0x70, 0x72, 0x6f, 0x6f, 0x74, 0x53, 0x69, 0x67, 0x6e,
+	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x31, 0x54, 0x6f, 0x32, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x6f, 0x6f, 0x74, 0x2e,
+	0x54, 0x61, 0x70, 0x72, 0x6f, 0x6f, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x6f, 0x75, 0x6e, 0x64,
+	0x31, 0x54, 0x6f, 0x32, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x48, 0x00, 0x52, 0x1a, 0x74, 0x61,
+	0x70, 0x72, 0x6f, 0x6f, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x31, 0x54,
+	0x6f, 0x32, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x65, 0x0a, 0x1a, 0x74, 0x61, 0x70, 0x72,
+	0x6f, 0x6f, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x32, 0x54, 0x6f, 0x33,
+	0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x74,
+	0x61, 0x70, 0x72, 0x6f, 0x6f, 0x74, 0x2e, 0x54, 0x61, 0x70, 0x72, 0x6f, 0x6f, 0x74, 0x53, 0x69,
+	0x67, 0x6e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x32, 0x54, 0x6f, 0x33, 0x4f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x48, 0x00, 0x52, 0x1a, 0x74, 0x61, 0x70, 0x72, 0x6f, 0x6f, 0x74, 0x53, 0x69, 0x67, 0x6e,
+	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x32, 0x54, 0x6f, 0x33, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12,
+	0x65, 0x0a, 0x1a, 0x74, 0x61, 0x70, 0x72, 0x6f, 0x6f, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x6f,
+	0x75, 0x6e, 0x64, 0x33, 0x54, 0x6f, 0x34, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x6f, 0x6f, 0x74, 0x2e, 0x54, 0x61,
+	0x70, 0x72, 0x6f, 0x6f, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x33, 0x54,
+	0x6f, 0x34, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x48, 0x00, 0x52, 0x1a, 0x74, 0x61, 0x70, 0x72,
+	0x6f, 0x6f, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x33, 0x54, 0x6f, 0x34,
+	0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x77, 0x0a, 0x20, 0x74, 0x61, 0x70, 0x72, 0x6f, 0x6f,
+	0x74, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x34, 0x54, 0x6f, 0x47, 0x61, 0x74,
+	0x65, 0x77, 0x61, 0x79, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x29, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x6f, 0x6f, 0x74, 0x2e, 0x54, 0x61, 0x70, 0x72, 0x6f,
+	0x6f, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x34, 0x54, 0x6f, 0x47, 0x61,
+	0x74, 0x65, 0x77, 0x61, 0x79, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x48, 0x00, 0x52, 0x20, 0x74,
+	0x61, 0x70, 0x72, 0x6f, 0x6f, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x34,
+	0x54, 0x6f, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x42,
+	0x05, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x22, 0x1d, 0x0a, 0x1b, 0x54, 0x61, 0x70, 0x72, 0x6f, 0x6f,
+	0x74, 0x53, 0x69, 0x67, 0x6e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x54, 0x6f, 0x31, 0x4f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x36, 0x0a, 0x1a, 0x54, 0x61, 0x70, 0x72, 0x6f, 0x6f, 0x74,
+	0x53, 0x69, 0x67, 0x6e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x31, 0x54, 0x6f, 0x32, 0x4f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x36, 0x0a,
+	0x1a, 0x54, 0x61, 0x70, 0x72, 0x6f, 0x6f, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x6f, 0x75, 0x6e,
+	0x64, 0x32, 0x54, 0x6f, 0x33, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70,
+	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x36, 0x0a, 0x1a, 0x54, 0x61, 0x70, 0x72, 0x6f, 0x6f, 0x74,
+	0x53, 0x69, 0x67, 0x6e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x33, 0x54, 0x6f, 0x34, 0x4f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x5f, 0x0a,
+	0x20, 0x54, 0x61, 0x70, 0x72, 0x6f, 0x6f, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x6f, 0x75, 0x6e,
+	0x64, 0x34, 0x54, 0x6f, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x4f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64,
+	0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x32, 0x5a,
+	0x0a, 0x12, 0x54, 0x61, 0x70, 0x72, 0x6f, 0x6f, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x12, 0x44, 0x0a, 0x04, 0x53, 0x69, 0x67, 0x6e, 0x12, 0x1b, 0x2e, 0x74,
+	0x61, 0x70, 0x72, 0x6f, 0x6f, 0x74, 0x2e, 0x54, 0x61, 0x70, 0x72, 0x6f, 0x6f, 0x74, 0x53, 0x69,
+	0x67, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x1b, 0x2e, 0x74, 0x61, 0x70, 0x72,
+	0x6f, 0x6f, 0x74, 0x2e, 0x54, 0x61, 0x70, 0x72, 0x6f, 0x6f, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x28, 0x01, 0x30, 0x01, 0x42, 0x16, 0x5a, 0x14, 0x74, 0x65,
+	0x63, 0x64, 0x73, 0x61, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x74, 0x61, 0x70, 0x72, 0x6f,
+	0x6f, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_taproot_taproot_proto_rawDescOnce sync.Once
+	file_taproot_taproot_proto_rawDescData = file_taproot_taproot_proto_rawDesc
+)
+
+func file_taproot_taproot_proto_rawDescGZIP() []byte {
+	file_taproot_taproot_proto_rawDescOnce.Do(func() {
+		file_taproot_taproot_proto_rawDescData = protoimpl.X.CompressGZIP(file_taproot_taproot_proto_rawDescData)
+	})
+	return file_taproot_taproot_proto_rawDescData
+}
+
+var file_taproot_taproot_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_taproot_taproot_proto_goTypes = []any{
+	(*TaprootSignMessage)(nil),               // 0: taproot.TaprootSignMessage
+	(*TaprootSignGatewayTo1Output)(nil),      // 1: taproot.TaprootSignGatewayTo1Output
+	(*TaprootSignRound1To2Output)(nil),       // 2: taproot.TaprootSignRound1To2Output
+	(*TaprootSignRound2To3Output)(nil),       // 3: taproot.TaprootSignRound2To3Output
+	(*TaprootSignRound3To4Output)(nil),       // 4: taproot.TaprootSignRound3To4Output
+	(*TaprootSignRound4ToGatewayOutput)(nil), // 5: taproot.TaprootSignRound4ToGatewayOutput
+}
+var file_taproot_taproot_proto_depIdxs = []int32{
+	1, // 0: taproot.TaprootSignMessage.taprootSignGatewayTo1Output:type_name -> taproot.TaprootSignGatewayTo1Output
+	2, // 1: taproot.TaprootSignMessage.taprootSignRound1To2Output:type_name -> taproot.TaprootSignRound1To2Output
+	3, // 2: taproot.TaprootSignMessage.taprootSignRound2To3Output:type_name -> taproot.TaprootSignRound2To3Output
+	4, // 3: taproot.TaprootSignMessage.taprootSignRound3To4Output:type_name -> taproot.TaprootSignRound3To4Output
+	5, // 4: taproot.TaprootSignMessage.taprootSignRound4ToGatewayOutput:type_name -> taproot.TaprootSignRound4ToGatewayOutput
+	0, // 5: taproot.TaprootSignService.Sign:input_type -> taproot.TaprootSignMessage
+	0, // 6: taproot.TaprootSignService.Sign:output_type -> taproot.TaprootSignMessage
+	6, // [6:7] is the sub-list for method output_type
+	5, // [5:6] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_taproot_taproot_proto_init() }
+func file_taproot_taproot_proto_init() {
+	if File_taproot_taproot_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_taproot_taproot_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*TaprootSignMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taproot_taproot_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*TaprootSignGatewayTo1Output); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taproot_taproot_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*TaprootSignRound1To2Output); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taproot_taproot_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*TaprootSignRound2To3Output); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taproot_taproot_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*TaprootSignRound3To4Output); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taproot_taproot_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*TaprootSignRound4ToGatewayOutput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_taproot_taproot_proto_msgTypes[0].OneofWrappers = []any{
+		(*TaprootSignMessage_TaprootSignGatewayTo1Output)(nil),
+		(*TaprootSignMessage_TaprootSignRound1To2Output)(nil),
+		(*TaprootSignMessage_TaprootSignRound2To3Output)(nil),
+		(*TaprootSignMessage_TaprootSignRound3To4Output)(nil),
+		(*TaprootSignMessage_TaprootSignRound4ToGatewayOutput)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_taproot_taproot_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_taproot_taproot_proto_goTypes,
+		DependencyIndexes: file_taproot_taproot_proto_depIdxs,
+		MessageInfos:      file_taproot_taproot_proto_msgTypes,
+	}.Build()
+	File_taproot_taproot_proto = out.File
+	file_taproot_taproot_proto_rawDesc = nil
+	file_taproot_taproot_proto_goTypes = nil
+	file_taproot_taproot_proto_depIdxs = nil
+}
@@ -0,0 +1,142 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.4.0
+// - protoc             v5.27.1
+// source: taproot/taproot.proto
+
+package taproot
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.62.0 or later.
+const _ = grpc.SupportPackageIsVersion8
+
+const (
+	TaprootSignService_Sign_FullMethodName = "/taproot.TaprootSignService/Sign"
+)
+
+// TaprootSignServiceClient is the client API for TaprootSignService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TaprootSignServiceClient interface {
+	Sign(ctx context.Context, opts ...grpc.CallOption) (TaprootSignService_SignClient, error)
+}
+
+type taprootSignServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTaprootSignServiceClient(cc grpc.ClientConnInterface) TaprootSignServiceClient {
+	return &taprootSignServiceClient{cc}
+}
+
+func (c *taprootSignServiceClient) Sign(ctx context.Context, opts ...grpc.CallOption) (TaprootSignService_SignClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &TaprootSignService_ServiceDesc.Streams[0], TaprootSignService_Sign_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &taprootSignServiceSignClient{ClientStream: stream}
+	return x, nil
+}
+
+type TaprootSignService_SignClient interface {
+	Send(*TaprootSignMessage) error
+	Recv() (*TaprootSignMessage, error)
+	grpc.ClientStream
+}
+
+type taprootSignServiceSignClient struct {
+	grpc.ClientStream
+}
+
+func (x *taprootSignServiceSignClient) Send(m *TaprootSignMessage) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *taprootSignServiceSignClient) Recv() (*TaprootSignMessage, error) {
+	m := new(TaprootSignMessage)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// TaprootSignServiceServer is the server API for TaprootSignService service.
+// All implementations must embed UnimplementedTaprootSignServiceServer
+// for forward compatibility
+type TaprootSignServiceServer interface {
+	Sign(TaprootSignService_SignServer) error
+	mustEmbedUnimplementedTaprootSignServiceServer()
+}
+
+// UnimplementedTaprootSignServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedTaprootSignServiceServer struct {
+}
+
+func (UnimplementedTaprootSignServiceServer) Sign(TaprootSignService_SignServer) error {
+	return status.Errorf(codes.Unimplemented, "method Sign not implemented")
+}
+func (UnimplementedTaprootSignServiceServer) mustEmbedUnimplementedTaprootSignServiceServer() {}
+
+// UnsafeTaprootSignServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TaprootSignServiceServer will
+// result in compilation errors.
+type UnsafeTaprootSignServiceServer interface {
+	mustEmbedUnimplementedTaprootSignServiceServer()
+}
+
+func RegisterTaprootSignServiceServer(s grpc.ServiceRegistrar, srv TaprootSignServiceServer) {
+	s.RegisterService(&TaprootSignService_ServiceDesc, srv)
+}
+
+func _TaprootSignService_Sign_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(TaprootSignServiceServer).Sign(&taprootSignServiceSignServer{ServerStream: stream})
+}
+
+type TaprootSignService_SignServer interface {
+	Send(*TaprootSignMessage) error
+	Recv() (*TaprootSignMessage, error)
+	grpc.ServerStream
+}
+
+type taprootSignServiceSignServer struct {
+	grpc.ServerStream
+}
+
+func (x *taprootSignServiceSignServer) Send(m *TaprootSignMessage) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *taprootSignServiceSignServer) Recv() (*TaprootSignMessage, error) {
+	m := new(TaprootSignMessage)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// TaprootSignService_ServiceDesc is the grpc.ServiceDesc for TaprootSignService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TaprootSignService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "taproot.TaprootSignService",
+	HandlerType: (*TaprootSignServiceServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Sign",
+			Handler:       _TaprootSignService_Sign_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "taproot/taproot.proto",
+}
@@ -0,0 +1,219 @@
+// Package harness 는 게이트웨이, Alice, Bob 을 한 프로세스에서 실행하는 테스트 하네스입니다.
+// Alice 와 Bob 은 bufconn gRPC 로, 게이트웨이는 httptest 서버로 띄우고
+// 각자 SQLite 인메모리 데이터베이스를 사용하므로 docker-compose 나 외부 RPC 없이 테스트할 수 있습니다.
+package harness
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+
+	"tecdsa/cmd/gateway/config"
+	gatewayServer "tecdsa/cmd/gateway/server"
+	"tecdsa/pkg/database"
+	"tecdsa/pkg/database/repository"
+	"tecdsa/pkg/response"
+	"tecdsa/pkg/service"
+
+	aliceServer "tecdsa/cmd/alice/server"
+	bobServer "tecdsa/cmd/bob/server"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+const (
+	aliceAddress = "alice"
+	bobAddress   = "bob"
+	bufSize      = 1024 * 1024
+)
+
+// Harness 는 실행 중인 게이트웨이와 두 파티입니다.
+type Harness struct {
+	// Gateway 는 게이트웨이 HTTP 서버입니다. Gateway.URL 로 요청을 보냅니다.
+	Gateway   *httptest.Server
+	Config    *config.Config
+	GatewayDB *gorm.DB
+	AliceDB   *gorm.DB
+	BobDB     *gorm.DB
+
+	server    *gatewayServer.Server
+	aliceGRPC *grpc.Server
+	bobGRPC   *grpc.Server
+}
+
+// Start 는 게이트웨이, Alice, Bob 을 시작합니다. configure 로 게이트웨이 설정 (풀 크기 등) 을 바꿀 수 있습니다.
+func Start(configure ...func(*config.Config)) (*Harness, error) {
+	h := &Harness{}
+	networkService := service.NewNetworkService()
+
+	var err error
+	if h.AliceDB, err = openMemoryDatabase(); err != nil {
+		return nil, err
+	}
+	if h.BobDB, err = openMemoryDatabase(); err != nil {
+		return nil, err
+	}
+	if h.GatewayDB, err = openMemoryDatabase(); err != nil {
+		return nil, err
+	}
+
+	aliceListener := bufconn.Listen(bufSize)
+	h.aliceGRPC = grpc.NewServer()
+	aliceServer.Register(h.aliceGRPC,
+		repository.NewPartialSecretShareRepository(h.AliceDB),
+		repository.NewKeyAddressRepository(h.AliceDB),
+		repository.NewPresignatureRepository(h.AliceDB),
+		networkService)
+	go h.aliceGRPC.Serve(aliceListener)
+
+	bobListener := bufconn.Listen(bufSize)
+	h.bobGRPC = grpc.NewServer()
+	bobServer.Register(h.bobGRPC,
+		repository.NewPartialSecretShareRepository(h.BobDB),
+		repository.NewKeyAddressRepository(h.BobDB),
+		repository.NewPresignatureRepository(h.BobDB),
+		networkService)
+	go h.bobGRPC.Serve(bobListener)
+
+	listeners := map[string]*bufconn.Listener{
+		aliceAddress: aliceListener,
+		bobAddress:   bobListener,
+	}
+	h.Config = &config.Config{
+		AliceGRPCAddress: aliceAddress,
+		BobGRPCAddress:   bobAddress,
+		DialOptions: []grpc.DialOption{
+			grpc.WithContextDialer(func(ctx context.Context, address string) (net.Conn, error) {
+				listener, ok := listeners[address]
+				if !ok {
+					return nil, fmt.Errorf("unknown party address: %s", address)
+				}
+				return listener.DialContext(ctx)
+			}),
+		},
+	}
+	for _, fn := range configure {
+		fn(h.Config)
+	}
+
+	h.server = gatewayServer.NewServer(h.Config,
+		repository.NewClientSecurityRepository(h.GatewayDB),
+		repository.NewKeyRepository(h.GatewayDB),
+		repository.NewKeyAddressRepository(h.GatewayDB),
+		repository.NewPresignatureRepository(h.GatewayDB),
+		repository.NewContractABIRepository(h.GatewayDB),
+		repository.NewNonceRepository(h.GatewayDB),
+		repository.NewRequestNonceRepository(h.GatewayDB))
+	h.server.StartPresignPool()
+	if err := h.server.StartKeyPool(); err != nil {
+		h.Close()
+		return nil, err
+	}
+	h.Gateway = httptest.NewServer(h.server)
+
+	return h, nil
+}
+
+// Close 는 게이트웨이와 두 파티를 종료합니다.
+func (h *Harness) Close() {
+	if h.Gateway != nil {
+		h.Gateway.Close()
+	}
+	h.server.Close()
+	h.aliceGRPC.Stop()
+	h.bobGRPC.Stop()
+}
+
+// Register 는 하네스 클라이언트 (127.0.0.1) 를 게이트웨이에 등록합니다.
+func (h *Harness) Register() error {
+	return h.RegisterFrom("")
+}
+
+// RegisterFrom 은 ip 에서 접속한 클라이언트를 게이트웨이에 등록합니다. ip 가 비어 있으면 127.0.0.1 입니다.
+func (h *Harness) RegisterFrom(ip string) error {
+	return h.PostFrom(ip, "/register", map[string]string{"public_key": "harness"}, nil)
+}
+
+// Post 는 게이트웨이에 JSON 요청을 보내고 응답의 data 를 out 에 디코딩합니다.
+// 게이트웨이가 오류를 응답하면 *response.ErrorResponse 를 돌려줍니다.
+func (h *Harness) Post(path string, req interface{}, out interface{}) error {
+	return h.PostFrom("", path, req, out)
+}
+
+// PostFrom 은 ip 에서 접속한 클라이언트로 Post 를 보냅니다. 게이트웨이는 X-Real-IP 헤더로 클라이언트를 구분합니다.
+func (h *Harness) PostFrom(ip string, path string, req interface{}, out interface{}) error {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+	return h.do(ip, http.MethodPost, path, bytes.NewReader(body), out)
+}
+
+// Get 은 게이트웨이에 GET 요청을 보내고 응답의 data 를 out 에 디코딩합니다.
+func (h *Harness) Get(path string, out interface{}) error {
+	return h.GetFrom("", path, out)
+}
+
+// GetFrom 은 ip 에서 접속한 클라이언트로 Get 을 보냅니다.
+func (h *Harness) GetFrom(ip string, path string, out interface{}) error {
+	return h.do(ip, http.MethodGet, path, nil, out)
+}
+
+func (h *Harness) do(ip string, method string, path string, body io.Reader, out interface{}) error {
+	req, err := http.NewRequest(method, h.Gateway.URL+path, body)
+	if err != nil {
+		return err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if ip != "" {
+		req.Header.Set("X-Real-IP", ip)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	return decodeResponse(resp, out)
+}
+
+func decodeResponse(resp *http.Response, out interface{}) error {
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		errResp := &response.ErrorResponse{}
+		if err := json.Unmarshal(body, errResp); err != nil || errResp.Message == "" {
+			return fmt.Errorf("unexpected status %d: %s", resp.StatusCode, body)
+		}
+		return errResp
+	}
+	if out == nil {
+		return nil
+	}
+	return json.Unmarshal(body, &struct {
+		Data interface{} `json:"data"`
+	}{Data: out})
+}
+
+// openMemoryDatabase 는 마이그레이션된 SQLite 인메모리 데이터베이스를 엽니다.
+// 인메모리 데이터베이스는 연결마다 따로 만들어지므로 연결을 하나로 고정합니다.
+func openMemoryDatabase() (*gorm.DB, error) {
+	sqlDB, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		return nil, err
+	}
+	sqlDB.SetMaxOpenConns(1)
+	return database.Open(sqlite.Dialector{Conn: sqlDB})
+}
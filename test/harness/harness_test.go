@@ -0,0 +1,763 @@
+package harness
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"tecdsa/cmd/gateway/config"
+	"tecdsa/cmd/gateway/handlers"
+	gatewayServer "tecdsa/cmd/gateway/server"
+	"tecdsa/pkg/auth"
+	"tecdsa/pkg/database/models"
+	"tecdsa/pkg/database/repository"
+	"tecdsa/pkg/network"
+	"tecdsa/pkg/response"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/btcsuite/btcutil/base58"
+	"github.com/coinbase/kryptology/pkg/core/curves"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func startHarness(t *testing.T) *Harness {
+	h, err := Start()
+	require.NoError(t, err)
+	t.Cleanup(h.Close)
+	require.NoError(t, h.Register())
+	return h
+}
+
+func randomMessage(t *testing.T) []byte {
+	message := make([]byte, 64)
+	_, err := rand.Read(message)
+	require.NoError(t, err)
+	return message
+}
+
+func TestEthereumKeyGenSignVerify(t *testing.T) {
+	h := startHarness(t)
+
+	var key handlers.KeyGenResponse
+	require.NoError(t, h.Post("/key_gen", map[string]interface{}{"network": 4}, &key))
+	assert.Equal(t, "secp256k1", key.Curve)
+
+	message := randomMessage(t)
+	var sig handlers.SignResponse
+	require.NoError(t, h.Post("/sign", map[string]interface{}{
+		"address":   key.Address,
+		"tx_origin": base64.StdEncoding.EncodeToString(message),
+	}, &sig))
+
+	// 복구한 공개키의 주소가 발급한 주소와 같아야 합니다.
+	assert.Equal(t, key.Address, recoverEthereumAddress(t, message, sig))
+}
+
+func TestNeoP256KeyGenSignVerify(t *testing.T) {
+	h := startHarness(t)
+
+	var key handlers.KeyGenResponse
+	require.NoError(t, h.Post("/key_gen", map[string]interface{}{"network": 10}, &key))
+	assert.Equal(t, "p256", key.Curve)
+
+	message := randomMessage(t)
+	var sig handlers.SignResponse
+	require.NoError(t, h.Post("/sign", map[string]interface{}{
+		"address":   key.Address,
+		"tx_origin": base64.StdEncoding.EncodeToString(message),
+	}, &sig))
+	assert.Nil(t, sig.V)
+
+	publicKeyBytes, err := hex.DecodeString(key.Publickey)
+	require.NoError(t, err)
+	x, y := elliptic.UnmarshalCompressed(elliptic.P256(), publicKeyBytes)
+	require.NotNil(t, x)
+	publicKey := &ecdsa.PublicKey{Curve: elliptic.P256(), X: x, Y: y}
+
+	raw, err := base64.StdEncoding.DecodeString(sig.Signature)
+	require.NoError(t, err)
+	require.Len(t, raw, 64)
+	digest := sha256.Sum256(message)
+	assert.True(t, ecdsa.Verify(publicKey, digest[:], new(big.Int).SetBytes(raw[:32]), new(big.Int).SetBytes(raw[32:])))
+
+	der, err := base64.StdEncoding.DecodeString(sig.SignatureDER)
+	require.NoError(t, err)
+	assert.True(t, ecdsa.VerifyASN1(publicKey, digest[:], der))
+}
+
+func TestKeyGenRejectsP256ForSecp256k1Networks(t *testing.T) {
+	h := startHarness(t)
+
+	// 비트코인, EVM 네트워크는 secp256k1 주소만 만들 수 있으므로 P-256 키를 만들지 않습니다.
+	for _, net := range []int{1, 2, 4, 6} {
+		err := h.Post("/key_gen", map[string]interface{}{"network": net, "curve": int(network.P256)}, nil)
+		var errResp *response.ErrorResponse
+		require.ErrorAs(t, err, &errResp, "network %d", net)
+		assert.Equal(t, response.ErrCodeBadRequest, errResp.ErrorCode)
+		assert.Equal(t, response.ErrMsgUnsupportedCurve, errResp.Message)
+	}
+	var count int64
+	require.NoError(t, h.GatewayDB.Model(&models.Key{}).Count(&count).Error)
+	assert.Equal(t, int64(0), count)
+}
+
+func TestSolanaEd25519KeyGenSignVerify(t *testing.T) {
+	h := startHarness(t)
+
+	var key handlers.KeyGenResponse
+	require.NoError(t, h.Post("/key_gen_ed25519", map[string]interface{}{"network": 8}, &key))
+	assert.Equal(t, "ed25519", key.Curve)
+
+	message := randomMessage(t)
+	var sig handlers.EddsaSignResponse
+	require.NoError(t, h.Post("/sign_ed25519", map[string]interface{}{
+		"address":   key.Address,
+		"tx_origin": base64.StdEncoding.EncodeToString(message),
+	}, &sig))
+
+	// 솔라나 주소는 공개키의 base58 입니다.
+	publicKey := ed25519.PublicKey(base58.Decode(key.Address))
+	signature, err := base64.StdEncoding.DecodeString(sig.Signature)
+	require.NoError(t, err)
+	assert.True(t, ed25519.Verify(publicKey, message, signature))
+}
+
+func TestKeyGenAddressTypes(t *testing.T) {
+	h := startHarness(t)
+
+	// 지원하지 않는 주소 유형은 키를 만들기 전에 거부합니다.
+	for _, req := range []map[string]interface{}{
+		{"network": 1, "address_type": network.P2TR + 1},
+		{"network": 1, "address_type": -1},
+		{"network": 4, "address_type": network.P2WPKH},
+	} {
+		err := h.Post("/key_gen", req, nil)
+		var errResp *response.ErrorResponse
+		require.ErrorAs(t, err, &errResp, "%v", req)
+		assert.Equal(t, response.ErrCodeBadRequest, errResp.ErrorCode)
+		assert.Equal(t, response.ErrMsgInvalidAddressType, errResp.Message)
+	}
+	var count int64
+	require.NoError(t, h.GatewayDB.Model(&models.Key{}).Count(&count).Error)
+	assert.Equal(t, int64(0), count)
+
+	// 요청한 주소 유형의 주소를 공개키에서 만듭니다.
+	var key handlers.KeyGenResponse
+	require.NoError(t, h.Post("/key_gen", map[string]interface{}{"network": 2, "address_type": network.P2SHP2WPKH}, &key))
+	assert.Equal(t, int32(network.P2SHP2WPKH), key.AddressType)
+	publicKey, err := hex.DecodeString(key.Publickey)
+	require.NoError(t, err)
+	point, err := curves.K256().Point.FromAffineCompressed(publicKey)
+	require.NoError(t, err)
+	expected, err := network.DeriveBitcoinAddress(point, network.BitcoinTestNet, network.P2SHP2WPKH)
+	require.NoError(t, err)
+	assert.Equal(t, expected, key.Address)
+	assert.Equal(t, byte('2'), key.Address[0])
+}
+
+func TestSignRequiresRegisteredClient(t *testing.T) {
+	h, err := Start()
+	require.NoError(t, err)
+	defer h.Close()
+
+	err = h.Post("/key_gen", map[string]interface{}{"network": 4}, nil)
+	assert.Error(t, err)
+}
+
+func TestKeyPoolAssignsPregeneratedKey(t *testing.T) {
+	h, err := Start(func(cfg *config.Config) {
+		cfg.KeyPoolTargets = []config.KeyPoolTarget{{Network: 4, Size: 1}}
+		cfg.KeyPoolRefillInterval = 50 * time.Millisecond
+	})
+	require.NoError(t, err)
+	t.Cleanup(h.Close)
+	require.NoError(t, h.Register())
+
+	poolDepth := func() handlers.KeyPoolMetrics {
+		var metrics struct {
+			Pools []handlers.KeyPoolMetrics `json:"pools"`
+		}
+		require.NoError(t, h.Get("/key_pool", &metrics))
+		require.Len(t, metrics.Pools, 1)
+		return metrics.Pools[0]
+	}
+	require.Eventually(t, func() bool { return poolDepth().Depth == 1 }, 30*time.Second, 50*time.Millisecond)
+
+	var key handlers.KeyGenResponse
+	require.NoError(t, h.Post("/key_gen", map[string]interface{}{"network": 4}, &key))
+
+	// 풀의 키가 할당되었고, 게이트웨이와 두 파티 모두 클라이언트가 기록되어야 합니다.
+	assert.Equal(t, int64(1), poolDepth().AssignedTotal)
+	for _, db := range []*gorm.DB{h.AliceDB, h.BobDB} {
+		var share models.ParitalSecretShare
+		require.NoError(t, db.Where("key_id = ?", key.KeyID).First(&share).Error)
+		assert.NotZero(t, share.ClientSecurityID)
+	}
+
+	message := randomMessage(t)
+	var sig handlers.SignResponse
+	require.NoError(t, h.Post("/sign", map[string]interface{}{
+		"address":   key.Address,
+		"tx_origin": base64.StdEncoding.EncodeToString(message),
+	}, &sig))
+	assert.Equal(t, key.Address, recoverEthereumAddress(t, message, sig))
+}
+
+func TestKeyPoolConcurrentAssign(t *testing.T) {
+	const poolSize = 3
+	h, err := Start(func(cfg *config.Config) {
+		cfg.KeyPoolTargets = []config.KeyPoolTarget{{Network: 4, Size: poolSize}}
+		cfg.KeyPoolRefillInterval = 50 * time.Millisecond
+	})
+	require.NoError(t, err)
+	t.Cleanup(h.Close)
+
+	poolMetrics := func() handlers.KeyPoolMetrics {
+		var metrics struct {
+			Pools []handlers.KeyPoolMetrics `json:"pools"`
+		}
+		require.NoError(t, h.GetFrom("10.0.0.1", "/key_pool", &metrics))
+		require.Len(t, metrics.Pools, 1)
+		return metrics.Pools[0]
+	}
+	shareClient := func(db *gorm.DB, keyID uint32) uint {
+		var share models.ParitalSecretShare
+		require.NoError(t, db.Where("key_id = ?", keyID).First(&share).Error)
+		return share.ClientSecurityID
+	}
+
+	ips := make([]string, poolSize)
+	for i := range ips {
+		ips[i] = fmt.Sprintf("10.0.0.%d", i+1)
+		require.NoError(t, h.RegisterFrom(ips[i]))
+	}
+	require.Eventually(t, func() bool { return poolMetrics().Depth == poolSize }, 30*time.Second, 50*time.Millisecond)
+
+	// 여러 클라이언트가 동시에 요청해도 풀의 키는 한 클라이언트에만 할당됩니다.
+	keys := make([]handlers.KeyGenResponse, poolSize)
+	var wg sync.WaitGroup
+	for i, ip := range ips {
+		wg.Add(1)
+		go func(i int, ip string) {
+			defer wg.Done()
+			assert.NoError(t, h.PostFrom(ip, "/key_gen", map[string]interface{}{"network": 4}, &keys[i]))
+		}(i, ip)
+	}
+	wg.Wait()
+
+	metrics := poolMetrics()
+	assert.Equal(t, int64(poolSize), metrics.AssignedTotal)
+	assert.GreaterOrEqual(t, metrics.GeneratedTotal, int64(poolSize))
+	assert.Zero(t, metrics.FailedTotal)
+	seen := make(map[uint32]bool)
+	for i, ip := range ips {
+		require.False(t, seen[keys[i].KeyID], "key %d assigned twice", keys[i].KeyID)
+		seen[keys[i].KeyID] = true
+
+		var client models.ClientSecurity
+		require.NoError(t, h.GatewayDB.Where("ip = ?", ip).First(&client).Error)
+		var key models.Key
+		require.NoError(t, h.GatewayDB.First(&key, keys[i].KeyID).Error)
+		assert.Equal(t, uint(client.ID), key.ClientSecurityID)
+		assert.Equal(t, uint(client.ID), shareClient(h.AliceDB, key.ID))
+		assert.Equal(t, uint(client.ID), shareClient(h.BobDB, key.ID))
+	}
+
+	// 할당한 만큼 다시 채웁니다.
+	require.Eventually(t, func() bool {
+		metrics := poolMetrics()
+		return metrics.Depth == poolSize && metrics.GeneratedTotal == 2*poolSize
+	}, 30*time.Second, 50*time.Millisecond)
+	assert.Greater(t, poolMetrics().RefillRatePerMinute, 0.0)
+
+	// 파티에 할당한 뒤 게이트웨이에서 주소를 찾지 못하면, 게이트웨이와 두 파티 모두 할당을 되돌리고 DKG 로 키를 만듭니다.
+	var broken models.Key
+	require.NoError(t, h.GatewayDB.Where("client_security_id = ? AND public_key <> ?", 0, "").Order("id").First(&broken).Error)
+	require.NoError(t, h.GatewayDB.Unscoped().Where("key_id = ?", broken.ID).Delete(&models.KeyAddress{}).Error)
+
+	var key handlers.KeyGenResponse
+	require.NoError(t, h.PostFrom(ips[0], "/key_gen", map[string]interface{}{"network": 4}, &key))
+	assert.NotEqual(t, broken.ID, key.KeyID)
+	assert.Equal(t, int64(poolSize), poolMetrics().AssignedTotal)
+	require.NoError(t, h.GatewayDB.First(&broken, broken.ID).Error)
+	assert.Zero(t, broken.ClientSecurityID)
+	assert.Zero(t, shareClient(h.AliceDB, broken.ID))
+	assert.Zero(t, shareClient(h.BobDB, broken.ID))
+}
+
+func TestKeyPoolDeletesSharesOfFailedKeys(t *testing.T) {
+	h, err := Start(func(cfg *config.Config) {
+		cfg.KeyPoolTargets = []config.KeyPoolTarget{{Network: 4, Size: 1}}
+		cfg.KeyPoolRefillInterval = 50 * time.Millisecond
+	})
+	require.NoError(t, err)
+	t.Cleanup(h.Close)
+	require.NoError(t, h.Register())
+
+	poolMetrics := func() handlers.KeyPoolMetrics {
+		var metrics struct {
+			Pools []handlers.KeyPoolMetrics `json:"pools"`
+		}
+		require.NoError(t, h.Get("/key_pool", &metrics))
+		require.Len(t, metrics.Pools, 1)
+		return metrics.Pools[0]
+	}
+	require.Eventually(t, func() bool { return poolMetrics().Depth == 1 }, 30*time.Second, 50*time.Millisecond)
+
+	// 두 파티가 쉐어를 저장한 뒤 게이트웨이가 키를 기록하지 못하게 합니다.
+	failComplete := func(db *gorm.DB) {
+		if dest, ok := db.Statement.Dest.(map[string]interface{}); ok && db.Statement.Table == "keys" && dest["public_key"] != nil {
+			db.AddError(fmt.Errorf("complete failed"))
+		}
+	}
+	require.NoError(t, h.GatewayDB.Callback().Update().Before("gorm:update").Register("test:fail_complete", failComplete))
+	var key handlers.KeyGenResponse
+	require.NoError(t, h.Post("/key_gen", map[string]interface{}{"network": 4}, &key))
+	require.Eventually(t, func() bool { return poolMetrics().FailedTotal >= 2 }, 30*time.Second, 50*time.Millisecond)
+	require.NoError(t, h.GatewayDB.Callback().Update().Remove("test:fail_complete"))
+	require.Eventually(t, func() bool { return poolMetrics().Depth == 1 }, 30*time.Second, 50*time.Millisecond)
+
+	// 실패한 키의 쉐어와 주소는 파티에 남지 않고, 파티에는 게이트웨이의 키 (할당한 키와 풀의 키) 만 남습니다.
+	var keyIDs []uint32
+	require.NoError(t, h.GatewayDB.Model(&models.Key{}).Order("id").Pluck("id", &keyIDs).Error)
+	require.Len(t, keyIDs, 2)
+	for _, db := range []*gorm.DB{h.AliceDB, h.BobDB} {
+		var shareKeyIDs, addressKeyIDs []uint32
+		require.NoError(t, db.Unscoped().Model(&models.ParitalSecretShare{}).Order("key_id").Pluck("key_id", &shareKeyIDs).Error)
+		require.NoError(t, db.Unscoped().Model(&models.KeyAddress{}).Order("key_id").Distinct().Pluck("key_id", &addressKeyIDs).Error)
+		assert.Equal(t, keyIDs, shareKeyIDs)
+		assert.Equal(t, keyIDs, addressKeyIDs)
+	}
+}
+
+func TestKeyAddresses(t *testing.T) {
+	h := startHarness(t)
+	owner, other := "10.0.0.1", "10.0.0.2"
+	require.NoError(t, h.RegisterFrom(owner))
+	require.NoError(t, h.RegisterFrom(other))
+
+	var key handlers.KeyGenResponse
+	require.NoError(t, h.PostFrom(owner, "/key_gen", map[string]interface{}{"network": 4}, &key))
+	path := fmt.Sprintf("/keys/%d/addresses", key.KeyID)
+
+	// 다른 클라이언트는 주소를 조회하거나 추가할 수 없습니다.
+	var errResp *response.ErrorResponse
+	err := h.GetFrom(other, path, nil)
+	require.ErrorAs(t, err, &errResp)
+	assert.Equal(t, response.ErrCodeForbidden, errResp.ErrorCode)
+	err = h.PostFrom(other, path, map[string]interface{}{"network": 6}, nil)
+	require.ErrorAs(t, err, &errResp)
+	assert.Equal(t, response.ErrCodeForbidden, errResp.ErrorCode)
+	err = h.GetFrom(owner, "/keys/9999/addresses", nil)
+	require.ErrorAs(t, err, &errResp)
+	assert.Equal(t, response.ErrCodeNotFound, errResp.ErrorCode)
+
+	// 같은 키를 다른 네트워크의 주소로도 사용합니다. EVM 네트워크는 주소가 같고, 비트코인은 주소 유형별로 주소를 파생합니다.
+	var avalanche handlers.KeyAddressResponse
+	require.NoError(t, h.PostFrom(owner, path, map[string]interface{}{"network": 6}, &avalanche))
+	assert.Equal(t, key.Address, avalanche.Address)
+
+	publicKeyBytes, err := hex.DecodeString(key.Publickey)
+	require.NoError(t, err)
+	point, err := curves.K256().Point.FromAffineCompressed(publicKeyBytes)
+	require.NoError(t, err)
+	var bitcoin handlers.KeyAddressResponse
+	require.NoError(t, h.PostFrom(owner, path, map[string]interface{}{"network": 2, "address_type": network.P2WPKH}, &bitcoin))
+	expected, err := network.DeriveBitcoinAddress(point, network.BitcoinTestNet, network.P2WPKH)
+	require.NoError(t, err)
+	assert.Equal(t, expected, bitcoin.Address)
+
+	// 이미 등록된 주소를 다시 추가하면 기존 주소를 돌려줍니다.
+	var again handlers.KeyAddressResponse
+	require.NoError(t, h.PostFrom(owner, path, map[string]interface{}{"network": 2, "address_type": network.P2WPKH}, &again))
+	assert.Equal(t, bitcoin, again)
+
+	var addresses []handlers.KeyAddressResponse
+	require.NoError(t, h.GetFrom(owner, path, &addresses))
+	require.Len(t, addresses, 3)
+	assert.Equal(t, int32(4), addresses[0].Network)
+	assert.Equal(t, avalanche, addresses[1])
+	assert.Equal(t, bitcoin, addresses[2])
+
+	// 파티에도 같은 주소가 등록되어 있어야 합니다.
+	for _, db := range []*gorm.DB{h.AliceDB, h.BobDB} {
+		var count int64
+		require.NoError(t, db.Model(&models.KeyAddress{}).Where("key_id = ? AND address = ?", key.KeyID, bitcoin.Address).Count(&count).Error)
+		assert.Equal(t, int64(1), count)
+	}
+}
+
+func TestNonceRequiresKeyOwner(t *testing.T) {
+	h, err := Start(func(cfg *config.Config) {
+		cfg.ChainNonce = func(network.Network, string) (uint64, error) { return 7, nil }
+	})
+	require.NoError(t, err)
+	t.Cleanup(h.Close)
+	owner, other := "10.0.0.1", "10.0.0.2"
+	require.NoError(t, h.RegisterFrom(owner))
+	require.NoError(t, h.RegisterFrom(other))
+
+	var key handlers.KeyGenResponse
+	require.NoError(t, h.PostFrom(owner, "/key_gen", map[string]interface{}{"network": 4}, &key))
+	path := "/nonces/4/" + key.Address
+
+	// 다른 클라이언트는 논스를 할당, 조회, 해제, 재동기화할 수 없습니다.
+	for _, call := range []func() error{
+		func() error {
+			return h.PostFrom(other, "/create_unsigned_tx/4", map[string]interface{}{"from": key.Address, "to": key.Address, "amount": "1"}, nil)
+		},
+		func() error { return h.GetFrom(other, path, nil) },
+		func() error { return h.PostFrom(other, path+"/release", map[string]interface{}{"nonce": 7}, nil) },
+		func() error { return h.PostFrom(other, path+"/resync", map[string]interface{}{}, nil) },
+	} {
+		var errResp *response.ErrorResponse
+		require.ErrorAs(t, call(), &errResp)
+		assert.Equal(t, response.ErrCodeForbidden, errResp.ErrorCode)
+		assert.Equal(t, response.ErrMsgKeyNotOwned, errResp.Message)
+	}
+	var count int64
+	require.NoError(t, h.GatewayDB.Model(&models.NonceReservation{}).Count(&count).Error)
+	assert.Equal(t, int64(0), count)
+
+	// 게이트웨이 키가 아닌 주소는 찾을 수 없습니다.
+	var errResp *response.ErrorResponse
+	err = h.GetFrom(owner, "/nonces/4/0x000000000000000000000000000000000000dEaD", nil)
+	require.ErrorAs(t, err, &errResp)
+	assert.Equal(t, response.ErrCodeNotFound, errResp.ErrorCode)
+
+	// 키의 클라이언트는 체크섬이 없는 주소로도 조회할 수 있습니다.
+	var state struct {
+		ChainNonce uint64 `json:"chain_nonce"`
+		NextNonce  uint64 `json:"next_nonce"`
+	}
+	require.NoError(t, h.GetFrom(owner, "/nonces/4/"+strings.ToLower(key.Address), &state))
+	assert.Equal(t, uint64(7), state.ChainNonce)
+	assert.Equal(t, uint64(7), state.NextNonce)
+	require.NoError(t, h.PostFrom(owner, path+"/resync", map[string]interface{}{}, nil))
+}
+
+// newWitnessPSBT 는 addresses (P2WPKH) 의 이전 출력을 쓰는 입력으로 최소한의 BIP174 PSBT (base64) 를 만듭니다.
+func newWitnessPSBT(t *testing.T, addresses ...string) string {
+	tx := wire.NewMsgTx(wire.TxVersion)
+	utxos := make([]*wire.TxOut, 0, len(addresses))
+	for i, address := range addresses {
+		decoded, err := btcutil.DecodeAddress(address, &chaincfg.TestNet3Params)
+		require.NoError(t, err)
+		pkScript, err := txscript.PayToAddrScript(decoded)
+		require.NoError(t, err)
+		tx.AddTxIn(wire.NewTxIn(&wire.OutPoint{Index: uint32(i)}, nil, nil))
+		utxos = append(utxos, wire.NewTxOut(50000, pkScript))
+	}
+	tx.AddTxOut(wire.NewTxOut(int64(40000*len(addresses)), utxos[0].PkScript))
+
+	writePair := func(buf *bytes.Buffer, key, value []byte) {
+		require.NoError(t, wire.WriteVarBytes(buf, 0, key))
+		require.NoError(t, wire.WriteVarBytes(buf, 0, value))
+	}
+	var buf, unsigned bytes.Buffer
+	buf.WriteString("psbt\xff")
+	require.NoError(t, tx.SerializeNoWitness(&unsigned))
+	writePair(&buf, []byte{0x00}, unsigned.Bytes())
+	buf.WriteByte(0x00)
+	for _, utxo := range utxos {
+		var value bytes.Buffer
+		require.NoError(t, wire.WriteTxOut(&value, 0, 0, utxo))
+		writePair(&buf, []byte{0x01}, value.Bytes())
+		buf.WriteByte(0x00)
+	}
+	buf.WriteByte(0x00)
+	return base64.StdEncoding.EncodeToString(buf.Bytes())
+}
+
+func TestPSBTSignOnlyOwnedInputs(t *testing.T) {
+	h := startHarness(t)
+	owner, other, stranger := "10.0.0.1", "10.0.0.2", "10.0.0.3"
+	for _, ip := range []string{owner, other, stranger} {
+		require.NoError(t, h.RegisterFrom(ip))
+	}
+	var ownerKey, otherKey handlers.KeyGenResponse
+	require.NoError(t, h.PostFrom(owner, "/key_gen", map[string]interface{}{"network": 2, "address_type": network.P2WPKH}, &ownerKey))
+	require.NoError(t, h.PostFrom(other, "/key_gen", map[string]interface{}{"network": 2, "address_type": network.P2WPKH}, &otherKey))
+	psbt := newWitnessPSBT(t, ownerKey.Address, otherKey.Address)
+
+	// 다른 클라이언트의 키 주소인 입력은 서명하지 않습니다.
+	var errResp *response.ErrorResponse
+	err := h.PostFrom(stranger, "/sign/psbt", map[string]interface{}{"network": 2, "psbt": psbt}, nil)
+	require.ErrorAs(t, err, &errResp)
+	assert.Equal(t, response.ErrCodeBadRequest, errResp.ErrorCode)
+	assert.Equal(t, response.ErrMsgNoOwnedPSBTInputs, errResp.Message)
+
+	var ownerSigned handlers.PSBTSignResponse
+	require.NoError(t, h.PostFrom(owner, "/sign/psbt", map[string]interface{}{"network": 2, "psbt": psbt, "finalize": true}, &ownerSigned))
+	assert.Equal(t, []int{0}, ownerSigned.SignedInputs)
+	assert.False(t, ownerSigned.Complete)
+
+	// 각 클라이언트가 자기 입력을 서명하면 PSBT 가 완성됩니다.
+	var otherSigned handlers.PSBTSignResponse
+	require.NoError(t, h.PostFrom(other, "/sign/psbt", map[string]interface{}{"network": 2, "psbt": ownerSigned.PSBT, "finalize": true}, &otherSigned))
+	assert.Equal(t, []int{1}, otherSigned.SignedInputs)
+	assert.True(t, otherSigned.Complete)
+	assert.NotEmpty(t, otherSigned.SignedTx)
+}
+
+func TestKeyXpub(t *testing.T) {
+	h := startHarness(t)
+	owner, other := "10.0.0.1", "10.0.0.2"
+	require.NoError(t, h.RegisterFrom(owner))
+	require.NoError(t, h.RegisterFrom(other))
+
+	var key handlers.KeyGenResponse
+	require.NoError(t, h.PostFrom(owner, "/key_gen", map[string]interface{}{"network": 2, "address_type": network.P2WPKH}, &key))
+	path := fmt.Sprintf("/keys/%d/xpub", key.KeyID)
+
+	var errResp *response.ErrorResponse
+	err := h.GetFrom(other, path, nil)
+	require.ErrorAs(t, err, &errResp)
+	assert.Equal(t, response.ErrCodeForbidden, errResp.ErrorCode)
+
+	// 조회는 키를 바꾸지 않으며, 몇 번을 조회해도 키를 만들 때 정한 체인코드의 tpub 을 돌려줍니다.
+	var stored models.Key
+	require.NoError(t, h.GatewayDB.First(&stored, key.KeyID).Error)
+	require.Len(t, stored.ChainCode, 32)
+	publicKeyBytes, err := hex.DecodeString(key.Publickey)
+	require.NoError(t, err)
+	point, err := curves.K256().Point.FromAffineCompressed(publicKeyBytes)
+	require.NoError(t, err)
+	expected, err := network.DeriveBitcoinXpub(point, stored.ChainCode, network.BitcoinTestNet)
+	require.NoError(t, err)
+
+	for i := 0; i < 2; i++ {
+		var xpub handlers.KeyXpubResponse
+		require.NoError(t, h.GetFrom(owner, path, &xpub))
+		assert.Equal(t, expected, xpub.Xpub)
+		require.Len(t, xpub.Descriptors, 4)
+		assert.Equal(t, key.Address, xpub.Descriptors[2].Address)
+	}
+	var after models.Key
+	require.NoError(t, h.GatewayDB.First(&after, key.KeyID).Error)
+	assert.Equal(t, stored.UpdatedAt, after.UpdatedAt)
+
+	// 비트코인 키가 아니면 내보낼 수 없습니다.
+	var ethereumKey handlers.KeyGenResponse
+	require.NoError(t, h.PostFrom(owner, "/key_gen", map[string]interface{}{"network": 4}, &ethereumKey))
+	err = h.GetFrom(owner, fmt.Sprintf("/keys/%d/xpub", ethereumKey.KeyID), nil)
+	require.ErrorAs(t, err, &errResp)
+	assert.Equal(t, response.ErrCodeBadRequest, errResp.ErrorCode)
+}
+
+func TestPresignatureSign(t *testing.T) {
+	h, err := Start(func(cfg *config.Config) {
+		cfg.PresignPoolSize = 1
+		cfg.PresignRefillInterval = 50 * time.Millisecond
+	})
+	require.NoError(t, err)
+	t.Cleanup(h.Close)
+	require.NoError(t, h.Register())
+
+	var key handlers.KeyGenResponse
+	require.NoError(t, h.Post("/key_gen", map[string]interface{}{"network": 4}, &key))
+
+	countPresignatures := func(db *gorm.DB, status int32) int64 {
+		var count int64
+		require.NoError(t, db.Model(&models.Presignature{}).Where("status = ?", status).Count(&count).Error)
+		return count
+	}
+	require.Eventually(t, func() bool {
+		return countPresignatures(h.GatewayDB, models.PresignatureAvailable) == 1
+	}, 30*time.Second, 50*time.Millisecond)
+
+	message := randomMessage(t)
+	var sig handlers.SignResponse
+	require.NoError(t, h.Post("/sign", map[string]interface{}{
+		"address":   key.Address,
+		"tx_origin": base64.StdEncoding.EncodeToString(message),
+	}, &sig))
+	assert.Equal(t, key.Address, recoverEthereumAddress(t, message, sig))
+
+	// presignature 는 세 곳 모두에서 한 번 사용되어야 합니다.
+	for _, db := range []*gorm.DB{h.GatewayDB, h.AliceDB, h.BobDB} {
+		assert.Equal(t, int64(1), countPresignatures(db, models.PresignatureUsed))
+	}
+}
+
+func TestPresignatureStaleShareInvalidatesPool(t *testing.T) {
+	h, err := Start(func(cfg *config.Config) {
+		cfg.PresignPoolSize = 2
+		cfg.PresignRefillInterval = 50 * time.Millisecond
+	})
+	require.NoError(t, err)
+	t.Cleanup(h.Close)
+	require.NoError(t, h.Register())
+
+	var key handlers.KeyGenResponse
+	require.NoError(t, h.Post("/key_gen", map[string]interface{}{"network": 4}, &key))
+	// 게이트웨이는 두 파티가 presignature 를 저장한 뒤에 기록하므로 게이트웨이와 Alice 를 모두 기다립니다.
+	countAvailable := func(db *gorm.DB) int64 {
+		var count int64
+		require.NoError(t, db.Model(&models.Presignature{}).Where("status = ?", models.PresignatureAvailable).Count(&count).Error)
+		return count
+	}
+	require.Eventually(t, func() bool {
+		return countAvailable(h.GatewayDB) == 2 && countAvailable(h.AliceDB) == 2
+	}, 30*time.Second, 50*time.Millisecond)
+
+	// 키 리프레시 전의 쉐어로 만든 presignature 처럼 Alice 의 쉐어 다이제스트를 바꿉니다.
+	require.NoError(t, h.AliceDB.Model(&models.Presignature{}).Where("status = ?", models.PresignatureAvailable).
+		Update("share_digest", "stale").Error)
+
+	// presign 서명이 실패하면 전체 서명 프로토콜로 서명합니다.
+	message := randomMessage(t)
+	var sig handlers.SignResponse
+	require.NoError(t, h.Post("/sign", map[string]interface{}{
+		"address":   key.Address,
+		"tx_origin": base64.StdEncoding.EncodeToString(message),
+	}, &sig))
+	assert.Equal(t, key.Address, recoverEthereumAddress(t, message, sig))
+
+	// Alice 는 오래된 presignature 를 모두 버리고, 게이트웨이도 풀을 비웁니다.
+	countStale := func(status int32) int64 {
+		var count int64
+		require.NoError(t, h.AliceDB.Model(&models.Presignature{}).Where("share_digest = ? AND status = ?", "stale", status).Count(&count).Error)
+		return count
+	}
+	assert.Equal(t, int64(0), countStale(models.PresignatureAvailable))
+	assert.Equal(t, int64(1), countStale(models.PresignatureUsed))
+	assert.Equal(t, int64(1), countStale(models.PresignatureInvalidated))
+	var invalidated int64
+	require.NoError(t, h.GatewayDB.Model(&models.Presignature{}).Where("status = ?", models.PresignatureInvalidated).Count(&invalidated).Error)
+	assert.GreaterOrEqual(t, invalidated, int64(1))
+}
+
+func TestSignedRequests(t *testing.T) {
+	h, err := Start(func(cfg *config.Config) {
+		cfg.RequireSignedRequests = true
+	})
+	require.NoError(t, err)
+	t.Cleanup(h.Close)
+
+	key, err := auth.GenerateKey()
+	require.NoError(t, err)
+	publicKey, err := auth.MarshalPublicKey(key.Public())
+	require.NoError(t, err)
+	var registered handlers.RegisterClientSecurityResponse
+	body, err := json.Marshal(handlers.RegisterClientSecurityRequest{PublicKey: publicKey})
+	require.NoError(t, err)
+	resp, err := http.Post(h.Gateway.URL+"/register", "application/json", bytes.NewReader(body))
+	require.NoError(t, err)
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&registered))
+	resp.Body.Close()
+
+	postSigned := func(signer *ecdsa.PrivateKey, path string, req interface{}, out interface{}) error {
+		body, err := json.Marshal(req)
+		require.NoError(t, err)
+		httpReq, err := http.NewRequest(http.MethodPost, h.Gateway.URL+path, bytes.NewReader(body))
+		require.NoError(t, err)
+		require.NoError(t, auth.SignRequest(httpReq, body, registered.ID, signer, time.Now()))
+		resp, err := http.DefaultClient.Do(httpReq)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		return decodeResponse(resp, out)
+	}
+
+	// 서명이 없는 요청은 거부합니다.
+	err = h.Post("/key_gen", map[string]interface{}{"network": 4}, nil)
+	var errResp *response.ErrorResponse
+	require.ErrorAs(t, err, &errResp)
+	assert.Equal(t, response.ErrCodeUnauthorized, errResp.ErrorCode)
+
+	// 등록하지 않은 키로 서명한 요청은 거부합니다.
+	otherKey, err := auth.GenerateKey()
+	require.NoError(t, err)
+	err = postSigned(otherKey, "/key_gen", map[string]interface{}{"network": 4}, nil)
+	require.ErrorAs(t, err, &errResp)
+	assert.Equal(t, response.ErrCodeUnauthorized, errResp.ErrorCode)
+
+	var keyGen handlers.KeyGenResponse
+	require.NoError(t, postSigned(key, "/key_gen", map[string]interface{}{"network": 4}, &keyGen))
+
+	// 같은 서명 헤더와 본문을 그대로 다시 보내면 재전송으로 거부합니다.
+	body, err = json.Marshal(map[string]interface{}{"network": 4})
+	require.NoError(t, err)
+	signed, err := http.NewRequest(http.MethodPost, h.Gateway.URL+"/key_gen", bytes.NewReader(body))
+	require.NoError(t, err)
+	require.NoError(t, auth.SignRequest(signed, body, registered.ID, key, time.Now()))
+	replayTo := func(gatewayURL string) error {
+		httpReq, err := http.NewRequest(http.MethodPost, gatewayURL+"/key_gen", bytes.NewReader(body))
+		require.NoError(t, err)
+		httpReq.Header = signed.Header.Clone()
+		resp, err := http.DefaultClient.Do(httpReq)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		return decodeResponse(resp, nil)
+	}
+	replay := func() error { return replayTo(h.Gateway.URL) }
+	require.NoError(t, replay())
+	err = replay()
+	require.ErrorAs(t, err, &errResp)
+	assert.Equal(t, response.ErrCodeUnauthorized, errResp.ErrorCode)
+
+	// 논스는 데이터베이스에 기록하므로, 같은 데이터베이스를 사용하는 다른 (또는 다시 시작한) 게이트웨이도 재전송을 거부합니다.
+	other := httptest.NewServer(gatewayServer.NewServer(h.Config,
+		repository.NewClientSecurityRepository(h.GatewayDB),
+		repository.NewKeyRepository(h.GatewayDB),
+		repository.NewKeyAddressRepository(h.GatewayDB),
+		repository.NewPresignatureRepository(h.GatewayDB),
+		repository.NewContractABIRepository(h.GatewayDB),
+		repository.NewNonceRepository(h.GatewayDB),
+		repository.NewRequestNonceRepository(h.GatewayDB)))
+	t.Cleanup(other.Close)
+	err = replayTo(other.URL)
+	require.ErrorAs(t, err, &errResp)
+	assert.Equal(t, response.ErrCodeUnauthorized, errResp.ErrorCode)
+
+	// 논스가 없는 서명 요청도 거부합니다.
+	signed.Header.Del(auth.HeaderNonce)
+	err = replay()
+	require.ErrorAs(t, err, &errResp)
+	assert.Equal(t, response.ErrCodeUnauthorized, errResp.ErrorCode)
+
+	message := randomMessage(t)
+	var sig handlers.SignResponse
+	require.NoError(t, postSigned(key, "/sign", map[string]interface{}{
+		"address":   keyGen.Address,
+		"tx_origin": base64.StdEncoding.EncodeToString(message),
+	}, &sig))
+	assert.Equal(t, keyGen.Address, recoverEthereumAddress(t, message, sig))
+}
+
+// recoverEthereumAddress 는 서명에서 복구한 공개키의 이더리움 주소를 돌려줍니다.
+func recoverEthereumAddress(t *testing.T, message []byte, sig handlers.SignResponse) string {
+	require.NotNil(t, sig.V)
+	r, err := base64.StdEncoding.DecodeString(sig.R)
+	require.NoError(t, err)
+	s, err := base64.StdEncoding.DecodeString(sig.S)
+	require.NoError(t, err)
+
+	signature := make([]byte, 65)
+	copy(signature[32-len(r):32], r)
+	copy(signature[64-len(s):64], s)
+	signature[64] = byte(*sig.V)
+	recovered, err := crypto.SigToPub(crypto.Keccak256(message), signature)
+	require.NoError(t, err)
+	return crypto.PubkeyToAddress(*recovered).Hex()
+}